@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"rslbot.com/go/internal/jsonutil"
 	"rslbot.com/go/pkg/rbapi"
 	"rslbot.com/go/pkg/rbdb"
@@ -17,6 +19,17 @@ var (
 	licenseDuration string
 	licenseKey      string
 	searchTerm      string
+	paymentStatus   string
+	dryRun          bool
+	revenueFrom     string
+	revenueTo       string
+	includeSandbox  bool
+	webhookStatus   string
+	webhookProvider string
+	webhookLimit    int32
+	webhookEventId  int64
+	stuckMinutes    int32
+	stuckLimit      int32
 )
 
 var adminCmd = &cobra.Command{
@@ -38,14 +51,41 @@ func init() {
 
 	// Add flags for SearchDatabaseCmd
 	SearchDatabaseCmd.Flags().StringVar(&searchTerm, "term", "", "Search term to query the database")
-	if err := SearchDatabaseCmd.MarkFlagRequired("term"); err != nil {
+	SearchDatabaseCmd.Flags().StringVar(&paymentStatus, "payment-status", "", "Only return payments with this status (COMPLETED, PENDING, FAILED, REFUNDED, PARTIALLY_REFUNDED)")
+
+	// Add flags for RevenueCmd
+	RevenueCmd.Flags().StringVar(&revenueFrom, "from", "", "Only count payments created on or after this date (YYYY-MM-DD)")
+	RevenueCmd.Flags().StringVar(&revenueTo, "to", "", "Only count payments created before this date (YYYY-MM-DD)")
+	RevenueCmd.Flags().BoolVar(&includeSandbox, "include-sandbox", false, "Include sandbox payments")
+
+	// Add flags for SyncDiscourseGroupCmd
+	SyncDiscourseGroupCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the planned membership changes")
+
+	// Add flags for WebhookEventsCmd
+	WebhookEventsCmd.Flags().StringVar(&webhookStatus, "status", "FAILED", "Only list events with this status (PENDING, PROCESSED, FAILED, empty for all)")
+	WebhookEventsCmd.Flags().StringVar(&webhookProvider, "provider", "", "Only list events from this provider (PAYPAL, STRIPE, DISCOURSE)")
+	WebhookEventsCmd.Flags().Int32Var(&webhookLimit, "limit", 50, "Maximum number of events to list")
+
+	// Add flags for ReplayWebhookCmd
+	ReplayWebhookCmd.Flags().Int64Var(&webhookEventId, "id", 0, "ID of the webhook event to replay")
+	if err := ReplayWebhookCmd.MarkFlagRequired("id"); err != nil {
 		panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
 	}
 
+	// Add flags for StuckOrdersCmd
+	StuckOrdersCmd.Flags().Int32Var(&stuckMinutes, "older-than", 60, "Only list orders pending for more than this many minutes")
+	StuckOrdersCmd.Flags().Int32Var(&stuckLimit, "limit", 50, "Maximum number of orders to list")
+
 	// Add command to parent
 	adminCmd.AddCommand(CreateLicenseCmd)
 	adminCmd.AddCommand(RevokeLicenseCmd)
 	adminCmd.AddCommand(SearchDatabaseCmd)
+	adminCmd.AddCommand(SyncDiscourseGroupCmd)
+	adminCmd.AddCommand(ProvisionPayPalPlansCmd)
+	adminCmd.AddCommand(RevenueCmd)
+	adminCmd.AddCommand(WebhookEventsCmd)
+	adminCmd.AddCommand(ReplayWebhookCmd)
+	adminCmd.AddCommand(StuckOrdersCmd)
 }
 
 var activeUsersCmd = &cobra.Command{
@@ -215,6 +255,18 @@ var SearchDatabaseCmd = &cobra.Command{
 		cmd.SilenceUsage = true
 		ctx := cmd.Context()
 
+		status := rbdb.Payment_STATUS_UNSPECIFIED
+		if paymentStatus != "" {
+			value, ok := rbdb.Payment_Status_value["STATUS_"+paymentStatus]
+			if !ok {
+				return fmt.Errorf("invalid payment status: %s", paymentStatus)
+			}
+			status = rbdb.Payment_Status(value)
+		}
+		if searchTerm == "" && status == rbdb.Payment_STATUS_UNSPECIFIED {
+			return fmt.Errorf("--term or --payment-status is required")
+		}
+
 		// Check if we need to get a new token
 		token, err := loadToken()
 		if err != nil || token.isExpired() {
@@ -241,7 +293,8 @@ var SearchDatabaseCmd = &cobra.Command{
 
 		// Search database
 		resp, err := client.AdminSearchDatabase(ctx, &rbapi.AdminSearchDatabase_Input{
-			SearchTerm: searchTerm,
+			SearchTerm:    searchTerm,
+			PaymentStatus: status,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to search database: %w", err)
@@ -293,3 +346,317 @@ var SearchDatabaseCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var SyncDiscourseGroupCmd = &cobra.Command{
+	Use:   "sync-discourse-group",
+	Short: "Sync the Discourse license holders group with active licenses",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		// Check if we need to get a new token
+		token, err := loadToken()
+		if err != nil || token.isExpired() {
+			token, err = getNewToken()
+			if err != nil {
+				return fmt.Errorf("failed to get new token: %w", err)
+			}
+			if err := saveToken(token); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+		}
+
+		// Create HTTP client with auth
+		httpClient := &http.Client{
+			Transport: &http.Transport{},
+		}
+		httpClient.Transport = &authTransport{
+			token:     token,
+			transport: httpClient.Transport,
+		}
+
+		// Create API client
+		client := rbapi.NewHTTPClient(httpClient, serverAddr)
+
+		// Call AdminSyncDiscourseGroup
+		resp, err := client.AdminSyncDiscourseGroup(ctx, &rbapi.AdminSyncDiscourseGroup_Input{
+			DryRun: dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to sync discourse group: %w", err)
+		}
+
+		if resp.DryRun {
+			fmt.Println("Planned changes (dry run):")
+		} else {
+			fmt.Println("Discourse group synced:")
+		}
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+
+		return nil
+	},
+}
+
+var ProvisionPayPalPlansCmd = &cobra.Command{
+	Use:   "provision-paypal-plans",
+	Short: "Create the PayPal billing plans of recurring licenses from the price table",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		// Check if we need to get a new token
+		token, err := loadToken()
+		if err != nil || token.isExpired() {
+			token, err = getNewToken()
+			if err != nil {
+				return fmt.Errorf("failed to get new token: %w", err)
+			}
+			if err := saveToken(token); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+		}
+
+		// Create HTTP client with auth
+		httpClient := &http.Client{
+			Transport: &http.Transport{},
+		}
+		httpClient.Transport = &authTransport{
+			token:     token,
+			transport: httpClient.Transport,
+		}
+
+		// Create API client
+		client := rbapi.NewHTTPClient(httpClient, serverAddr)
+
+		// Call AdminProvisionPayPalPlans
+		resp, err := client.AdminProvisionPayPalPlans(ctx, &rbapi.AdminProvisionPayPalPlans_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to provision paypal plans: %w", err)
+		}
+
+		fmt.Printf("PayPal plans provisioned (%d created):\n", resp.Created)
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+
+		return nil
+	},
+}
+
+var RevenueCmd = &cobra.Command{
+	Use:   "revenue",
+	Short: "Get revenue totals per provider and currency, refunds excluded",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		input := &rbapi.AdminGetRevenue_Input{IncludeSandbox: includeSandbox}
+		if revenueFrom != "" {
+			from, err := time.Parse(time.DateOnly, revenueFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from date: %w", err)
+			}
+			input.From = timestamppb.New(from)
+		}
+		if revenueTo != "" {
+			to, err := time.Parse(time.DateOnly, revenueTo)
+			if err != nil {
+				return fmt.Errorf("invalid --to date: %w", err)
+			}
+			input.To = timestamppb.New(to)
+		}
+
+		// Check if we need to get a new token
+		token, err := loadToken()
+		if err != nil || token.isExpired() {
+			token, err = getNewToken()
+			if err != nil {
+				return fmt.Errorf("failed to get new token: %w", err)
+			}
+			if err := saveToken(token); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+		}
+
+		// Create HTTP client with auth
+		httpClient := &http.Client{
+			Transport: &http.Transport{},
+		}
+		httpClient.Transport = &authTransport{
+			token:     token,
+			transport: httpClient.Transport,
+		}
+
+		// Create API client
+		client := rbapi.NewHTTPClient(httpClient, serverAddr)
+
+		// Call AdminGetRevenue
+		resp, err := client.AdminGetRevenue(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to get revenue: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+
+		return nil
+	},
+}
+
+var WebhookEventsCmd = &cobra.Command{
+	Use:   "webhook-events",
+	Short: "List the payment webhook events of the inbox, failed ones by default",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		input := &rbapi.AdminListWebhookEvents_Input{Limit: webhookLimit}
+		if webhookStatus != "" {
+			value, ok := rbdb.WebhookEvent_Status_value["STATUS_"+webhookStatus]
+			if !ok {
+				return fmt.Errorf("invalid status: %s", webhookStatus)
+			}
+			input.Status = rbdb.WebhookEvent_Status(value)
+		}
+		if webhookProvider != "" {
+			value, ok := rbdb.WebhookEvent_Provider_value["PROVIDER_"+webhookProvider]
+			if !ok {
+				return fmt.Errorf("invalid provider: %s", webhookProvider)
+			}
+			input.Provider = rbdb.WebhookEvent_Provider(value)
+		}
+
+		// Check if we need to get a new token
+		token, err := loadToken()
+		if err != nil || token.isExpired() {
+			token, err = getNewToken()
+			if err != nil {
+				return fmt.Errorf("failed to get new token: %w", err)
+			}
+			if err := saveToken(token); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+		}
+
+		// Create HTTP client with auth
+		httpClient := &http.Client{
+			Transport: &http.Transport{},
+		}
+		httpClient.Transport = &authTransport{
+			token:     token,
+			transport: httpClient.Transport,
+		}
+
+		// Create API client
+		client := rbapi.NewHTTPClient(httpClient, serverAddr)
+
+		// Call AdminListWebhookEvents
+		resp, err := client.AdminListWebhookEvents(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to list webhook events: %w", err)
+		}
+
+		fmt.Println("Webhook events found:", len(resp.Events))
+		for _, event := range resp.Events {
+			// The payload is only useful when replaying
+			event.Payload = ""
+			fmt.Println(jsonutil.PrettyJSONPB(event))
+		}
+
+		return nil
+	},
+}
+
+var ReplayWebhookCmd = &cobra.Command{
+	Use:   "replay-webhook",
+	Short: "Process a webhook event of the inbox again",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		// Check if we need to get a new token
+		token, err := loadToken()
+		if err != nil || token.isExpired() {
+			token, err = getNewToken()
+			if err != nil {
+				return fmt.Errorf("failed to get new token: %w", err)
+			}
+			if err := saveToken(token); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+		}
+
+		// Create HTTP client with auth
+		httpClient := &http.Client{
+			Transport: &http.Transport{},
+		}
+		httpClient.Transport = &authTransport{
+			token:     token,
+			transport: httpClient.Transport,
+		}
+
+		// Create API client
+		client := rbapi.NewHTTPClient(httpClient, serverAddr)
+
+		// Call AdminReplayWebhookEvent
+		resp, err := client.AdminReplayWebhookEvent(ctx, &rbapi.AdminReplayWebhookEvent_Input{Id: webhookEventId})
+		if err != nil {
+			return fmt.Errorf("failed to replay webhook event: %w", err)
+		}
+
+		fmt.Printf("Webhook event replayed, status %s:\n", resp.Event.Status)
+		resp.Event.Payload = ""
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Event))
+
+		return nil
+	},
+}
+
+var StuckOrdersCmd = &cobra.Command{
+	Use:   "stuck-orders",
+	Short: "Report the checkout orders stuck in each pending state",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		// Check if we need to get a new token
+		token, err := loadToken()
+		if err != nil || token.isExpired() {
+			token, err = getNewToken()
+			if err != nil {
+				return fmt.Errorf("failed to get new token: %w", err)
+			}
+			if err := saveToken(token); err != nil {
+				return fmt.Errorf("failed to save token: %w", err)
+			}
+		}
+
+		// Create HTTP client with auth
+		httpClient := &http.Client{
+			Transport: &http.Transport{},
+		}
+		httpClient.Transport = &authTransport{
+			token:     token,
+			transport: httpClient.Transport,
+		}
+
+		// Create API client
+		client := rbapi.NewHTTPClient(httpClient, serverAddr)
+
+		// Call AdminListStuckOrders
+		resp, err := client.AdminListStuckOrders(ctx, &rbapi.AdminListStuckOrders_Input{
+			OlderThanMinutes: stuckMinutes,
+			Limit:            stuckLimit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list stuck orders: %w", err)
+		}
+
+		for _, count := range resp.Counts {
+			fmt.Printf("%s: %d\n", count.Status, count.Orders)
+		}
+		fmt.Println("Stuck orders found:", len(resp.Orders))
+		for _, order := range resp.Orders {
+			fmt.Println(jsonutil.PrettyJSONPB(order))
+		}
+
+		return nil
+	},
+}
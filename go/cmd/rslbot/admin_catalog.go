@@ -0,0 +1,455 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+var (
+	catalogId          int64
+	productName        string
+	productDescription string
+	productTier        string
+	productImageURL    string
+	catalogActive      bool
+	priceProductId     int64
+	priceDuration      string
+	priceCurrency      string
+	priceAmount        int64
+	priceDisplayName   string
+	priceValidFrom     string
+	priceValidUntil    string
+	exchangeCurrency   string
+	exchangeRate       float64
+)
+
+var catalogCmd = &cobra.Command{
+	Use:   "catalog",
+	Short: "Manage the products and prices sold on checkout",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListCatalog
+		resp, err := client.AdminListCatalog(ctx, &rbapi.AdminListCatalog_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list the catalog: %w", err)
+		}
+
+		fmt.Println("Products:", len(resp.Products))
+		for _, product := range resp.Products {
+			fmt.Println(jsonutil.PrettyJSONPB(product))
+		}
+		fmt.Println("Prices:", len(resp.Prices))
+		for _, price := range resp.Prices {
+			price.Product = nil
+			fmt.Println(jsonutil.PrettyJSONPB(price))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for the product commands
+	for _, cmd := range []*cobra.Command{createProductCmd, updateProductCmd} {
+		cmd.Flags().StringVar(&productName, "name", "", "Product name")
+		cmd.Flags().StringVar(&productDescription, "description", "", "Product description")
+		cmd.Flags().StringVar(&productTier, "tier", "PREMIUM", "Tier of the licenses sold (FREE, REGULAR, PREMIUM)")
+		cmd.Flags().StringVar(&productImageURL, "image-url", "", "Image shown on checkout")
+		cmd.Flags().BoolVar(&catalogActive, "active", true, "Whether the product prices can be bought")
+	}
+
+	// Add flags for the price commands
+	for _, cmd := range []*cobra.Command{createPriceCmd, updatePriceCmd} {
+		cmd.Flags().Int64Var(&priceProductId, "product-id", 0, "Product of the price")
+		cmd.Flags().StringVar(&priceDuration, "duration", "", "License duration (LIFETIME, ONE_WEEK, ONE_MONTH, SIX_MONTHS, ONE_YEAR)")
+		cmd.Flags().StringVar(&priceCurrency, "currency", "eur", "Currency (ISO 4217 code)")
+		cmd.Flags().Int64Var(&priceAmount, "amount", 0, "Amount in cents")
+		cmd.Flags().StringVar(&priceDisplayName, "display-name", "", "Line item name shown on checkout")
+		cmd.Flags().BoolVar(&catalogActive, "active", true, "Whether the price can be bought")
+		cmd.Flags().StringVar(&priceValidFrom, "valid-from", "", "Start of the validity window (YYYY-MM-DD)")
+		cmd.Flags().StringVar(&priceValidUntil, "valid-until", "", "End of the validity window, excluded (YYYY-MM-DD)")
+	}
+
+	// Updates and deletes need an ID
+	for _, cmd := range []*cobra.Command{updateProductCmd, deleteProductCmd, updatePriceCmd, deletePriceCmd} {
+		cmd.Flags().Int64Var(&catalogId, "id", 0, "ID of the product or price")
+		if err := cmd.MarkFlagRequired("id"); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	// Add flags for setExchangeRateCmd
+	setExchangeRateCmd.Flags().StringVar(&exchangeCurrency, "currency", "", "Currency (ISO 4217 code)")
+	setExchangeRateCmd.Flags().Float64Var(&exchangeRate, "rate", 0, "Units of the currency worth one unit of the base currency")
+	for _, name := range []string{"currency", "rate"} {
+		if err := setExchangeRateCmd.MarkFlagRequired(name); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	catalogCmd.AddCommand(createProductCmd)
+	catalogCmd.AddCommand(updateProductCmd)
+	catalogCmd.AddCommand(deleteProductCmd)
+	catalogCmd.AddCommand(createPriceCmd)
+	catalogCmd.AddCommand(updatePriceCmd)
+	catalogCmd.AddCommand(deletePriceCmd)
+	catalogCmd.AddCommand(exchangeRatesCmd)
+	catalogCmd.AddCommand(setExchangeRateCmd)
+	adminCmd.AddCommand(catalogCmd)
+}
+
+var createProductCmd = &cobra.Command{
+	Use:   "create-product",
+	Short: "Create a catalog product",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		product := &rbdb.Product{}
+		if err := applyProductFlags(cmd, product); err != nil {
+			return err
+		}
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminCreateProduct
+		resp, err := client.AdminCreateProduct(ctx, &rbapi.AdminCreateProduct_Input{Product: product})
+		if err != nil {
+			return fmt.Errorf("failed to create product: %w", err)
+		}
+
+		fmt.Println("Product created:")
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Product))
+		return nil
+	},
+}
+
+var updateProductCmd = &cobra.Command{
+	Use:   "update-product",
+	Short: "Update the given fields of a catalog product",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// The update replaces the whole product, start from the current one
+		catalog, err := client.AdminListCatalog(ctx, &rbapi.AdminListCatalog_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list the catalog: %w", err)
+		}
+		var product *rbdb.Product
+		for _, candidate := range catalog.Products {
+			if candidate.Id == catalogId {
+				product = candidate
+			}
+		}
+		if product == nil {
+			return fmt.Errorf("product %d not found", catalogId)
+		}
+		if err := applyProductFlags(cmd, product); err != nil {
+			return err
+		}
+
+		// Call AdminUpdateProduct
+		resp, err := client.AdminUpdateProduct(ctx, &rbapi.AdminUpdateProduct_Input{Product: product})
+		if err != nil {
+			return fmt.Errorf("failed to update product: %w", err)
+		}
+
+		fmt.Println("Product updated:")
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Product))
+		return nil
+	},
+}
+
+var deleteProductCmd = &cobra.Command{
+	Use:   "delete-product",
+	Short: "Delete a catalog product without prices",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminDeleteProduct
+		if _, err := client.AdminDeleteProduct(ctx, &rbapi.AdminDeleteProduct_Input{Id: catalogId}); err != nil {
+			return fmt.Errorf("failed to delete product: %w", err)
+		}
+
+		fmt.Println("Product deleted:", catalogId)
+		return nil
+	},
+}
+
+var createPriceCmd = &cobra.Command{
+	Use:   "create-price",
+	Short: "Create a catalog price",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		price := &rbdb.Price{}
+		if err := applyPriceFlags(cmd, price); err != nil {
+			return err
+		}
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminCreatePrice
+		resp, err := client.AdminCreatePrice(ctx, &rbapi.AdminCreatePrice_Input{Price: price})
+		if err != nil {
+			return fmt.Errorf("failed to create price: %w", err)
+		}
+
+		fmt.Println("Price created:")
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Price))
+		return nil
+	},
+}
+
+var updatePriceCmd = &cobra.Command{
+	Use:   "update-price",
+	Short: "Update the given fields of a catalog price",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// The update replaces the whole price, start from the current one
+		catalog, err := client.AdminListCatalog(ctx, &rbapi.AdminListCatalog_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list the catalog: %w", err)
+		}
+		var price *rbdb.Price
+		for _, candidate := range catalog.Prices {
+			if candidate.Id == catalogId {
+				price = candidate
+			}
+		}
+		if price == nil {
+			return fmt.Errorf("price %d not found", catalogId)
+		}
+		if err := applyPriceFlags(cmd, price); err != nil {
+			return err
+		}
+
+		// Call AdminUpdatePrice
+		resp, err := client.AdminUpdatePrice(ctx, &rbapi.AdminUpdatePrice_Input{Price: price})
+		if err != nil {
+			return fmt.Errorf("failed to update price: %w", err)
+		}
+
+		fmt.Println("Price updated:")
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Price))
+		return nil
+	},
+}
+
+var deletePriceCmd = &cobra.Command{
+	Use:   "delete-price",
+	Short: "Delete a catalog price",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminDeletePrice
+		if _, err := client.AdminDeletePrice(ctx, &rbapi.AdminDeletePrice_Input{Id: catalogId}); err != nil {
+			return fmt.Errorf("failed to delete price: %w", err)
+		}
+
+		fmt.Println("Price deleted:", catalogId)
+		return nil
+	},
+}
+
+var exchangeRatesCmd = &cobra.Command{
+	Use:   "exchange-rates",
+	Short: "List the exchange rates used to report revenue in the base currency",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListExchangeRates
+		resp, err := client.AdminListExchangeRates(ctx, &rbapi.AdminListExchangeRates_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list exchange rates: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
+
+var setExchangeRateCmd = &cobra.Command{
+	Use:   "set-exchange-rate",
+	Short: "Set the exchange rate of a currency to the base currency",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminSetExchangeRate
+		resp, err := client.AdminSetExchangeRate(ctx, &rbapi.AdminSetExchangeRate_Input{
+			Currency: exchangeCurrency,
+			Rate:     exchangeRate,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set exchange rate: %w", err)
+		}
+
+		fmt.Println("Exchange rate set:")
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Rate))
+		return nil
+	},
+}
+
+// applyProductFlags sets the product fields given on the command line, all of them for a new product
+func applyProductFlags(cmd *cobra.Command, product *rbdb.Product) error {
+	isNew := product.Id == 0
+	if isNew || cmd.Flags().Changed("name") {
+		product.Name = productName
+	}
+	if isNew || cmd.Flags().Changed("description") {
+		product.Description = productDescription
+	}
+	if isNew || cmd.Flags().Changed("tier") {
+		value, ok := rbdb.LicenseKey_Tier_value["TIER_"+productTier]
+		if !ok {
+			return fmt.Errorf("invalid tier: %s", productTier)
+		}
+		product.Tier = rbdb.LicenseKey_Tier(value)
+	}
+	if isNew || cmd.Flags().Changed("image-url") {
+		product.ImageUrl = productImageURL
+	}
+	if isNew || cmd.Flags().Changed("active") {
+		product.Active = catalogActive
+	}
+	return nil
+}
+
+// applyPriceFlags sets the price fields given on the command line, all of them for a new price
+func applyPriceFlags(cmd *cobra.Command, price *rbdb.Price) error {
+	isNew := price.Id == 0
+	if isNew || cmd.Flags().Changed("product-id") {
+		price.ProductId = priceProductId
+		price.Product = nil
+	}
+	if isNew || cmd.Flags().Changed("duration") {
+		value, ok := rbdb.LicenseKey_Duration_value[priceDuration]
+		if !ok {
+			return fmt.Errorf("invalid license duration: %s", priceDuration)
+		}
+		price.Duration = rbdb.LicenseKey_Duration(value)
+	}
+	if isNew || cmd.Flags().Changed("currency") {
+		price.Currency = priceCurrency
+	}
+	if isNew || cmd.Flags().Changed("amount") {
+		price.AmountInCents = priceAmount
+	}
+	if isNew || cmd.Flags().Changed("display-name") {
+		price.DisplayName = priceDisplayName
+	}
+	if isNew || cmd.Flags().Changed("active") {
+		price.Active = catalogActive
+	}
+	if isNew || cmd.Flags().Changed("valid-from") {
+		validFrom, err := parseOptionalDate(priceValidFrom)
+		if err != nil {
+			return fmt.Errorf("invalid --valid-from date: %w", err)
+		}
+		price.ValidFrom = validFrom
+	}
+	if isNew || cmd.Flags().Changed("valid-until") {
+		validUntil, err := parseOptionalDate(priceValidUntil)
+		if err != nil {
+			return fmt.Errorf("invalid --valid-until date: %w", err)
+		}
+		price.ValidUntil = validUntil
+	}
+	return nil
+}
+
+// parseOptionalDate parses a YYYY-MM-DD date, an empty one is nil
+func parseOptionalDate(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(date), nil
+}
+
+// newAdminClient returns an API client authenticated with the saved token, renewed when expired
+func newAdminClient() (*rbapi.HTTPClient, error) {
+	// Check if we need to get a new token
+	token, err := loadToken()
+	if err != nil || token.isExpired() {
+		token, err = getNewToken()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get new token: %w", err)
+		}
+		if err := saveToken(token); err != nil {
+			return nil, fmt.Errorf("failed to save token: %w", err)
+		}
+	}
+
+	// Create HTTP client with auth
+	httpClient := &http.Client{
+		Transport: &http.Transport{},
+	}
+	httpClient.Transport = &authTransport{
+		token:     token,
+		transport: httpClient.Transport,
+	}
+
+	return rbapi.NewHTTPClient(httpClient, serverAddr), nil
+}
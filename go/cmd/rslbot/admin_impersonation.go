@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+)
+
+var (
+	impersonateUserID    int64
+	impersonateReadWrite bool
+	impersonateReason    string
+	impersonationsAdmin  string
+	impersonationsLimit  int32
+)
+
+var impersonateCmd = &cobra.Command{
+	Use:   "impersonate",
+	Short: "Get a token calling the user RPCs as a user, every call is audited",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminImpersonateUser
+		resp, err := client.AdminImpersonateUser(ctx, &rbapi.AdminImpersonateUser_Input{
+			UserId:    impersonateUserID,
+			ReadWrite: impersonateReadWrite,
+			Reason:    impersonateReason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to impersonate user: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
+
+var impersonationsCmd = &cobra.Command{
+	Use:   "impersonations",
+	Short: "List the calls made by admins impersonating users",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListImpersonationAudits
+		resp, err := client.AdminListImpersonationAudits(ctx, &rbapi.AdminListImpersonationAudits_Input{
+			UserId:        impersonateUserID,
+			AdminUsername: impersonationsAdmin,
+			Limit:         impersonationsLimit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list impersonations: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for impersonateCmd
+	impersonateCmd.Flags().Int64Var(&impersonateUserID, "user-id", 0, "User ID")
+	impersonateCmd.Flags().BoolVar(&impersonateReadWrite, "read-write", false, "Also allow the RPCs changing the user data, like checkouts")
+	impersonateCmd.Flags().StringVar(&impersonateReason, "reason", "", "Why, recorded in the audit log")
+	for _, flag := range []string{"user-id", "reason"} {
+		if err := impersonateCmd.MarkFlagRequired(flag); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	// Add flags for impersonationsCmd
+	impersonationsCmd.Flags().Int64Var(&impersonateUserID, "user-id", 0, "Only list the calls made as a user")
+	impersonationsCmd.Flags().StringVar(&impersonationsAdmin, "admin", "", "Only list the calls made by an admin")
+	impersonationsCmd.Flags().Int32Var(&impersonationsLimit, "limit", 100, "Maximum number of calls")
+
+	adminCmd.AddCommand(impersonateCmd)
+	adminCmd.AddCommand(impersonationsCmd)
+}
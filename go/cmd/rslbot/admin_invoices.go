@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+)
+
+var invoicePaymentId int64
+
+func init() {
+	// Add flags for RegenerateInvoicesCmd
+	RegenerateInvoicesCmd.Flags().Int64Var(&invoicePaymentId, "payment-id", 0, "Payment whose invoice and credit notes are rendered again")
+	if err := RegenerateInvoicesCmd.MarkFlagRequired("payment-id"); err != nil {
+		panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+	}
+
+	adminCmd.AddCommand(RegenerateInvoicesCmd)
+}
+
+var RegenerateInvoicesCmd = &cobra.Command{
+	Use:   "regenerate-invoices",
+	Short: "Issue the missing invoice of a payment and render its documents again",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminRegenerateInvoices
+		resp, err := client.AdminRegenerateInvoices(ctx, &rbapi.AdminRegenerateInvoices_Input{PaymentId: invoicePaymentId})
+		if err != nil {
+			return fmt.Errorf("failed to regenerate invoices: %w", err)
+		}
+
+		fmt.Println("Invoices regenerated:", len(resp.Invoices))
+		for _, invoice := range resp.Invoices {
+			fmt.Println(jsonutil.PrettyJSONPB(invoice))
+		}
+		return nil
+	},
+}
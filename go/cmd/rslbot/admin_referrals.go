@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+)
+
+var (
+	referralUserID          int64
+	referralCurrency        string
+	referralPayoutReference string
+)
+
+var referralsCmd = &cobra.Command{
+	Use:   "referrals",
+	Short: "List the referrers owed a commission payout",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListPayableCommissions
+		resp, err := client.AdminListPayableCommissions(ctx, &rbapi.AdminListPayableCommissions_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list payable commissions: %w", err)
+		}
+
+		fmt.Println("Payable referrers:", len(resp.Payables))
+		for _, payable := range resp.Payables {
+			fmt.Println(jsonutil.PrettyJSONPB(payable))
+		}
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for payCommissionsCmd
+	payCommissionsCmd.Flags().Int64Var(&referralUserID, "user-id", 0, "User ID of the referrer")
+	payCommissionsCmd.Flags().StringVar(&referralCurrency, "currency", "", "Currency of the commissions paid")
+	payCommissionsCmd.Flags().StringVar(&referralPayoutReference, "reference", "", "Reference of the payout sent to the referrer")
+	for _, name := range []string{"user-id", "currency", "reference"} {
+		if err := payCommissionsCmd.MarkFlagRequired(name); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	referralsCmd.AddCommand(payCommissionsCmd)
+	adminCmd.AddCommand(referralsCmd)
+}
+
+var payCommissionsCmd = &cobra.Command{
+	Use:   "pay",
+	Short: "Mark the payable commissions of a referrer as paid",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminPayCommissions
+		resp, err := client.AdminPayCommissions(ctx, &rbapi.AdminPayCommissions_Input{
+			UserId:          referralUserID,
+			Currency:        referralCurrency,
+			PayoutReference: referralPayoutReference,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to pay commissions: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
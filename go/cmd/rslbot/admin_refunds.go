@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+var (
+	refundRequestStatus string
+	refundRequestLimit  int32
+	refundRequestID     int64
+	refundRequestNote   string
+)
+
+var refundRequestsCmd = &cobra.Command{
+	Use:   "refund-requests",
+	Short: "List the refund requests of users",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		status, ok := rbdb.RefundRequest_Status_value["STATUS_"+strings.ToUpper(refundRequestStatus)]
+		if !ok {
+			return fmt.Errorf("unknown status %q, expected pending, approved or denied", refundRequestStatus)
+		}
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListRefundRequests
+		resp, err := client.AdminListRefundRequests(ctx, &rbapi.AdminListRefundRequests_Input{
+			Status: rbdb.RefundRequest_Status(status),
+			Limit:  refundRequestLimit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list refund requests: %w", err)
+		}
+
+		fmt.Println("Refund requests:", len(resp.RefundRequests))
+		for _, request := range resp.RefundRequests {
+			fmt.Println(jsonutil.PrettyJSONPB(request))
+		}
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for refundRequestsCmd
+	refundRequestsCmd.Flags().StringVar(&refundRequestStatus, "status", "pending", "Status of the requests: pending, approved or denied")
+	refundRequestsCmd.Flags().Int32Var(&refundRequestLimit, "limit", 50, "Maximum number of requests")
+
+	// Add flags for approveRefundRequestCmd and denyRefundRequestCmd
+	for _, decideCmd := range []*cobra.Command{approveRefundRequestCmd, denyRefundRequestCmd} {
+		decideCmd.Flags().Int64Var(&refundRequestID, "id", 0, "Refund request ID")
+		decideCmd.Flags().StringVar(&refundRequestNote, "note", "", "Note kept on the request")
+		if err := decideCmd.MarkFlagRequired("id"); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	refundRequestsCmd.AddCommand(approveRefundRequestCmd)
+	refundRequestsCmd.AddCommand(denyRefundRequestCmd)
+	adminCmd.AddCommand(refundRequestsCmd)
+}
+
+var approveRefundRequestCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Refund the payment of a request on PayPal and revoke its license",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminApproveRefundRequest
+		resp, err := client.AdminApproveRefundRequest(ctx, &rbapi.AdminApproveRefundRequest_Input{
+			RefundRequestId: refundRequestID,
+			Note:            refundRequestNote,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to approve refund request: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
+
+var denyRefundRequestCmd = &cobra.Command{
+	Use:   "deny",
+	Short: "Deny a refund request",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminDenyRefundRequest
+		resp, err := client.AdminDenyRefundRequest(ctx, &rbapi.AdminDenyRefundRequest_Input{
+			RefundRequestId: refundRequestID,
+			Note:            refundRequestNote,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to deny refund request: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp.RefundRequest))
+		return nil
+	},
+}
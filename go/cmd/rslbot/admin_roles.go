@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+var (
+	roleUserID int64
+	roleName   string
+)
+
+var rolesCmd = &cobra.Command{
+	Use:   "roles",
+	Short: "List the roles granted to users and the Discourse groups giving roles",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListRoles
+		resp, err := client.AdminListRoles(ctx, &rbapi.AdminListRoles_Input{UserId: roleUserID})
+		if err != nil {
+			return fmt.Errorf("failed to list roles: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for rolesCmd
+	rolesCmd.Flags().Int64Var(&roleUserID, "user-id", 0, "Only list the roles of a user")
+
+	// Add flags for grantRoleCmd and revokeRoleCmd
+	for _, changeCmd := range []*cobra.Command{grantRoleCmd, revokeRoleCmd} {
+		changeCmd.Flags().Int64Var(&roleUserID, "user-id", 0, "User ID")
+		changeCmd.Flags().StringVar(&roleName, "role", "", "Role: admin, support, billing or offsets-maintainer")
+		for _, flag := range []string{"user-id", "role"} {
+			if err := changeCmd.MarkFlagRequired(flag); err != nil {
+				panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+			}
+		}
+	}
+
+	rolesCmd.AddCommand(grantRoleCmd)
+	rolesCmd.AddCommand(revokeRoleCmd)
+	adminCmd.AddCommand(rolesCmd)
+}
+
+// parseRoleFlag converts a role name like offsets-maintainer
+func parseRoleFlag(name string) (rbdb.UserRole_Role, error) {
+	role, ok := rbdb.UserRole_Role_value["ROLE_"+strings.ToUpper(strings.ReplaceAll(name, "-", "_"))]
+	if !ok || role == int32(rbdb.UserRole_ROLE_UNSPECIFIED) {
+		return 0, fmt.Errorf("unknown role %q, expected admin, support, billing or offsets-maintainer", name)
+	}
+	return rbdb.UserRole_Role(role), nil
+}
+
+var grantRoleCmd = &cobra.Command{
+	Use:   "grant",
+	Short: "Grant a role to a user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		role, err := parseRoleFlag(roleName)
+		if err != nil {
+			return err
+		}
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminGrantRole
+		resp, err := client.AdminGrantRole(ctx, &rbapi.AdminGrantRole_Input{UserId: roleUserID, Role: role})
+		if err != nil {
+			return fmt.Errorf("failed to grant role: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp.UserRole))
+		return nil
+	},
+}
+
+var revokeRoleCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke a role granted to a user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		role, err := parseRoleFlag(roleName)
+		if err != nil {
+			return err
+		}
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminRevokeRole
+		if _, err := client.AdminRevokeRole(ctx, &rbapi.AdminRevokeRole_Input{UserId: roleUserID, Role: role}); err != nil {
+			return fmt.Errorf("failed to revoke role: %w", err)
+		}
+
+		fmt.Printf("Role %s revoked from user %d\n", roleName, roleUserID)
+		return nil
+	},
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+)
+
+var (
+	taxCountryCode    string
+	taxRate           float64
+	taxYear           int32
+	taxQuarter        int32
+	taxIncludeSandbox bool
+)
+
+var taxCmd = &cobra.Command{
+	Use:   "tax",
+	Short: "Manage the VAT rates and report the VAT collected",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminListTaxRates
+		resp, err := client.AdminListTaxRates(ctx, &rbapi.AdminListTaxRates_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list tax rates: %w", err)
+		}
+
+		fmt.Println("Tax rates:", len(resp.Rates))
+		for _, rate := range resp.Rates {
+			fmt.Println(jsonutil.PrettyJSONPB(rate))
+		}
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for setTaxRateCmd
+	setTaxRateCmd.Flags().StringVar(&taxCountryCode, "country", "", "Country (ISO 3166-1 alpha-2 code)")
+	setTaxRateCmd.Flags().Float64Var(&taxRate, "rate", 0, "VAT rate of digital goods, 0.2 for 20%")
+	for _, name := range []string{"country", "rate"} {
+		if err := setTaxRateCmd.MarkFlagRequired(name); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	// Add flags for taxReportCmd
+	now := time.Now().UTC()
+	taxReportCmd.Flags().Int32Var(&taxYear, "year", int32(now.Year()), "Year of the quarter")
+	taxReportCmd.Flags().Int32Var(&taxQuarter, "quarter", int32(now.Month()-1)/3+1, "Quarter (1 to 4)")
+	taxReportCmd.Flags().BoolVar(&taxIncludeSandbox, "include-sandbox", false, "Include sandbox payments")
+
+	taxCmd.AddCommand(setTaxRateCmd)
+	taxCmd.AddCommand(taxReportCmd)
+	adminCmd.AddCommand(taxCmd)
+}
+
+var setTaxRateCmd = &cobra.Command{
+	Use:   "set-rate",
+	Short: "Set the VAT rate of a country",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminSetTaxRate
+		resp, err := client.AdminSetTaxRate(ctx, &rbapi.AdminSetTaxRate_Input{
+			CountryCode: taxCountryCode,
+			Rate:        taxRate,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set tax rate: %w", err)
+		}
+
+		fmt.Println("Tax rate set:")
+		fmt.Println(jsonutil.PrettyJSONPB(resp.Rate))
+		return nil
+	},
+}
+
+var taxReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Report the VAT collected in a quarter, by country",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call AdminGetTaxReport
+		resp, err := client.AdminGetTaxReport(ctx, &rbapi.AdminGetTaxReport_Input{
+			Year:           taxYear,
+			Quarter:        taxQuarter,
+			IncludeSandbox: taxIncludeSandbox,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get tax report: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp))
+		return nil
+	},
+}
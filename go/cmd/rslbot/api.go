@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/rbapi"
 )
 
@@ -22,6 +23,9 @@ var (
 	corsAllowedOrigins string
 	requestTimeout     time.Duration
 	shutdownTimeout    time.Duration
+	discourseSyncEvery time.Duration
+	webhookRetryEvery  time.Duration
+	reconcileEvery     time.Duration
 )
 
 var apiCmd = &cobra.Command{
@@ -36,13 +40,17 @@ func init() {
 	apiCmd.Flags().StringVar(&dbURN, "db-urn", "", "Database URN")
 	apiCmd.Flags().StringVar(&redisURL, "redis-url", "localhost:6379", "Redis URL")
 
+	// Secrets and integration settings, also read from the config file and the environment
+	config.RegisterFlags(apiCmd.Flags())
+
 	// PayPal configuration
-	apiCmd.Flags().StringVar(&rbapi.PayPalClientID, "paypal-client-id", "", "PayPal Client ID")
-	apiCmd.Flags().StringVar(&rbapi.PayPalClientSecret, "paypal-client-secret", "", "PayPal Client Secret")
-	apiCmd.Flags().StringVar(&rbapi.PayPalWebhookID, "paypal-webhook-id", "", "PayPal Webhook ID")
+	apiCmd.Flags().DurationVar(&reconcileEvery, "paypal-reconcile-interval", 5*time.Minute, "Interval of the stale PayPal checkout orders reconciliation (0 disables it)")
+
+	// Discourse configuration
+	apiCmd.Flags().DurationVar(&discourseSyncEvery, "discourse-group-sync-interval", time.Hour, "Interval of the Discourse license group sweep (0 disables it)")
 
-	// Discord configuration
-	apiCmd.Flags().StringVar(&rbapi.DiscordBotToken, "discord-bot-token", "", "Discord bot token for role management")
+	// Webhook inbox
+	apiCmd.Flags().DurationVar(&webhookRetryEvery, "webhook-retry-interval", time.Minute, "Interval of the failed payment webhooks retries (0 disables them)")
 
 	apiCmd.Flags().StringVar(&corsAllowedOrigins, "cors-allowed-origins", "*", "Allowed CORS origins")
 	apiCmd.Flags().DurationVar(&requestTimeout, "request-timeout", 20*time.Minute, "Request timeout")
@@ -58,12 +66,19 @@ func runAPIServer(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithCancel(cmd.Context())
 	defer cancel()
 
-	// Initialize PayPal
-	rbapi.SetupPayPal()
+	// Load the secrets and integration settings
+	cfg, err := config.Load(cmd.Flags())
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
 
 	// Create service
 	svcOpts := rbapi.ServiceOpts{
 		Logger:      logger.Named("service"),
+		Config:      cfg,
 		DBUrn:       dbURN,
 		RedisConfig: rbapi.RedisConfig{Addr: redisURL},
 	}
@@ -81,6 +96,10 @@ func runAPIServer(cmd *cobra.Command, args []string) error {
 		CORSAllowedOrigins: corsAllowedOrigins,
 		RequestTimeout:     requestTimeout,
 		ShutdownTimeout:    shutdownTimeout,
+
+		DiscourseGroupSyncInterval: discourseSyncEvery,
+		WebhookRetryInterval:       webhookRetryEvery,
+		PayPalReconcileInterval:    reconcileEvery,
 	}
 
 	server, err := rbapi.NewServer(ctx, svc, svc.DB(), svc.Redis(), serverOpts)
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"rslbot.com/go/internal/jsonutil"
+	"rslbot.com/go/pkg/rbapi"
+)
+
+var (
+	apiKeyName          string
+	apiKeyScopes        []string
+	apiKeyExpiresInDays int32
+	apiKeyID            int64
+)
+
+var apiKeysCmd = &cobra.Command{
+	Use:   "api-keys",
+	Short: "List your personal API keys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call UserListApiKeys
+		resp, err := client.UserListApiKeys(ctx, &rbapi.UserListApiKeys_Input{})
+		if err != nil {
+			return fmt.Errorf("failed to list API keys: %w", err)
+		}
+
+		fmt.Println("API keys:", len(resp.ApiKeys))
+		for _, key := range resp.ApiKeys {
+			fmt.Println(jsonutil.PrettyJSONPB(key))
+		}
+		return nil
+	},
+}
+
+func init() {
+	// Add flags for createApiKeyCmd
+	createApiKeyCmd.Flags().StringVar(&apiKeyName, "name", "", "Name of the key")
+	createApiKeyCmd.Flags().StringSliceVar(&apiKeyScopes, "scope", nil, "Scopes of the key: licenses:read, licenses:write, offsets:write or payments:read")
+	createApiKeyCmd.Flags().Int32Var(&apiKeyExpiresInDays, "expires-in-days", 90, "Days until the key expires, at most 365")
+	for _, flag := range []string{"name", "scope"} {
+		if err := createApiKeyCmd.MarkFlagRequired(flag); err != nil {
+			panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+		}
+	}
+
+	// Add flags for revokeApiKeyCmd
+	revokeApiKeyCmd.Flags().Int64Var(&apiKeyID, "id", 0, "API key ID")
+	if err := revokeApiKeyCmd.MarkFlagRequired("id"); err != nil {
+		panic(fmt.Sprintf("Failed to mark flag as required: %v", err))
+	}
+
+	apiKeysCmd.AddCommand(createApiKeyCmd)
+	apiKeysCmd.AddCommand(revokeApiKeyCmd)
+	cliCmd.AddCommand(apiKeysCmd)
+}
+
+var createApiKeyCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a personal API key, pass it to scripts in RSLBOT_API_KEY",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call UserCreateApiKey
+		resp, err := client.UserCreateApiKey(ctx, &rbapi.UserCreateApiKey_Input{
+			Name:          apiKeyName,
+			Scopes:        apiKeyScopes,
+			ExpiresInDays: apiKeyExpiresInDays,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create API key: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp.ApiKey))
+		fmt.Println("Key, shown only once:", resp.Key)
+		return nil
+	},
+}
+
+var revokeApiKeyCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke a personal API key",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		ctx := cmd.Context()
+
+		client, err := newAdminClient()
+		if err != nil {
+			return err
+		}
+
+		// Call UserRevokeApiKey
+		resp, err := client.UserRevokeApiKey(ctx, &rbapi.UserRevokeApiKey_Input{ApiKeyId: apiKeyID})
+		if err != nil {
+			return fmt.Errorf("failed to revoke API key: %w", err)
+		}
+
+		fmt.Println(jsonutil.PrettyJSONPB(resp.ApiKey))
+		return nil
+	},
+}
@@ -2,14 +2,9 @@ package main
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -21,20 +16,36 @@ import (
 
 const (
 	tokenFile = "raidbot_token.json"
+
+	// apiKeyEnv holds a personal API key, used instead of the saved token by scripts
+	apiKeyEnv = "RSLBOT_API_KEY"
 )
 
 type Token struct {
-	AccessToken string              `json:"access_token"`
-	TokenType   string              `json:"token_type"`
-	ExpiresAt   time.Time           `json:"expires_at"`
-	UserInfo    *rbdb.DiscourseUser `json:"user_info"`
+	AccessToken      string              `json:"access_token"`
+	TokenType        string              `json:"token_type"`
+	ExpiresAt        time.Time           `json:"expires_at"`
+	RefreshToken     string              `json:"refresh_token"`
+	RefreshExpiresAt time.Time           `json:"refresh_expires_at"`
+	UserInfo         *rbdb.DiscourseUser `json:"user_info"`
 }
 
 func (t *Token) isExpired() bool {
 	return time.Now().After(t.ExpiresAt)
 }
 
+// loadToken reads the saved token, an expired access token is refreshed while the refresh token is valid
+// A personal API key in RSLBOT_API_KEY takes precedence, it skips the browser login
 func loadToken() (*Token, error) {
+	if apiKey := os.Getenv(apiKeyEnv); apiKey != "" {
+		// The server checks the expiry of the key
+		return &Token{
+			AccessToken: apiKey,
+			TokenType:   "Bearer",
+			ExpiresAt:   time.Now().Add(24 * time.Hour),
+		}, nil
+	}
+
 	data, err := os.ReadFile(tokenFile)
 	if err != nil {
 		return nil, err
@@ -45,9 +56,34 @@ func loadToken() (*Token, error) {
 		return nil, err
 	}
 
+	if token.isExpired() && token.RefreshToken != "" && time.Now().Before(token.RefreshExpiresAt) {
+		if err := refreshToken(context.Background(), &token); err != nil {
+			logger.Warn("Failed to refresh the session token", zap.Error(err))
+			return &token, nil
+		}
+		if err := saveToken(&token); err != nil {
+			return nil, err
+		}
+	}
+
 	return &token, nil
 }
 
+// refreshToken rotates the session tokens of a saved token
+func refreshToken(ctx context.Context, token *Token) error {
+	client := rbapi.NewHTTPClient(http.DefaultClient, serverAddr)
+	resp, err := client.AuthRefreshSession(ctx, &rbapi.AuthRefreshSession_Input{RefreshToken: token.RefreshToken})
+	if err != nil {
+		return err
+	}
+
+	token.AccessToken = resp.AccessToken
+	token.ExpiresAt = resp.AccessTokenExpiresAt.AsTime()
+	token.RefreshToken = resp.RefreshToken
+	token.RefreshExpiresAt = resp.RefreshTokenExpiresAt.AsTime()
+	return nil
+}
+
 func saveToken(token *Token) error {
 	data, err := json.MarshalIndent(token, "", "  ")
 	if err != nil {
@@ -67,26 +103,15 @@ func getNewToken() (*Token, error) {
 
 	// Handler for initial page
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		// Create SSO request like in discourse-test.go
-		nonce := fmt.Sprintf("nonce-%d", time.Now().UnixNano())
-		returnURL := "http://localhost:8085/callback"
-		payload := fmt.Sprintf("return_sso_url=%s&nonce=%s",
-			url.QueryEscape(returnURL),
-			url.QueryEscape(nonce))
-
-		// Base64 encode the payload
-		base64Payload := base64.StdEncoding.EncodeToString([]byte(payload))
-
-		// Generate signature using rbapi.DiscourseSecret
-		mac := hmac.New(sha256.New, []byte(rbapi.DiscourseSecret))
-		mac.Write([]byte(base64Payload))
-		sig := hex.EncodeToString(mac.Sum(nil))
-
-		// Construct SSO URL
-		ssoURL := fmt.Sprintf("%s/session/sso_provider?sso=%s&sig=%s",
-			rbapi.DiscoursePath,
-			url.QueryEscape(base64Payload),
-			sig)
+		// The backend signs the SSO request and remembers its nonce
+		client := rbapi.NewHTTPClient(http.DefaultClient, serverAddr)
+		start, err := client.AuthStartSSO(r.Context(), &rbapi.AuthStartSSO_Input{ReturnSsoUrl: rbapi.CLILoopbackURL})
+		if err != nil {
+			logger.Error("SSO start failed", zap.Error(err))
+			http.Error(w, "Failed to start the SSO login", http.StatusBadGateway)
+			return
+		}
+		ssoURL := start.SsoUrl
 
 		// Display page with auto-redirect
 		fmt.Fprintf(w, `
@@ -116,20 +141,34 @@ func getNewToken() (*Token, error) {
 			return
 		}
 
-		userInfo, err := rbapi.VerifySSO(sso, sig, rbapi.DiscourseSecret)
+		// The backend verifies the signature when exchanging the response
+		response, err := rbapi.DecodeSSO(sso)
 		if err != nil {
-			logger.Error("SSO verification failed", zap.Error(err))
-			errChan <- fmt.Errorf("SSO verification failed: %w", err)
+			logger.Error("SSO decoding failed", zap.Error(err))
+			errChan <- fmt.Errorf("SSO decoding failed: %w", err)
 			http.Error(w, "Invalid SSO response", http.StatusBadRequest)
 			return
 		}
+		userInfo := response.User
+
+		// Exchange the SSO response for session tokens
+		client := rbapi.NewHTTPClient(http.DefaultClient, serverAddr)
+		session, err := client.AuthExchangeSSO(r.Context(), &rbapi.AuthExchangeSSO_Input{Sso: sso, Sig: sig})
+		if err != nil {
+			logger.Error("SSO exchange failed", zap.Error(err))
+			errChan <- fmt.Errorf("SSO exchange failed: %w", err)
+			http.Error(w, "SSO exchange failed", http.StatusBadGateway)
+			return
+		}
 
 		// Create token
 		token := &Token{
-			AccessToken: fmt.Sprintf("SSO_%s.%s", sso, sig),
-			TokenType:   "Bearer",
-			ExpiresAt:   time.Now().Add(24 * time.Hour),
-			UserInfo:    userInfo,
+			AccessToken:      session.AccessToken,
+			TokenType:        "Bearer",
+			ExpiresAt:        session.AccessTokenExpiresAt.AsTime(),
+			RefreshToken:     session.RefreshToken,
+			RefreshExpiresAt: session.RefreshTokenExpiresAt.AsTime(),
+			UserInfo:         userInfo,
 		}
 
 		// Display success page with token info
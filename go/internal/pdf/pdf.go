@@ -0,0 +1,149 @@
+// Package pdf writes simple text documents as PDF, with the standard Helvetica and Courier fonts
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page size in points
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font is one of the standard PDF fonts, they don't need to be embedded
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+	Courier
+)
+
+var fontNames = []string{"Helvetica", "Helvetica-Bold", "Courier"}
+
+// Document is a PDF document being written
+type Document struct {
+	pages []*Page
+}
+
+// Page is a page of a document, coordinates are in points from the bottom-left corner
+type Page struct {
+	content bytes.Buffer
+}
+
+// New returns an empty document
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage appends an A4 page to the document
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text writes a line of text with its baseline starting at x, y
+func (p *Page) Text(x float64, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT /F%d %.2f Tf %.2f %.2f Td (%s) Tj ET\n", int(font)+1, size, x, y, escape(text))
+}
+
+// Line draws a thin line from x1, y1 to x2, y2
+func (p *Page) Line(x1 float64, y1 float64, x2 float64, y2 float64) {
+	fmt.Fprintf(&p.content, "0.5 w %.2f %.2f m %.2f %.2f l S\n", x1, y1, x2, y2)
+}
+
+// CourierWidth returns the width of a text written in Courier, all its glyphs are 0.6 em wide
+func CourierWidth(size float64, text string) float64 {
+	return float64(len(encode(text))) * size * 0.6
+}
+
+// Bytes serializes the document, a document without page gets an empty one
+func (d *Document) Bytes() []byte {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	// Objects: catalog, page tree, fonts, then a page and its content stream for each page
+	fontObject := 3
+	firstPageObject := fontObject + len(fontNames)
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+	}
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPageObject+2*i))
+	}
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for _, name := range fontNames {
+		objects = append(objects, fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+	fonts := make([]string, 0, len(fontNames))
+	for i := range fontNames {
+		fonts = append(fonts, fmt.Sprintf("/F%d %d 0 R", i+1, fontObject+i))
+	}
+	for i, page := range d.pages {
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+				PageWidth, PageHeight, strings.Join(fonts, " "), firstPageObject+2*i+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
+
+// winAnsiRunes are the WinAnsiEncoding characters outside of Latin-1
+var winAnsiRunes = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92,
+	'“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// encode converts a text to WinAnsiEncoding, characters it can't represent are replaced by "?"
+func encode(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r < 0x80 || (r >= 0xA0 && r <= 0xFF):
+			encoded = append(encoded, byte(r))
+		case winAnsiRunes[r] != 0:
+			encoded = append(encoded, winAnsiRunes[r])
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+	return encoded
+}
+
+// escape encodes a text as the content of a PDF literal string
+func escape(text string) string {
+	var out strings.Builder
+	for _, b := range encode(text) {
+		switch b {
+		case '\\', '(', ')':
+			out.WriteByte('\\')
+			out.WriteByte(b)
+		case '\n', '\r', '\t':
+			out.WriteByte(' ')
+		default:
+			out.WriteByte(b)
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,448 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Config holds the secrets and integration settings of the API server
+// Values are loaded in this order, each source overriding the previous one:
+// defaults, the JSON file, the RSLBOT_* environment variables and the flags
+type Config struct {
+	Discourse Discourse `json:"discourse"`
+	Discord   Discord   `json:"discord"`
+	PayPal    PayPal    `json:"paypal"`
+	Stripe    Stripe    `json:"stripe"`
+	License   License   `json:"license"`
+	Checkout  Checkout  `json:"checkout"`
+	Frontend  Frontend  `json:"frontend"`
+	Auth      Auth      `json:"auth"`
+	Roles     Roles     `json:"roles"`
+	Invoice   Invoice   `json:"invoice"`
+	Tax       Tax       `json:"tax"`
+	Referral  Referral  `json:"referral"`
+
+	RefundRequests RefundRequests `json:"refund_requests"`
+
+	FakePayments FakePayments `json:"fake_payments"`
+}
+
+// Discourse holds the SSO secret and the admin API credentials of our Discourse instance
+type Discourse struct {
+	URL           string `json:"url"`
+	SSOSecret     string `json:"sso_secret"`
+	APIKey        string `json:"api_key"`
+	APIUsername   string `json:"api_username"`
+	WebhookSecret string `json:"webhook_secret"` // Empty disables the Discourse webhook
+	LicenseGroup  string `json:"license_group"`  // Group reserved to license holders, empty disables the sync
+
+	// User-Api-Key verifications, cached and guarded by a circuit breaker
+	Timeout          Duration `json:"timeout"`
+	TokenCacheTTL    Duration `json:"token_cache_ttl"`    // Verified identities
+	TokenRejectedTTL Duration `json:"token_rejected_ttl"` // Keys rejected by Discourse
+	BreakerThreshold int      `json:"breaker_threshold"`  // Consecutive failures opening the circuit
+	BreakerCooldown  Duration `json:"breaker_cooldown"`   // Time failing fast before trying Discourse again
+}
+
+// Discord holds the bot and lifetime role settings, the Discord features are disabled while one is empty
+type Discord struct {
+	BotToken       string `json:"bot_token"`
+	GuildID        string `json:"guild_id"`
+	LifetimeRoleID string `json:"lifetime_role_id"`
+
+	RemoveRoleOnRefund bool `json:"remove_role_on_refund"` // Remove the lifetime role when a refund or dispute revokes the last lifetime license
+}
+
+// PayPal holds the PayPal REST app credentials
+type PayPal struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	WebhookID    string `json:"webhook_id"`
+	Sandbox      bool   `json:"sandbox"` // Use the PayPal sandbox API
+}
+
+// Stripe holds the Stripe credentials, empty disables Stripe
+type Stripe struct {
+	APIKey        string `json:"api_key"`
+	WebhookSecret string `json:"webhook_secret"`
+	APIBase       string `json:"api_base"`
+}
+
+// License holds the secrets shared with the bot by the license endpoints
+type License struct {
+	ActivateSecret string `json:"activate_secret"`
+	CheckSecret    string `json:"check_secret"`
+}
+
+// Checkout holds the frontend pages the payment providers redirect to
+type Checkout struct {
+	SuccessURL string `json:"success_url"`
+	CancelURL  string `json:"cancel_url"`
+}
+
+// Frontend holds the web app origin, with the CLI it is the only one allowed to receive the SSO responses
+type Frontend struct {
+	URL string `json:"url"`
+}
+
+// Auth holds the lifetimes of the sessions and of the logins in progress
+type Auth struct {
+	AccessTokenTTL   Duration `json:"access_token_ttl"`
+	RefreshTokenTTL  Duration `json:"refresh_token_ttl"` // Renewed by each refresh
+	ImpersonationTTL Duration `json:"impersonation_ttl"` // Impersonation tokens can't be refreshed
+	SSONonceTTL      Duration `json:"sso_nonce_ttl"`     // Time to complete a login started with AuthStartSSO
+}
+
+// Roles holds the Discourse groups giving the staff roles, a role is disabled while its group is empty
+// Discourse admins are always admins
+type Roles struct {
+	SupportGroup           string `json:"support_group"`
+	BillingGroup           string `json:"billing_group"`
+	OffsetsMaintainerGroup string `json:"offsets_maintainer_group"`
+}
+
+// Invoice holds the seller printed on the invoices and their layout
+type Invoice struct {
+	SellerName    string   `json:"seller_name"`
+	SellerAddress []string `json:"seller_address"`
+	SellerEmail   string   `json:"seller_email"`
+	SellerTaxID   string   `json:"seller_tax_id"`
+	Template      string   `json:"template"` // File replacing the default layout, empty keeps it
+}
+
+// Tax holds the VAT evidence settings
+type Tax struct {
+	IPCountryHeader string `json:"ip_country_header"` // Request header with the country of the client IP, set by the CDN in front of the API
+}
+
+// Referral holds the commissions earned by referrers
+type Referral struct {
+	CommissionRate float64  `json:"commission_rate"` // Share of the net price of a purchase earned by its referrer
+	HoldingPeriod  Duration `json:"holding_period"`  // Commissions stay pending so refunds reverse them before they are paid
+}
+
+// RefundRequests holds the policy of the refunds users can request
+type RefundRequests struct {
+	MaxAge     Duration `json:"max_age"`     // Time after a purchase during which a refund can be requested
+	UnusedOnly bool     `json:"unused_only"` // Only license keys that were never activated can be refunded
+}
+
+// FakePayments replaces PayPal with an in-process fake provider, for local development without a PayPal account
+type FakePayments struct {
+	Enabled bool   `json:"enabled"`
+	BaseURL string `json:"base_url"` // Base URL of the API serving the fake approval page
+}
+
+const (
+	// FileFlag is the flag of the configuration file
+	FileFlag = "config"
+	// FileEnv is the environment variable of the configuration file, the flag overrides it
+	FileEnv = "RSLBOT_CONFIG"
+
+	envPrefix = "RSLBOT_"
+)
+
+// Default returns the configuration used for the values set nowhere
+func Default() *Config {
+	return &Config{
+		Discourse: Discourse{
+			URL:         "https://community.rslbot.com",
+			APIUsername: "Fernandel",
+
+			Timeout:          Duration(5 * time.Second),
+			TokenCacheTTL:    Duration(5 * time.Minute),
+			TokenRejectedTTL: Duration(30 * time.Second),
+			BreakerThreshold: 5,
+			BreakerCooldown:  Duration(30 * time.Second),
+		},
+		Stripe: Stripe{
+			APIBase: "https://api.stripe.com",
+		},
+		Checkout: Checkout{
+			SuccessURL: "https://rslbot.com/payment/success",
+			CancelURL:  "https://rslbot.com/purchase",
+		},
+		Frontend: Frontend{
+			URL: "https://rslbot.com",
+		},
+		Auth: Auth{
+			AccessTokenTTL:   Duration(15 * time.Minute),
+			RefreshTokenTTL:  Duration(30 * 24 * time.Hour),
+			ImpersonationTTL: Duration(30 * time.Minute),
+			SSONonceTTL:      Duration(10 * time.Minute),
+		},
+		Invoice: Invoice{
+			SellerName: "RSL Bot",
+		},
+		Tax: Tax{
+			IPCountryHeader: "cf-ipcountry",
+		},
+		Referral: Referral{
+			CommissionRate: 0.2,
+			HoldingPeriod:  Duration(30 * 24 * time.Hour),
+		},
+		RefundRequests: RefundRequests{
+			MaxAge:     Duration(14 * 24 * time.Hour),
+			UnusedOnly: true,
+		},
+		FakePayments: FakePayments{
+			BaseURL: "http://localhost:8080",
+		},
+	}
+}
+
+// Duration is a time.Duration written like "15m" or "720h" in the JSON file
+type Duration time.Duration
+
+// MarshalJSON writes the duration in the format of time.ParseDuration
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON reads a duration in the format of time.ParseDuration
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("duration must be a string like \"15m\": %w", err)
+	}
+	parsed, err := time.ParseDuration(raw)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// field is a setting, named by its flag, its environment variable is derived from the flag
+type field struct {
+	flag     string
+	usage    string
+	value    interface{} // *string, *bool, *[]string, *Duration, *int or *float64
+	required bool        // Optional settings disable a feature while empty, durations and counts must be positive
+}
+
+func (f field) env() string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(f.flag, "-", "_"))
+}
+
+func (f field) set(raw string) error {
+	switch value := f.value.(type) {
+	case *string:
+		*value = raw
+	case *bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		*value = parsed
+	case *[]string:
+		*value = nil
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*value = append(*value, item)
+			}
+		}
+	case *Duration:
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		*value = Duration(parsed)
+	case *int:
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		*value = parsed
+	case *float64:
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		*value = parsed
+	}
+	return nil
+}
+
+// fields lists the settings of a configuration, the flags keep the names they had before the config package
+func (cfg *Config) fields() []field {
+	return []field{
+		{"discourse-url", "Base URL of our Discourse instance", &cfg.Discourse.URL, true},
+		{"discourse-sso-secret", "Secret shared with Discourse for the SSO logins", &cfg.Discourse.SSOSecret, true},
+		{"discourse-api-key", "Discourse admin API key", &cfg.Discourse.APIKey, true},
+		{"discourse-api-username", "Discourse user of the admin API key", &cfg.Discourse.APIUsername, true},
+		{"discourse-webhook-secret", "Secret of the Discourse webhook sending user and group events", &cfg.Discourse.WebhookSecret, false},
+		{"discourse-license-group", "Discourse group reserved to license holders (empty disables the sync)", &cfg.Discourse.LicenseGroup, false},
+		{"discourse-timeout", "Timeout of the Discourse User-Api-Key verifications", &cfg.Discourse.Timeout, true},
+		{"discourse-token-cache-ttl", "How long a verified Discourse User-Api-Key is trusted without asking Discourse", &cfg.Discourse.TokenCacheTTL, true},
+		{"discourse-token-rejected-ttl", "How long a Discourse User-Api-Key rejected by Discourse stays rejected", &cfg.Discourse.TokenRejectedTTL, true},
+		{"discourse-breaker-threshold", "Consecutive Discourse failures after which verifications fail fast", &cfg.Discourse.BreakerThreshold, true},
+		{"discourse-breaker-cooldown", "Time verifications fail fast before Discourse is tried again", &cfg.Discourse.BreakerCooldown, true},
+		{"discord-bot-token", "Discord bot token for role management", &cfg.Discord.BotToken, false},
+		{"discord-guild-id", "Discord server of the lifetime role", &cfg.Discord.GuildID, false},
+		{"discord-lifetime-role-id", "Discord role given to lifetime license holders", &cfg.Discord.LifetimeRoleID, false},
+		{"discord-remove-role-on-refund", "Remove the Discord lifetime role when a refund or dispute revokes the license", &cfg.Discord.RemoveRoleOnRefund, false},
+		{"paypal-client-id", "PayPal Client ID", &cfg.PayPal.ClientID, false},
+		{"paypal-client-secret", "PayPal Client Secret", &cfg.PayPal.ClientSecret, false},
+		{"paypal-webhook-id", "PayPal Webhook ID", &cfg.PayPal.WebhookID, false},
+		{"paypal-sandbox", "Use the PayPal sandbox API", &cfg.PayPal.Sandbox, false},
+		{"fake-payments", "Replace PayPal with an in-process fake provider for local development (one-time checkouts only)", &cfg.FakePayments.Enabled, false},
+		{"fake-payments-base-url", "Base URL of the API serving the fake payment approval page", &cfg.FakePayments.BaseURL, false},
+		{"stripe-api-key", "Stripe secret API key", &cfg.Stripe.APIKey, false},
+		{"stripe-webhook-secret", "Stripe webhook signing secret", &cfg.Stripe.WebhookSecret, false},
+		{"stripe-api-base", "Base URL of the Stripe API", &cfg.Stripe.APIBase, true},
+		{"license-activate-secret", "Secret sent by the bot to activate licenses", &cfg.License.ActivateSecret, true},
+		{"license-check-secret", "Secret sent by the bot to check licenses", &cfg.License.CheckSecret, true},
+		{"checkout-success-url", "Frontend page the payment providers redirect to after a payment", &cfg.Checkout.SuccessURL, true},
+		{"checkout-cancel-url", "Frontend page the payment providers redirect to after a cancelled payment", &cfg.Checkout.CancelURL, true},
+		{"frontend-url", "Frontend origin allowed to receive the Discourse SSO responses", &cfg.Frontend.URL, true},
+		{"access-token-ttl", "Lifetime of the session access tokens", &cfg.Auth.AccessTokenTTL, true},
+		{"refresh-token-ttl", "Lifetime of the session refresh tokens, renewed by each refresh", &cfg.Auth.RefreshTokenTTL, true},
+		{"impersonation-ttl", "Lifetime of the admin impersonation tokens, they can't be refreshed", &cfg.Auth.ImpersonationTTL, true},
+		{"sso-nonce-ttl", "How long a Discourse SSO login can be completed after it was started", &cfg.Auth.SSONonceTTL, true},
+		{"support-group", "Discourse group giving the support role, disabled when empty", &cfg.Roles.SupportGroup, false},
+		{"billing-group", "Discourse group giving the billing role, disabled when empty", &cfg.Roles.BillingGroup, false},
+		{"offsets-maintainer-group", "Discourse group giving the offsets-maintainer role, disabled when empty", &cfg.Roles.OffsetsMaintainerGroup, false},
+		{"invoice-seller-name", "Seller name printed on the invoices", &cfg.Invoice.SellerName, false},
+		{"invoice-seller-address", "Seller address lines printed on the invoices, comma-separated in the environment", &cfg.Invoice.SellerAddress, false},
+		{"invoice-seller-email", "Seller contact email printed on the invoices", &cfg.Invoice.SellerEmail, false},
+		{"invoice-seller-tax-id", "Seller VAT ID printed on the invoices", &cfg.Invoice.SellerTaxID, false},
+		{"invoice-template", "File replacing the default invoice layout template", &cfg.Invoice.Template, false},
+		{"tax-ip-country-header", "Request header with the client IP country, used as VAT evidence", &cfg.Tax.IPCountryHeader, true},
+		{"referral-commission-rate", "Share of the net price of referred purchases earned by the referrer", &cfg.Referral.CommissionRate, true},
+		{"referral-holding-period", "Time commissions stay pending before they can be paid out", &cfg.Referral.HoldingPeriod, true},
+		{"refund-request-max-age", "Time after a purchase during which users can request a refund", &cfg.RefundRequests.MaxAge, true},
+		{"refund-request-unused-only", "Only accept refund requests for license keys never activated", &cfg.RefundRequests.UnusedOnly, false},
+	}
+}
+
+// RegisterFlags adds the configuration flags to a flag set, Load reads the ones that were set
+func RegisterFlags(fs *pflag.FlagSet) {
+	fs.String(FileFlag, "", fmt.Sprintf("JSON configuration file, also set by %s", FileEnv))
+	for _, f := range Default().fields() {
+		usage := fmt.Sprintf("%s (env %s)", f.usage, f.env())
+		switch value := f.value.(type) {
+		case *string:
+			fs.String(f.flag, *value, usage)
+		case *bool:
+			fs.Bool(f.flag, *value, usage)
+		case *[]string:
+			fs.StringSlice(f.flag, *value, usage)
+		case *Duration:
+			fs.Duration(f.flag, time.Duration(*value), usage)
+		case *int:
+			fs.Int(f.flag, *value, usage)
+		case *float64:
+			fs.Float64(f.flag, *value, usage)
+		}
+	}
+}
+
+// Load builds the configuration from the defaults, the file, the environment and the flags set in fs
+// fs can be nil, Load doesn't validate the configuration
+func Load(fs *pflag.FlagSet) (*Config, error) {
+	cfg := Default()
+
+	path := os.Getenv(FileEnv)
+	if fs != nil && fs.Changed(FileFlag) {
+		path = fs.Lookup(FileFlag).Value.String()
+	}
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, f := range cfg.fields() {
+		if raw, ok := os.LookupEnv(f.env()); ok {
+			if err := f.set(raw); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", f.env(), err)
+			}
+		}
+		if fs != nil && fs.Changed(f.flag) {
+			// Slices are taken as parsed, their String() is not in the format of the environment
+			if slice, ok := fs.Lookup(f.flag).Value.(pflag.SliceValue); ok {
+				*f.value.(*[]string) = slice.GetSlice()
+				continue
+			}
+			if err := f.set(fs.Lookup(f.flag).Value.String()); err != nil {
+				return nil, fmt.Errorf("invalid --%s: %w", f.flag, err)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// loadFile overrides the configuration with a JSON file, unknown keys are rejected to catch typos
+func (cfg *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate checks the values required to start the API server
+func (cfg *Config) Validate() error {
+	var missing []string
+	for _, f := range cfg.fields() {
+		switch value := f.value.(type) {
+		case *string:
+			if f.required && *value == "" {
+				missing = append(missing, fmt.Sprintf("--%s (%s)", f.flag, f.env()))
+			}
+		case *Duration:
+			if *value <= 0 {
+				return fmt.Errorf("invalid --%s: %s is not a positive duration", f.flag, time.Duration(*value))
+			}
+		case *int:
+			if *value <= 0 {
+				return fmt.Errorf("invalid --%s: %d is not a positive number", f.flag, *value)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required configuration: %s", strings.Join(missing, ", "))
+	}
+
+	// PayPal credentials are optional, fake payments run without them, but they go together
+	if (cfg.PayPal.ClientID == "") != (cfg.PayPal.ClientSecret == "") {
+		return fmt.Errorf("paypal client id and client secret must be set together")
+	}
+
+	if cfg.Referral.CommissionRate < 0 || cfg.Referral.CommissionRate > 1 {
+		return fmt.Errorf("invalid --referral-commission-rate: %v is not between 0 and 1", cfg.Referral.CommissionRate)
+	}
+
+	urls := map[string]string{
+		"discourse-url":        cfg.Discourse.URL,
+		"stripe-api-base":      cfg.Stripe.APIBase,
+		"checkout-success-url": cfg.Checkout.SuccessURL,
+		"checkout-cancel-url":  cfg.Checkout.CancelURL,
+		"frontend-url":         cfg.Frontend.URL,
+	}
+	if cfg.FakePayments.Enabled {
+		urls["fake-payments-base-url"] = cfg.FakePayments.BaseURL
+	}
+	for name, raw := range urls {
+		parsed, err := url.Parse(raw)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("invalid --%s: %q is not an absolute URL", name, raw)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, t.Name()+".json")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	flags := func(t *testing.T, args ...string) *pflag.FlagSet {
+		t.Helper()
+		fs := pflag.NewFlagSet("api", pflag.ContinueOnError)
+		RegisterFlags(fs)
+		require.NoError(t, fs.Parse(args))
+		return fs
+	}
+
+	t.Run("flags override the environment which overrides the file", func(t *testing.T) {
+		path := writeFile(t, `{
+			"discourse": {"sso_secret": "file-sso", "api_key": "file-key"},
+			"license": {"activate_secret": "file-activate", "check_secret": "file-check"},
+			"paypal": {"sandbox": true}
+		}`)
+		t.Setenv(FileEnv, path)
+		t.Setenv("RSLBOT_DISCOURSE_API_KEY", "env-key")
+		t.Setenv("RSLBOT_LICENSE_CHECK_SECRET", "env-check")
+		t.Setenv("RSLBOT_PAYPAL_SANDBOX", "false")
+
+		cfg, err := Load(flags(t, "--license-check-secret=flag-check"))
+		require.NoError(t, err)
+		assert.Equal(t, "file-sso", cfg.Discourse.SSOSecret)
+		assert.Equal(t, "env-key", cfg.Discourse.APIKey)
+		assert.Equal(t, "file-activate", cfg.License.ActivateSecret)
+		assert.Equal(t, "flag-check", cfg.License.CheckSecret)
+		assert.False(t, cfg.PayPal.Sandbox)
+		// Defaults stay for the values set nowhere
+		assert.Equal(t, "Fernandel", cfg.Discourse.APIUsername)
+		assert.Equal(t, Default().Checkout, cfg.Checkout)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("the config flag overrides its environment variable", func(t *testing.T) {
+		t.Setenv(FileEnv, filepath.Join(dir, "missing.json"))
+		path := writeFile(t, `{"stripe": {"api_key": "sk_test_file"}}`)
+
+		cfg, err := Load(flags(t, "--config", path))
+		require.NoError(t, err)
+		assert.Equal(t, "sk_test_file", cfg.Stripe.APIKey)
+
+		_, err = Load(flags(t))
+		assert.Error(t, err)
+	})
+
+	t.Run("lists are comma-separated in the environment", func(t *testing.T) {
+		t.Setenv(FileEnv, "")
+		t.Setenv("RSLBOT_INVOICE_SELLER_ADDRESS", "1 Main Street, 75001 Paris,")
+		cfg, err := Load(nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1 Main Street", "75001 Paris"}, cfg.Invoice.SellerAddress)
+		assert.Equal(t, "RSL Bot", cfg.Invoice.SellerName)
+
+		cfg, err = Load(flags(t, "--invoice-seller-address=Rue A", "--invoice-seller-address=Lyon", "--fake-payments"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Rue A", "Lyon"}, cfg.Invoice.SellerAddress)
+		assert.True(t, cfg.FakePayments.Enabled)
+	})
+
+	t.Run("durations and numbers are read from every source", func(t *testing.T) {
+		t.Setenv(FileEnv, writeFile(t, `{
+			"auth": {"access_token_ttl": "5m"},
+			"referral": {"commission_rate": 0.1},
+			"refund_requests": {"unused_only": false}
+		}`))
+		t.Setenv("RSLBOT_DISCOURSE_BREAKER_THRESHOLD", "3")
+		t.Setenv("RSLBOT_REFERRAL_HOLDING_PERIOD", "72h")
+
+		cfg, err := Load(flags(t, "--sso-nonce-ttl=2m", "--referral-commission-rate=0.25"))
+		require.NoError(t, err)
+		assert.Equal(t, Duration(5*time.Minute), cfg.Auth.AccessTokenTTL)
+		assert.Equal(t, Duration(2*time.Minute), cfg.Auth.SSONonceTTL)
+		assert.Equal(t, Default().Auth.RefreshTokenTTL, cfg.Auth.RefreshTokenTTL)
+		assert.Equal(t, 3, cfg.Discourse.BreakerThreshold)
+		assert.Equal(t, Duration(72*time.Hour), cfg.Referral.HoldingPeriod)
+		assert.Equal(t, 0.25, cfg.Referral.CommissionRate)
+		assert.False(t, cfg.RefundRequests.UnusedOnly)
+
+		t.Setenv(FileEnv, writeFile(t, `{"auth": {"access_token_ttl": 300}}`))
+		_, err = Load(nil)
+		assert.ErrorContains(t, err, "15m")
+	})
+
+	t.Run("invalid sources are rejected", func(t *testing.T) {
+		t.Setenv(FileEnv, writeFile(t, `{"discourse": {"sso_secrett": "typo"}}`))
+		_, err := Load(nil)
+		assert.ErrorContains(t, err, "sso_secrett")
+
+		t.Setenv(FileEnv, "")
+		t.Setenv("RSLBOT_PAYPAL_SANDBOX", "maybe")
+		_, err = Load(nil)
+		assert.ErrorContains(t, err, "RSLBOT_PAYPAL_SANDBOX")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Config {
+		cfg := Default()
+		cfg.Discourse.SSOSecret = "sso"
+		cfg.Discourse.APIKey = "key"
+		cfg.License.ActivateSecret = "activate"
+		cfg.License.CheckSecret = "check"
+		return cfg
+	}
+	require.NoError(t, valid().Validate())
+
+	err := Default().Validate()
+	require.Error(t, err)
+	for _, name := range []string{"--discourse-sso-secret", "RSLBOT_DISCOURSE_API_KEY", "--license-activate-secret", "--license-check-secret"} {
+		assert.Contains(t, err.Error(), name)
+	}
+
+	cfg := valid()
+	cfg.PayPal.ClientID = "client"
+	assert.ErrorContains(t, cfg.Validate(), "paypal")
+	cfg.PayPal.ClientSecret = "secret"
+	assert.NoError(t, cfg.Validate())
+
+	cfg = valid()
+	cfg.Checkout.SuccessURL = "/payment/success"
+	assert.ErrorContains(t, cfg.Validate(), "checkout-success-url")
+
+	cfg = valid()
+	cfg.FakePayments.BaseURL = "localhost:8080"
+	assert.NoError(t, cfg.Validate())
+	cfg.FakePayments.Enabled = true
+	assert.ErrorContains(t, cfg.Validate(), "fake-payments-base-url")
+
+	cfg = valid()
+	cfg.Frontend.URL = "rslbot.com"
+	assert.ErrorContains(t, cfg.Validate(), "frontend-url")
+
+	cfg = valid()
+	cfg.Discourse.URL = ""
+	assert.ErrorContains(t, cfg.Validate(), "--discourse-url")
+
+	cfg = valid()
+	cfg.Auth.RefreshTokenTTL = 0
+	assert.ErrorContains(t, cfg.Validate(), "refresh-token-ttl")
+
+	cfg = valid()
+	cfg.Discourse.BreakerThreshold = -1
+	assert.ErrorContains(t, cfg.Validate(), "discourse-breaker-threshold")
+
+	cfg = valid()
+	cfg.Referral.CommissionRate = 20
+	assert.ErrorContains(t, cfg.Validate(), "referral-commission-rate")
+}
@@ -51,6 +51,16 @@ const (
 	ERR_LOAD_OR_CREATE_USER                   ERR = 1014
 	ERR_PAYMENT_PROTOBUF_CONVERSION           ERR = 1015
 	ERR_SUBSCRIPTION_PROTOBUF_CONVERSION      ERR = 1016
+	ERR_BILLING_PLAN_PROTOBUF_CONVERSION      ERR = 1017
+	ERR_WEBHOOK_EVENT_PROTOBUF_CONVERSION     ERR = 1018
+	ERR_CHECKOUT_ORDER_PROTOBUF_CONVERSION    ERR = 1019
+	ERR_PRODUCT_PROTOBUF_CONVERSION           ERR = 1020
+	ERR_PRICE_PROTOBUF_CONVERSION             ERR = 1021
+	ERR_EXCHANGE_RATE_PROTOBUF_CONVERSION     ERR = 1022
+	ERR_INVOICE_PROTOBUF_CONVERSION           ERR = 1023
+	ERR_TAX_RATE_PROTOBUF_CONVERSION          ERR = 1024
+	ERR_COMMISSION_PROTOBUF_CONVERSION        ERR = 1025
+	ERR_REFUND_REQUEST_PROTOBUF_CONVERSION    ERR = 1026
 	// Authentication errors (starting at 2001)
 	ERR_AUTH_MISSING_METADATA         ERR = 2001
 	ERR_AUTH_MISSING_TOKEN            ERR = 2002
@@ -67,6 +77,13 @@ const (
 	ERR_AUTH_DISCOURSE_LOGOUT_ERROR   ERR = 2013
 	ERR_AUTH_DISCOURSE_REQUEST_ERROR  ERR = 2014
 	ERR_AUTH_DISCOURSE_RESPONSE_ERROR ERR = 2015
+	ERR_AUTH_SESSION_STORE_ERROR      ERR = 2016
+	ERR_AUTH_INVALID_REFRESH_TOKEN    ERR = 2017
+	ERR_AUTH_SSO_TOKEN_NOT_ACCEPTED   ERR = 2018
+	ERR_AUTH_SSO_NONCE_UNKNOWN        ERR = 2019
+	ERR_AUTH_SSO_NONCE_REUSED         ERR = 2020
+	ERR_AUTH_SSO_RETURN_URL_MISMATCH  ERR = 2021
+	ERR_AUTH_DISCOURSE_UNAVAILABLE    ERR = 2022
 	// License errors (starting at 3001)
 	ERR_LICENSE_REVOKED           ERR = 3001
 	ERR_LICENSE_EXPIRED           ERR = 3002
@@ -102,114 +119,263 @@ const (
 	ERR_PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED      ERR = 6011
 	ERR_PAYMENT_PAYPAL_ORDER_ID_MISSING          ERR = 6012
 	ERR_PAYMENT_INVALID_DURATION_PRICING         ERR = 6013
+	ERR_PAYMENT_METADATA_INVALID                 ERR = 6014
+	ERR_PAYMENT_STRIPE_CONFIG_MISSING            ERR = 6015
+	ERR_PAYMENT_STRIPE_API_REQUEST               ERR = 6016
+	ERR_PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID ERR = 6017
+	ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR       ERR = 6018
+	ERR_PAYMENT_RECURRING_DURATION_UNSUPPORTED   ERR = 6019
+	ERR_PAYMENT_BILLING_PLAN_NOT_FOUND           ERR = 6020
+	ERR_PAYMENT_PAYPAL_PLAN_PROVISIONING         ERR = 6021
+	ERR_PAYMENT_CREATE_PAYPAL_SUBSCRIPTION       ERR = 6022
+	ERR_PAYMENT_RETRIEVE_PAYPAL_SUBSCRIPTION     ERR = 6023
+	ERR_PAYMENT_NOT_FOUND                        ERR = 6024
+	ERR_PAYMENT_PAYPAL_CAPTURE_ID_MISSING        ERR = 6025
+	ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND         ERR = 6026
+	ERR_PAYMENT_CURRENCY_UNSUPPORTED             ERR = 6027
+	ERR_PAYMENT_EXCHANGE_RATE_INVALID            ERR = 6028
+	ERR_PAYMENT_TAX_RATE_INVALID                 ERR = 6029
+	ERR_PAYMENT_TAX_REPORT_PERIOD_INVALID        ERR = 6030
+	ERR_PAYMENT_SUBSCRIPTIONS_UNAVAILABLE        ERR = 6031
 	// Subscription errors (starting at 7001)
 	ERR_SUBSCRIPTION_ALREADY_ACTIVE   ERR = 7001
 	ERR_SUBSCRIPTION_ALREADY_CANCELED ERR = 7002
 	ERR_SUBSCRIPTION_CANCEL           ERR = 7003
+	ERR_SUBSCRIPTION_NOT_FOUND        ERR = 7004
 	// Rate limit errors (starting at 8001)
 	ERR_RATE_LIMIT_EXCEEDED ERR = 8001
 	// Discord errors (starting at 9001)
-	ERR_DISCORD_CONFIG_MISSING    ERR = 9001
-	ERR_DISCORD_REQUEST_CREATE    ERR = 9002
-	ERR_DISCORD_API_REQUEST       ERR = 9003
-	ERR_DISCORD_API_ERROR         ERR = 9004
-	ERR_DISCORD_USER_NOT_IN_GUILD ERR = 9005
-	ERR_DISCORD_BOT_NO_PERMISSION ERR = 9006
-	ERR_DISCOURSE_REQUEST_CREATE  ERR = 9007
-	ERR_DISCOURSE_API_REQUEST     ERR = 9008
-	ERR_DISCOURSE_API_RESPONSE    ERR = 9009
-	ERR_DISCOURSE_RESPONSE_PARSE  ERR = 9010
+	ERR_DISCORD_CONFIG_MISSING              ERR = 9001
+	ERR_DISCORD_REQUEST_CREATE              ERR = 9002
+	ERR_DISCORD_API_REQUEST                 ERR = 9003
+	ERR_DISCORD_API_ERROR                   ERR = 9004
+	ERR_DISCORD_USER_NOT_IN_GUILD           ERR = 9005
+	ERR_DISCORD_BOT_NO_PERMISSION           ERR = 9006
+	ERR_DISCOURSE_REQUEST_CREATE            ERR = 9007
+	ERR_DISCOURSE_API_REQUEST               ERR = 9008
+	ERR_DISCOURSE_API_RESPONSE              ERR = 9009
+	ERR_DISCOURSE_RESPONSE_PARSE            ERR = 9010
+	ERR_DISCOURSE_GROUP_NOT_CONFIGURED      ERR = 9011
+	ERR_DISCOURSE_GROUP_NOT_FOUND           ERR = 9012
+	ERR_DISCOURSE_GROUP_UPDATE              ERR = 9013
+	ERR_DISCOURSE_WEBHOOK_NOT_CONFIGURED    ERR = 9014
+	ERR_DISCOURSE_WEBHOOK_SIGNATURE_INVALID ERR = 9015
+	ERR_DISCOURSE_WEBHOOK_PAYLOAD_INVALID   ERR = 9016
+	ERR_DISCOURSE_NOT_FOUND                 ERR = 9017
+	// Webhook inbox errors (starting at 10001)
+	ERR_WEBHOOK_EVENT_NOT_FOUND       ERR = 10001
+	ERR_WEBHOOK_PROVIDER_UNSUPPORTED  ERR = 10002
+	ERR_WEBHOOK_EVENT_PAYLOAD_MISSING ERR = 10003
+	// Catalog errors (starting at 11001)
+	ERR_CATALOG_PRODUCT_NOT_FOUND  ERR = 11001
+	ERR_CATALOG_PRICE_NOT_FOUND    ERR = 11002
+	ERR_CATALOG_PRODUCT_INVALID    ERR = 11003
+	ERR_CATALOG_PRICE_INVALID      ERR = 11004
+	ERR_CATALOG_PRODUCT_HAS_PRICES ERR = 11005
+	// Invoice errors (starting at 12001)
+	ERR_INVOICE_NOT_FOUND         ERR = 12001
+	ERR_INVOICE_NOT_ISSUABLE      ERR = 12002
+	ERR_INVOICE_TEMPLATE          ERR = 12003
+	ERR_INVOICE_NUMBER_ALLOCATION ERR = 12004
+	// Referral errors (starting at 13001)
+	ERR_REFERRAL_CODE_GENERATION ERR = 13001
+	ERR_REFERRAL_NOTHING_PAYABLE ERR = 13002
+	// Refund request errors (starting at 14001)
+	ERR_REFUND_REQUEST_NOT_FOUND              ERR = 14001
+	ERR_REFUND_REQUEST_ALREADY_PENDING        ERR = 14002
+	ERR_REFUND_REQUEST_ALREADY_DECIDED        ERR = 14003
+	ERR_REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE ERR = 14004
+	ERR_REFUND_REQUEST_PERIOD_EXPIRED         ERR = 14005
+	ERR_REFUND_REQUEST_LICENSE_ACTIVATED      ERR = 14006
+	ERR_REFUND_REQUEST_PAYPAL_REFUND          ERR = 14007
+	// API key errors (starting at 15001)
+	ERR_API_KEY_NOT_FOUND           ERR = 15001
+	ERR_API_KEY_INVALID             ERR = 15002
+	ERR_API_KEY_EXPIRED             ERR = 15003
+	ERR_API_KEY_REVOKED             ERR = 15004
+	ERR_API_KEY_UNKNOWN_SCOPE       ERR = 15005
+	ERR_API_KEY_SCOPE_DENIED        ERR = 15006
+	ERR_API_KEY_INVALID_EXPIRY      ERR = 15007
+	ERR_API_KEY_PROTOBUF_CONVERSION ERR = 15008
+	// Role errors (starting at 16001)
+	ERR_ROLE_UNKNOWN             ERR = 16001
+	ERR_ROLE_NOT_GRANTED         ERR = 16002
+	ERR_ROLE_PROTOBUF_CONVERSION ERR = 16003
+	// Impersonation errors (starting at 17001)
+	ERR_IMPERSONATION_METHOD_DENIED       ERR = 17001
+	ERR_IMPERSONATION_READ_ONLY           ERR = 17002
+	ERR_IMPERSONATION_AUDIT_FAILED        ERR = 17003
+	ERR_IMPERSONATION_PROTOBUF_CONVERSION ERR = 17004
 )
 
 // Enum value maps for ERR.
 var (
 	ERR_name = map[int32]string{
-		0:    "UNSPECIFIED",
-		666:  "TODO",
-		777:  "NOT_IMPLEMENTED",
-		888:  "DEPRECATED",
-		999:  "INTERNAL",
-		101:  "INVALID_INPUT",
-		102:  "MISSING_INPUT",
-		105:  "RESTRICTED_AREA",
-		106:  "MARSHAL",
-		107:  "UNMARSHAL",
-		1001: "DB_NOT_FOUND",
-		1002: "DB_INTERNAL",
-		1003: "DB_INIT",
-		1004: "DB_CONNECT",
-		1005: "DB_AUTO_MIGRATE",
-		1006: "DB_ADD_CALLBACK",
-		1007: "CONFIGURE_DB",
-		1008: "USER_PROTOBUF_CONVERSION",
-		1009: "LICENSE_PROTOBUF_CONVERSION",
-		1010: "USER_NOT_FOUND",
-		1011: "USER_ID_FROM_STRING_CONVERSION",
-		1012: "LICENSE_KEY_ID_FROM_STRING_CONVERSION",
-		1013: "DISCOURSE_ID_FROM_STRING_CONVERSION",
-		1014: "LOAD_OR_CREATE_USER",
-		1015: "PAYMENT_PROTOBUF_CONVERSION",
-		1016: "SUBSCRIPTION_PROTOBUF_CONVERSION",
-		2001: "AUTH_MISSING_METADATA",
-		2002: "AUTH_MISSING_TOKEN",
-		2003: "AUTH_MISSING_CONTEXT",
-		2004: "AUTH_NO_PERMISSION",
-		2005: "AUTH_INVALID_TOKEN",
-		2006: "AUTH_INVALID_CLAIMS",
-		2007: "AUTH_INVALID_CREDENTIALS",
-		2008: "AUTH_INVALID_SSO_SIGNATURE",
-		2009: "AUTH_INVALID_SSO_PAYLOAD",
-		2010: "AUTH_INVALID_SSO_FORMAT",
-		2011: "AUTH_MISSING_SSO_USER_INFO",
-		2012: "AUTH_DISCOURSE_API_ERROR",
-		2013: "AUTH_DISCOURSE_LOGOUT_ERROR",
-		2014: "AUTH_DISCOURSE_REQUEST_ERROR",
-		2015: "AUTH_DISCOURSE_RESPONSE_ERROR",
-		3001: "LICENSE_REVOKED",
-		3002: "LICENSE_EXPIRED",
-		3003: "LICENSE_RANDOM_GENERATION",
-		3004: "LICENSE_COLLISION",
-		3005: "LICENSE_NOT_FOUND",
-		3006: "LICENSE_INVALID_USAGE_ID",
-		3007: "LICENSE_NOT_YET_EXPIRED",
-		3008: "LICENSE_INVALID_OPERATION",
-		3009: "LICENSE_NOT_YET_ACTIVATED",
-		3010: "LICENSE_REQUIRED",
-		4001: "REDIS_CONNECTION_ERROR",
-		4002: "REDIS_SCAN_ERROR",
-		4003: "REDIS_CONFIG_ERROR",
-		4004: "REDIS_QUERY_ERROR",
-		5001: "GET_USER_FROM_CTX",
-		5002: "API_LOGOUT",
-		5003: "GENERATE_LICENSE",
-		5004: "LICENSE_ALREADY_REVOKED",
-		6001: "PAYMENT_WEBHOOK_INVALID",
-		6002: "PAYMENT_CREATE_STRIPE_CHECKOUT_SESSION",
-		6003: "PAYMENT_CREATE_PAYPAL_CHECKOUT_SESSION",
-		6004: "PAYMENT_CREATE_PAYPAL_OAUTH_TOKEN",
-		6005: "PAYMENT_RETRIEVE_PAYPAL_ORDER",
-		6006: "PAYMENT_PAYPAL_WEBHOOK_SIGNATURE_INVALID",
-		6007: "PAYMENT_PAYPAL_ORDER_LINKS_MISSING",
-		6008: "PAYMENT_PAYPAL_APPROVAL_URL_MISSING",
-		6009: "PAYMENT_PAYPAL_METADATA_ERROR",
-		6010: "PAYMENT_PAYPAL_EVENT_PARSING_ERROR",
-		6011: "PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED",
-		6012: "PAYMENT_PAYPAL_ORDER_ID_MISSING",
-		6013: "PAYMENT_INVALID_DURATION_PRICING",
-		7001: "SUBSCRIPTION_ALREADY_ACTIVE",
-		7002: "SUBSCRIPTION_ALREADY_CANCELED",
-		7003: "SUBSCRIPTION_CANCEL",
-		8001: "RATE_LIMIT_EXCEEDED",
-		9001: "DISCORD_CONFIG_MISSING",
-		9002: "DISCORD_REQUEST_CREATE",
-		9003: "DISCORD_API_REQUEST",
-		9004: "DISCORD_API_ERROR",
-		9005: "DISCORD_USER_NOT_IN_GUILD",
-		9006: "DISCORD_BOT_NO_PERMISSION",
-		9007: "DISCOURSE_REQUEST_CREATE",
-		9008: "DISCOURSE_API_REQUEST",
-		9009: "DISCOURSE_API_RESPONSE",
-		9010: "DISCOURSE_RESPONSE_PARSE",
+		0:     "UNSPECIFIED",
+		666:   "TODO",
+		777:   "NOT_IMPLEMENTED",
+		888:   "DEPRECATED",
+		999:   "INTERNAL",
+		101:   "INVALID_INPUT",
+		102:   "MISSING_INPUT",
+		105:   "RESTRICTED_AREA",
+		106:   "MARSHAL",
+		107:   "UNMARSHAL",
+		1001:  "DB_NOT_FOUND",
+		1002:  "DB_INTERNAL",
+		1003:  "DB_INIT",
+		1004:  "DB_CONNECT",
+		1005:  "DB_AUTO_MIGRATE",
+		1006:  "DB_ADD_CALLBACK",
+		1007:  "CONFIGURE_DB",
+		1008:  "USER_PROTOBUF_CONVERSION",
+		1009:  "LICENSE_PROTOBUF_CONVERSION",
+		1010:  "USER_NOT_FOUND",
+		1011:  "USER_ID_FROM_STRING_CONVERSION",
+		1012:  "LICENSE_KEY_ID_FROM_STRING_CONVERSION",
+		1013:  "DISCOURSE_ID_FROM_STRING_CONVERSION",
+		1014:  "LOAD_OR_CREATE_USER",
+		1015:  "PAYMENT_PROTOBUF_CONVERSION",
+		1016:  "SUBSCRIPTION_PROTOBUF_CONVERSION",
+		1017:  "BILLING_PLAN_PROTOBUF_CONVERSION",
+		1018:  "WEBHOOK_EVENT_PROTOBUF_CONVERSION",
+		1019:  "CHECKOUT_ORDER_PROTOBUF_CONVERSION",
+		1020:  "PRODUCT_PROTOBUF_CONVERSION",
+		1021:  "PRICE_PROTOBUF_CONVERSION",
+		1022:  "EXCHANGE_RATE_PROTOBUF_CONVERSION",
+		1023:  "INVOICE_PROTOBUF_CONVERSION",
+		1024:  "TAX_RATE_PROTOBUF_CONVERSION",
+		1025:  "COMMISSION_PROTOBUF_CONVERSION",
+		1026:  "REFUND_REQUEST_PROTOBUF_CONVERSION",
+		2001:  "AUTH_MISSING_METADATA",
+		2002:  "AUTH_MISSING_TOKEN",
+		2003:  "AUTH_MISSING_CONTEXT",
+		2004:  "AUTH_NO_PERMISSION",
+		2005:  "AUTH_INVALID_TOKEN",
+		2006:  "AUTH_INVALID_CLAIMS",
+		2007:  "AUTH_INVALID_CREDENTIALS",
+		2008:  "AUTH_INVALID_SSO_SIGNATURE",
+		2009:  "AUTH_INVALID_SSO_PAYLOAD",
+		2010:  "AUTH_INVALID_SSO_FORMAT",
+		2011:  "AUTH_MISSING_SSO_USER_INFO",
+		2012:  "AUTH_DISCOURSE_API_ERROR",
+		2013:  "AUTH_DISCOURSE_LOGOUT_ERROR",
+		2014:  "AUTH_DISCOURSE_REQUEST_ERROR",
+		2015:  "AUTH_DISCOURSE_RESPONSE_ERROR",
+		2016:  "AUTH_SESSION_STORE_ERROR",
+		2017:  "AUTH_INVALID_REFRESH_TOKEN",
+		2018:  "AUTH_SSO_TOKEN_NOT_ACCEPTED",
+		2019:  "AUTH_SSO_NONCE_UNKNOWN",
+		2020:  "AUTH_SSO_NONCE_REUSED",
+		2021:  "AUTH_SSO_RETURN_URL_MISMATCH",
+		2022:  "AUTH_DISCOURSE_UNAVAILABLE",
+		3001:  "LICENSE_REVOKED",
+		3002:  "LICENSE_EXPIRED",
+		3003:  "LICENSE_RANDOM_GENERATION",
+		3004:  "LICENSE_COLLISION",
+		3005:  "LICENSE_NOT_FOUND",
+		3006:  "LICENSE_INVALID_USAGE_ID",
+		3007:  "LICENSE_NOT_YET_EXPIRED",
+		3008:  "LICENSE_INVALID_OPERATION",
+		3009:  "LICENSE_NOT_YET_ACTIVATED",
+		3010:  "LICENSE_REQUIRED",
+		4001:  "REDIS_CONNECTION_ERROR",
+		4002:  "REDIS_SCAN_ERROR",
+		4003:  "REDIS_CONFIG_ERROR",
+		4004:  "REDIS_QUERY_ERROR",
+		5001:  "GET_USER_FROM_CTX",
+		5002:  "API_LOGOUT",
+		5003:  "GENERATE_LICENSE",
+		5004:  "LICENSE_ALREADY_REVOKED",
+		6001:  "PAYMENT_WEBHOOK_INVALID",
+		6002:  "PAYMENT_CREATE_STRIPE_CHECKOUT_SESSION",
+		6003:  "PAYMENT_CREATE_PAYPAL_CHECKOUT_SESSION",
+		6004:  "PAYMENT_CREATE_PAYPAL_OAUTH_TOKEN",
+		6005:  "PAYMENT_RETRIEVE_PAYPAL_ORDER",
+		6006:  "PAYMENT_PAYPAL_WEBHOOK_SIGNATURE_INVALID",
+		6007:  "PAYMENT_PAYPAL_ORDER_LINKS_MISSING",
+		6008:  "PAYMENT_PAYPAL_APPROVAL_URL_MISSING",
+		6009:  "PAYMENT_PAYPAL_METADATA_ERROR",
+		6010:  "PAYMENT_PAYPAL_EVENT_PARSING_ERROR",
+		6011:  "PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED",
+		6012:  "PAYMENT_PAYPAL_ORDER_ID_MISSING",
+		6013:  "PAYMENT_INVALID_DURATION_PRICING",
+		6014:  "PAYMENT_METADATA_INVALID",
+		6015:  "PAYMENT_STRIPE_CONFIG_MISSING",
+		6016:  "PAYMENT_STRIPE_API_REQUEST",
+		6017:  "PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID",
+		6018:  "PAYMENT_STRIPE_EVENT_PARSING_ERROR",
+		6019:  "PAYMENT_RECURRING_DURATION_UNSUPPORTED",
+		6020:  "PAYMENT_BILLING_PLAN_NOT_FOUND",
+		6021:  "PAYMENT_PAYPAL_PLAN_PROVISIONING",
+		6022:  "PAYMENT_CREATE_PAYPAL_SUBSCRIPTION",
+		6023:  "PAYMENT_RETRIEVE_PAYPAL_SUBSCRIPTION",
+		6024:  "PAYMENT_NOT_FOUND",
+		6025:  "PAYMENT_PAYPAL_CAPTURE_ID_MISSING",
+		6026:  "PAYMENT_CHECKOUT_ORDER_NOT_FOUND",
+		6027:  "PAYMENT_CURRENCY_UNSUPPORTED",
+		6028:  "PAYMENT_EXCHANGE_RATE_INVALID",
+		6029:  "PAYMENT_TAX_RATE_INVALID",
+		6030:  "PAYMENT_TAX_REPORT_PERIOD_INVALID",
+		6031:  "PAYMENT_SUBSCRIPTIONS_UNAVAILABLE",
+		7001:  "SUBSCRIPTION_ALREADY_ACTIVE",
+		7002:  "SUBSCRIPTION_ALREADY_CANCELED",
+		7003:  "SUBSCRIPTION_CANCEL",
+		7004:  "SUBSCRIPTION_NOT_FOUND",
+		8001:  "RATE_LIMIT_EXCEEDED",
+		9001:  "DISCORD_CONFIG_MISSING",
+		9002:  "DISCORD_REQUEST_CREATE",
+		9003:  "DISCORD_API_REQUEST",
+		9004:  "DISCORD_API_ERROR",
+		9005:  "DISCORD_USER_NOT_IN_GUILD",
+		9006:  "DISCORD_BOT_NO_PERMISSION",
+		9007:  "DISCOURSE_REQUEST_CREATE",
+		9008:  "DISCOURSE_API_REQUEST",
+		9009:  "DISCOURSE_API_RESPONSE",
+		9010:  "DISCOURSE_RESPONSE_PARSE",
+		9011:  "DISCOURSE_GROUP_NOT_CONFIGURED",
+		9012:  "DISCOURSE_GROUP_NOT_FOUND",
+		9013:  "DISCOURSE_GROUP_UPDATE",
+		9014:  "DISCOURSE_WEBHOOK_NOT_CONFIGURED",
+		9015:  "DISCOURSE_WEBHOOK_SIGNATURE_INVALID",
+		9016:  "DISCOURSE_WEBHOOK_PAYLOAD_INVALID",
+		9017:  "DISCOURSE_NOT_FOUND",
+		10001: "WEBHOOK_EVENT_NOT_FOUND",
+		10002: "WEBHOOK_PROVIDER_UNSUPPORTED",
+		10003: "WEBHOOK_EVENT_PAYLOAD_MISSING",
+		11001: "CATALOG_PRODUCT_NOT_FOUND",
+		11002: "CATALOG_PRICE_NOT_FOUND",
+		11003: "CATALOG_PRODUCT_INVALID",
+		11004: "CATALOG_PRICE_INVALID",
+		11005: "CATALOG_PRODUCT_HAS_PRICES",
+		12001: "INVOICE_NOT_FOUND",
+		12002: "INVOICE_NOT_ISSUABLE",
+		12003: "INVOICE_TEMPLATE",
+		12004: "INVOICE_NUMBER_ALLOCATION",
+		13001: "REFERRAL_CODE_GENERATION",
+		13002: "REFERRAL_NOTHING_PAYABLE",
+		14001: "REFUND_REQUEST_NOT_FOUND",
+		14002: "REFUND_REQUEST_ALREADY_PENDING",
+		14003: "REFUND_REQUEST_ALREADY_DECIDED",
+		14004: "REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE",
+		14005: "REFUND_REQUEST_PERIOD_EXPIRED",
+		14006: "REFUND_REQUEST_LICENSE_ACTIVATED",
+		14007: "REFUND_REQUEST_PAYPAL_REFUND",
+		15001: "API_KEY_NOT_FOUND",
+		15002: "API_KEY_INVALID",
+		15003: "API_KEY_EXPIRED",
+		15004: "API_KEY_REVOKED",
+		15005: "API_KEY_UNKNOWN_SCOPE",
+		15006: "API_KEY_SCOPE_DENIED",
+		15007: "API_KEY_INVALID_EXPIRY",
+		15008: "API_KEY_PROTOBUF_CONVERSION",
+		16001: "ROLE_UNKNOWN",
+		16002: "ROLE_NOT_GRANTED",
+		16003: "ROLE_PROTOBUF_CONVERSION",
+		17001: "IMPERSONATION_METHOD_DENIED",
+		17002: "IMPERSONATION_READ_ONLY",
+		17003: "IMPERSONATION_AUDIT_FAILED",
+		17004: "IMPERSONATION_PROTOBUF_CONVERSION",
 	}
 	ERR_value = map[string]int32{
 		"UNSPECIFIED":                              0,
@@ -238,6 +404,16 @@ var (
 		"LOAD_OR_CREATE_USER":                      1014,
 		"PAYMENT_PROTOBUF_CONVERSION":              1015,
 		"SUBSCRIPTION_PROTOBUF_CONVERSION":         1016,
+		"BILLING_PLAN_PROTOBUF_CONVERSION":         1017,
+		"WEBHOOK_EVENT_PROTOBUF_CONVERSION":        1018,
+		"CHECKOUT_ORDER_PROTOBUF_CONVERSION":       1019,
+		"PRODUCT_PROTOBUF_CONVERSION":              1020,
+		"PRICE_PROTOBUF_CONVERSION":                1021,
+		"EXCHANGE_RATE_PROTOBUF_CONVERSION":        1022,
+		"INVOICE_PROTOBUF_CONVERSION":              1023,
+		"TAX_RATE_PROTOBUF_CONVERSION":             1024,
+		"COMMISSION_PROTOBUF_CONVERSION":           1025,
+		"REFUND_REQUEST_PROTOBUF_CONVERSION":       1026,
 		"AUTH_MISSING_METADATA":                    2001,
 		"AUTH_MISSING_TOKEN":                       2002,
 		"AUTH_MISSING_CONTEXT":                     2003,
@@ -253,6 +429,13 @@ var (
 		"AUTH_DISCOURSE_LOGOUT_ERROR":              2013,
 		"AUTH_DISCOURSE_REQUEST_ERROR":             2014,
 		"AUTH_DISCOURSE_RESPONSE_ERROR":            2015,
+		"AUTH_SESSION_STORE_ERROR":                 2016,
+		"AUTH_INVALID_REFRESH_TOKEN":               2017,
+		"AUTH_SSO_TOKEN_NOT_ACCEPTED":              2018,
+		"AUTH_SSO_NONCE_UNKNOWN":                   2019,
+		"AUTH_SSO_NONCE_REUSED":                    2020,
+		"AUTH_SSO_RETURN_URL_MISMATCH":             2021,
+		"AUTH_DISCOURSE_UNAVAILABLE":               2022,
 		"LICENSE_REVOKED":                          3001,
 		"LICENSE_EXPIRED":                          3002,
 		"LICENSE_RANDOM_GENERATION":                3003,
@@ -284,9 +467,28 @@ var (
 		"PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED":      6011,
 		"PAYMENT_PAYPAL_ORDER_ID_MISSING":          6012,
 		"PAYMENT_INVALID_DURATION_PRICING":         6013,
+		"PAYMENT_METADATA_INVALID":                 6014,
+		"PAYMENT_STRIPE_CONFIG_MISSING":            6015,
+		"PAYMENT_STRIPE_API_REQUEST":               6016,
+		"PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID": 6017,
+		"PAYMENT_STRIPE_EVENT_PARSING_ERROR":       6018,
+		"PAYMENT_RECURRING_DURATION_UNSUPPORTED":   6019,
+		"PAYMENT_BILLING_PLAN_NOT_FOUND":           6020,
+		"PAYMENT_PAYPAL_PLAN_PROVISIONING":         6021,
+		"PAYMENT_CREATE_PAYPAL_SUBSCRIPTION":       6022,
+		"PAYMENT_RETRIEVE_PAYPAL_SUBSCRIPTION":     6023,
+		"PAYMENT_NOT_FOUND":                        6024,
+		"PAYMENT_PAYPAL_CAPTURE_ID_MISSING":        6025,
+		"PAYMENT_CHECKOUT_ORDER_NOT_FOUND":         6026,
+		"PAYMENT_CURRENCY_UNSUPPORTED":             6027,
+		"PAYMENT_EXCHANGE_RATE_INVALID":            6028,
+		"PAYMENT_TAX_RATE_INVALID":                 6029,
+		"PAYMENT_TAX_REPORT_PERIOD_INVALID":        6030,
+		"PAYMENT_SUBSCRIPTIONS_UNAVAILABLE":        6031,
 		"SUBSCRIPTION_ALREADY_ACTIVE":              7001,
 		"SUBSCRIPTION_ALREADY_CANCELED":            7002,
 		"SUBSCRIPTION_CANCEL":                      7003,
+		"SUBSCRIPTION_NOT_FOUND":                   7004,
 		"RATE_LIMIT_EXCEEDED":                      8001,
 		"DISCORD_CONFIG_MISSING":                   9001,
 		"DISCORD_REQUEST_CREATE":                   9002,
@@ -298,6 +500,49 @@ var (
 		"DISCOURSE_API_REQUEST":                    9008,
 		"DISCOURSE_API_RESPONSE":                   9009,
 		"DISCOURSE_RESPONSE_PARSE":                 9010,
+		"DISCOURSE_GROUP_NOT_CONFIGURED":           9011,
+		"DISCOURSE_GROUP_NOT_FOUND":                9012,
+		"DISCOURSE_GROUP_UPDATE":                   9013,
+		"DISCOURSE_WEBHOOK_NOT_CONFIGURED":         9014,
+		"DISCOURSE_WEBHOOK_SIGNATURE_INVALID":      9015,
+		"DISCOURSE_WEBHOOK_PAYLOAD_INVALID":        9016,
+		"DISCOURSE_NOT_FOUND":                      9017,
+		"WEBHOOK_EVENT_NOT_FOUND":                  10001,
+		"WEBHOOK_PROVIDER_UNSUPPORTED":             10002,
+		"WEBHOOK_EVENT_PAYLOAD_MISSING":            10003,
+		"CATALOG_PRODUCT_NOT_FOUND":                11001,
+		"CATALOG_PRICE_NOT_FOUND":                  11002,
+		"CATALOG_PRODUCT_INVALID":                  11003,
+		"CATALOG_PRICE_INVALID":                    11004,
+		"CATALOG_PRODUCT_HAS_PRICES":               11005,
+		"INVOICE_NOT_FOUND":                        12001,
+		"INVOICE_NOT_ISSUABLE":                     12002,
+		"INVOICE_TEMPLATE":                         12003,
+		"INVOICE_NUMBER_ALLOCATION":                12004,
+		"REFERRAL_CODE_GENERATION":                 13001,
+		"REFERRAL_NOTHING_PAYABLE":                 13002,
+		"REFUND_REQUEST_NOT_FOUND":                 14001,
+		"REFUND_REQUEST_ALREADY_PENDING":           14002,
+		"REFUND_REQUEST_ALREADY_DECIDED":           14003,
+		"REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE":    14004,
+		"REFUND_REQUEST_PERIOD_EXPIRED":            14005,
+		"REFUND_REQUEST_LICENSE_ACTIVATED":         14006,
+		"REFUND_REQUEST_PAYPAL_REFUND":             14007,
+		"API_KEY_NOT_FOUND":                        15001,
+		"API_KEY_INVALID":                          15002,
+		"API_KEY_EXPIRED":                          15003,
+		"API_KEY_REVOKED":                          15004,
+		"API_KEY_UNKNOWN_SCOPE":                    15005,
+		"API_KEY_SCOPE_DENIED":                     15006,
+		"API_KEY_INVALID_EXPIRY":                   15007,
+		"API_KEY_PROTOBUF_CONVERSION":              15008,
+		"ROLE_UNKNOWN":                             16001,
+		"ROLE_NOT_GRANTED":                         16002,
+		"ROLE_PROTOBUF_CONVERSION":                 16003,
+		"IMPERSONATION_METHOD_DENIED":              17001,
+		"IMPERSONATION_READ_ONLY":                  17002,
+		"IMPERSONATION_AUDIT_FAILED":               17003,
+		"IMPERSONATION_PROTOBUF_CONVERSION":        17004,
 	}
 )
 
@@ -333,7 +578,7 @@ var File_proto_rslbot_errcode_proto protoreflect.FileDescriptor
 var file_proto_rslbot_errcode_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2f, 0x65,
 	0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x72, 0x73,
-	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x65, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xa1, 0x13, 0x0a,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x65, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xfc, 0x27, 0x0a,
 	0x03, 0x45, 0x52, 0x52, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
 	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x04, 0x54, 0x4f, 0x44, 0x4f, 0x10, 0x9a, 0x05,
 	0x12, 0x14, 0x0a, 0x0f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e,
@@ -372,133 +617,298 @@ var file_proto_rslbot_errcode_proto_rawDesc = []byte{
 	0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xf7, 0x07, 0x12, 0x25, 0x0a,
 	0x20, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52,
 	0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
-	0x4e, 0x10, 0xf8, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49, 0x53,
-	0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0xd1, 0x0f,
-	0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
-	0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xd2, 0x0f, 0x12, 0x19, 0x0a, 0x14, 0x41, 0x55, 0x54,
-	0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x58,
-	0x54, 0x10, 0xd3, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4e, 0x4f, 0x5f,
-	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xd4, 0x0f, 0x12, 0x17, 0x0a,
-	0x12, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x10, 0xd5, 0x0f, 0x12, 0x18, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49,
-	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x53, 0x10, 0xd6, 0x0f,
-	0x12, 0x1d, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x53, 0x10, 0xd7, 0x0f, 0x12,
-	0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
-	0x53, 0x53, 0x4f, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0xd8, 0x0f,
-	0x12, 0x1d, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0xd9, 0x0f, 0x12,
-	0x1c, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
-	0x53, 0x53, 0x4f, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0xda, 0x0f, 0x12, 0x1f, 0x0a,
-	0x1a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x53,
-	0x4f, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0xdb, 0x0f, 0x12, 0x1d,
-	0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45,
-	0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xdc, 0x0f, 0x12, 0x20, 0x0a,
-	0x1b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f,
-	0x4c, 0x4f, 0x47, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xdd, 0x0f, 0x12,
-	0x21, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53,
-	0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0xde, 0x0f, 0x12, 0x22, 0x0a, 0x1d, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f,
-	0x55, 0x52, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0xdf, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
-	0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0xb9, 0x17, 0x12, 0x14, 0x0a, 0x0f,
-	0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
-	0xba, 0x17, 0x12, 0x1e, 0x0a, 0x19, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x41,
-	0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0xbb, 0x17, 0x12, 0x16, 0x0a, 0x11, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f,
-	0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xbc, 0x17, 0x12, 0x16, 0x0a, 0x11, 0x4c, 0x49,
-	0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0xbd, 0x17, 0x12, 0x1d, 0x0a, 0x18, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x10, 0xbe,
-	0x17, 0x12, 0x1c, 0x0a, 0x17, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x59, 0x45, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xbf, 0x17, 0x12,
-	0x1e, 0x0a, 0x19, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0, 0x17, 0x12,
-	0x1e, 0x0a, 0x19, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59,
-	0x45, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x44, 0x10, 0xc1, 0x17, 0x12,
-	0x15, 0x0a, 0x10, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49,
-	0x52, 0x45, 0x44, 0x10, 0xc2, 0x17, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f,
-	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0xa1, 0x1f, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f, 0x53, 0x43, 0x41,
-	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa2, 0x1f, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x45,
-	0x44, 0x49, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0xa3, 0x1f, 0x12, 0x16, 0x0a, 0x11, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f, 0x51, 0x55, 0x45,
-	0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa4, 0x1f, 0x12, 0x16, 0x0a, 0x11, 0x47,
-	0x45, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x43, 0x54, 0x58,
-	0x10, 0x89, 0x27, 0x12, 0x0f, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x5f, 0x4c, 0x4f, 0x47, 0x4f, 0x55,
-	0x54, 0x10, 0x8a, 0x27, 0x12, 0x15, 0x0a, 0x10, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45,
-	0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x8b, 0x27, 0x12, 0x1c, 0x0a, 0x17, 0x4c,
-	0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52,
-	0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x8c, 0x27, 0x12, 0x1c, 0x0a, 0x17, 0x50, 0x41, 0x59,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x10, 0xf1, 0x2e, 0x12, 0x2b, 0x0a, 0x26, 0x50, 0x41, 0x59, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45,
-	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f,
-	0x4e, 0x10, 0xf2, 0x2e, 0x12, 0x2b, 0x0a, 0x26, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xf3,
-	0x2e, 0x12, 0x26, 0x0a, 0x21, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xf4, 0x2e, 0x12, 0x22, 0x0a, 0x1d, 0x50, 0x41, 0x59,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f, 0x50, 0x41,
-	0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0xf5, 0x2e, 0x12, 0x2d, 0x0a,
-	0x28, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f,
-	0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52,
-	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xf6, 0x2e, 0x12, 0x27, 0x0a, 0x22,
-	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
-	0x4e, 0x47, 0x10, 0xf7, 0x2e, 0x12, 0x28, 0x0a, 0x23, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c,
-	0x5f, 0x55, 0x52, 0x4c, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xf8, 0x2e, 0x12,
-	0x22, 0x0a, 0x1d, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41,
-	0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0xf9, 0x2e, 0x12, 0x27, 0x0a, 0x22, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50,
-	0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x53,
-	0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xfa, 0x2e, 0x12, 0x28, 0x0a, 0x23,
-	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0xfb, 0x2e, 0x12, 0x24, 0x0a, 0x1f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49,
-	0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xfc, 0x2e, 0x12, 0x25, 0x0a, 0x20,
-	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
-	0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x49, 0x4e, 0x47,
-	0x10, 0xfd, 0x2e, 0x12, 0x20, 0x0a, 0x1b, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0xd9, 0x36, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49,
-	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x41,
-	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0xda, 0x36, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x55, 0x42,
-	0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
-	0x10, 0xdb, 0x36, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
-	0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0xc1, 0x3e, 0x12, 0x1b, 0x0a,
-	0x16, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xa9, 0x46, 0x12, 0x1b, 0x0a, 0x16, 0x44, 0x49,
-	0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x10, 0xaa, 0x46, 0x12, 0x18, 0x0a, 0x13, 0x44, 0x49, 0x53, 0x43, 0x4f,
-	0x52, 0x44, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xab,
-	0x46, 0x12, 0x16, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x41, 0x50, 0x49,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xac, 0x46, 0x12, 0x1e, 0x0a, 0x19, 0x44, 0x49, 0x53,
-	0x43, 0x4f, 0x52, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e,
-	0x5f, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x10, 0xad, 0x46, 0x12, 0x1e, 0x0a, 0x19, 0x44, 0x49, 0x53,
-	0x43, 0x4f, 0x52, 0x44, 0x5f, 0x42, 0x4f, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x45, 0x52, 0x4d,
-	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x46, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x49, 0x53,
-	0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43,
-	0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0xaf, 0x46, 0x12, 0x1a, 0x0a, 0x15, 0x44, 0x49, 0x53, 0x43,
-	0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x10, 0xb0, 0x46, 0x12, 0x1b, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53,
-	0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0xb1,
-	0x46, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x52,
-	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x10, 0xb2, 0x46,
-	0x42, 0x96, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
-	0x65, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x45, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x19, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x72, 0x72, 0x63, 0x6f,
-	0x64, 0x65, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x52, 0x73, 0x6c, 0x62, 0x6f,
-	0x74, 0x2e, 0x45, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0xca, 0x02, 0x0e, 0x52, 0x73, 0x6c, 0x62,
-	0x6f, 0x74, 0x5c, 0x45, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0xe2, 0x02, 0x1a, 0x52, 0x73, 0x6c,
-	0x62, 0x6f, 0x74, 0x5c, 0x45, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74,
-	0x3a, 0x3a, 0x45, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x4e, 0x10, 0xf8, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x42, 0x49, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f,
+	0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xf9, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x57,
+	0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0xfa, 0x07, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43,
+	0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xfb, 0x07, 0x12, 0x20, 0x0a, 0x1b,
+	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46,
+	0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xfc, 0x07, 0x12, 0x1e,
+	0x0a, 0x19, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46,
+	0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xfd, 0x07, 0x12, 0x26,
+	0x0a, 0x21, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0xfe, 0x07, 0x12, 0x20, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43,
+	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xff, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x54, 0x41, 0x58, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f,
+	0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x80, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x43,
+	0x4f, 0x4d, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42,
+	0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x81, 0x08,
+	0x12, 0x27, 0x0a, 0x22, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x82, 0x08, 0x12, 0x1a, 0x0a, 0x15, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41,
+	0x54, 0x41, 0x10, 0xd1, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xd2, 0x0f, 0x12, 0x19,
+	0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0xd3, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10,
+	0xd4, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xd5, 0x0f, 0x12, 0x18, 0x0a, 0x13, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4c, 0x41, 0x49,
+	0x4d, 0x53, 0x10, 0xd6, 0x0f, 0x12, 0x1d, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c,
+	0x53, 0x10, 0xd7, 0x0f, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0xd8, 0x0f, 0x12, 0x1d, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41,
+	0x44, 0x10, 0xd9, 0x0f, 0x12, 0x1c, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10,
+	0xda, 0x0f, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
+	0x10, 0xdb, 0x0f, 0x12, 0x1d, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0xdc, 0x0f, 0x12, 0x20, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f,
+	0x55, 0x52, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0xdd, 0x0f, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x53,
+	0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xde, 0x0f, 0x12, 0x22, 0x0a, 0x1d, 0x41, 0x55, 0x54, 0x48, 0x5f,
+	0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xdf, 0x0f, 0x12, 0x1d, 0x0a, 0x18, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x52,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xe0, 0x0f, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45,
+	0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xe1, 0x0f, 0x12, 0x20, 0x0a, 0x1b, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0xe2, 0x0f, 0x12, 0x1b, 0x0a,
+	0x16, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x4e, 0x4f, 0x4e, 0x43, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xe3, 0x0f, 0x12, 0x1a, 0x0a, 0x15, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x53, 0x53, 0x4f, 0x5f, 0x4e, 0x4f, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0xe4, 0x0f, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53,
+	0x53, 0x4f, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x55, 0x52, 0x4c, 0x5f, 0x4d, 0x49,
+	0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0xe5, 0x0f, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56,
+	0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xe6, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49,
+	0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0xb9, 0x17,
+	0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0xba, 0x17, 0x12, 0x1e, 0x0a, 0x19, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
+	0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0xbb, 0x17, 0x12, 0x16, 0x0a, 0x11, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xbc, 0x17, 0x12, 0x16,
+	0x0a, 0x11, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0xbd, 0x17, 0x12, 0x1d, 0x0a, 0x18, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
+	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x49, 0x44, 0x10, 0xbe, 0x17, 0x12, 0x1c, 0x0a, 0x17, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x45, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0xbf, 0x17, 0x12, 0x1e, 0x0a, 0x19, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0xc0, 0x17, 0x12, 0x1e, 0x0a, 0x19, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x59, 0x45, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0xc1, 0x17, 0x12, 0x15, 0x0a, 0x10, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0xc2, 0x17, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x45,
+	0x44, 0x49, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xa1, 0x1f, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x44, 0x49, 0x53,
+	0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa2, 0x1f, 0x12, 0x17,
+	0x0a, 0x12, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xa3, 0x1f, 0x12, 0x16, 0x0a, 0x11, 0x52, 0x45, 0x44, 0x49, 0x53,
+	0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa4, 0x1f, 0x12,
+	0x16, 0x0a, 0x11, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x4f, 0x4d,
+	0x5f, 0x43, 0x54, 0x58, 0x10, 0x89, 0x27, 0x12, 0x0f, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x5f, 0x4c,
+	0x4f, 0x47, 0x4f, 0x55, 0x54, 0x10, 0x8a, 0x27, 0x12, 0x15, 0x0a, 0x10, 0x47, 0x45, 0x4e, 0x45,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x10, 0x8b, 0x27, 0x12,
+	0x1c, 0x0a, 0x17, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x8c, 0x27, 0x12, 0x1c, 0x0a,
+	0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xf1, 0x2e, 0x12, 0x2b, 0x0a, 0x26, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54,
+	0x52, 0x49, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xf2, 0x2e, 0x12, 0x2b, 0x0a, 0x26, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41,
+	0x4c, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0xf3, 0x2e, 0x12, 0x26, 0x0a, 0x21, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xf4, 0x2e, 0x12, 0x22, 0x0a,
+	0x1d, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56,
+	0x45, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0xf5,
+	0x2e, 0x12, 0x2d, 0x0a, 0x28, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59,
+	0x50, 0x41, 0x4c, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xf6, 0x2e,
+	0x12, 0x27, 0x0a, 0x22, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50,
+	0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x5f, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xf7, 0x2e, 0x12, 0x28, 0x0a, 0x23, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x52,
+	0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x52, 0x4c, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0xf8, 0x2e, 0x12, 0x22, 0x0a, 0x1d, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50,
+	0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xf9, 0x2e, 0x12, 0x27, 0x0a, 0x22, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xfa, 0x2e,
+	0x12, 0x28, 0x0a, 0x23, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50,
+	0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0xfb, 0x2e, 0x12, 0x24, 0x0a, 0x1f, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xfc, 0x2e,
+	0x12, 0x25, 0x0a, 0x20, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x49, 0x4e, 0x47, 0x10, 0xfd, 0x2e, 0x12, 0x1d, 0x0a, 0x18, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x10, 0xfe, 0x2e, 0x12, 0x22, 0x0a, 0x1d, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xff, 0x2e, 0x12, 0x1f, 0x0a, 0x1a, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x80, 0x2f, 0x12, 0x2d, 0x0a, 0x28, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45, 0x5f, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x81, 0x2f, 0x12, 0x27, 0x0a, 0x22, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x82, 0x2f, 0x12, 0x2b, 0x0a, 0x26, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52,
+	0x45, 0x43, 0x55, 0x52, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x83, 0x2f,
+	0x12, 0x23, 0x0a, 0x1e, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x49, 0x4c, 0x4c,
+	0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x84, 0x2f, 0x12, 0x25, 0x0a, 0x20, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x85, 0x2f, 0x12, 0x27, 0x0a, 0x22,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x86, 0x2f, 0x12, 0x29, 0x0a, 0x24, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c,
+	0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x87, 0x2f,
+	0x12, 0x16, 0x0a, 0x11, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x88, 0x2f, 0x12, 0x26, 0x0a, 0x21, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55,
+	0x52, 0x45, 0x5f, 0x49, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x89, 0x2f,
+	0x12, 0x25, 0x0a, 0x20, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x8a, 0x2f, 0x12, 0x21, 0x0a, 0x1c, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x55,
+	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x8b, 0x2f, 0x12, 0x22, 0x0a, 0x1d, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52,
+	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x8c, 0x2f, 0x12, 0x1d,
+	0x0a, 0x18, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x41, 0x58, 0x5f, 0x52, 0x41,
+	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x8d, 0x2f, 0x12, 0x26, 0x0a,
+	0x21, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x8e, 0x2f, 0x12, 0x26, 0x0a, 0x21, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x55,
+	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x8f, 0x2f, 0x12, 0x20, 0x0a,
+	0x1b, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xd9, 0x36, 0x12,
+	0x22, 0x0a, 0x1d, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44,
+	0x10, 0xda, 0x36, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0xdb, 0x36, 0x12, 0x1b, 0x0a,
+	0x16, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xdc, 0x36, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x41,
+	0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0xc1, 0x3e, 0x12, 0x1b, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0xa9,
+	0x46, 0x12, 0x1b, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0xaa, 0x46, 0x12, 0x18,
+	0x0a, 0x13, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xab, 0x46, 0x12, 0x16, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x52, 0x44, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xac, 0x46,
+	0x12, 0x1e, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x10, 0xad, 0x46,
+	0x12, 0x1e, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x42, 0x4f, 0x54, 0x5f,
+	0x4e, 0x4f, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x46,
+	0x12, 0x1d, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0xaf, 0x46, 0x12,
+	0x1a, 0x0a, 0x15, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x41, 0x50, 0x49,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xb0, 0x46, 0x12, 0x1b, 0x0a, 0x16, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0xb1, 0x46, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x50,
+	0x41, 0x52, 0x53, 0x45, 0x10, 0xb2, 0x46, 0x12, 0x23, 0x0a, 0x1e, 0x44, 0x49, 0x53, 0x43, 0x4f,
+	0x55, 0x52, 0x53, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0xb3, 0x46, 0x12, 0x1e, 0x0a, 0x19,
+	0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xb4, 0x46, 0x12, 0x1b, 0x0a, 0x16,
+	0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0xb5, 0x46, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x49, 0x53,
+	0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0xb6, 0x46,
+	0x12, 0x28, 0x0a, 0x23, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xb7, 0x46, 0x12, 0x26, 0x0a, 0x21, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f,
+	0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0xb8, 0x46, 0x12, 0x18, 0x0a, 0x13, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xb9, 0x46, 0x12, 0x1c, 0x0a, 0x17,
+	0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x91, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x92, 0x4e, 0x12, 0x22, 0x0a,
+	0x1d, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50,
+	0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x93,
+	0x4e, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x52, 0x4f,
+	0x44, 0x55, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xf9,
+	0x55, 0x12, 0x1c, 0x0a, 0x17, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xfa, 0x55, 0x12,
+	0x1c, 0x0a, 0x17, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55,
+	0x43, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xfb, 0x55, 0x12, 0x1a, 0x0a,
+	0x15, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xfc, 0x55, 0x12, 0x1f, 0x0a, 0x1a, 0x43, 0x41, 0x54,
+	0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x48, 0x41, 0x53,
+	0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x53, 0x10, 0xfd, 0x55, 0x12, 0x16, 0x0a, 0x11, 0x49, 0x4e,
+	0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0xe1, 0x5d, 0x12, 0x19, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xe2, 0x5d, 0x12, 0x15, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54,
+	0x45, 0x10, 0xe3, 0x5d, 0x12, 0x1e, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
+	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0xe4, 0x5d, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x41, 0x4c,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0xc9, 0x65, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x41, 0x4c, 0x5f,
+	0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x59, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0xca, 0x65, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xb1,
+	0x6d, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0xb2, 0x6d, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x44, 0x10, 0xb3, 0x6d, 0x12, 0x2a, 0x0a, 0x25, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0xb4, 0x6d, 0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb5, 0x6d, 0x12, 0x25, 0x0a, 0x20, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x49,
+	0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0xb6, 0x6d, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x10, 0xb7, 0x6d, 0x12, 0x16, 0x0a, 0x11, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x99, 0x75, 0x12, 0x14, 0x0a,
+	0x0f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x9a, 0x75, 0x12, 0x14, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x9b, 0x75, 0x12, 0x14, 0x0a, 0x0f, 0x41, 0x50, 0x49,
+	0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x9c, 0x75, 0x12,
+	0x1a, 0x0a, 0x15, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x10, 0x9d, 0x75, 0x12, 0x19, 0x0a, 0x14, 0x41,
+	0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4e,
+	0x49, 0x45, 0x44, 0x10, 0x9e, 0x75, 0x12, 0x1b, 0x0a, 0x16, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59,
+	0x10, 0x9f, 0x75, 0x12, 0x20, 0x0a, 0x1b, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0xa0, 0x75, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x81, 0x7d, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x82, 0x7d, 0x12,
+	0x1d, 0x0a, 0x18, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46,
+	0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x83, 0x7d, 0x12, 0x21,
+	0x0a, 0x1b, 0x49, 0x4d, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0xe9, 0x84,
+	0x01, 0x12, 0x1d, 0x0a, 0x17, 0x49, 0x4d, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0xea, 0x84, 0x01,
+	0x12, 0x20, 0x0a, 0x1a, 0x49, 0x4d, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0xeb,
+	0x84, 0x01, 0x12, 0x27, 0x0a, 0x21, 0x49, 0x4d, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x5f, 0x43, 0x4f, 0x4e,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xec, 0x84, 0x01, 0x42, 0x96, 0x01, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x65, 0x72, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x42, 0x0c, 0x45, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x19, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0xa2, 0x02, 0x03,
+	0x52, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x45, 0x72, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0xca, 0x02, 0x0e, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x45, 0x72,
+	0x72, 0x63, 0x6f, 0x64, 0x65, 0xe2, 0x02, 0x1a, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x45,
+	0x72, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0f, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x3a, 0x3a, 0x45, 0x72, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5,14 +5,15 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 	"rslbot.com/go/pkg/errcode"
 	"rslbot.com/go/pkg/rbdb"
 )
 
 func (svc *service) AdminAddLicenseKey(ctx context.Context, in *AdminAddLicenseKey_Input) (*AdminAddLicenseKey_Output, error) {
-	if !isAdmin(ctx) {
-		return nil, errcode.ERR_RESTRICTED_AREA
+	if err := svc.authorize(ctx, PermissionLicensesWrite); err != nil {
+		return nil, err
 	}
 
 	if in == nil || (in.UserId == 0 && in.UserEmail == "") || in.Duration == rbdb.LicenseKey_UNSPECIFIED {
@@ -53,6 +54,8 @@ func (svc *service) AdminAddLicenseKey(ctx context.Context, in *AdminAddLicenseK
 			Provider:    rbdb.Payment_PROVIDER_MANUAL,
 			ReferenceId: fmt.Sprintf("MANUAL-%d-%d", adminUser.Id, time.Now().UnixNano()),
 			UserId:      adminUser.Id,
+			Status:      rbdb.Payment_STATUS_COMPLETED,
+			CompletedAt: timestamppb.Now(),
 		}, svc.db)
 		if err != nil {
 			return rbdb.GormToErrcode(err)
@@ -81,5 +84,7 @@ func (svc *service) AdminAddLicenseKey(ctx context.Context, in *AdminAddLicenseK
 		return nil, err
 	}
 
+	syncUserDiscourseGroup(ctx, svc.db, svc.logger, svc.cfg.Discourse, userORM.Id)
+
 	return out, nil
 }
@@ -0,0 +1,73 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminApproveRefundRequest(ctx context.Context, in *AdminApproveRefundRequest_Input) (*AdminApproveRefundRequest_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingWrite); err != nil {
+		return nil, err
+	}
+	adminUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	requestOrm, err := rbdb.GetRefundRequest(svc.db, in.GetRefundRequestId())
+	if err != nil {
+		return nil, err
+	}
+	if rbdb.RefundRequest_Status(requestOrm.Status) != rbdb.RefundRequest_STATUS_PENDING {
+		return nil, errcode.ERR_REFUND_REQUEST_ALREADY_DECIDED.Wrap(fmt.Errorf("%d", requestOrm.Id))
+	}
+
+	// The policy is checked again as of the request, the key may have been activated since
+	paymentOrm, err := rbdb.GetUserPayment(svc.db, requestOrm.UserId, requestOrm.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRefundPolicy(svc.cfg.RefundRequests, paymentOrm, svc.payments.SandboxMode(), *requestOrm.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	// Refund on PayPal first, the refund is only recorded once the money is sent back
+	refundID, err := refundPayPalCapture(ctx, svc.payments, paymentOrm.ReferenceId, fmt.Sprintf("refund-request-%d", requestOrm.Id))
+	if err != nil {
+		svc.logger.Error("Failed to refund PayPal capture", zap.Error(err),
+			zap.Int64("refund_request_id", requestOrm.Id),
+			zap.String("capture_id", paymentOrm.ReferenceId))
+		return nil, err
+	}
+
+	requestOrm, paymentOrm, err = rbdb.ApproveRefundRequest(svc.db, requestOrm.Id, refundID, adminUser.Username, in.GetNote(), time.Now().UTC())
+	if err != nil {
+		// The refund webhook records the refund on the payment when this fails
+		svc.logger.Error("Failed to record approved refund", zap.Error(err),
+			zap.Int64("refund_request_id", in.GetRefundRequestId()),
+			zap.String("refund_id", refundID))
+		return nil, err
+	}
+	svc.logger.Info("Refund request approved",
+		zap.Int64("refund_request_id", requestOrm.Id),
+		zap.Int64("payment_id", paymentOrm.Id),
+		zap.String("refund_id", refundID),
+		zap.String("admin", adminUser.Username))
+
+	syncLicenseAccessAfterRefund(ctx, svc.db, svc.logger, svc.cfg, paymentOrm.UserId)
+
+	requestPb, err := requestOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_REFUND_REQUEST_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	paymentPb, err := paymentOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &AdminApproveRefundRequest_Output{RefundRequest: &requestPb, Payment: &paymentPb}, nil
+}
@@ -0,0 +1,33 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminCreatePrice(ctx context.Context, in *AdminCreatePrice_Input) (*AdminCreatePrice_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+	if in.GetPrice() == nil {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing price"))
+	}
+
+	priceOrm, err := in.Price.ToORM(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PRICE_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	priceOrm.Id = 0
+	if err := rbdb.SavePrice(svc.db, &priceOrm); err != nil {
+		return nil, err
+	}
+
+	prices, err := pricesToPB(ctx, []*rbdb.PriceORM{&priceOrm})
+	if err != nil {
+		return nil, err
+	}
+	return &AdminCreatePrice_Output{Price: prices[0]}, nil
+}
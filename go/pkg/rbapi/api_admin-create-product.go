@@ -0,0 +1,33 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminCreateProduct(ctx context.Context, in *AdminCreateProduct_Input) (*AdminCreateProduct_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+	if in.GetProduct() == nil {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing product"))
+	}
+
+	productOrm, err := in.Product.ToORM(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PRODUCT_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	productOrm.Id = 0
+	if err := rbdb.SaveProduct(svc.db, &productOrm); err != nil {
+		return nil, err
+	}
+
+	product, err := productToPB(ctx, &productOrm)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminCreateProduct_Output{Product: product}, nil
+}
@@ -0,0 +1,23 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminDeletePrice(ctx context.Context, in *AdminDeletePrice_Input) (*AdminDeletePrice_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+	if in.GetId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing price ID"))
+	}
+
+	if err := rbdb.DeletePrice(svc.db, in.Id); err != nil {
+		return nil, err
+	}
+	return &AdminDeletePrice_Output{}, nil
+}
@@ -0,0 +1,23 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminDeleteProduct(ctx context.Context, in *AdminDeleteProduct_Input) (*AdminDeleteProduct_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+	if in.GetId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing product ID"))
+	}
+
+	if err := rbdb.DeleteProduct(svc.db, in.Id); err != nil {
+		return nil, err
+	}
+	return &AdminDeleteProduct_Output{}, nil
+}
@@ -0,0 +1,34 @@
+package rbapi
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminDenyRefundRequest(ctx context.Context, in *AdminDenyRefundRequest_Input) (*AdminDenyRefundRequest_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingWrite); err != nil {
+		return nil, err
+	}
+	adminUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	requestOrm, err := rbdb.DenyRefundRequest(svc.db, in.GetRefundRequestId(), adminUser.Username, in.GetNote(), time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("Refund request denied",
+		zap.Int64("refund_request_id", requestOrm.Id),
+		zap.String("admin", adminUser.Username))
+
+	requestPb, err := requestOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_REFUND_REQUEST_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &AdminDenyRefundRequest_Output{RefundRequest: &requestPb}, nil
+}
@@ -7,8 +7,8 @@ import (
 )
 
 func (svc *service) AdminGetActiveUsers(ctx context.Context, in *AdminGetActiveUsers_Input) (*AdminGetActiveUsers_Output, error) {
-	if !isAdmin(ctx) {
-		return nil, errcode.ERR_RESTRICTED_AREA
+	if err := svc.authorize(ctx, PermissionSupportRead); err != nil {
+		return nil, err
 	}
 
 	// Get active users from Redis
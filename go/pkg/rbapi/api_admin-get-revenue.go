@@ -0,0 +1,72 @@
+package rbapi
+
+import (
+	"context"
+	"time"
+
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminGetRevenue(ctx context.Context, in *AdminGetRevenue_Input) (*AdminGetRevenue_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingRead); err != nil {
+		return nil, err
+	}
+
+	var from, to time.Time
+	if in.GetFrom() != nil {
+		from = in.From.AsTime()
+	}
+	if in.GetTo() != nil {
+		to = in.To.AsTime()
+	}
+	includeSandbox := in.GetIncludeSandbox()
+
+	totals, err := rbdb.GetRevenue(svc.db, from, to, includeSandbox)
+	if err != nil {
+		return nil, err
+	}
+
+	ratesOrm, err := rbdb.ListExchangeRates(svc.db)
+	if err != nil {
+		return nil, err
+	}
+	rates := map[string]float64{}
+	for _, rateOrm := range ratesOrm {
+		rates[rateOrm.Currency] = rateOrm.Rate
+	}
+
+	out := &AdminGetRevenue_Output{BaseCurrency: rbdb.BaseCurrency}
+	missingRates := map[string]bool{}
+	for _, total := range totals {
+		netInCents := total.GrossInCents - total.RefundedInCents
+		netInBaseCents, ok := rbdb.ConvertToBaseCurrency(netInCents, total.Currency, rates)
+		if !ok && !missingRates[total.Currency] {
+			missingRates[total.Currency] = true
+			out.MissingRates = append(out.MissingRates, total.Currency)
+		}
+		out.NetInBaseCents += netInBaseCents
+
+		out.Totals = append(out.Totals, &AdminGetRevenue_Output_Total{
+			Provider:        rbdb.Payment_Provider(total.Provider),
+			Currency:        total.Currency,
+			Payments:        total.Payments,
+			GrossInCents:    total.GrossInCents,
+			RefundedInCents: total.RefundedInCents,
+			NetInCents:      netInCents,
+			NetInBaseCents:  netInBaseCents,
+		})
+	}
+
+	pending, err := rbdb.CountPaymentsByStatus(svc.db, rbdb.Payment_STATUS_PENDING, from, to, includeSandbox)
+	if err != nil {
+		return nil, err
+	}
+	failed, err := rbdb.CountPaymentsByStatus(svc.db, rbdb.Payment_STATUS_FAILED, from, to, includeSandbox)
+	if err != nil {
+		return nil, err
+	}
+	out.PendingPayments = int32(pending)
+	out.FailedPayments = int32(failed)
+
+	return out, nil
+}
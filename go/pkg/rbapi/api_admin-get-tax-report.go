@@ -0,0 +1,47 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// AdminGetTaxReport sums the VAT of the payments of a quarter, by country and currency
+func (svc *service) AdminGetTaxReport(ctx context.Context, in *AdminGetTaxReport_Input) (*AdminGetTaxReport_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingRead); err != nil {
+		return nil, err
+	}
+
+	if in.GetYear() < 1 || in.GetQuarter() < 1 || in.GetQuarter() > 4 {
+		return nil, errcode.ERR_PAYMENT_TAX_REPORT_PERIOD_INVALID.Wrap(fmt.Errorf("year %d, quarter %d", in.GetYear(), in.GetQuarter()))
+	}
+	from := time.Date(int(in.Year), time.Month(3*(in.Quarter-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 3, 0)
+
+	totals, err := rbdb.GetTaxReport(svc.db, from, to, in.GetIncludeSandbox())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminGetTaxReport_Output{
+		From: timestamppb.New(from),
+		To:   timestamppb.New(to),
+	}
+	for _, total := range totals {
+		out.Totals = append(out.Totals, &AdminGetTaxReport_Output_Total{
+			CountryCode:        total.CountryCode,
+			Currency:           total.Currency,
+			Payments:           total.Payments,
+			GrossInCents:       total.GrossInCents,
+			TaxInCents:         total.TaxInCents,
+			RefundedInCents:    total.RefundedInCents,
+			RefundedTaxInCents: total.RefundedTaxInCents,
+			NetTaxInCents:      total.TaxInCents - total.RefundedTaxInCents,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,41 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminGrantRole(ctx context.Context, in *AdminGrantRole_Input) (*AdminGrantRole_Output, error) {
+	if err := svc.authorize(ctx, PermissionRolesWrite); err != nil {
+		return nil, err
+	}
+	if in.GetUserId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("user_id is required"))
+	}
+	if err := validateRole(in.GetRole()); err != nil {
+		return nil, err
+	}
+	adminUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	roleOrm, err := rbdb.GrantUserRole(svc.db, in.GetUserId(), in.GetRole(), adminUser.Username)
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("Role granted",
+		zap.Int64("user_id", in.GetUserId()),
+		zap.String("role", in.GetRole().String()),
+		zap.String("admin", adminUser.Username))
+
+	rolePb, err := roleOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_ROLE_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &AdminGrantRole_Output{UserRole: &rolePb}, nil
+}
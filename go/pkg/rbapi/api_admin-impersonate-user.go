@@ -0,0 +1,79 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminImpersonateUser(ctx context.Context, in *AdminImpersonateUser_Input) (*AdminImpersonateUser_Output, error) {
+	if err := svc.authorize(ctx, PermissionImpersonate); err != nil {
+		return nil, err
+	}
+	if in.GetUserId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("user_id is required"))
+	}
+	adminUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	var userOrm rbdb.UserORM
+	if err := svc.db.Where(&rbdb.UserORM{Id: in.GetUserId()}).First(&userOrm).Error; err != nil {
+		if rbdb.IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_USER_NOT_FOUND.Wrap(fmt.Errorf("%d", in.GetUserId()))
+		}
+		return nil, rbdb.GormToErrcode(err)
+	}
+
+	impersonationID, err := randomSessionToken("")
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	impersonation := &Impersonation{
+		ID:    impersonationID,
+		Admin: adminUser,
+		// The user gets no groups, the impersonated calls never reach the admin RPCs anyway
+		User: &rbdb.DiscourseUser{
+			ExternalId: userOrm.DiscourseId,
+			Username:   userOrm.Username,
+			Email:      userOrm.Email,
+		},
+		UserId:    userOrm.Id,
+		ReadWrite: in.GetReadWrite(),
+		Reason:    in.GetReason(),
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(svc.cfg.Auth.ImpersonationTTL)),
+	}
+
+	// The creation is recorded before the token exists, so no token is ever missing from the audit log
+	if err := svc.auditImpersonation(impersonation, impersonateMethod, true); err != nil {
+		return nil, err
+	}
+	token, err := svc.redis.CreateImpersonation(ctx, impersonation)
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("Impersonation started",
+		zap.String("impersonation_id", impersonation.ID),
+		zap.String("admin", adminUser.Username),
+		zap.Int64("user_id", userOrm.Id),
+		zap.Bool("read_write", impersonation.ReadWrite),
+		zap.String("reason", impersonation.Reason))
+
+	userPb, err := userOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_USER_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &AdminImpersonateUser_Output{
+		AccessToken: token,
+		ExpiresAt:   timestamppb.New(impersonation.ExpiresAt),
+		User:        &userPb,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminListCatalog(ctx context.Context, in *AdminListCatalog_Input) (*AdminListCatalog_Output, error) {
+	if err := svc.authorize(ctx, PermissionSupportRead); err != nil {
+		return nil, err
+	}
+
+	productsOrm, err := rbdb.ListProducts(svc.db)
+	if err != nil {
+		return nil, err
+	}
+	pricesOrm, err := rbdb.ListPrices(svc.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListCatalog_Output{}
+	for _, productOrm := range productsOrm {
+		product, err := productToPB(ctx, productOrm)
+		if err != nil {
+			return nil, err
+		}
+		out.Products = append(out.Products, product)
+	}
+	out.Prices, err = pricesToPB(ctx, pricesOrm)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,29 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminListExchangeRates(ctx context.Context, in *AdminListExchangeRates_Input) (*AdminListExchangeRates_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingRead); err != nil {
+		return nil, err
+	}
+
+	ratesOrm, err := rbdb.ListExchangeRates(svc.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListExchangeRates_Output{BaseCurrency: rbdb.BaseCurrency}
+	for _, rateOrm := range ratesOrm {
+		ratePb, err := rateOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_EXCHANGE_RATE_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Rates = append(out.Rates, &ratePb)
+	}
+	return out, nil
+}
@@ -0,0 +1,35 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const defaultImpersonationAuditsLimit = 100
+
+func (svc *service) AdminListImpersonationAudits(ctx context.Context, in *AdminListImpersonationAudits_Input) (*AdminListImpersonationAudits_Output, error) {
+	if err := svc.authorize(ctx, PermissionImpersonate); err != nil {
+		return nil, err
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultImpersonationAuditsLimit
+	}
+	auditsOrm, err := rbdb.ListImpersonationAudits(svc.db, in.GetUserId(), in.GetAdminUsername(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListImpersonationAudits_Output{}
+	for _, auditOrm := range auditsOrm {
+		auditPb, err := auditOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_IMPERSONATION_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Audits = append(out.Audits, &auditPb)
+	}
+	return out, nil
+}
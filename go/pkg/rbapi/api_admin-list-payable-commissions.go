@@ -0,0 +1,46 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminListPayableCommissions(ctx context.Context, _ *AdminListPayableCommissions_Input) (*AdminListPayableCommissions_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingRead); err != nil {
+		return nil, err
+	}
+
+	if err := rbdb.ReleaseCommissions(svc.db, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	payables, err := rbdb.ListPayableCommissions(svc.db)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListPayableCommissions_Output{}
+	for _, payable := range payables {
+		var userOrm rbdb.UserORM
+		if err := svc.db.Where(&rbdb.UserORM{Id: payable.UserId}).First(&userOrm).Error; err != nil {
+			if rbdb.IsRecordNotFoundError(err) {
+				return nil, errcode.ERR_USER_NOT_FOUND.Wrap(fmt.Errorf("%d", payable.UserId))
+			}
+			return nil, rbdb.GormToErrcode(err)
+		}
+		userPb, err := userOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_USER_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Payables = append(out.Payables, &AdminListPayableCommissions_Output_Payable{
+			Referrer:      &userPb,
+			Currency:      payable.Currency,
+			AmountInCents: payable.AmountInCents,
+			Commissions:   payable.Commissions,
+		})
+	}
+	return out, nil
+}
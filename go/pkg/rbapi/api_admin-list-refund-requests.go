@@ -0,0 +1,46 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const (
+	defaultRefundRequestsLimit = 50
+	maxRefundRequestsLimit     = 500
+)
+
+func (svc *service) AdminListRefundRequests(ctx context.Context, in *AdminListRefundRequests_Input) (*AdminListRefundRequests_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingRead); err != nil {
+		return nil, err
+	}
+
+	status := in.GetStatus()
+	if status == rbdb.RefundRequest_STATUS_UNSPECIFIED {
+		status = rbdb.RefundRequest_STATUS_PENDING
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultRefundRequestsLimit
+	}
+	if limit > maxRefundRequestsLimit {
+		limit = maxRefundRequestsLimit
+	}
+
+	requestsOrm, err := rbdb.ListRefundRequests(svc.db, status, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListRefundRequests_Output{}
+	for _, requestOrm := range requestsOrm {
+		requestPb, err := requestOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_REFUND_REQUEST_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.RefundRequests = append(out.RefundRequests, &requestPb)
+	}
+	return out, nil
+}
@@ -0,0 +1,37 @@
+package rbapi
+
+import (
+	"context"
+	"sort"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminListRoles(ctx context.Context, in *AdminListRoles_Input) (*AdminListRoles_Output, error) {
+	if err := svc.authorize(ctx, PermissionRolesWrite); err != nil {
+		return nil, err
+	}
+
+	rolesOrm, err := rbdb.ListUserRoles(svc.db, in.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListRoles_Output{}
+	for _, roleOrm := range rolesOrm {
+		rolePb, err := roleOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_ROLE_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.UserRoles = append(out.UserRoles, &rolePb)
+	}
+	for role, group := range roleGroups(svc.cfg.Roles) {
+		if group == "" {
+			continue
+		}
+		out.RoleGroups = append(out.RoleGroups, &AdminListRoles_Output_RoleGroup{Role: role, DiscourseGroup: group})
+	}
+	sort.Slice(out.RoleGroups, func(i, j int) bool { return out.RoleGroups[i].Role < out.RoleGroups[j].Role })
+	return out, nil
+}
@@ -0,0 +1,60 @@
+package rbapi
+
+import (
+	"context"
+	"time"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const (
+	defaultStuckOrdersAge   = 60 * time.Minute
+	defaultStuckOrdersLimit = 50
+	maxStuckOrdersLimit     = 500
+)
+
+func (svc *service) AdminListStuckOrders(ctx context.Context, in *AdminListStuckOrders_Input) (*AdminListStuckOrders_Output, error) {
+	if err := svc.authorize(ctx, PermissionSupportRead); err != nil {
+		return nil, err
+	}
+
+	age := defaultStuckOrdersAge
+	if in.GetOlderThanMinutes() > 0 {
+		age = time.Duration(in.GetOlderThanMinutes()) * time.Minute
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultStuckOrdersLimit
+	}
+	if limit > maxStuckOrdersLimit {
+		limit = maxStuckOrdersLimit
+	}
+	olderThan := time.Now().UTC().Add(-age)
+
+	counts, err := rbdb.CountStuckCheckoutOrders(svc.db, olderThan)
+	if err != nil {
+		return nil, err
+	}
+	ordersOrm, err := rbdb.ListStuckCheckoutOrders(svc.db, olderThan, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListStuckOrders_Output{}
+	for _, count := range counts {
+		out.Counts = append(out.Counts, &AdminListStuckOrders_Output_Count{
+			Status: rbdb.CheckoutOrder_Status(count.Status),
+			Orders: count.Count,
+		})
+	}
+	for _, orderOrm := range ordersOrm {
+		orderPb, err := orderOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_CHECKOUT_ORDER_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Orders = append(out.Orders, &orderPb)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,43 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const (
+	defaultWebhookEventsLimit = 50
+	maxWebhookEventsLimit     = 500
+)
+
+func (svc *service) AdminListWebhookEvents(ctx context.Context, in *AdminListWebhookEvents_Input) (*AdminListWebhookEvents_Output, error) {
+	if err := svc.authorize(ctx, PermissionSupportRead); err != nil {
+		return nil, err
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultWebhookEventsLimit
+	}
+	if limit > maxWebhookEventsLimit {
+		limit = maxWebhookEventsLimit
+	}
+
+	eventsOrm, err := rbdb.ListWebhookEvents(svc.db, in.GetStatus(), in.GetProvider(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AdminListWebhookEvents_Output{}
+	for _, eventOrm := range eventsOrm {
+		eventPb, err := eventOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_WEBHOOK_EVENT_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Events = append(out.Events, &eventPb)
+	}
+
+	return out, nil
+}
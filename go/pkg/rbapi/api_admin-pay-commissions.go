@@ -0,0 +1,46 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminPayCommissions(ctx context.Context, in *AdminPayCommissions_Input) (*AdminPayCommissions_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingWrite); err != nil {
+		return nil, err
+	}
+	if in.GetUserId() == 0 || in.GetCurrency() == "" || in.GetPayoutReference() == "" {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("user_id, currency and payout_reference are required"))
+	}
+
+	// Commissions released since the last listing are part of the payout
+	now := time.Now().UTC()
+	if err := rbdb.ReleaseCommissions(svc.db, now); err != nil {
+		return nil, err
+	}
+	commissionsOrm, paidInCents, err := rbdb.PayCommissions(svc.db, in.GetUserId(), in.GetCurrency(), in.GetPayoutReference(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("Commissions paid",
+		zap.Int64("user_id", in.GetUserId()),
+		zap.String("currency", in.GetCurrency()),
+		zap.Int64("paid_in_cents", paidInCents),
+		zap.String("payout_reference", in.GetPayoutReference()))
+
+	out := &AdminPayCommissions_Output{PaidInCents: paidInCents}
+	for _, commissionOrm := range commissionsOrm {
+		commissionPb, err := commissionOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_COMMISSION_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Commissions = append(out.Commissions, &commissionPb)
+	}
+	return out, nil
+}
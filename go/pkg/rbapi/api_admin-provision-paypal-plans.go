@@ -0,0 +1,35 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// AdminProvisionPayPalPlans creates the PayPal product and billing plans from the price table
+// It is safe to call repeatedly, plans are only created for missing durations or changed prices
+func (svc *service) AdminProvisionPayPalPlans(ctx context.Context, in *AdminProvisionPayPalPlans_Input) (*AdminProvisionPayPalPlans_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+
+	planOrms, created, err := ProvisionPayPalPlans(ctx, svc.db, svc.logger, svc.payments)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*rbdb.BillingPlan, 0, len(planOrms))
+	for _, planOrm := range planOrms {
+		planPb, err := planOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_BILLING_PLAN_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		plans = append(plans, &planPb)
+	}
+
+	return &AdminProvisionPayPalPlans_Output{
+		Plans:   plans,
+		Created: int32(created),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// AdminRegenerateInvoices issues the missing invoice of a payment and renders its documents again,
+// e.g. after the seller details or the template changed. Invoice numbers and amounts are kept.
+func (svc *service) AdminRegenerateInvoices(ctx context.Context, in *AdminRegenerateInvoices_Input) (*AdminRegenerateInvoices_Output, error) {
+	if err := svc.authorize(ctx, PermissionBillingWrite); err != nil {
+		return nil, err
+	}
+	if in.GetPaymentId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing payment ID"))
+	}
+
+	// Payments completed before invoicing existed get their invoice now
+	var issued *rbdb.InvoiceORM
+	err := svc.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		issued, err = rbdb.IssueInvoiceTx(tx, in.PaymentId, time.Now().UTC())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if issued != nil {
+		svc.logger.Info("Missing invoice issued", zap.Int64("payment_id", in.PaymentId), zap.String("number", issued.Number))
+	}
+
+	invoicesOrm, err := rbdb.ListPaymentInvoices(svc.db, in.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(invoicesOrm) == 0 {
+		return nil, errcode.ERR_INVOICE_NOT_ISSUABLE.Wrap(fmt.Errorf("payment %d is not completed or is not invoiced", in.PaymentId))
+	}
+	for _, invoiceOrm := range invoicesOrm {
+		if _, err := invoicePDF(svc.db, svc.cfg.Invoice, invoiceOrm, true); err != nil {
+			return nil, err
+		}
+	}
+
+	invoices, err := invoicesToPB(ctx, invoicesOrm)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminRegenerateInvoices_Output{Invoices: invoices}, nil
+}
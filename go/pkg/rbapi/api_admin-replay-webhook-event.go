@@ -0,0 +1,29 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+)
+
+func (svc *service) AdminReplayWebhookEvent(ctx context.Context, in *AdminReplayWebhookEvent_Input) (*AdminReplayWebhookEvent_Output, error) {
+	if err := svc.authorize(ctx, PermissionOpsWrite); err != nil {
+		return nil, err
+	}
+
+	if in.GetId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT
+	}
+
+	eventOrm, err := replayWebhookEvent(ctx, svc.db, svc.logger.Named("webhook-inbox"), svc.cfg, svc.payments, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPb, err := eventOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_WEBHOOK_EVENT_PROTOBUF_CONVERSION.Wrap(err)
+	}
+
+	return &AdminReplayWebhookEvent_Output{Event: &eventPb}, nil
+}
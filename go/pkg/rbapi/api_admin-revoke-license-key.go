@@ -9,8 +9,8 @@ import (
 )
 
 func (svc *service) AdminRevokeLicense(ctx context.Context, in *AdminRevokeLicense_Input) (*AdminRevokeLicense_Output, error) {
-	if !isAdmin(ctx) {
-		return nil, errcode.ERR_RESTRICTED_AREA
+	if err := svc.authorize(ctx, PermissionLicensesWrite); err != nil {
+		return nil, err
 	}
 
 	if in == nil || in.Key == "" {
@@ -78,5 +78,7 @@ func (svc *service) AdminRevokeLicense(ctx context.Context, in *AdminRevokeLicen
 		return nil, err
 	}
 
+	syncUserDiscourseGroup(ctx, svc.db, svc.logger, svc.cfg.Discourse, output.LicenseKey.UserId)
+
 	return output, nil
 }
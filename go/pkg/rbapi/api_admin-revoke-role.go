@@ -0,0 +1,37 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// AdminRevokeRole removes a local role grant, roles given by Discourse are managed on Discourse
+func (svc *service) AdminRevokeRole(ctx context.Context, in *AdminRevokeRole_Input) (*AdminRevokeRole_Output, error) {
+	if err := svc.authorize(ctx, PermissionRolesWrite); err != nil {
+		return nil, err
+	}
+	if in.GetUserId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("user_id is required"))
+	}
+	if err := validateRole(in.GetRole()); err != nil {
+		return nil, err
+	}
+	adminUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	if err := rbdb.RevokeUserRole(svc.db, in.GetUserId(), in.GetRole()); err != nil {
+		return nil, err
+	}
+	svc.logger.Info("Role revoked",
+		zap.Int64("user_id", in.GetUserId()),
+		zap.String("role", in.GetRole().String()),
+		zap.String("admin", adminUser.Username))
+
+	return &AdminRevokeRole_Output{}, nil
+}
@@ -9,18 +9,30 @@ import (
 )
 
 func (svc *service) AdminSearchDatabase(ctx context.Context, in *AdminSearchDatabase_Input) (*AdminSearchDatabase_Output, error) {
-	// Check admin permissions
-	if !isAdmin(ctx) {
-		return nil, errcode.ERR_RESTRICTED_AREA
+	// Check permissions
+	if err := svc.authorize(ctx, PermissionSupportRead); err != nil {
+		return nil, err
 	}
 
-	if in == nil || in.SearchTerm == "" {
+	if in == nil || (in.SearchTerm == "" && in.PaymentStatus == rbdb.Payment_STATUS_UNSPECIFIED) {
 		return nil, errcode.ERR_MISSING_INPUT
 	}
 
 	searchTerm := in.SearchTerm
 	output := &AdminSearchDatabase_Output{}
 
+	// Without a search term, list the payments with the requested status
+	if searchTerm == "" {
+		var paymentsOrm []*rbdb.PaymentORM
+		if err := svc.db.Where("status = ?", int32(in.PaymentStatus)).Order("created_at DESC").Find(&paymentsOrm).Error; err != nil {
+			return nil, rbdb.GormToErrcode(err)
+		}
+		if err := appendSearchPayments(ctx, output, paymentsOrm); err != nil {
+			return nil, err
+		}
+		return output, nil
+	}
+
 	// Try to parse as an integer for ID searches
 	searchID, err := strconv.ParseInt(searchTerm, 10, 64)
 	isIDSearch := err == nil
@@ -94,31 +106,17 @@ func (svc *service) AdminSearchDatabase(ctx context.Context, in *AdminSearchData
 		paymentQuery = paymentQuery.Or(&rbdb.PaymentORM{SubscriptionId: &searchID})
 	}
 
+	// Grouped so the status applies to every match
+	paymentQuery = svc.db.Where(paymentQuery)
+	if in.PaymentStatus != rbdb.Payment_STATUS_UNSPECIFIED {
+		paymentQuery = paymentQuery.Where("status = ?", int32(in.PaymentStatus))
+	}
+
 	if err := paymentQuery.Find(&paymentsOrm).Error; err != nil {
 		return nil, rbdb.GormToErrcode(err)
 	}
-
-	for _, paymentOrm := range paymentsOrm {
-		paymentPb, err := paymentOrm.ToPB(ctx)
-		if err != nil {
-			return nil, errcode.ERR_PAYMENT_PROTOBUF_CONVERSION.Wrap(err)
-		}
-
-		// For LicenseKeyId, create a minimal LicenseKey object with just the ID
-		if paymentOrm.LicenseKeyId != nil && paymentPb.LicenseKey == nil {
-			paymentPb.LicenseKey = &rbdb.LicenseKey{
-				Id: *paymentOrm.LicenseKeyId,
-			}
-		}
-
-		// For SubscriptionId, create a minimal Subscription object with just the ID
-		if paymentOrm.SubscriptionId != nil && paymentPb.Subscription == nil {
-			paymentPb.Subscription = &rbdb.Subscription{
-				Id: *paymentOrm.SubscriptionId,
-			}
-		}
-
-		output.Payments = append(output.Payments, &paymentPb)
+	if err := appendSearchPayments(ctx, output, paymentsOrm); err != nil {
+		return nil, err
 	}
 
 	// --------------------------------
@@ -126,8 +124,8 @@ func (svc *service) AdminSearchDatabase(ctx context.Context, in *AdminSearchData
 	// --------------------------------
 	var subscriptionsOrm []*rbdb.SubscriptionORM
 
-	subscriptionQuery := svc.db.Where(&rbdb.SubscriptionORM{StripeSubscriptionId: searchTerm})
-	subscriptionQuery = subscriptionQuery.Or(&rbdb.SubscriptionORM{StripeCustomerId: searchTerm})
+	subscriptionQuery := svc.db.Where(&rbdb.SubscriptionORM{ProviderSubscriptionId: searchTerm})
+	subscriptionQuery = subscriptionQuery.Or(&rbdb.SubscriptionORM{ProviderCustomerId: searchTerm})
 
 	if isIDSearch {
 		subscriptionQuery = subscriptionQuery.Or(&rbdb.SubscriptionORM{Id: searchID})
@@ -149,3 +147,30 @@ func (svc *service) AdminSearchDatabase(ctx context.Context, in *AdminSearchData
 
 	return output, nil
 }
+
+// appendSearchPayments adds payments to the search output with their license key and subscription IDs
+func appendSearchPayments(ctx context.Context, output *AdminSearchDatabase_Output, paymentsOrm []*rbdb.PaymentORM) error {
+	for _, paymentOrm := range paymentsOrm {
+		paymentPb, err := paymentOrm.ToPB(ctx)
+		if err != nil {
+			return errcode.ERR_PAYMENT_PROTOBUF_CONVERSION.Wrap(err)
+		}
+
+		// For LicenseKeyId, create a minimal LicenseKey object with just the ID
+		if paymentOrm.LicenseKeyId != nil && paymentPb.LicenseKey == nil {
+			paymentPb.LicenseKey = &rbdb.LicenseKey{
+				Id: *paymentOrm.LicenseKeyId,
+			}
+		}
+
+		// For SubscriptionId, create a minimal Subscription object with just the ID
+		if paymentOrm.SubscriptionId != nil && paymentPb.Subscription == nil {
+			paymentPb.Subscription = &rbdb.Subscription{
+				Id: *paymentOrm.SubscriptionId,
+			}
+		}
+
+		output.Payments = append(output.Payments, &paymentPb)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminSetExchangeRate(ctx context.Context, in *AdminSetExchangeRate_Input) (*AdminSetExchangeRate_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+
+	rateOrm, err := rbdb.SetExchangeRate(svc.db, in.GetCurrency(), in.GetRate())
+	if err != nil {
+		return nil, err
+	}
+
+	ratePb, err := rateOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_EXCHANGE_RATE_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &AdminSetExchangeRate_Output{Rate: &ratePb}, nil
+}
@@ -0,0 +1,25 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminSetTaxRate(ctx context.Context, in *AdminSetTaxRate_Input) (*AdminSetTaxRate_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+
+	rateOrm, err := rbdb.SetTaxRate(svc.db, in.GetCountryCode(), in.GetRate())
+	if err != nil {
+		return nil, err
+	}
+
+	ratePb, err := rateOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_TAX_RATE_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &AdminSetTaxRate_Output{Rate: &ratePb}, nil
+}
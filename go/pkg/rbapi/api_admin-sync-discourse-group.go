@@ -0,0 +1,32 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+)
+
+// AdminSyncDiscourseGroup reconciles the Discourse license holders group with active licenses
+// In dry-run mode, the planned changes are returned without being applied
+func (svc *service) AdminSyncDiscourseGroup(ctx context.Context, in *AdminSyncDiscourseGroup_Input) (*AdminSyncDiscourseGroup_Output, error) {
+	if err := svc.authorize(ctx, PermissionOpsWrite); err != nil {
+		return nil, err
+	}
+
+	if in == nil {
+		return nil, errcode.ERR_MISSING_INPUT
+	}
+
+	report, err := SyncDiscourseGroup(ctx, svc.db, svc.cfg.Discourse, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminSyncDiscourseGroup_Output{
+		Group:            report.Group,
+		AddedUsernames:   report.Added,
+		RemovedUsernames: report.Removed,
+		Unchanged:        int32(report.Unchanged),
+		DryRun:           report.DryRun,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminUpdatePrice(ctx context.Context, in *AdminUpdatePrice_Input) (*AdminUpdatePrice_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+	if in.GetPrice().GetId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing price ID"))
+	}
+
+	priceOrm, err := in.Price.ToORM(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PRICE_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	if err := rbdb.SavePrice(svc.db, &priceOrm); err != nil {
+		return nil, err
+	}
+
+	prices, err := pricesToPB(ctx, []*rbdb.PriceORM{&priceOrm})
+	if err != nil {
+		return nil, err
+	}
+	return &AdminUpdatePrice_Output{Price: prices[0]}, nil
+}
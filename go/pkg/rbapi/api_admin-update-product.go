@@ -0,0 +1,32 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func (svc *service) AdminUpdateProduct(ctx context.Context, in *AdminUpdateProduct_Input) (*AdminUpdateProduct_Output, error) {
+	if err := svc.authorize(ctx, PermissionCatalogWrite); err != nil {
+		return nil, err
+	}
+	if in.GetProduct().GetId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("missing product ID"))
+	}
+
+	productOrm, err := in.Product.ToORM(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PRODUCT_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	if err := rbdb.SaveProduct(svc.db, &productOrm); err != nil {
+		return nil, err
+	}
+
+	product, err := productToPB(ctx, &productOrm)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminUpdateProduct_Output{Product: product}, nil
+}
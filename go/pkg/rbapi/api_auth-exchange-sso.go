@@ -0,0 +1,50 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// AuthExchangeSSO verifies a Discourse SSO response once and opens a session for the user
+func (svc *service) AuthExchangeSSO(ctx context.Context, in *AuthExchangeSSO_Input) (*AuthExchangeSSO_Output, error) {
+	if in.Sso == "" || in.Sig == "" {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("sso and sig are required"))
+	}
+
+	response, err := ParseSSO(in.Sso, in.Sig, svc.cfg.Discourse.SSOSecret)
+	if err != nil {
+		return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(err)
+	}
+
+	// Only responses to a request signed by AuthStartSSO are accepted, once
+	returnURL, err := svc.redis.ConsumeSSONonce(ctx, response.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if response.ReturnURL != returnURL {
+		return nil, errcode.ERR_AUTH_SSO_RETURN_URL_MISMATCH.Wrap(fmt.Errorf("expected %q, got %q", returnURL, response.ReturnURL))
+	}
+	discourseUser := response.User
+
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	_, tokens, err := svc.redis.CreateSession(ctx, svc.cfg.Auth, discourseUser, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthExchangeSSO_Output{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+		User:                  user,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// AuthRefreshSession rotates the tokens of a session from its refresh token
+// The admin flag and groups of the session are reloaded, a demoted user loses its permissions at the next refresh
+func (svc *service) AuthRefreshSession(ctx context.Context, in *AuthRefreshSession_Input) (*AuthRefreshSession_Output, error) {
+	if in.RefreshToken == "" {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("refresh_token is required"))
+	}
+
+	_, tokens, err := svc.redis.RefreshSession(ctx, svc.cfg.Auth, in.RefreshToken, time.Now().UTC(), svc.reloadSessionUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthRefreshSession_Output{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+	}, nil
+}
+
+// reloadSessionUser returns the session user with its current Discourse admin flag, groups and username
+func (svc *service) reloadSessionUser(ctx context.Context, user *rbdb.DiscourseUser) (*rbdb.DiscourseUser, error) {
+	current, err := GetDiscourseUser(ctx, svc.cfg.Discourse, user.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	return &rbdb.DiscourseUser{
+		ExternalId: user.ExternalId,
+		Username:   current.Username,
+		Email:      user.Email,
+		Groups:     current.Groups,
+		Admin:      current.Admin,
+	}, nil
+}
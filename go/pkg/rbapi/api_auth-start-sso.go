@@ -0,0 +1,68 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// CLILoopbackURL is where the token command of the CLI receives the SSO responses
+const CLILoopbackURL = "http://localhost:8085/callback"
+
+// AuthStartSSO signs a Discourse SSO request with a single use nonce
+// The return URL must be on the frontend or the CLI loopback origin, Discourse sends the signed user data there
+func (svc *service) AuthStartSSO(ctx context.Context, in *AuthStartSSO_Input) (*AuthStartSSO_Output, error) {
+	if in.ReturnSsoUrl == "" {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("return_sso_url is required"))
+	}
+	returnURL, err := url.Parse(in.ReturnSsoUrl)
+	if err != nil {
+		return nil, errcode.ERR_INVALID_INPUT.Wrap(err)
+	}
+	if (returnURL.Scheme != "http" && returnURL.Scheme != "https") || returnURL.Host == "" {
+		return nil, errcode.ERR_INVALID_INPUT.Wrap(fmt.Errorf("return_sso_url must be an absolute http(s) URL"))
+	}
+	if !svc.allowedSSOReturnOrigin(returnURL) {
+		return nil, errcode.ERR_INVALID_INPUT.Wrap(fmt.Errorf("return_sso_url origin %s is not allowed", urlOrigin(returnURL)))
+	}
+
+	nonce, expiresAt, err := svc.redis.CreateSSONonce(ctx, svc.cfg.Auth, in.ReturnSsoUrl, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthStartSSO_Output{
+		SsoUrl:    SignSSORequest(svc.cfg.Discourse, nonce, in.ReturnSsoUrl),
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// allowedSSOReturnOrigin tells if a return URL has the scheme, host and port of the frontend or the CLI loopback
+func (svc *service) allowedSSOReturnOrigin(returnURL *url.URL) bool {
+	for _, allowed := range []string{svc.cfg.Frontend.URL, CLILoopbackURL} {
+		allowedURL, err := url.Parse(allowed)
+		if err != nil || allowedURL.Host == "" {
+			continue
+		}
+		if urlOrigin(allowedURL) == urlOrigin(returnURL) {
+			return true
+		}
+	}
+	return false
+}
+
+// urlOrigin returns the lower-case scheme://host:port of a URL, with the default port of its scheme when it has none
+func urlOrigin(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+	port := u.Port()
+	if port == "" {
+		port = map[string]string{"http": "80", "https": "443"}[scheme]
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(strings.ToLower(u.Hostname()), port))
+}
@@ -0,0 +1,150 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// Scopes of the personal API keys
+const (
+	ApiKeyScopeLicensesRead  = "licenses:read"
+	ApiKeyScopeLicensesWrite = "licenses:write"
+	ApiKeyScopeOffsetsWrite  = "offsets:write"
+	ApiKeyScopePaymentsRead  = "payments:read"
+)
+
+// Personal API keys are opaque random strings like session tokens, only their SHA-256 is stored
+const (
+	apiKeyPrefix         = "rbk_"
+	apiKeyDisplayedChars = 12
+	apiKeyDefaultTTL     = 90 * 24 * time.Hour
+	apiKeyMaxDays        = 365
+	apiKeyTouchInterval  = time.Minute // last_used_at is updated at most once per interval
+)
+
+var apiKeyKnownScopes = []string{
+	ApiKeyScopeLicensesRead,
+	ApiKeyScopeLicensesWrite,
+	ApiKeyScopeOffsetsWrite,
+	ApiKeyScopePaymentsRead,
+}
+
+// apiKeyRPCScopes is the scope an API key needs for each RPC, an empty scope is granted to every key
+// RPCs missing here can't be called with an API key, admin RPCs still check the permissions of the owner
+var apiKeyRPCScopes = map[string]string{
+	"/rslbot.api.Service/AdminAddLicenseKey":  ApiKeyScopeLicensesWrite,
+	"/rslbot.api.Service/AdminGetActiveUsers": ApiKeyScopeLicensesRead,
+	"/rslbot.api.Service/AdminGetRevenue":     ApiKeyScopePaymentsRead,
+	"/rslbot.api.Service/AdminGetTaxReport":   ApiKeyScopePaymentsRead,
+	"/rslbot.api.Service/AdminRevokeLicense":  ApiKeyScopeLicensesWrite,
+	"/rslbot.api.Service/UserGetInvoice":      ApiKeyScopePaymentsRead,
+	"/rslbot.api.Service/UserGetLicenses":     ApiKeyScopeLicensesRead,
+	"/rslbot.api.Service/UserGetPayment":      ApiKeyScopePaymentsRead,
+	"/rslbot.api.Service/UserGetSession":      "",
+	"/rslbot.api.Service/UserListPayments":    ApiKeyScopePaymentsRead,
+}
+
+// isApiKey tells if a bearer token is a personal API key
+func isApiKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+// normalizeApiKeyScopes checks the requested scopes and returns them sorted without duplicates
+func normalizeApiKeyScopes(scopes []string) ([]string, error) {
+	seen := map[string]bool{}
+	normalized := []string{}
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		known := false
+		for _, knownScope := range apiKeyKnownScopes {
+			if scope == knownScope {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, errcode.ERR_API_KEY_UNKNOWN_SCOPE.Wrap(fmt.Errorf("%q", scope))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// apiKeyHasScope tells if a key grants a scope, the empty scope is granted to every key
+func apiKeyHasScope(keyOrm *rbdb.ApiKeyORM, scope string) bool {
+	if scope == "" {
+		return true
+	}
+	for _, granted := range strings.Split(keyOrm.Scopes, ",") {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticateApiKey verifies a personal API key and its scope, it returns the owner of the key
+// The admin flag and groups of the owner are loaded from Discourse on each use, a demoted owner's keys lose their access
+func authenticateApiKey(ctx context.Context, db *gorm.DB, cfg config.Discourse, key string, scope string, now time.Time) (*rbdb.DiscourseUser, *rbdb.ApiKeyORM, error) {
+	keyOrm, err := rbdb.GetApiKeyByHash(db, hashSessionToken(key))
+	if err != nil {
+		return nil, nil, err
+	}
+	if keyOrm.RevokedAt != nil {
+		return nil, nil, errcode.ERR_API_KEY_REVOKED.Wrap(fmt.Errorf("key %d", keyOrm.Id))
+	}
+	if keyOrm.ExpiresAt != nil && !now.Before(*keyOrm.ExpiresAt) {
+		return nil, nil, errcode.ERR_API_KEY_EXPIRED.Wrap(fmt.Errorf("key %d", keyOrm.Id))
+	}
+	if !apiKeyHasScope(keyOrm, scope) {
+		return nil, nil, errcode.ERR_API_KEY_SCOPE_DENIED.Wrap(fmt.Errorf("key %d lacks %s", keyOrm.Id, scope))
+	}
+	if keyOrm.User == nil {
+		return nil, nil, errcode.ERR_API_KEY_INVALID.Wrap(fmt.Errorf("key %d has no user", keyOrm.Id))
+	}
+
+	owner, err := GetDiscourseUser(ctx, cfg, keyOrm.User.DiscourseId)
+	if errcode.Code(err) == int32(errcode.ERR_DISCOURSE_NOT_FOUND) {
+		return nil, nil, errcode.ERR_API_KEY_INVALID.Wrap(fmt.Errorf("key %d owner left Discourse: %w", keyOrm.Id, err))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if keyOrm.LastUsedAt == nil || now.Sub(*keyOrm.LastUsedAt) >= apiKeyTouchInterval {
+		if err := rbdb.TouchApiKey(db, keyOrm.Id, now); err != nil {
+			return nil, nil, err
+		}
+		keyOrm.LastUsedAt = &now
+	}
+
+	return &rbdb.DiscourseUser{
+		ExternalId: keyOrm.User.DiscourseId,
+		Username:   keyOrm.User.Username,
+		Email:      keyOrm.User.Email,
+		Groups:     owner.Groups,
+		Admin:      owner.Admin,
+	}, keyOrm, nil
+}
+
+// apiKeyToPB converts an API key for the owner, without its hash
+func apiKeyToPB(ctx context.Context, keyOrm *rbdb.ApiKeyORM) (*rbdb.ApiKey, error) {
+	keyPb, err := keyOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_API_KEY_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	keyPb.KeyHash = ""
+	return &keyPb, nil
+}
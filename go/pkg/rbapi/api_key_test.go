@@ -0,0 +1,170 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestApiKeys(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+		Config: TestingRolesConfig(),
+	})
+	defer cleanup()
+	typed := svc.(*service)
+	db := TestingSvcDB(t, svc)
+
+	userCtx := TestingSetContextToken(ctx, t)
+	_, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 9301, Username: "ops", Email: "ops@example.com"}, db)
+	require.NoError(t, err)
+	adminCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 9301, Username: "ops", Email: "ops@example.com", Admin: true})
+
+	// Discourse gives the current admin flag and groups of the key owners
+	discourseUsers := map[string]string{
+		"/admin/users/7.json":    `{"id": 7, "username": "test", "admin": false, "groups": [{"name": "trust_level_0"}]}`,
+		"/admin/users/9301.json": `{"id": 9301, "username": "ops", "admin": true, "groups": []}`,
+	}
+	discourse := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := discourseUsers[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(user))
+	}))
+	defer discourse.Close()
+	svc.Config().Discourse.URL = discourse.URL
+
+	create := func(t *testing.T, ctx context.Context, scopes ...string) *UserCreateApiKey_Output {
+		t.Helper()
+		out, err := svc.UserCreateApiKey(ctx, &UserCreateApiKey_Input{Name: "script", Scopes: scopes})
+		require.NoError(t, err)
+		return out
+	}
+
+	t.Run("create validates the input", func(t *testing.T) {
+		_, err := svc.UserCreateApiKey(userCtx, &UserCreateApiKey_Input{Scopes: []string{ApiKeyScopeLicensesRead}})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+		_, err = svc.UserCreateApiKey(userCtx, &UserCreateApiKey_Input{Name: "script", Scopes: []string{"licenses:delete"}})
+		assert.Equal(t, int32(errcode.ERR_API_KEY_UNKNOWN_SCOPE), errcode.Code(err))
+		_, err = svc.UserCreateApiKey(userCtx, &UserCreateApiKey_Input{Name: "script", Scopes: []string{ApiKeyScopeLicensesRead}, ExpiresInDays: 366})
+		assert.Equal(t, int32(errcode.ERR_API_KEY_INVALID_EXPIRY), errcode.Code(err))
+
+		out := create(t, userCtx, "Payments:Read ", ApiKeyScopeLicensesRead, ApiKeyScopeLicensesRead)
+		assert.Equal(t, "licenses:read,payments:read", out.ApiKey.Scopes)
+		assert.Equal(t, out.Key[:apiKeyDisplayedChars], out.ApiKey.Prefix)
+		assert.Empty(t, out.ApiKey.KeyHash)
+		assert.WithinDuration(t, time.Now().Add(apiKeyDefaultTTL), out.ApiKey.ExpiresAt.AsTime(), time.Minute)
+	})
+
+	t.Run("scopes are checked per RPC", func(t *testing.T) {
+		out := create(t, userCtx, ApiKeyScopePaymentsRead)
+
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "UserGetSession", out.Key)
+		require.NoError(t, err)
+		session, err := svc.UserGetSession(authCtx, &UserGetSession_Input{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), session.User.DiscourseId)
+
+		_, err = TestingAuthenticate(ctx, t, svc, "UserListPayments", out.Key)
+		assert.NoError(t, err)
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetLicenses", out.Key)
+		assert.Equal(t, int32(errcode.ERR_API_KEY_SCOPE_DENIED), errcode.Code(err))
+		// Keys can't manage keys
+		_, err = TestingAuthenticate(ctx, t, svc, "UserCreateApiKey", out.Key)
+		assert.Equal(t, int32(errcode.ERR_API_KEY_SCOPE_DENIED), errcode.Code(err))
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", out.Key+"x")
+		assert.Equal(t, int32(errcode.ERR_API_KEY_INVALID), errcode.Code(err))
+
+		list, err := svc.UserListApiKeys(userCtx, &UserListApiKeys_Input{})
+		require.NoError(t, err)
+		require.NotEmpty(t, list.ApiKeys)
+		assert.Equal(t, out.ApiKey.Id, list.ApiKeys[0].Id)
+		assert.NotNil(t, list.ApiKeys[0].LastUsedAt)
+		assert.Empty(t, list.ApiKeys[0].KeyHash)
+	})
+
+	t.Run("admin RPCs need the key of an admin", func(t *testing.T) {
+		userKey := create(t, userCtx, ApiKeyScopeLicensesRead)
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "AdminGetActiveUsers", userKey.Key)
+		require.NoError(t, err)
+		_, err = svc.AdminGetActiveUsers(authCtx, &AdminGetActiveUsers_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		adminKey := create(t, adminCtx, ApiKeyScopeLicensesRead)
+		authCtx, err = TestingAuthenticate(ctx, t, svc, "AdminGetActiveUsers", adminKey.Key)
+		require.NoError(t, err)
+		assert.NoError(t, typed.authorize(authCtx, PermissionSupportRead))
+	})
+
+	t.Run("keys follow the current permissions of their owner", func(t *testing.T) {
+		adminKey := create(t, adminCtx, ApiKeyScopeLicensesRead)
+		defer func() {
+			discourseUsers["/admin/users/9301.json"] = `{"id": 9301, "username": "ops", "admin": true, "groups": []}`
+		}()
+
+		// Demoted on the forum, the key of the former admin loses the admin RPCs
+		discourseUsers["/admin/users/9301.json"] = `{"id": 9301, "username": "ops", "admin": false, "groups": []}`
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "AdminGetActiveUsers", adminKey.Key)
+		require.NoError(t, err)
+		_, err = svc.AdminGetActiveUsers(authCtx, &AdminGetActiveUsers_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		// Groups are carried, a support member keeps the support RPCs
+		discourseUsers["/admin/users/9301.json"] = `{"id": 9301, "username": "ops", "admin": false, "groups": [{"name": "support"}]}`
+		authCtx, err = TestingAuthenticate(ctx, t, svc, "AdminGetActiveUsers", adminKey.Key)
+		require.NoError(t, err)
+		_, err = svc.AdminGetActiveUsers(authCtx, &AdminGetActiveUsers_Input{})
+		assert.NoError(t, err)
+
+		// Deleted from the forum, the key stops working
+		delete(discourseUsers, "/admin/users/9301.json")
+		_, err = TestingAuthenticate(ctx, t, svc, "AdminGetActiveUsers", adminKey.Key)
+		assert.Equal(t, int32(errcode.ERR_API_KEY_INVALID), errcode.Code(err))
+	})
+
+	t.Run("revoked and expired keys stop working", func(t *testing.T) {
+		out := create(t, userCtx, ApiKeyScopeLicensesRead)
+		_, err := svc.UserRevokeApiKey(adminCtx, &UserRevokeApiKey_Input{ApiKeyId: out.ApiKey.Id})
+		assert.Equal(t, int32(errcode.ERR_API_KEY_NOT_FOUND), errcode.Code(err))
+		revoked, err := svc.UserRevokeApiKey(userCtx, &UserRevokeApiKey_Input{ApiKeyId: out.ApiKey.Id})
+		require.NoError(t, err)
+		assert.NotNil(t, revoked.ApiKey.RevokedAt)
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", out.Key)
+		assert.Equal(t, int32(errcode.ERR_API_KEY_REVOKED), errcode.Code(err))
+
+		out = create(t, userCtx, ApiKeyScopeLicensesRead)
+		require.NoError(t, db.Model(&rbdb.ApiKeyORM{}).Where("id = ?", out.ApiKey.Id).Update("expires_at", time.Now().UTC().Add(-time.Minute)).Error)
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", out.Key)
+		assert.Equal(t, int32(errcode.ERR_API_KEY_EXPIRED), errcode.Code(err))
+	})
+
+	t.Run("offsets update", func(t *testing.T) {
+		handler := updateOffsets(db, typed.redis, typed.cfg)
+		update := func(token string) int {
+			body := []byte(`{"version":"9.9.9","offsets":{"x":1}}`)
+			req := httptest.NewRequest(http.MethodPost, "/offsets/update", bytes.NewReader(body))
+			req.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			return rec.Code
+		}
+
+		assert.Equal(t, http.StatusForbidden, update(create(t, adminCtx, ApiKeyScopeLicensesRead).Key))
+		assert.Equal(t, http.StatusForbidden, update(create(t, userCtx, ApiKeyScopeOffsetsWrite).Key))
+		assert.Equal(t, http.StatusUnauthorized, update("rbk_unknown"))
+		assert.Equal(t, http.StatusOK, update(create(t, adminCtx, ApiKeyScopeOffsetsWrite).Key))
+	})
+}
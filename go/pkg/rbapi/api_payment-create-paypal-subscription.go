@@ -0,0 +1,92 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/plutov/paypal/v4"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PaymentCreatePayPalSubscription implements the API endpoint for subscribing to a PayPal billing plan
+// It takes either a license_duration for new licenses or a renewal_key_id for renewals,
+// the license is delivered and renewed from the PAYMENT.SALE.COMPLETED webhooks
+func (svc *service) PaymentCreatePayPalSubscription(ctx context.Context, in *PaymentCreatePayPalSubscription_Input) (*PaymentCreatePayPalSubscription_Output, error) {
+	if in == nil {
+		return nil, errcode.ERR_MISSING_INPUT
+	}
+
+	// Subscriptions are billed in the currency of the provisioned plans
+	checkout, err := svc.prepareLicenseCheckout(ctx, in.LicenseDuration, in.RenewalKeyId, paypalPlanCurrency, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := paypalPlanFrequency(checkout.Duration); !ok {
+		return nil, errcode.ERR_PAYMENT_RECURRING_DURATION_UNSUPPORTED.Wrap(fmt.Errorf("duration: %s", checkout.Duration.String()))
+	}
+
+	// A user can only have one subscription billed at a time
+	hasSubscription, err := rbdb.UserHasActiveSubscription(svc.db, checkout.User.Id)
+	if err != nil {
+		return nil, err
+	}
+	if hasSubscription {
+		return nil, errcode.ERR_SUBSCRIPTION_ALREADY_ACTIVE.Wrap(fmt.Errorf("user %d", checkout.User.Id))
+	}
+
+	provider, err := paypalSubscriptionProvider(svc.payments)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := rbdb.GetActiveBillingPlan(svc.db, rbdb.Payment_PROVIDER_PAYPAL, checkout.Duration, provider.SandboxMode())
+	if err != nil {
+		return nil, err
+	}
+
+	// The metadata is stored on the subscription, sales read it back when they are completed
+	metadataJSON, err := json.Marshal(checkout.metadata(provider.SandboxMode()))
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(err)
+	}
+
+	ppClient, err := provider.Client(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_CREATE_PAYPAL_OAUTH_TOKEN.Wrap(err)
+	}
+
+	subscription, err := ppClient.CreateSubscription(ctx, paypal.SubscriptionBase{
+		PlanID:   plan.ProviderPlanId,
+		CustomID: string(metadataJSON),
+		ApplicationContext: &paypal.ApplicationContext{
+			ReturnURL:          svc.cfg.Checkout.SuccessURL,
+			CancelURL:          svc.cfg.Checkout.CancelURL,
+			UserAction:         paypal.UserActionSubscribeNow,
+			ShippingPreference: paypal.ShippingPreferenceNoShipping,
+		},
+	})
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_CREATE_PAYPAL_SUBSCRIPTION.Wrap(err)
+	}
+
+	// Find the approval URL
+	var approvalURL string
+	for _, link := range subscription.Links {
+		if link.Rel == "approve" {
+			approvalURL = link.Href
+			break
+		}
+	}
+
+	if approvalURL == "" {
+		return nil, errcode.ERR_PAYMENT_PAYPAL_APPROVAL_URL_MISSING.Wrap(fmt.Errorf("subscription ID: %s", subscription.ID))
+	}
+
+	return &PaymentCreatePayPalSubscription_Output{
+		SubscriptionId: subscription.ID,
+		CheckoutUrl:    approvalURL,
+	}, nil
+}
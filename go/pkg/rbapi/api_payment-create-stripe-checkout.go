@@ -0,0 +1,51 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PaymentCreateStripeCheckout implements the API endpoint for creating a Stripe Checkout Session
+// It takes either a license_duration for new licenses or a renewal_key_id for renewals,
+// and starts an auto-renewing subscription when recurring is set
+func (svc *service) PaymentCreateStripeCheckout(ctx context.Context, in *PaymentCreateStripeCheckout_Input) (*PaymentCreateStripeCheckout_Output, error) {
+	if in == nil {
+		return nil, errcode.ERR_MISSING_INPUT
+	}
+
+	checkout, err := svc.prepareLicenseCheckout(ctx, in.LicenseDuration, in.RenewalKeyId, in.Currency, in.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.applyReferralCode(checkout, in.ReferralCode); err != nil {
+		return nil, err
+	}
+
+	// A user can only have one subscription billed at a time
+	if in.Recurring {
+		hasSubscription, err := rbdb.UserHasActiveSubscription(svc.db, checkout.User.Id)
+		if err != nil {
+			return nil, err
+		}
+		if hasSubscription {
+			return nil, errcode.ERR_SUBSCRIPTION_ALREADY_ACTIVE.Wrap(fmt.Errorf("user %d", checkout.User.Id))
+		}
+	}
+
+	session, err := CreateStripeCheckoutSession(ctx, svc.cfg, checkout, in.Recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.URL == "" {
+		return nil, errcode.ERR_PAYMENT_CREATE_STRIPE_CHECKOUT_SESSION.Wrap(fmt.Errorf("missing URL for session %s", session.ID))
+	}
+
+	return &PaymentCreateStripeCheckout_Output{
+		SessionId:   session.ID,
+		CheckoutUrl: session.URL,
+	}, nil
+}
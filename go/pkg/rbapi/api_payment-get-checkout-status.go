@@ -0,0 +1,83 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PaymentGetCheckoutStatus implements the PaymentGetCheckoutStatus RPC method
+// It reports the progress of a PayPal checkout of the authenticated user, for the payment success page
+func (svc *service) PaymentGetCheckoutStatus(ctx context.Context, in *PaymentGetCheckoutStatus_Input) (*PaymentGetCheckoutStatus_Output, error) {
+	if in.GetOrderId() == "" {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("must provide order ID"))
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	return svc.checkoutStatus(ctx, user.Id, in.OrderId)
+}
+
+// checkoutStatus reports the status of a checkout order, orders of other users are not found
+func (svc *service) checkoutStatus(ctx context.Context, userId int64, orderID string) (*PaymentGetCheckoutStatus_Output, error) {
+	orderOrm, err := rbdb.GetCheckoutOrderByProviderID(svc.db, rbdb.Payment_PROVIDER_PAYPAL, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if orderOrm.UserId != userId {
+		return nil, errcode.ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND.Wrap(fmt.Errorf("provider order %s of user %d", orderID, orderOrm.UserId))
+	}
+
+	out := &PaymentGetCheckoutStatus_Output{
+		OrderStatus: rbdb.CheckoutOrder_Status(orderOrm.Status),
+	}
+	switch out.OrderStatus {
+	case rbdb.CheckoutOrder_STATUS_CAPTURED:
+		out.Status = PaymentGetCheckoutStatus_STATUS_CAPTURED
+	case rbdb.CheckoutOrder_STATUS_COMPLETED:
+		out.Status = PaymentGetCheckoutStatus_STATUS_LICENSE_ISSUED
+	case rbdb.CheckoutOrder_STATUS_VOIDED, rbdb.CheckoutOrder_STATUS_FAILED:
+		out.Status = PaymentGetCheckoutStatus_STATUS_FAILED
+	default:
+		out.Status = PaymentGetCheckoutStatus_STATUS_PENDING
+	}
+	if out.Status != PaymentGetCheckoutStatus_STATUS_LICENSE_ISSUED {
+		return out, nil
+	}
+
+	// The capture is the reference of the payment delivering the license
+	paymentOrm, err := rbdb.GetPaymentByReference(svc.db, orderOrm.CaptureId)
+	if err != nil {
+		return nil, err
+	}
+	out.PaymentId = paymentOrm.Id
+	if paymentOrm.LicenseKeyId != nil {
+		var licenseOrm rbdb.LicenseKeyORM
+		if err := svc.db.Where(&rbdb.LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error; err != nil {
+			return nil, rbdb.GormToErrcode(err)
+		}
+		licensePb, err := licenseOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_LICENSE_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.LicenseKey = &licensePb
+	}
+	return out, nil
+}
+
+// isCheckoutStatusFinal reports if a checkout status can't change anymore
+func isCheckoutStatusFinal(status PaymentGetCheckoutStatus_Status) bool {
+	return status == PaymentGetCheckoutStatus_STATUS_LICENSE_ISSUED || status == PaymentGetCheckoutStatus_STATUS_FAILED
+}
@@ -0,0 +1,154 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// fakeCheckoutStatusStream records the statuses sent by PaymentWatchCheckoutStatus
+type fakeCheckoutStatusStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	mu   sync.Mutex
+	sent []*PaymentGetCheckoutStatus_Output
+}
+
+func (s *fakeCheckoutStatusStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeCheckoutStatusStream) Send(out *PaymentGetCheckoutStatus_Output) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, out)
+	return nil
+}
+
+func TestCheckoutStatus(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, _, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	prevInterval := checkoutStatusPollInterval
+	checkoutStatusPollInterval = 10 * time.Millisecond
+	defer func() {
+		checkoutStatusPollInterval = prevInterval
+	}()
+
+	userCtx := TestingSetContextToken(ctx, t)
+	sendEvent := func(t *testing.T, eventType string, resource map[string]interface{}) {
+		t.Helper()
+		raw, err := json.Marshal(resource)
+		require.NoError(t, err)
+		event := paypal.AnyEvent{
+			Event:    paypal.Event{ID: "WH-" + eventType, EventType: eventType},
+			Resource: raw,
+		}
+		require.NoError(t, processPayPalWebhookEvent(ctx, event, db, logger, svc.Config(), fake))
+	}
+	watch := func(orderID string) (*fakeCheckoutStatusStream, chan error) {
+		stream := &fakeCheckoutStatusStream{ctx: userCtx}
+		done := make(chan error, 1)
+		go func() {
+			done <- svc.PaymentWatchCheckoutStatus(&PaymentWatchCheckoutStatus_Input{OrderId: orderID}, stream)
+		}()
+		return stream, done
+	}
+
+	t.Run("reports the progress of the checkout", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+
+		status, err := svc.PaymentGetCheckoutStatus(userCtx, &PaymentGetCheckoutStatus_Input{OrderId: out.OrderId})
+		require.NoError(t, err)
+		assert.Equal(t, PaymentGetCheckoutStatus_STATUS_PENDING, status.Status)
+		assert.Equal(t, rbdb.CheckoutOrder_STATUS_CREATED, status.OrderStatus)
+		assert.Nil(t, status.LicenseKey)
+
+		_, err = fake.Approve(out.OrderId)
+		require.NoError(t, err)
+		sendEvent(t, paypal.EventCheckoutOrderApproved, map[string]interface{}{"id": out.OrderId})
+		status, err = svc.PaymentGetCheckoutStatus(userCtx, &PaymentGetCheckoutStatus_Input{OrderId: out.OrderId})
+		require.NoError(t, err)
+		assert.Equal(t, PaymentGetCheckoutStatus_STATUS_CAPTURED, status.Status)
+		orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, out.OrderId)
+		require.NoError(t, err)
+		captureID := orderOrm.CaptureId
+
+		// The watcher is told when the webhook issues the license
+		stream, done := watch(out.OrderId)
+		require.Eventually(t, func() bool {
+			stream.mu.Lock()
+			defer stream.mu.Unlock()
+			return len(stream.sent) == 1
+		}, time.Second, 5*time.Millisecond)
+		sendEvent(t, paypal.EventPaymentCaptureCompleted, map[string]interface{}{
+			"id": captureID,
+			"links": []interface{}{
+				map[string]interface{}{"rel": "up", "href": "http://localhost/v2/checkout/orders/" + out.OrderId},
+			},
+		})
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the watch did not end")
+		}
+
+		require.Len(t, stream.sent, 2)
+		assert.Equal(t, PaymentGetCheckoutStatus_STATUS_CAPTURED, stream.sent[0].Status)
+		issued := stream.sent[1]
+		assert.Equal(t, PaymentGetCheckoutStatus_STATUS_LICENSE_ISSUED, issued.Status)
+		require.NotNil(t, issued.LicenseKey)
+		assert.NotEmpty(t, issued.LicenseKey.Key)
+		assert.Equal(t, rbdb.LicenseKey_ONE_MONTH, issued.LicenseKey.Duration)
+		paymentOrm, err := rbdb.GetPaymentByReference(db, captureID)
+		require.NoError(t, err)
+		assert.Equal(t, paymentOrm.Id, issued.PaymentId)
+	})
+
+	t.Run("failed checkouts end the watch", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_WEEK,
+		})
+		require.NoError(t, err)
+		_, err = rbdb.AdvanceCheckoutOrder(db, rbdb.Payment_PROVIDER_PAYPAL, out.OrderId, rbdb.CheckoutOrder_STATUS_VOIDED, "", time.Now().UTC())
+		require.NoError(t, err)
+
+		stream, done := watch(out.OrderId)
+		require.NoError(t, <-done)
+		require.Len(t, stream.sent, 1)
+		assert.Equal(t, PaymentGetCheckoutStatus_STATUS_FAILED, stream.sent[0].Status)
+		assert.Equal(t, rbdb.CheckoutOrder_STATUS_VOIDED, stream.sent[0].OrderStatus)
+	})
+
+	t.Run("orders of other users are not found", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_WEEK,
+		})
+		require.NoError(t, err)
+
+		_, err = rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 6001, Username: "someone_else"}, db)
+		require.NoError(t, err)
+		otherCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 6001, Username: "someone_else"})
+		_, err = svc.PaymentGetCheckoutStatus(otherCtx, &PaymentGetCheckoutStatus_Input{OrderId: out.OrderId})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND), errcode.Code(err))
+		_, err = svc.PaymentGetCheckoutStatus(userCtx, &PaymentGetCheckoutStatus_Input{OrderId: "UNKNOWN"})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND), errcode.Code(err))
+		_, err = svc.PaymentGetCheckoutStatus(userCtx, &PaymentGetCheckoutStatus_Input{})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+	})
+}
@@ -0,0 +1,64 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"rslbot.com/go/pkg/errcode"
+)
+
+// The webhooks can be processed by any API instance, watchers check the order in the database
+var (
+	checkoutStatusPollInterval = 2 * time.Second
+	checkoutStatusWatchTimeout = 15 * time.Minute
+)
+
+// PaymentWatchCheckoutStatus implements the PaymentWatchCheckoutStatus RPC method
+// It streams the status of a PayPal checkout when it changes, until the license is issued or the checkout failed.
+// The stream ends after checkoutStatusWatchTimeout, clients reconnect to keep watching
+func (svc *service) PaymentWatchCheckoutStatus(in *PaymentWatchCheckoutStatus_Input, stream Service_PaymentWatchCheckoutStatusServer) error {
+	if in.GetOrderId() == "" {
+		return errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("must provide order ID"))
+	}
+	ctx, cancel := context.WithTimeout(stream.Context(), checkoutStatusWatchTimeout)
+	defer cancel()
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	ticker := time.NewTicker(checkoutStatusPollInterval)
+	defer ticker.Stop()
+
+	var last *PaymentGetCheckoutStatus_Output
+	for {
+		out, err := svc.checkoutStatus(ctx, user.Id, in.OrderId)
+		if err != nil {
+			return err
+		}
+		if last == nil || out.Status != last.Status || out.OrderStatus != last.OrderStatus {
+			if err := stream.Send(out); err != nil {
+				return err
+			}
+			last = out
+		}
+		if isCheckoutStatusFinal(out.Status) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package rbapi
+
+import (
+	"context"
+	"time"
+
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PublicListPrices returns the catalog prices that can be bought now, for the pricing page
+// Without currency, a country code lists the prices in the currency of the country, or in the default currency
+func (svc *service) PublicListPrices(ctx context.Context, in *PublicListPrices_Input) (*PublicListPrices_Output, error) {
+	now := time.Now().UTC()
+	currency := in.GetCurrency()
+	if currency == "" && in.GetCountryCode() != "" {
+		currency = defaultCurrency
+		if regional := countryCurrency(in.CountryCode); regional != "" && regional != defaultCurrency {
+			regionalPrices, err := rbdb.ListActivePrices(svc.db, regional, now)
+			if err != nil {
+				return nil, err
+			}
+			if len(regionalPrices) > 0 {
+				currency = regional
+			}
+		}
+	}
+
+	pricesOrm, err := rbdb.ListActivePrices(svc.db, currency, now)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := pricesToPB(ctx, pricesOrm)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicListPrices_Output{Prices: prices}, nil
+}
@@ -0,0 +1,85 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserCancelSubscription implements the UserCancelSubscription RPC method
+// The billing is stopped on the provider, the license stays valid until the end of the paid period
+func (svc *service) UserCancelSubscription(ctx context.Context, in *UserCancelSubscription_Input) (*UserCancelSubscription_Output, error) {
+	if in == nil || in.SubscriptionId == 0 {
+		return nil, errcode.ERR_MISSING_INPUT
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	var subscriptionOrm rbdb.SubscriptionORM
+	err = svc.db.Where(&rbdb.SubscriptionORM{Id: in.SubscriptionId}).First(&subscriptionOrm).Error
+	if err != nil {
+		if rbdb.IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_SUBSCRIPTION_NOT_FOUND.Wrap(fmt.Errorf("subscription %d", in.SubscriptionId))
+		}
+		return nil, rbdb.GormToErrcode(err)
+	}
+
+	// Make sure the subscription belongs to the current user
+	if subscriptionOrm.UserId != user.Id {
+		return nil, errcode.ERR_AUTH_NO_PERMISSION.Wrap(fmt.Errorf("subscription %d", in.SubscriptionId))
+	}
+
+	if subscriptionOrm.Status == int32(rbdb.Subscription_STATUS_CANCELED) {
+		return nil, errcode.ERR_SUBSCRIPTION_ALREADY_CANCELED.Wrap(fmt.Errorf("subscription %d", in.SubscriptionId))
+	}
+
+	if err := cancelProviderSubscription(ctx, svc.cfg, svc.payments, &subscriptionOrm); err != nil {
+		return nil, err
+	}
+
+	// The provider also notifies the cancellation through its webhook, which is then a no-op
+	canceledOrm, err := rbdb.CancelSubscription(svc.db, subscriptionOrm.Id, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("Subscription canceled by user",
+		zap.Int64("subscription_id", canceledOrm.Id),
+		zap.Int64("user_id", user.Id))
+
+	subscriptionPb, err := canceledOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_SUBSCRIPTION_PROTOBUF_CONVERSION.Wrap(err)
+	}
+
+	return &UserCancelSubscription_Output{
+		Subscription: &subscriptionPb,
+	}, nil
+}
+
+// cancelProviderSubscription stops the billing of a subscription on its payment provider
+func cancelProviderSubscription(ctx context.Context, cfg *config.Config, payments PaymentProvider, subscriptionOrm *rbdb.SubscriptionORM) error {
+	switch rbdb.Payment_Provider(subscriptionOrm.Provider) {
+	case rbdb.Payment_PROVIDER_PAYPAL:
+		return CancelPayPalSubscription(ctx, payments, subscriptionOrm.ProviderSubscriptionId)
+	case rbdb.Payment_PROVIDER_STRIPE:
+		return CancelStripeSubscription(ctx, cfg.Stripe, subscriptionOrm.ProviderSubscriptionId)
+	default:
+		return errcode.ERR_SUBSCRIPTION_CANCEL.Wrap(fmt.Errorf("unsupported provider %s", rbdb.Payment_Provider(subscriptionOrm.Provider)))
+	}
+}
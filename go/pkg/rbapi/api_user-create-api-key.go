@@ -0,0 +1,71 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserCreateApiKey implements the UserCreateApiKey RPC method
+// It creates a personal API key for the authenticated user, the key is only returned by this call
+func (svc *service) UserCreateApiKey(ctx context.Context, in *UserCreateApiKey_Input) (*UserCreateApiKey_Output, error) {
+	name := strings.TrimSpace(in.GetName())
+	if name == "" || len(in.GetScopes()) == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("name and scopes are required"))
+	}
+	scopes, err := normalizeApiKeyScopes(in.GetScopes())
+	if err != nil {
+		return nil, err
+	}
+	ttl := apiKeyDefaultTTL
+	if days := in.GetExpiresInDays(); days != 0 {
+		if days < 0 || days > apiKeyMaxDays {
+			return nil, errcode.ERR_API_KEY_INVALID_EXPIRY.Wrap(fmt.Errorf("expires_in_days must be between 1 and %d", apiKeyMaxDays))
+		}
+		ttl = time.Duration(days) * 24 * time.Hour
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	key, err := randomSessionToken(apiKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().UTC().Add(ttl)
+	keyOrm := &rbdb.ApiKeyORM{
+		Name:      name,
+		Prefix:    key[:apiKeyDisplayedChars],
+		KeyHash:   hashSessionToken(key),
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: &expiresAt,
+		UserId:    user.Id,
+	}
+	if err := rbdb.CreateApiKey(svc.db, keyOrm); err != nil {
+		return nil, err
+	}
+	svc.logger.Info("API key created",
+		zap.Int64("api_key_id", keyOrm.Id),
+		zap.Int64("user_id", user.Id),
+		zap.Strings("scopes", scopes))
+
+	keyPb, err := apiKeyToPB(ctx, keyOrm)
+	if err != nil {
+		return nil, err
+	}
+	return &UserCreateApiKey_Output{Key: key, ApiKey: keyPb}, nil
+}
@@ -0,0 +1,65 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserGetInvoice implements the UserGetInvoice RPC method
+// It returns an invoice or a credit note of the authenticated user with its PDF document
+func (svc *service) UserGetInvoice(ctx context.Context, in *UserGetInvoice_Input) (*UserGetInvoice_Output, error) {
+	if in.GetInvoiceId() == 0 && in.GetPaymentId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("must provide either invoice ID or payment ID"))
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	var invoiceOrm *rbdb.InvoiceORM
+	if in.InvoiceId != 0 {
+		invoiceOrm, err = rbdb.GetInvoice(svc.db, in.InvoiceId)
+	} else {
+		invoiceOrm, err = rbdb.GetPaymentInvoice(svc.db, in.PaymentId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Invoices of other users are reported as missing
+	if invoiceOrm.UserId != user.Id {
+		return nil, errcode.ERR_INVOICE_NOT_FOUND.Wrap(fmt.Errorf("invoice %d of user %d", invoiceOrm.Id, invoiceOrm.UserId))
+	}
+
+	document, err := invoicePDF(svc.db, svc.cfg.Invoice, invoiceOrm, false)
+	if err != nil {
+		return nil, err
+	}
+
+	creditNotesOrm, err := rbdb.ListCreditNotes(svc.db, invoiceOrm.Id)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := invoicesToPB(ctx, append([]*rbdb.InvoiceORM{invoiceOrm}, creditNotesOrm...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserGetInvoice_Output{
+		Invoice:     invoices[0],
+		Pdf:         document,
+		Filename:    invoiceFilename(invoiceOrm),
+		CreditNotes: invoices[1:],
+	}, nil
+}
@@ -0,0 +1,52 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserGetPayment implements the UserGetPayment RPC method
+// It returns a payment of the authenticated user with its license and invoices
+func (svc *service) UserGetPayment(ctx context.Context, in *UserGetPayment_Input) (*UserGetPayment_Output, error) {
+	if in.GetPaymentId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("must provide payment ID"))
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	paymentOrm, err := rbdb.GetUserPayment(svc.db, user.Id, in.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+	paymentPb, err := paymentOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_PROTOBUF_CONVERSION.Wrap(err)
+	}
+
+	invoicesOrm, err := rbdb.ListPaymentInvoices(svc.db, paymentOrm.Id)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := invoicesToPB(ctx, invoicesOrm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserGetPayment_Output{
+		Payment:  &paymentPb,
+		Invoices: invoices,
+	}, nil
+}
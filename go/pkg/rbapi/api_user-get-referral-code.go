@@ -0,0 +1,34 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserGetReferralCode implements the UserGetReferralCode RPC method
+// It returns the referral code of the authenticated user, created on first use
+func (svc *service) UserGetReferralCode(ctx context.Context, _ *UserGetReferralCode_Input) (*UserGetReferralCode_Output, error) {
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	codeOrm, err := rbdb.GetOrCreateReferralCode(svc.db, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserGetReferralCode_Output{
+		Code:           codeOrm.Code,
+		CommissionRate: svc.cfg.Referral.CommissionRate,
+	}, nil
+}
@@ -20,9 +20,15 @@ func (svc *service) UserGetSession(ctx context.Context, in *UserGetSession_Input
 		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
 	}
 
-	return &UserGetSession_Output{
+	out := &UserGetSession_Output{
 		User: user,
-	}, nil
+	}
+	// Lets the frontend show that an admin is acting as the user
+	if impersonation := impersonationFromContext(ctx); impersonation != nil {
+		out.ImpersonatedBy = impersonation.Admin.Username
+		out.ImpersonationReadWrite = impersonation.ReadWrite
+	}
+	return out, nil
 }
 
 func (svc *service) loadOrCreateUser(ctx context.Context, userInfo *rbdb.DiscourseUser) (*rbdb.User, error) {
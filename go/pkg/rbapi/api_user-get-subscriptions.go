@@ -0,0 +1,49 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserGetSubscriptions implements the UserGetSubscriptions RPC method
+// It retrieves all subscriptions associated with the authenticated user, most recent first
+func (svc *service) UserGetSubscriptions(ctx context.Context, in *UserGetSubscriptions_Input) (*UserGetSubscriptions_Output, error) {
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	// Find all subscriptions belonging to this user
+	var subscriptionsOrm []*rbdb.SubscriptionORM
+	err = svc.db.
+		Preload("LicenseKey").
+		Where(&rbdb.SubscriptionORM{UserId: user.Id}).
+		Order("created_at DESC").
+		Find(&subscriptionsOrm).Error
+	if err != nil {
+		return nil, rbdb.GormToErrcode(err)
+	}
+
+	// Convert subscriptions to protobuf types
+	subscriptions := make([]*rbdb.Subscription, 0, len(subscriptionsOrm))
+	for _, subscriptionOrm := range subscriptionsOrm {
+		subscriptionPb, err := subscriptionOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_SUBSCRIPTION_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		subscriptions = append(subscriptions, &subscriptionPb)
+	}
+
+	return &UserGetSubscriptions_Output{
+		Subscriptions: subscriptions,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserListApiKeys implements the UserListApiKeys RPC method
+// It returns the personal API keys of the authenticated user, without the keys themselves
+func (svc *service) UserListApiKeys(ctx context.Context, in *UserListApiKeys_Input) (*UserListApiKeys_Output, error) {
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	keysOrm, err := rbdb.ListUserApiKeys(svc.db, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*rbdb.ApiKey, 0, len(keysOrm))
+	for _, keyOrm := range keysOrm {
+		keyPb, err := apiKeyToPB(ctx, keyOrm)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, keyPb)
+	}
+	return &UserListApiKeys_Output{ApiKeys: keys}, nil
+}
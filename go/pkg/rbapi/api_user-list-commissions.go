@@ -0,0 +1,71 @@
+package rbapi
+
+import (
+	"context"
+	"time"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const (
+	defaultUserCommissionsLimit = 50
+	maxUserCommissionsLimit     = 200
+)
+
+// UserListCommissions implements the UserListCommissions RPC method
+// It returns the latest commissions earned by the authenticated user and their balances
+func (svc *service) UserListCommissions(ctx context.Context, in *UserListCommissions_Input) (*UserListCommissions_Output, error) {
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultUserCommissionsLimit
+	}
+	if limit > maxUserCommissionsLimit {
+		limit = maxUserCommissionsLimit
+	}
+
+	// Commissions past their holding period are shown as payable
+	if err := rbdb.ReleaseCommissions(svc.db, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	commissionsOrm, err := rbdb.ListUserCommissions(svc.db, user.Id, limit)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := rbdb.GetCommissionBalances(svc.db, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &UserListCommissions_Output{}
+	for _, commissionOrm := range commissionsOrm {
+		commissionPb, err := commissionOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_COMMISSION_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Commissions = append(out.Commissions, &commissionPb)
+	}
+	for _, balance := range balances {
+		out.Balances = append(out.Balances, &UserListCommissions_Output_Balance{
+			Currency:       balance.Currency,
+			PendingInCents: balance.PendingInCents,
+			PayableInCents: balance.PayableInCents,
+			PaidInCents:    balance.PaidInCents,
+		})
+	}
+
+	return out, nil
+}
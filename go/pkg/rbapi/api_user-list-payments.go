@@ -0,0 +1,62 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const (
+	defaultUserPaymentsLimit = 20
+	maxUserPaymentsLimit     = 100
+)
+
+// UserListPayments implements the UserListPayments RPC method
+// It returns a page of the payments of the authenticated user, latest first
+func (svc *service) UserListPayments(ctx context.Context, in *UserListPayments_Input) (*UserListPayments_Output, error) {
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultUserPaymentsLimit
+	}
+	if limit > maxUserPaymentsLimit {
+		limit = maxUserPaymentsLimit
+	}
+
+	// Load one more payment to know if there is a next page
+	paymentsOrm, err := rbdb.ListUserPayments(svc.db, user.Id, in.GetBeforeId(), limit+1)
+	if err != nil {
+		return nil, err
+	}
+	total, err := rbdb.CountUserPayments(svc.db, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &UserListPayments_Output{Total: int32(total)}
+	if len(paymentsOrm) > limit {
+		paymentsOrm = paymentsOrm[:limit]
+		out.NextBeforeId = paymentsOrm[limit-1].Id
+	}
+	for _, paymentOrm := range paymentsOrm {
+		paymentPb, err := paymentOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_PAYMENT_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		out.Payments = append(out.Payments, &paymentPb)
+	}
+
+	return out, nil
+}
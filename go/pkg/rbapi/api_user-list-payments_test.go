@@ -0,0 +1,87 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestUserPayments(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+	db := TestingSvcDB(t, svc)
+
+	newBuyer := func(t *testing.T, discourseId int64, references ...string) (context.Context, *rbdb.User) {
+		t.Helper()
+		user, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: discourseId, Username: fmt.Sprintf("buyer_%d", discourseId)}, db)
+		require.NoError(t, err)
+		for _, reference := range references {
+			require.NoError(t, fulfillLicensePayment(ctx, db, logger, svc.Config(), &rbdb.Payment{
+				Provider:      rbdb.Payment_PROVIDER_PAYPAL,
+				ReferenceId:   reference,
+				AmountInCents: 1900,
+				Currency:      "eur",
+			}, map[string]string{
+				"user_id":  fmt.Sprintf("%d", user.Id),
+				"duration": rbdb.LicenseKey_ONE_MONTH.String(),
+			}))
+		}
+		userCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: discourseId, Username: user.Username})
+		return userCtx, user
+	}
+	buyerCtx, _ := newBuyer(t, 5001, "CAP-history-1", "CAP-history-2", "CAP-history-3")
+	otherCtx, _ := newBuyer(t, 5002, "CAP-history-other")
+
+	t.Run("pages through the payments", func(t *testing.T) {
+		page, err := svc.UserListPayments(buyerCtx, &UserListPayments_Input{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), page.Total)
+		require.Len(t, page.Payments, 2)
+		assert.Equal(t, "CAP-history-3", page.Payments[0].ReferenceId)
+		assert.Equal(t, "CAP-history-2", page.Payments[1].ReferenceId)
+		require.NotNil(t, page.Payments[0].LicenseKey)
+		assert.Equal(t, rbdb.LicenseKey_ONE_MONTH, page.Payments[0].LicenseKey.Duration)
+		assert.Equal(t, rbdb.Payment_STATUS_COMPLETED, page.Payments[0].Status)
+		assert.Equal(t, page.Payments[1].Id, page.NextBeforeId)
+
+		page, err = svc.UserListPayments(buyerCtx, &UserListPayments_Input{Limit: 2, BeforeId: page.NextBeforeId})
+		require.NoError(t, err)
+		require.Len(t, page.Payments, 1)
+		assert.Equal(t, "CAP-history-1", page.Payments[0].ReferenceId)
+		assert.Zero(t, page.NextBeforeId)
+	})
+
+	t.Run("gets a payment with its invoices", func(t *testing.T) {
+		list, err := svc.UserListPayments(buyerCtx, &UserListPayments_Input{})
+		require.NoError(t, err)
+		require.Len(t, list.Payments, 3)
+		assert.Zero(t, list.NextBeforeId)
+
+		out, err := svc.UserGetPayment(buyerCtx, &UserGetPayment_Input{PaymentId: list.Payments[0].Id})
+		require.NoError(t, err)
+		assert.Equal(t, "CAP-history-3", out.Payment.ReferenceId)
+		assert.Equal(t, int64(1900), out.Payment.AmountInCents)
+		assert.Equal(t, rbdb.Payment_PROVIDER_PAYPAL, out.Payment.Provider)
+		assert.False(t, out.Payment.IsRenewal)
+		require.NotNil(t, out.Payment.LicenseKey)
+		require.Len(t, out.Invoices, 1)
+		assert.Empty(t, out.Invoices[0].Pdf)
+
+		_, err = svc.UserGetPayment(buyerCtx, &UserGetPayment_Input{})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+
+		// Payments of other users are not found
+		_, err = svc.UserGetPayment(otherCtx, &UserGetPayment_Input{PaymentId: list.Payments[0].Id})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_NOT_FOUND), errcode.Code(err))
+	})
+}
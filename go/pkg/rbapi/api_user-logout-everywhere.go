@@ -0,0 +1,33 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+)
+
+// UserLogoutEverywhere revokes all the sessions of the user and logs them out of Discourse
+func (svc *service) UserLogoutEverywhere(ctx context.Context, in *UserLogoutEverywhere_Input) (*UserLogoutEverywhere_Output, error) {
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	revoked, err := svc.redis.RevokeUserSessions(ctx, discourseUser.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.redis.InvalidateDiscourseTokens(ctx, discourseUser.ExternalId); err != nil {
+		return nil, err
+	}
+
+	err = LogoutUserFromDiscourse(ctx, svc.cfg.Discourse, discourseUser.ExternalId)
+	if err != nil {
+		return nil, errcode.ERR_API_LOGOUT.Wrap(err)
+	}
+
+	return &UserLogoutEverywhere_Output{
+		RevokedSessions: int32(revoked),
+	}, nil
+}
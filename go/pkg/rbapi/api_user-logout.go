@@ -14,8 +14,20 @@ func (svc *service) UserLogout(ctx context.Context, in *UserLogout_Input) (*User
 		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
 	}
 
+	// Revoke the session of the access token, other sessions are kept
+	if session := sessionFromContext(ctx); session != nil {
+		if err := svc.redis.RevokeSession(ctx, session.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keys verified by Discourse are verified again on next use
+	if err := svc.redis.InvalidateDiscourseTokens(ctx, discourseUser.ExternalId); err != nil {
+		return nil, err
+	}
+
 	// Call Discourse API to log out the user
-	err = LogoutUserFromDiscourse(ctx, discourseUser.ExternalId)
+	err = LogoutUserFromDiscourse(ctx, svc.cfg.Discourse, discourseUser.ExternalId)
 	if err != nil {
 		return nil, errcode.ERR_API_LOGOUT.Wrap(err)
 	}
@@ -0,0 +1,55 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserRequestRefund implements the UserRequestRefund RPC method
+// It queues a refund request of a payment of the authenticated user for the admins, see checkRefundPolicy
+func (svc *service) UserRequestRefund(ctx context.Context, in *UserRequestRefund_Input) (*UserRequestRefund_Output, error) {
+	if in.GetPaymentId() == 0 || strings.TrimSpace(in.GetReason()) == "" {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("payment_id and reason are required"))
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	paymentOrm, err := rbdb.GetUserPayment(svc.db, user.Id, in.GetPaymentId())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRefundPolicy(svc.cfg.RefundRequests, paymentOrm, svc.payments.SandboxMode(), time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	requestOrm, err := rbdb.CreateRefundRequest(svc.db, paymentOrm, strings.TrimSpace(in.GetReason()))
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("Refund requested",
+		zap.Int64("refund_request_id", requestOrm.Id),
+		zap.Int64("payment_id", paymentOrm.Id),
+		zap.Int64("user_id", user.Id))
+
+	requestPb, err := requestOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_REFUND_REQUEST_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &UserRequestRefund_Output{RefundRequest: &requestPb}, nil
+}
@@ -0,0 +1,45 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// UserRevokeApiKey implements the UserRevokeApiKey RPC method
+// The key stops working immediately, it stays listed as revoked
+func (svc *service) UserRevokeApiKey(ctx context.Context, in *UserRevokeApiKey_Input) (*UserRevokeApiKey_Output, error) {
+	if in.GetApiKeyId() == 0 {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("api_key_id is required"))
+	}
+
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Try loading from database
+	user, err := svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	keyOrm, err := rbdb.RevokeUserApiKey(svc.db, user.Id, in.GetApiKeyId(), time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("API key revoked",
+		zap.Int64("api_key_id", keyOrm.Id),
+		zap.Int64("user_id", user.Id))
+
+	keyPb, err := apiKeyToPB(ctx, keyOrm)
+	if err != nil {
+		return nil, err
+	}
+	return &UserRevokeApiKey_Output{ApiKey: keyPb}, nil
+}
@@ -55,7 +55,7 @@ func (svc *service) UserSyncDiscordRole(ctx context.Context, in *UserSyncDiscord
 	}
 
 	// Get Discord ID from Discourse
-	discordID, discourseErr := GetDiscordIDFromDiscourse(ctx, discourseUser.ExternalId)
+	discordID, discourseErr := GetDiscordIDFromDiscourse(ctx, svc.cfg.Discourse, discourseUser.ExternalId)
 	if discourseErr != nil {
 		// If we can't fetch from Discourse, still return a structured response
 		// The error likely means Discord isn't linked or there's an API issue
@@ -80,7 +80,7 @@ func (svc *service) UserSyncDiscordRole(ctx context.Context, in *UserSyncDiscord
 	}
 
 	// Assign Discord role
-	err = AssignDiscordRole(ctx, discordID)
+	err = AssignDiscordRole(ctx, svc.cfg.Discord, discordID)
 	if err != nil {
 		// Check specific error types
 		if errors.Is(err, errcode.ERR_DISCORD_USER_NOT_IN_GUILD) {
@@ -2,8 +2,9 @@ package rbapi
 
 import (
 	"context"
-	"net/url"
+	"fmt"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 	"rslbot.com/go/pkg/errcode"
@@ -13,15 +14,20 @@ import (
 type ctxKey string
 
 const (
-	userInfoCtx     ctxKey = "user-info"
-	adminGroup      ctxKey = "admin"
-	DiscourseSecret string = "y<6aS~lI@bPMZSweDnonEb4.pGPJl"
+	userInfoCtx      ctxKey = "user-info"
+	sessionCtx       ctxKey = "session"
+	impersonationCtx ctxKey = "impersonation"
+	adminGroup       ctxKey = "admin"
 )
 
 func (svc *service) AuthFuncOverride(ctx context.Context, path string) (context.Context, error) {
 	// Check if this is a public endpoint that doesn't require authentication
 	publicEndpoints := []string{
+		"/rslbot.api.Service/AuthExchangeSSO",
+		"/rslbot.api.Service/AuthRefreshSession",
+		"/rslbot.api.Service/AuthStartSSO",
 		"/rslbot.api.Service/ToolStatus",
+		"/rslbot.api.Service/PublicListPrices",
 		"/rslbot.api.Service/PublicBuildGet",
 		"/rslbot.api.Service/PublicBuildList",
 		"/rslbot.api.Service/PublicPickitGet",
@@ -47,30 +53,43 @@ func (svc *service) AuthFuncOverride(ctx context.Context, path string) (context.
 	// Clean up the authorization header
 	tokenString := strings.TrimPrefix(auth[0], "Bearer ")
 
-	// Check if this is an SSO token
+	// The raw SSO payload never expires, it is only accepted by AuthExchangeSSO
 	if strings.HasPrefix(tokenString, "SSO_") {
-		// Extract SSO and signature from the token
-		parts := strings.Split(strings.TrimPrefix(tokenString, "SSO_"), ".")
-		if len(parts) != 2 {
-			return nil, errcode.ERR_AUTH_INVALID_TOKEN
-		}
+		return nil, errcode.ERR_AUTH_SSO_TOKEN_NOT_ACCEPTED
+	}
 
-		// URL decode the token part if needed since it might be URL-encoded
-		decodedToken := parts[0]
-		if unescaped, err := url.QueryUnescape(decodedToken); err == nil {
-			decodedToken = unescaped
+	// Session access tokens issued by AuthExchangeSSO
+	if isAccessToken(tokenString) {
+		session, err := svc.redis.GetSessionByAccessToken(ctx, tokenString)
+		if err != nil {
+			return nil, err
 		}
+		ctx = context.WithValue(ctx, userInfoCtx, session.User)
+		ctx = context.WithValue(ctx, sessionCtx, session)
+		return ctx, nil
+	}
 
-		userInfo, err := VerifySSO(decodedToken, parts[1], DiscourseSecret)
+	// Personal API keys, limited to the RPCs of their scopes
+	if isApiKey(tokenString) {
+		scope, ok := apiKeyRPCScopes[path]
+		if !ok {
+			return nil, errcode.ERR_API_KEY_SCOPE_DENIED.Wrap(fmt.Errorf("%s can't be called with an API key", path))
+		}
+		userInfo, _, err := authenticateApiKey(ctx, svc.db, svc.cfg.Discourse, tokenString, scope, time.Now().UTC())
 		if err != nil {
-			return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(err)
+			return nil, err
 		}
 		ctx = context.WithValue(ctx, userInfoCtx, userInfo)
 		return ctx, nil
 	}
 
-	// Fall back to API token verification
-	userInfo, err := VerifyTokenAndGetUser(ctx, tokenString)
+	// Impersonation tokens issued by AdminImpersonateUser, limited to the user RPCs and audited
+	if isImpersonationToken(tokenString) {
+		return svc.authenticateImpersonation(ctx, tokenString, path)
+	}
+
+	// Fall back to Discourse User-Api-Key verification
+	userInfo, err := svc.verifyDiscourseToken(ctx, tokenString)
 	if err != nil {
 		return nil, err
 	}
@@ -86,12 +105,3 @@ func discourseUserFromContext(ctx context.Context) (*rbdb.DiscourseUser, error)
 	}
 	return discourseUser.(*rbdb.DiscourseUser), nil
 }
-
-func isAdmin(ctx context.Context) bool {
-	discourseUser, err := discourseUserFromContext(ctx)
-	if err != nil {
-		return false
-	}
-
-	return discourseUser.Admin
-}
@@ -0,0 +1,30 @@
+package rbapi
+
+import (
+	"context"
+
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// productToPB converts a catalog product for the API
+func productToPB(ctx context.Context, productOrm *rbdb.ProductORM) (*rbdb.Product, error) {
+	productPb, err := productOrm.ToPB(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PRODUCT_PROTOBUF_CONVERSION.Wrap(err)
+	}
+	return &productPb, nil
+}
+
+// pricesToPB converts catalog prices for the API, with their product when loaded
+func pricesToPB(ctx context.Context, pricesOrm []*rbdb.PriceORM) ([]*rbdb.Price, error) {
+	prices := make([]*rbdb.Price, 0, len(pricesOrm))
+	for _, priceOrm := range pricesOrm {
+		pricePb, err := priceOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_PRICE_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		prices = append(prices, &pricePb)
+	}
+	return prices, nil
+}
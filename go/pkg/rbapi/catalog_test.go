@@ -0,0 +1,168 @@
+package rbapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestCatalog(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, _ := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	findPrice := func(prices []*rbdb.Price, duration rbdb.LicenseKey_Duration) *rbdb.Price {
+		for _, price := range prices {
+			if price.Duration == duration {
+				return price
+			}
+		}
+		return nil
+	}
+
+	t.Run("default catalog is seeded", func(t *testing.T) {
+		out, err := svc.PublicListPrices(ctx, &PublicListPrices_Input{})
+		require.NoError(t, err)
+		require.Len(t, out.Prices, 5)
+
+		month := findPrice(out.Prices, rbdb.LicenseKey_ONE_MONTH)
+		require.NotNil(t, month)
+		assert.Equal(t, int64(1900), month.AmountInCents)
+		assert.Equal(t, "eur", month.Currency)
+		assert.Equal(t, "EB2 - 1-Month License", month.DisplayName)
+		require.NotNil(t, month.Product)
+		assert.Equal(t, rbdb.LicenseKey_TIER_PREMIUM, month.Product.Tier)
+
+		out, err = svc.PublicListPrices(ctx, &PublicListPrices_Input{Currency: "USD"})
+		require.NoError(t, err)
+		assert.Empty(t, out.Prices)
+	})
+
+	t.Run("admin endpoints are restricted", func(t *testing.T) {
+		_, err := svc.AdminListCatalog(ctx, &AdminListCatalog_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminCreateProduct(ctx, &AdminCreateProduct_Input{Product: &rbdb.Product{Name: "X", Tier: rbdb.LicenseKey_TIER_REGULAR}})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminDeletePrice(ctx, &AdminDeletePrice_Input{Id: 1})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+	})
+
+	t.Run("admin manages products and prices", func(t *testing.T) {
+		_, err := svc.AdminCreateProduct(adminCtx, &AdminCreateProduct_Input{Product: &rbdb.Product{Name: "Regular"}})
+		assert.Equal(t, int32(errcode.ERR_CATALOG_PRODUCT_INVALID), errcode.Code(err))
+
+		product, err := svc.AdminCreateProduct(adminCtx, &AdminCreateProduct_Input{Product: &rbdb.Product{
+			Name:   "Regular",
+			Tier:   rbdb.LicenseKey_TIER_REGULAR,
+			Active: true,
+		}})
+		require.NoError(t, err)
+		require.NotZero(t, product.Product.Id)
+
+		_, err = svc.AdminCreatePrice(adminCtx, &AdminCreatePrice_Input{Price: &rbdb.Price{
+			ProductId:     product.Product.Id,
+			Duration:      rbdb.LicenseKey_ONE_MONTH,
+			Currency:      "euro",
+			AmountInCents: 500,
+			DisplayName:   "Regular - 1-Month License",
+		}})
+		assert.Equal(t, int32(errcode.ERR_CATALOG_PRICE_INVALID), errcode.Code(err))
+
+		price, err := svc.AdminCreatePrice(adminCtx, &AdminCreatePrice_Input{Price: &rbdb.Price{
+			ProductId:     product.Product.Id,
+			Duration:      rbdb.LicenseKey_ONE_MONTH,
+			Currency:      "EUR",
+			AmountInCents: 500,
+			DisplayName:   "Regular - 1-Month License",
+			Active:        true,
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, "eur", price.Price.Currency)
+		assert.Equal(t, "Regular", price.Price.Product.Name)
+
+		// Products with prices are deactivated instead of deleted
+		_, err = svc.AdminDeleteProduct(adminCtx, &AdminDeleteProduct_Input{Id: product.Product.Id})
+		assert.Equal(t, int32(errcode.ERR_CATALOG_PRODUCT_HAS_PRICES), errcode.Code(err))
+
+		product.Product.Active = false
+		_, err = svc.AdminUpdateProduct(adminCtx, &AdminUpdateProduct_Input{Product: product.Product})
+		require.NoError(t, err)
+		out, err := svc.PublicListPrices(ctx, &PublicListPrices_Input{})
+		require.NoError(t, err)
+		assert.Len(t, out.Prices, 5)
+
+		catalog, err := svc.AdminListCatalog(adminCtx, &AdminListCatalog_Input{})
+		require.NoError(t, err)
+		assert.Len(t, catalog.Products, 2)
+		assert.Len(t, catalog.Prices, 6)
+
+		_, err = svc.AdminDeletePrice(adminCtx, &AdminDeletePrice_Input{Id: price.Price.Id})
+		require.NoError(t, err)
+		_, err = svc.AdminDeletePrice(adminCtx, &AdminDeletePrice_Input{Id: price.Price.Id})
+		assert.Equal(t, int32(errcode.ERR_CATALOG_PRICE_NOT_FOUND), errcode.Code(err))
+		_, err = svc.AdminDeleteProduct(adminCtx, &AdminDeleteProduct_Input{Id: product.Product.Id})
+		require.NoError(t, err)
+	})
+
+	t.Run("limited offer overrides the checkout price", func(t *testing.T) {
+		catalog, err := svc.AdminListCatalog(adminCtx, &AdminListCatalog_Input{})
+		require.NoError(t, err)
+		regular := findPrice(catalog.Prices, rbdb.LicenseKey_ONE_MONTH)
+		require.NotNil(t, regular)
+
+		now := time.Now().UTC()
+		offer, err := svc.AdminCreatePrice(adminCtx, &AdminCreatePrice_Input{Price: &rbdb.Price{
+			ProductId:     regular.ProductId,
+			Duration:      rbdb.LicenseKey_ONE_MONTH,
+			Currency:      "eur",
+			AmountInCents: 1500,
+			DisplayName:   "EB2 - 1-Month License (Offer)",
+			Active:        true,
+			ValidFrom:     timestamppb.New(now.Add(-time.Hour)),
+			ValidUntil:    timestamppb.New(now.Add(time.Hour)),
+		}})
+		require.NoError(t, err)
+
+		out, err := svc.PublicListPrices(ctx, &PublicListPrices_Input{Currency: "eur"})
+		require.NoError(t, err)
+		require.Len(t, out.Prices, 5)
+		assert.Equal(t, offer.Price.Id, findPrice(out.Prices, rbdb.LicenseKey_ONE_MONTH).Id)
+
+		userCtx := TestingSetContextToken(ctx, t)
+		checkout, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+		orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, checkout.OrderId)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500), orderOrm.AmountInCents)
+
+		// Once the offer is over, the regular price applies again
+		offer.Price.ValidUntil = timestamppb.New(now.Add(-time.Minute))
+		_, err = svc.AdminUpdatePrice(adminCtx, &AdminUpdatePrice_Input{Price: offer.Price})
+		require.NoError(t, err)
+		checkout, err = svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+		orderOrm, err = rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, checkout.OrderId)
+		require.NoError(t, err)
+		assert.Equal(t, regular.AmountInCents, orderOrm.AmountInCents)
+
+		// Without an active price, the duration can't be bought
+		regular.Active = false
+		_, err = svc.AdminUpdatePrice(adminCtx, &AdminUpdatePrice_Input{Price: regular})
+		require.NoError(t, err)
+		_, err = svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_INVALID_DURATION_PRICING), errcode.Code(err))
+	})
+}
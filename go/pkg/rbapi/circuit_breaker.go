@@ -0,0 +1,70 @@
+package rbapi
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker fails fast once a dependency failed too many times in a row
+// After the cooldown a single trial call goes through, its success closes the breaker again
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openedAt  time.Time
+	trial     bool
+	now       func() time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow tells if a call can go through, every allowed call must report its outcome
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.threshold {
+		return true
+	}
+	if cb.trial || cb.now().Sub(cb.openedAt) < cb.cooldown {
+		return false
+	}
+	cb.trial = true
+	return true
+}
+
+// success closes the breaker
+func (cb *circuitBreaker) success() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures = 0
+	cb.trial = false
+}
+
+// abandon reports a call given up by its caller, it counts neither way and lets another trial through
+func (cb *circuitBreaker) abandon() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.trial = false
+}
+
+// failure counts a failed call, the breaker opens at the threshold and a failed trial opens it again
+func (cb *circuitBreaker) failure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures++
+	cb.trial = false
+	if cb.failures >= cb.threshold {
+		cb.openedAt = cb.now()
+	}
+}
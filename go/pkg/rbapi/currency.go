@@ -0,0 +1,85 @@
+package rbapi
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// defaultCurrency is the checkout currency when the buyer's currency has no price
+const defaultCurrency = "eur"
+
+// countryCurrencies maps ISO 3166 country codes to the currency buyers from the country pay in
+// Countries missing from the map pay in the default currency
+var countryCurrencies = map[string]string{
+	// Euro area
+	"AT": "eur", "BE": "eur", "CY": "eur", "DE": "eur", "EE": "eur", "ES": "eur", "FI": "eur",
+	"FR": "eur", "GR": "eur", "HR": "eur", "IE": "eur", "IT": "eur", "LT": "eur", "LU": "eur",
+	"LV": "eur", "MT": "eur", "NL": "eur", "PT": "eur", "SI": "eur", "SK": "eur",
+
+	"AU": "aud",
+	"BR": "brl",
+	"CA": "cad",
+	"CH": "chf",
+	"CZ": "czk",
+	"DK": "dkk",
+	"GB": "gbp",
+	"JP": "jpy",
+	"MX": "mxn",
+	"NO": "nok",
+	"NZ": "nzd",
+	"PL": "pln",
+	"SE": "sek",
+	"US": "usd",
+}
+
+// countryCurrency returns the currency of a country, empty for unknown countries
+func countryCurrency(countryCode string) string {
+	return countryCurrencies[strings.ToUpper(strings.TrimSpace(countryCode))]
+}
+
+// findCheckoutPrice returns the price of a license duration in the buyer's currency
+// An explicit currency must have a price, the currency of the country falls back to the default currency
+func findCheckoutPrice(db *gorm.DB, duration rbdb.LicenseKey_Duration, currency string, countryCode string, at time.Time) (*rbdb.PriceORM, error) {
+	if currency = strings.TrimSpace(currency); currency != "" {
+		price, err := rbdb.FindActivePrice(db, paidLicenseTier, duration, currency, at)
+		if errcode.Code(err) == int32(errcode.ERR_CATALOG_PRICE_NOT_FOUND) {
+			return nil, errcode.ERR_PAYMENT_CURRENCY_UNSUPPORTED.Wrap(err)
+		}
+		return price, err
+	}
+
+	if regional := countryCurrency(countryCode); regional != "" && regional != defaultCurrency {
+		price, err := rbdb.FindActivePrice(db, paidLicenseTier, duration, regional, at)
+		if errcode.Code(err) != int32(errcode.ERR_CATALOG_PRICE_NOT_FOUND) {
+			return price, err
+		}
+	}
+
+	price, err := rbdb.FindActivePrice(db, paidLicenseTier, duration, defaultCurrency, at)
+	if errcode.Code(err) == int32(errcode.ERR_CATALOG_PRICE_NOT_FOUND) {
+		return nil, errcode.ERR_PAYMENT_INVALID_DURATION_PRICING.Wrap(err)
+	}
+	return price, err
+}
+
+// formatAmount formats an amount in cents as a decimal string in the currency units, e.g. 1900 EUR as "19.00"
+func formatAmount(amountInCents int64, currency string) string {
+	exponent := rbdb.CurrencyExponent(currency)
+	return strconv.FormatFloat(float64(amountInCents)/math.Pow10(exponent), 'f', exponent, 64)
+}
+
+// parseAmount parses a decimal string in the currency units to cents, the inverse of formatAmount
+func parseAmount(value string, currency string) (int64, error) {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s amount %q: %w", currency, value, err)
+	}
+	return int64(math.Round(amount * math.Pow10(rbdb.CurrencyExponent(currency)))), nil
+}
@@ -0,0 +1,249 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestCurrencyAmounts(t *testing.T) {
+	for _, tc := range []struct {
+		currency      string
+		value         string
+		amountInCents int64
+	}{
+		{"eur", "19.00", 1900},
+		{"EUR", "0.29", 29},
+		{"usd", "129.99", 12999},
+		{"jpy", "3000", 3000},
+	} {
+		assert.Equal(t, tc.value, formatAmount(tc.amountInCents, tc.currency), tc.currency)
+		amountInCents, err := parseAmount(tc.value, tc.currency)
+		require.NoError(t, err)
+		assert.Equal(t, tc.amountInCents, amountInCents, tc.currency)
+	}
+
+	_, err := parseAmount("19,00", "eur")
+	assert.Error(t, err)
+
+	rates := map[string]float64{"usd": 1.25, "jpy": 160}
+	converted, ok := rbdb.ConvertToBaseCurrency(2500, "usd", rates)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2000), converted)
+	converted, ok = rbdb.ConvertToBaseCurrency(3200, "JPY", rates)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2000), converted)
+	converted, ok = rbdb.ConvertToBaseCurrency(1900, "eur", nil)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1900), converted)
+	_, ok = rbdb.ConvertToBaseCurrency(1900, "gbp", rates)
+	assert.False(t, ok)
+}
+
+func TestRegionalPricing(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	// Price the monthly license in dollars and yen
+	catalog, err := svc.AdminListCatalog(adminCtx, &AdminListCatalog_Input{})
+	require.NoError(t, err)
+	require.NotEmpty(t, catalog.Products)
+	productId := catalog.Products[0].Id
+	for currency, amountInCents := range map[string]int64{"usd": 2099, "jpy": 3000} {
+		_, err := svc.AdminCreatePrice(adminCtx, &AdminCreatePrice_Input{Price: &rbdb.Price{
+			ProductId:     productId,
+			Duration:      rbdb.LicenseKey_ONE_MONTH,
+			Currency:      currency,
+			AmountInCents: amountInCents,
+			DisplayName:   "EB2 - 1-Month License",
+			Active:        true,
+		}})
+		require.NoError(t, err)
+	}
+
+	t.Run("pricing page uses the country currency", func(t *testing.T) {
+		out, err := svc.PublicListPrices(ctx, &PublicListPrices_Input{CountryCode: "us"})
+		require.NoError(t, err)
+		require.Len(t, out.Prices, 1)
+		assert.Equal(t, "usd", out.Prices[0].Currency)
+		assert.Equal(t, int64(2099), out.Prices[0].AmountInCents)
+
+		// Countries without prices in their currency see the default prices
+		out, err = svc.PublicListPrices(ctx, &PublicListPrices_Input{CountryCode: "BR"})
+		require.NoError(t, err)
+		require.Len(t, out.Prices, 5)
+		assert.Equal(t, "eur", out.Prices[0].Currency)
+
+		// An explicit currency wins over the country
+		out, err = svc.PublicListPrices(ctx, &PublicListPrices_Input{Currency: "jpy", CountryCode: "US"})
+		require.NoError(t, err)
+		require.Len(t, out.Prices, 1)
+		assert.Equal(t, "jpy", out.Prices[0].Currency)
+	})
+
+	userCtx := TestingSetContextToken(ctx, t)
+	deliver := func(ctx context.Context, event paypal.AnyEvent) error {
+		return processPayPalWebhookEvent(ctx, event, db, logger, svc.Config(), fake)
+	}
+	loadOrder := func(t *testing.T, orderID string) *rbdb.CheckoutOrderORM {
+		t.Helper()
+		orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, orderID)
+		require.NoError(t, err)
+		return orderOrm
+	}
+
+	t.Run("checkout resolves the currency", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+			CountryCode:     "JP",
+		})
+		require.NoError(t, err)
+		orderOrm := loadOrder(t, out.OrderId)
+		assert.Equal(t, "jpy", orderOrm.Currency)
+		assert.Equal(t, int64(3000), orderOrm.AmountInCents)
+		fake.mu.Lock()
+		request := fake.orders[out.OrderId].request
+		fake.mu.Unlock()
+		assert.Equal(t, "jpy", request.Currency)
+		assert.Equal(t, int64(3000), request.AmountInCents)
+
+		// The yearly license has no yen price, the country falls back to the default currency
+		out, err = svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_YEAR,
+			CountryCode:     "JP",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "eur", loadOrder(t, out.OrderId).Currency)
+
+		// Explicit currencies must have a price
+		_, err = svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_YEAR,
+			Currency:        "usd",
+		})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_CURRENCY_UNSUPPORTED), errcode.Code(err))
+	})
+
+	t.Run("payment is stored in the charged currency", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+			Currency:        "USD",
+		})
+		require.NoError(t, err)
+		_, err = fake.Approve(out.OrderId)
+		require.NoError(t, err)
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, loadOrder(t, out.OrderId).CaptureId)
+		require.NoError(t, err)
+		assert.Equal(t, "usd", paymentOrm.Currency)
+		assert.Equal(t, int64(2099), paymentOrm.AmountInCents)
+	})
+
+	t.Run("revenue is normalized to the base currency", func(t *testing.T) {
+		_, err := svc.AdminSetExchangeRate(ctx, &AdminSetExchangeRate_Input{Currency: "usd", Rate: 1.1})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminSetExchangeRate(adminCtx, &AdminSetExchangeRate_Input{Currency: "eur", Rate: 1})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_EXCHANGE_RATE_INVALID), errcode.Code(err))
+		_, err = svc.AdminSetExchangeRate(adminCtx, &AdminSetExchangeRate_Input{Currency: "usd", Rate: 0})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_EXCHANGE_RATE_INVALID), errcode.Code(err))
+
+		// A yen payment without exchange rate
+		user, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 4242, Username: "yen_buyer"}, db)
+		require.NoError(t, err)
+		require.NoError(t, fulfillLicensePayment(ctx, db, logger, svc.Config(), &rbdb.Payment{
+			Provider:      rbdb.Payment_PROVIDER_PAYPAL,
+			ReferenceId:   "CAP-yen",
+			AmountInCents: 3000,
+			Currency:      "jpy",
+			SandboxMode:   true,
+		}, map[string]string{
+			"user_id":  fmt.Sprintf("%d", user.Id),
+			"duration": rbdb.LicenseKey_ONE_MONTH.String(),
+		}))
+
+		revenue, err := svc.AdminGetRevenue(adminCtx, &AdminGetRevenue_Input{IncludeSandbox: true})
+		require.NoError(t, err)
+		assert.Equal(t, "eur", revenue.BaseCurrency)
+		assert.ElementsMatch(t, []string{"jpy", "usd"}, revenue.MissingRates)
+		eurNetInCents := int64(0)
+		for _, total := range revenue.Totals {
+			if total.Currency == "eur" {
+				eurNetInCents += total.NetInCents
+				assert.Equal(t, total.NetInCents, total.NetInBaseCents)
+			}
+		}
+		assert.Equal(t, eurNetInCents, revenue.NetInBaseCents)
+
+		rate, err := svc.AdminSetExchangeRate(adminCtx, &AdminSetExchangeRate_Input{Currency: "USD", Rate: 1.1})
+		require.NoError(t, err)
+		assert.Equal(t, "usd", rate.Rate.Currency)
+		_, err = svc.AdminSetExchangeRate(adminCtx, &AdminSetExchangeRate_Input{Currency: "jpy", Rate: 150})
+		require.NoError(t, err)
+		_, err = svc.AdminSetExchangeRate(adminCtx, &AdminSetExchangeRate_Input{Currency: "usd", Rate: 1.05})
+		require.NoError(t, err)
+
+		rates, err := svc.AdminListExchangeRates(adminCtx, &AdminListExchangeRates_Input{})
+		require.NoError(t, err)
+		require.Len(t, rates.Rates, 2)
+		assert.Equal(t, "jpy", rates.Rates[0].Currency)
+		assert.Equal(t, 1.05, rates.Rates[1].Rate)
+
+		revenue, err = svc.AdminGetRevenue(adminCtx, &AdminGetRevenue_Input{IncludeSandbox: true})
+		require.NoError(t, err)
+		assert.Empty(t, revenue.MissingRates)
+		byCurrency := map[string]*AdminGetRevenue_Output_Total{}
+		for _, total := range revenue.Totals {
+			byCurrency[total.Currency] = total
+		}
+		require.Contains(t, byCurrency, "usd")
+		require.Contains(t, byCurrency, "jpy")
+		assert.Equal(t, int64(2099), byCurrency["usd"].NetInCents)
+		assert.Equal(t, int64(1999), byCurrency["usd"].NetInBaseCents)
+		assert.Equal(t, int64(2000), byCurrency["jpy"].NetInBaseCents)
+		assert.Equal(t, eurNetInCents+1999+2000, revenue.NetInBaseCents)
+
+		// Payments are stored in lower case whatever the provider sends, older upper-case rows share the bucket
+		require.NoError(t, fulfillLicensePayment(ctx, db, logger, svc.Config(), &rbdb.Payment{
+			Provider:      rbdb.Payment_PROVIDER_STRIPE,
+			ReferenceId:   "cs_upper",
+			AmountInCents: 1000,
+			Currency:      "USD",
+			SandboxMode:   true,
+		}, map[string]string{
+			"user_id":  fmt.Sprintf("%d", user.Id),
+			"duration": rbdb.LicenseKey_ONE_MONTH.String(),
+		}))
+		paymentOrm, err := rbdb.GetPaymentByReference(db, "cs_upper")
+		require.NoError(t, err)
+		assert.Equal(t, "usd", paymentOrm.Currency)
+		require.NoError(t, db.Create(&rbdb.PaymentORM{
+			Provider:      int32(rbdb.Payment_PROVIDER_STRIPE),
+			Status:        int32(rbdb.Payment_STATUS_COMPLETED),
+			ReferenceId:   "cs_legacy",
+			AmountInCents: 500,
+			Currency:      "USD",
+			SandboxMode:   true,
+			UserId:        user.Id,
+		}).Error)
+
+		revenue, err = svc.AdminGetRevenue(adminCtx, &AdminGetRevenue_Input{IncludeSandbox: true})
+		require.NoError(t, err)
+		stripeUsd := 0
+		for _, total := range revenue.Totals {
+			assert.NotEqual(t, "USD", total.Currency)
+			if total.Provider == rbdb.Payment_PROVIDER_STRIPE && total.Currency == "usd" {
+				stripeUsd++
+				assert.Equal(t, int64(1500), total.NetInCents)
+			}
+		}
+		assert.Equal(t, 1, stripeUsd)
+	})
+}
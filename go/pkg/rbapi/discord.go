@@ -7,26 +7,17 @@ import (
 	"io"
 	"net/http"
 
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/errcode"
 )
 
-// Discord configuration
-var (
-	DiscordBotToken string // Set via command-line flag
-)
-
-// Hardcoded Discord configuration
-const (
-	DiscordGuildID        = "1185938052389019769"
-	DiscordLifetimeRoleID = "1185971497802682589"
-	DiscordAPIBaseURL     = "https://discord.com/api/v10"
-)
+const DiscordAPIBaseURL = "https://discord.com/api/v10"
 
 // GetDiscordIDFromDiscourse fetches the Discord ID from Discourse user profile
-func GetDiscordIDFromDiscourse(ctx context.Context, discourseUserID int64) (string, error) {
+func GetDiscordIDFromDiscourse(ctx context.Context, cfg config.Discourse, discourseUserID int64) (string, error) {
 	// Construct the API URL to get user details with associated accounts
 	// Using the admin endpoint to get full user details including associated accounts
-	url := fmt.Sprintf("%s/admin/users/%d.json", DiscoursePath, discourseUserID)
+	url := fmt.Sprintf("%s/admin/users/%d.json", cfg.URL, discourseUserID)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -34,8 +25,8 @@ func GetDiscordIDFromDiscourse(ctx context.Context, discourseUserID int64) (stri
 	}
 
 	// Set API headers
-	req.Header.Set("Api-Key", DiscourseAPIKey)
-	req.Header.Set("Api-Username", DiscourseAPIUsername)
+	req.Header.Set("Api-Key", cfg.APIKey)
+	req.Header.Set("Api-Username", cfg.APIUsername)
 
 	client := &http.Client{}
 	resp, err := client.Do(req)
@@ -80,20 +71,20 @@ func GetDiscordIDFromDiscourse(ctx context.Context, discourseUserID int64) (stri
 }
 
 // AssignDiscordRole assigns the lifetime role to a Discord user
-func AssignDiscordRole(ctx context.Context, discordUserID string) error {
-	if DiscordBotToken == "" {
+func AssignDiscordRole(ctx context.Context, cfg config.Discord, discordUserID string) error {
+	if cfg.BotToken == "" {
 		return errcode.ERR_DISCORD_CONFIG_MISSING.Wrap(
 			fmt.Errorf("Discord bot token not configured"))
 	}
 
-	if DiscordGuildID == "" || DiscordLifetimeRoleID == "" {
+	if cfg.GuildID == "" || cfg.LifetimeRoleID == "" {
 		return errcode.ERR_DISCORD_CONFIG_MISSING.Wrap(
 			fmt.Errorf("Discord guild ID or role ID not configured"))
 	}
 
 	// Discord API URL to add role to guild member
 	url := fmt.Sprintf("%s/guilds/%s/members/%s/roles/%s",
-		DiscordAPIBaseURL, DiscordGuildID, discordUserID, DiscordLifetimeRoleID)
+		DiscordAPIBaseURL, cfg.GuildID, discordUserID, cfg.LifetimeRoleID)
 
 	req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
 	if err != nil {
@@ -101,7 +92,7 @@ func AssignDiscordRole(ctx context.Context, discordUserID string) error {
 	}
 
 	// Set Discord bot authorization
-	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", DiscordBotToken))
+	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", cfg.BotToken))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Audit-Log-Reason", "Lifetime license verification")
 
@@ -130,20 +121,20 @@ func AssignDiscordRole(ctx context.Context, discordUserID string) error {
 }
 
 // RemoveDiscordRole removes the lifetime role from a Discord user (for revoked licenses)
-func RemoveDiscordRole(ctx context.Context, discordUserID string) error {
-	if DiscordBotToken == "" {
+func RemoveDiscordRole(ctx context.Context, cfg config.Discord, discordUserID string) error {
+	if cfg.BotToken == "" {
 		return errcode.ERR_DISCORD_CONFIG_MISSING.Wrap(
 			fmt.Errorf("Discord bot token not configured"))
 	}
 
-	if DiscordGuildID == "" || DiscordLifetimeRoleID == "" {
+	if cfg.GuildID == "" || cfg.LifetimeRoleID == "" {
 		return errcode.ERR_DISCORD_CONFIG_MISSING.Wrap(
 			fmt.Errorf("Discord guild ID or role ID not configured"))
 	}
 
 	// Discord API URL to remove role from guild member
 	url := fmt.Sprintf("%s/guilds/%s/members/%s/roles/%s",
-		DiscordAPIBaseURL, DiscordGuildID, discordUserID, DiscordLifetimeRoleID)
+		DiscordAPIBaseURL, cfg.GuildID, discordUserID, cfg.LifetimeRoleID)
 
 	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
@@ -151,7 +142,7 @@ func RemoveDiscordRole(ctx context.Context, discordUserID string) error {
 	}
 
 	// Set Discord bot authorization
-	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", DiscordBotToken))
+	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", cfg.BotToken))
 	req.Header.Set("X-Audit-Log-Reason", "License revoked")
 
 	client := &http.Client{}
@@ -179,14 +170,14 @@ func RemoveDiscordRole(ctx context.Context, discordUserID string) error {
 }
 
 // CheckDiscordMembership checks if a Discord user is in the guild
-func CheckDiscordMembership(ctx context.Context, discordUserID string) (bool, error) {
-	if DiscordBotToken == "" || DiscordGuildID == "" {
+func CheckDiscordMembership(ctx context.Context, cfg config.Discord, discordUserID string) (bool, error) {
+	if cfg.BotToken == "" || cfg.GuildID == "" {
 		return false, errcode.ERR_DISCORD_CONFIG_MISSING
 	}
 
 	// Discord API URL to get guild member
 	url := fmt.Sprintf("%s/guilds/%s/members/%s",
-		DiscordAPIBaseURL, DiscordGuildID, discordUserID)
+		DiscordAPIBaseURL, cfg.GuildID, discordUserID)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -194,7 +185,7 @@ func CheckDiscordMembership(ctx context.Context, discordUserID string) (bool, er
 	}
 
 	// Set Discord bot authorization
-	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", DiscordBotToken))
+	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", cfg.BotToken))
 
 	client := &http.Client{}
 	resp, err := client.Do(req)
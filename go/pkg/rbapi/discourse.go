@@ -13,29 +13,42 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/errcode"
 	"rslbot.com/go/pkg/rbdb"
 )
 
-// TODO: add to environment
-const (
-	DiscourseAPIKey      = "8cd0974ce97eede11aa99f9b900cf607dae41f51cf11b0dcee7e4cdade362e26"
-	DiscourseAPIUsername = "Fernandel"
-	DiscoursePath        = "https://community.rslbot.com"
-)
+// SSOResponse is a verified SSO payload returned by Discourse
+type SSOResponse struct {
+	User      *rbdb.DiscourseUser
+	Nonce     string
+	ReturnURL string
+}
 
 // VerifySSO verifies that the SSO payload was signed by our Discourse instance
 func VerifySSO(sso, sig, secret string) (*rbdb.DiscourseUser, error) {
-	// Verify signature first
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write([]byte(sso))
-	expectedSig := hex.EncodeToString(mac.Sum(nil))
+	response, err := ParseSSO(sso, sig, secret)
+	if err != nil {
+		return nil, err
+	}
+	return response.User, nil
+}
 
-	if !hmac.Equal([]byte(sig), []byte(expectedSig)) {
+// ParseSSO verifies the SSO payload like VerifySSO and also returns the nonce and return URL of the request
+// It doesn't check the nonce, AuthExchangeSSO consumes it
+func ParseSSO(sso, sig, secret string) (*SSOResponse, error) {
+	// Verify signature first
+	if !hmac.Equal([]byte(sig), []byte(signSSOPayload(sso, secret))) {
 		return nil, errcode.ERR_AUTH_INVALID_SSO_SIGNATURE
 	}
+	return DecodeSSO(sso)
+}
 
+// DecodeSSO reads an SSO payload without verifying its signature
+// Only the backend knows the SSO secret, clients use it to display the user before exchanging the payload
+func DecodeSSO(sso string) (*SSOResponse, error) {
 	// Decode payload
 	decodedBytes, err := base64.StdEncoding.DecodeString(sso)
 	if err != nil {
@@ -72,26 +85,53 @@ func VerifySSO(sso, sig, secret string) (*rbdb.DiscourseUser, error) {
 		return nil, errcode.ERR_AUTH_MISSING_SSO_USER_INFO
 	}
 
-	return userInfo, nil
+	return &SSOResponse{
+		User:      userInfo,
+		Nonce:     params.Get("nonce"),
+		ReturnURL: params.Get("return_sso_url"),
+	}, nil
+}
+
+// SignSSORequest builds the Discourse login URL of an SSO request
+func SignSSORequest(cfg config.Discourse, nonce, returnURL string) string {
+	params := url.Values{}
+	params.Set("nonce", nonce)
+	params.Set("return_sso_url", returnURL)
+	payload := base64.StdEncoding.EncodeToString([]byte(params.Encode()))
+	sig := signSSOPayload(payload, cfg.SSOSecret)
+
+	return fmt.Sprintf("%s/session/sso_provider?sso=%s&sig=%s", cfg.URL, url.QueryEscape(payload), sig)
+}
+
+// signSSOPayload returns the hex HMAC of an SSO payload, both ways use it
+func signSSOPayload(payload, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
-// VerifyTokenAndGetUser verifies a Discourse API token
-func VerifyTokenAndGetUser(ctx context.Context, tokenString string) (*rbdb.DiscourseUser, error) {
+// VerifyTokenAndGetUser verifies a Discourse API token, within the timeout of cfg
+func VerifyTokenAndGetUser(ctx context.Context, cfg config.Discourse, tokenString string) (*rbdb.DiscourseUser, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET",
-		fmt.Sprintf("%s/session/current.json", DiscoursePath), nil)
+		fmt.Sprintf("%s/session/current.json", cfg.URL), nil)
 	if err != nil {
 		return nil, errcode.ERR_AUTH_DISCOURSE_REQUEST_ERROR.Wrap(err)
 	}
 
 	req.Header.Set("User-Api-Key", tokenString)
 
-	client := &http.Client{}
+	client := &http.Client{Timeout: time.Duration(cfg.Timeout)}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, errcode.ERR_AUTH_DISCOURSE_API_ERROR.Wrap(err)
 	}
 	defer resp.Body.Close()
 
+	// Discourse rejects unknown and revoked keys with 401 or 403, other client errors like 429 are upstream errors
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(
+			fmt.Errorf("discourse status %d", resp.StatusCode))
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, errcode.ERR_AUTH_DISCOURSE_RESPONSE_ERROR.Wrap(
 			fmt.Errorf("status %d", resp.StatusCode))
@@ -120,10 +160,42 @@ func VerifyTokenAndGetUser(ctx context.Context, tokenString string) (*rbdb.Disco
 	}, nil
 }
 
+// GetDiscourseUser loads the current admin flag and groups of a forum user from the admin API
+// The admin API doesn't return the email, it is left empty; a deleted user gives ERR_DISCOURSE_NOT_FOUND
+func GetDiscourseUser(ctx context.Context, cfg config.Discourse, externalID int64) (*rbdb.DiscourseUser, error) {
+	var userResp struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+		Admin    bool   `json:"admin"`
+		Groups   []struct {
+			Name string `json:"name"`
+		} `json:"groups"`
+	}
+
+	path := fmt.Sprintf("/admin/users/%d.json", externalID)
+	if err := discourseAdminRequest(ctx, cfg, "GET", path, nil, &userResp); err != nil {
+		return nil, err
+	}
+	if userResp.ID != externalID {
+		return nil, errcode.ERR_DISCOURSE_RESPONSE_PARSE.Wrap(fmt.Errorf("asked user %d, got %d", externalID, userResp.ID))
+	}
+
+	groups := []string{}
+	for _, group := range userResp.Groups {
+		groups = append(groups, group.Name)
+	}
+	return &rbdb.DiscourseUser{
+		ExternalId: userResp.ID,
+		Username:   userResp.Username,
+		Groups:     groups,
+		Admin:      userResp.Admin,
+	}, nil
+}
+
 // LogoutUser logs a user out from Discourse by their externalID
-func LogoutUserFromDiscourse(ctx context.Context, externalID int64) error {
+func LogoutUserFromDiscourse(ctx context.Context, cfg config.Discourse, externalID int64) error {
 	// Create the request URL
-	logoutURL := fmt.Sprintf("%s/admin/users/%d/log_out", DiscoursePath, externalID)
+	logoutURL := fmt.Sprintf("%s/admin/users/%d/log_out", cfg.URL, externalID)
 
 	// Create a new HTTP request - Discourse doesn't need form fields for this endpoint
 	req, err := http.NewRequestWithContext(ctx, "POST", logoutURL, nil)
@@ -133,8 +205,8 @@ func LogoutUserFromDiscourse(ctx context.Context, externalID int64) error {
 
 	// Set required headers - use simple Content-Type as in the curl example
 	req.Header.Set("Content-Type", "multipart/form-data")
-	req.Header.Set("Api-Key", DiscourseAPIKey)
-	req.Header.Set("Api-Username", DiscourseAPIUsername)
+	req.Header.Set("Api-Key", cfg.APIKey)
+	req.Header.Set("Api-Username", cfg.APIUsername)
 
 	// Send the request
 	client := &http.Client{}
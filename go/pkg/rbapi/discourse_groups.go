@@ -0,0 +1,339 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const (
+	discourseGroupMembersPageSize = 50
+	discourseGroupSyncTimeout     = 5 * time.Minute
+	discourseUserGroupSyncTimeout = time.Minute
+)
+
+// discourseAdminTimeout bounds each Discourse admin API request
+var discourseAdminTimeout = 10 * time.Second
+
+// userGroupSyncs tracks the background syncs started by syncUserDiscourseGroup
+var userGroupSyncs sync.WaitGroup
+
+// DiscourseGroupMember is a member entry returned by the Discourse group members endpoint
+type DiscourseGroupMember struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// DiscourseGroupSyncReport describes the changes applied (or planned, in dry-run mode) by a group sync
+type DiscourseGroupSyncReport struct {
+	Group     string
+	Added     []string
+	Removed   []string
+	Unchanged int
+	DryRun    bool
+}
+
+// discourseAdminRequest sends an authenticated request to the Discourse admin API and decodes the JSON response
+func discourseAdminRequest(ctx context.Context, cfg config.Discourse, method string, path string, form url.Values, out interface{}) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, cfg.URL+path, body)
+	if err != nil {
+		return errcode.ERR_DISCOURSE_REQUEST_CREATE.Wrap(err)
+	}
+
+	// Set API headers
+	req.Header.Set("Api-Key", cfg.APIKey)
+	req.Header.Set("Api-Username", cfg.APIUsername)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	client := &http.Client{Timeout: discourseAdminTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errcode.ERR_DISCOURSE_API_REQUEST.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errcode.ERR_DISCOURSE_RESPONSE_PARSE.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errcode.ERR_DISCOURSE_NOT_FOUND.Wrap(fmt.Errorf("%s %s", method, path))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errcode.ERR_DISCOURSE_API_RESPONSE.Wrap(
+			fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return errcode.ERR_DISCOURSE_RESPONSE_PARSE.Wrap(err)
+	}
+	return nil
+}
+
+// discourseGroupError reports a missing resource of a group request as a missing group
+func discourseGroupError(err error) error {
+	if errcode.Code(err) == int32(errcode.ERR_DISCOURSE_NOT_FOUND) {
+		return errcode.ERR_DISCOURSE_GROUP_NOT_FOUND.Wrap(err)
+	}
+	return err
+}
+
+// GetDiscourseGroupID resolves the numeric ID of a Discourse group from its name
+func GetDiscourseGroupID(ctx context.Context, cfg config.Discourse, groupName string) (int64, error) {
+	var groupResp struct {
+		Group struct {
+			ID int64 `json:"id"`
+		} `json:"group"`
+	}
+
+	path := fmt.Sprintf("/groups/%s.json", url.PathEscape(groupName))
+	if err := discourseAdminRequest(ctx, cfg, "GET", path, nil, &groupResp); err != nil {
+		return 0, discourseGroupError(err)
+	}
+	if groupResp.Group.ID == 0 {
+		return 0, errcode.ERR_DISCOURSE_GROUP_NOT_FOUND.Wrap(fmt.Errorf("group %q", groupName))
+	}
+
+	return groupResp.Group.ID, nil
+}
+
+// GetDiscourseGroupMembers lists every member of a Discourse group, following pagination
+func GetDiscourseGroupMembers(ctx context.Context, cfg config.Discourse, groupName string) ([]DiscourseGroupMember, error) {
+	members := []DiscourseGroupMember{}
+	for offset := 0; ; offset += discourseGroupMembersPageSize {
+		var membersResp struct {
+			Members []DiscourseGroupMember `json:"members"`
+			Meta    struct {
+				Total int `json:"total"`
+			} `json:"meta"`
+		}
+
+		path := fmt.Sprintf("/groups/%s/members.json?limit=%d&offset=%d",
+			url.PathEscape(groupName), discourseGroupMembersPageSize, offset)
+		if err := discourseAdminRequest(ctx, cfg, "GET", path, nil, &membersResp); err != nil {
+			return nil, discourseGroupError(err)
+		}
+
+		members = append(members, membersResp.Members...)
+		if len(membersResp.Members) < discourseGroupMembersPageSize || len(members) >= membersResp.Meta.Total {
+			break
+		}
+	}
+
+	return members, nil
+}
+
+// AddDiscourseGroupMembers adds users to a Discourse group by username
+func AddDiscourseGroupMembers(ctx context.Context, cfg config.Discourse, groupID int64, usernames []string) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	form := url.Values{}
+	form.Set("usernames", strings.Join(usernames, ","))
+	path := fmt.Sprintf("/groups/%d/members.json", groupID)
+	if err := discourseAdminRequest(ctx, cfg, "PUT", path, form, nil); err != nil {
+		return errcode.ERR_DISCOURSE_GROUP_UPDATE.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveDiscourseGroupMembers removes users from a Discourse group by username
+func RemoveDiscourseGroupMembers(ctx context.Context, cfg config.Discourse, groupID int64, usernames []string) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	form := url.Values{}
+	form.Set("usernames", strings.Join(usernames, ","))
+	path := fmt.Sprintf("/groups/%d/members.json", groupID)
+	if err := discourseAdminRequest(ctx, cfg, "DELETE", path, form, nil); err != nil {
+		return errcode.ERR_DISCOURSE_GROUP_UPDATE.Wrap(err)
+	}
+	return nil
+}
+
+// planDiscourseGroupSync compares the current group members with the license holders
+// and returns the usernames to add and to remove, plus the number of members left untouched
+func planDiscourseGroupSync(members []DiscourseGroupMember, holders []*rbdb.UserORM) ([]string, []string, int) {
+	holdersByDiscourseID := make(map[int64]*rbdb.UserORM, len(holders))
+	for _, holder := range holders {
+		holdersByDiscourseID[holder.DiscourseId] = holder
+	}
+
+	toRemove := []string{}
+	memberIDs := make(map[int64]bool, len(members))
+	for _, member := range members {
+		memberIDs[member.ID] = true
+		if _, ok := holdersByDiscourseID[member.ID]; !ok {
+			toRemove = append(toRemove, member.Username)
+		}
+	}
+
+	toAdd := []string{}
+	for _, holder := range holders {
+		// Users without a known username can't be added through the API
+		if memberIDs[holder.DiscourseId] || holder.Username == "" {
+			continue
+		}
+		toAdd = append(toAdd, holder.Username)
+	}
+
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	return toAdd, toRemove, len(members) - len(toRemove)
+}
+
+// SyncDiscourseGroup reconciles the license holders group with the active licenses in database
+// The group is fully managed by the backend: members without an active license are removed
+func SyncDiscourseGroup(ctx context.Context, db *gorm.DB, cfg config.Discourse, dryRun bool) (*DiscourseGroupSyncReport, error) {
+	if cfg.LicenseGroup == "" {
+		return nil, errcode.ERR_DISCOURSE_GROUP_NOT_CONFIGURED
+	}
+
+	holders, err := rbdb.UsersWithActiveLicense(db)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := GetDiscourseGroupMembers(ctx, cfg, cfg.LicenseGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	toAdd, toRemove, unchanged := planDiscourseGroupSync(members, holders)
+	report := &DiscourseGroupSyncReport{
+		Group:     cfg.LicenseGroup,
+		Added:     toAdd,
+		Removed:   toRemove,
+		Unchanged: unchanged,
+		DryRun:    dryRun,
+	}
+
+	if dryRun || (len(toAdd) == 0 && len(toRemove) == 0) {
+		return report, nil
+	}
+
+	groupID, err := GetDiscourseGroupID(ctx, cfg, cfg.LicenseGroup)
+	if err != nil {
+		return nil, err
+	}
+	if err := AddDiscourseGroupMembers(ctx, cfg, groupID, toAdd); err != nil {
+		return nil, err
+	}
+	if err := RemoveDiscourseGroupMembers(ctx, cfg, groupID, toRemove); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// syncUserDiscourseGroup adds or removes a single user from the license holders group
+// after a license change. It runs in the background so a slow Discourse never holds a license
+// delivery or a webhook acknowledgement. Failures are only logged, the periodic sweep will fix any drift.
+func syncUserDiscourseGroup(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg config.Discourse, userId int64) {
+	if cfg.LicenseGroup == "" {
+		return
+	}
+
+	// The request context ends with the RPC or the webhook, the sync outlives it
+	syncCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), discourseUserGroupSyncTimeout)
+	userGroupSyncs.Add(1)
+	go func() {
+		defer userGroupSyncs.Done()
+		defer cancel()
+		syncUserDiscourseGroupNow(syncCtx, db, logger, cfg, userId)
+	}()
+}
+
+// syncUserDiscourseGroupNow is the blocking part of syncUserDiscourseGroup
+func syncUserDiscourseGroupNow(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg config.Discourse, userId int64) {
+	var userOrm rbdb.UserORM
+	if err := db.Where(&rbdb.UserORM{Id: userId}).First(&userOrm).Error; err != nil {
+		logger.Warn("Discourse group sync: failed to load user", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+	if userOrm.Username == "" {
+		return
+	}
+
+	hasActiveLicense, err := rbdb.UserHasActiveLicense(db, userId)
+	if err != nil {
+		logger.Warn("Discourse group sync: failed to check licenses", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+
+	groupID, err := GetDiscourseGroupID(ctx, cfg, cfg.LicenseGroup)
+	if err != nil {
+		logger.Warn("Discourse group sync: failed to resolve group", zap.Error(err), zap.String("group", cfg.LicenseGroup))
+		return
+	}
+
+	if hasActiveLicense {
+		err = AddDiscourseGroupMembers(ctx, cfg, groupID, []string{userOrm.Username})
+	} else {
+		err = RemoveDiscourseGroupMembers(ctx, cfg, groupID, []string{userOrm.Username})
+	}
+	if err != nil {
+		// Discourse answers 422 when adding a user that is already a member
+		logger.Warn("Discourse group sync: failed to update membership",
+			zap.Error(err),
+			zap.String("username", userOrm.Username),
+			zap.Bool("has_active_license", hasActiveLicense))
+		return
+	}
+
+	logger.Info("Discourse group membership synced",
+		zap.String("username", userOrm.Username),
+		zap.Bool("member", hasActiveLicense))
+}
+
+// runDiscourseGroupSyncLoop periodically reconciles the license holders group until the context is done
+func runDiscourseGroupSyncLoop(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg config.Discourse, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			syncCtx, cancel := context.WithTimeout(ctx, discourseGroupSyncTimeout)
+			report, err := SyncDiscourseGroup(syncCtx, db, cfg, false)
+			cancel()
+			if err != nil {
+				logger.Error("Discourse group sweep failed", zap.Error(err))
+				continue
+			}
+			logger.Info("Discourse group sweep done",
+				zap.String("group", report.Group),
+				zap.Strings("added", report.Added),
+				zap.Strings("removed", report.Removed),
+				zap.Int("unchanged", report.Unchanged))
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// fakeDiscourseGroup emulates the group endpoints of the Discourse admin API
+type fakeDiscourseGroup struct {
+	mu      sync.Mutex
+	members []DiscourseGroupMember
+	added   []string
+	removed []string
+}
+
+func (f *fakeDiscourseGroup) handler(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /groups/premium.json", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"group": map[string]interface{}{"id": 42, "name": "premium"},
+		})
+	})
+	mux.HandleFunc("GET /groups/premium/members.json", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"members": f.members,
+			"meta":    map[string]interface{}{"total": len(f.members)},
+		})
+	})
+	mux.HandleFunc("/groups/42/members.json", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		form, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		switch r.Method {
+		case "PUT":
+			f.added = append(f.added, form.Get("usernames"))
+		case "DELETE":
+			f.removed = append(f.removed, form.Get("usernames"))
+		}
+		_, _ = w.Write([]byte(`{"success":"OK"}`))
+	})
+	return mux
+}
+
+func TestSyncDiscourseGroup(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{})
+	defer cleanup()
+	db := TestingSvcDB(t, svc)
+
+	users := CreateTestUsersSet(t, svc)
+	defaultUser := rbdb.TestingGetUser(t, db, 7)
+
+	fake := &fakeDiscourseGroup{
+		members: []DiscourseGroupMember{
+			{ID: users["active"].DiscourseID, Username: users["active"].Username},
+			{ID: users["expired"].DiscourseID, Username: users["expired"].Username},
+			{ID: users["revoked"].DiscourseID, Username: users["revoked"].Username},
+		},
+	}
+	discourse := httptest.NewServer(fake.handler(t))
+	defer discourse.Close()
+
+	svc.Config().Discourse.URL = discourse.URL
+
+	ctx := context.Background()
+	cfg := svc.Config().Discourse
+	cfg.LicenseGroup = "premium"
+
+	t.Run("dry run only plans changes", func(t *testing.T) {
+		report, err := SyncDiscourseGroup(ctx, db, cfg, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		// The default test user has no username, so it can't be added
+		assert.NotContains(t, report.Added, defaultUser.Username)
+		assert.ElementsMatch(t, []string{users["lifetime"].Username}, report.Added)
+		assert.ElementsMatch(t, []string{users["expired"].Username, users["revoked"].Username}, report.Removed)
+		assert.Equal(t, 1, report.Unchanged)
+		assert.Empty(t, fake.added)
+		assert.Empty(t, fake.removed)
+	})
+
+	t.Run("sync applies changes", func(t *testing.T) {
+		report, err := SyncDiscourseGroup(ctx, db, cfg, false)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, []string{users["lifetime"].Username}, fake.added)
+		assert.Equal(t, []string{users["expired"].Username + "," + users["revoked"].Username}, fake.removed)
+	})
+
+	t.Run("sync requires a configured group", func(t *testing.T) {
+		_, err := SyncDiscourseGroup(ctx, db, svc.Config().Discourse, true)
+		require.Error(t, err)
+	})
+}
+
+func TestSyncUserDiscourseGroupDoesNotBlock(t *testing.T) {
+	svc, cleanup := TestingService(t, ServiceOpts{})
+	defer cleanup()
+	db := TestingSvcDB(t, svc)
+	user := CreateTestUserWithActiveLicense(t, svc, 9701)
+
+	// Discourse accepts the requests and never answers
+	reached := make(chan struct{}, 1)
+	discourse := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case reached <- struct{}{}:
+		default:
+		}
+		<-r.Context().Done()
+	}))
+	defer discourse.Close()
+
+	svc.Config().Discourse.URL = discourse.URL
+	prevTimeout := discourseAdminTimeout
+	discourseAdminTimeout = 100 * time.Millisecond
+	defer func() { discourseAdminTimeout = prevTimeout }()
+	cfg := svc.Config().Discourse
+	cfg.LicenseGroup = "premium"
+
+	err := discourseAdminRequest(context.Background(), cfg, "GET", "/groups/premium.json", nil, nil)
+	assert.Equal(t, int32(errcode.ERR_DISCOURSE_API_REQUEST), errcode.Code(err))
+	<-reached
+
+	start := time.Now()
+	syncUserDiscourseGroup(context.Background(), db, zap.NewNop(), cfg, user.User.Id)
+	assert.Less(t, time.Since(start), discourseAdminTimeout)
+	select {
+	case <-reached:
+	case <-time.After(time.Second):
+		t.Fatal("the background sync never reached Discourse")
+	}
+	userGroupSyncs.Wait()
+}
@@ -0,0 +1,137 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// Cached identities are stored under the SHA-256 of the key, and indexed by user for the logouts
+const (
+	discourseTokenKeyPrefix      = "discourse-token:"
+	discourseUserTokensKeyPrefix = "discourse-user-tokens:"
+)
+
+// cachedDiscourseToken is the outcome of a verification, either the user or a rejection
+type cachedDiscourseToken struct {
+	User     *rbdb.DiscourseUser `json:"user,omitempty"`
+	Rejected bool                `json:"rejected,omitempty"`
+}
+
+// verifyDiscourseToken verifies a Discourse User-Api-Key, from the cache when possible
+// The cache is best effort, Discourse is only asked when the circuit breaker allows it
+func (svc *service) verifyDiscourseToken(ctx context.Context, token string) (*rbdb.DiscourseUser, error) {
+	tokenHash := hashSessionToken(token)
+	cached, err := svc.redis.GetCachedDiscourseToken(ctx, tokenHash)
+	if err != nil {
+		svc.logger.Warn("Failed to read the Discourse token cache", zap.Error(err))
+	} else if cached != nil {
+		if cached.Rejected {
+			return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(fmt.Errorf("key recently rejected by Discourse"))
+		}
+		return cached.User, nil
+	}
+
+	if !svc.discourseBreaker.allow() {
+		return nil, errcode.ERR_AUTH_DISCOURSE_UNAVAILABLE.Wrap(fmt.Errorf("circuit open after repeated failures"))
+	}
+	userInfo, err := VerifyTokenAndGetUser(ctx, svc.cfg.Discourse, token)
+	switch {
+	case err == nil:
+		svc.discourseBreaker.success()
+		if err := svc.redis.CacheDiscourseUser(ctx, svc.cfg.Discourse, tokenHash, userInfo); err != nil {
+			svc.logger.Warn("Failed to cache a Discourse identity", zap.Error(err))
+		}
+		return userInfo, nil
+	case errcode.Code(err) == int32(errcode.ERR_AUTH_INVALID_TOKEN):
+		// Discourse answered, only the key is bad
+		svc.discourseBreaker.success()
+		if err := svc.redis.CacheDiscourseRejection(ctx, svc.cfg.Discourse, tokenHash); err != nil {
+			svc.logger.Warn("Failed to cache a Discourse rejection", zap.Error(err))
+		}
+		return nil, err
+	case errors.Is(err, context.Canceled) && ctx.Err() != nil:
+		// The client went away, Discourse didn't fail
+		svc.discourseBreaker.abandon()
+		return nil, err
+	default:
+		svc.discourseBreaker.failure()
+		return nil, err
+	}
+}
+
+// GetCachedDiscourseToken returns the cached verification of a key, nil when it isn't cached
+func (rs *RedisStore) GetCachedDiscourseToken(ctx context.Context, tokenHash string) (*cachedDiscourseToken, error) {
+	data, err := rs.client.Get(ctx, discourseTokenKeyPrefix+tokenHash).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	var cached cachedDiscourseToken
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	if !cached.Rejected && cached.User == nil {
+		return nil, nil
+	}
+	return &cached, nil
+}
+
+// CacheDiscourseUser remembers the user of a verified key for the token cache TTL of cfg
+func (rs *RedisStore) CacheDiscourseUser(ctx context.Context, cfg config.Discourse, tokenHash string, user *rbdb.DiscourseUser) error {
+	data, err := json.Marshal(&cachedDiscourseToken{User: user})
+	if err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	userKey := fmt.Sprintf("%s%d", discourseUserTokensKeyPrefix, user.ExternalId)
+	pipe := rs.client.TxPipeline()
+	pipe.Set(ctx, discourseTokenKeyPrefix+tokenHash, data, time.Duration(cfg.TokenCacheTTL))
+	pipe.SAdd(ctx, userKey, tokenHash)
+	pipe.Expire(ctx, userKey, time.Duration(cfg.TokenCacheTTL))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return nil
+}
+
+// CacheDiscourseRejection remembers for the rejected TTL of cfg that Discourse rejected a key
+func (rs *RedisStore) CacheDiscourseRejection(ctx context.Context, cfg config.Discourse, tokenHash string) error {
+	data, err := json.Marshal(&cachedDiscourseToken{Rejected: true})
+	if err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	if err := rs.client.Set(ctx, discourseTokenKeyPrefix+tokenHash, data, time.Duration(cfg.TokenRejectedTTL)).Err(); err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return nil
+}
+
+// InvalidateDiscourseTokens forgets the cached identities of a user, its keys are verified again on next use
+func (rs *RedisStore) InvalidateDiscourseTokens(ctx context.Context, discourseID int64) error {
+	userKey := fmt.Sprintf("%s%d", discourseUserTokensKeyPrefix, discourseID)
+	tokenHashes, err := rs.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	keys := []string{userKey}
+	for _, tokenHash := range tokenHashes {
+		keys = append(keys, discourseTokenKeyPrefix+tokenHash)
+	}
+	if err := rs.client.Del(ctx, keys...).Err(); err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return nil
+}
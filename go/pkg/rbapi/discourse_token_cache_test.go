@@ -0,0 +1,163 @@
+package rbapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestDiscourseTokenCache(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+	typed := svc.(*service)
+
+	var hits atomic.Int32
+	var down atomic.Bool
+	discourse := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/session/current.json":
+			hits.Add(1)
+			switch {
+			case down.Load():
+				w.WriteHeader(http.StatusBadGateway)
+			case r.Header.Get("User-Api-Key") == "good-key":
+				w.Write([]byte(`{"current_user":{"id":9501,"username":"cached","groups":["trust_level_1"]}}`))
+			case r.Header.Get("User-Api-Key") == "throttled-key":
+				w.WriteHeader(http.StatusTooManyRequests)
+			case r.Header.Get("User-Api-Key") == "slow-key":
+				w.WriteHeader(http.StatusRequestTimeout)
+			default:
+				w.WriteHeader(http.StatusForbidden)
+			}
+		case "/admin/users/9501/log_out":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer discourse.Close()
+
+	svc.Config().Discourse.URL = discourse.URL
+
+	t.Run("verified keys are cached until logout", func(t *testing.T) {
+		hits.Store(0)
+		user, err := typed.verifyDiscourseToken(ctx, "good-key")
+		require.NoError(t, err)
+		assert.Equal(t, int64(9501), user.ExternalId)
+		user, err = typed.verifyDiscourseToken(ctx, "good-key")
+		require.NoError(t, err)
+		assert.Equal(t, "cached", user.Username)
+		assert.Equal(t, []string{"trust_level_1"}, user.Groups)
+		assert.Equal(t, int32(1), hits.Load())
+
+		logoutCtx := context.WithValue(ctx, userInfoCtx, user)
+		_, err = svc.UserLogout(logoutCtx, &UserLogout_Input{})
+		require.NoError(t, err)
+		_, err = typed.verifyDiscourseToken(ctx, "good-key")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), hits.Load())
+	})
+
+	t.Run("rejected keys are cached", func(t *testing.T) {
+		hits.Store(0)
+		for i := 0; i < 3; i++ {
+			_, err := typed.verifyDiscourseToken(ctx, "bad-key")
+			assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+		}
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("the breaker fails fast while Discourse is down", func(t *testing.T) {
+		now := time.Now()
+		typed.discourseBreaker = newCircuitBreaker(3, time.Minute)
+		typed.discourseBreaker.now = func() time.Time { return now }
+		down.Store(true)
+		hits.Store(0)
+
+		for i := 0; i < 3; i++ {
+			_, err := typed.verifyDiscourseToken(ctx, "unknown-key")
+			assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_RESPONSE_ERROR), errcode.Code(err))
+		}
+		_, err := typed.verifyDiscourseToken(ctx, "unknown-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_UNAVAILABLE), errcode.Code(err))
+		assert.Equal(t, int32(3), hits.Load())
+
+		// Cached identities keep working
+		user, err := typed.verifyDiscourseToken(ctx, "good-key")
+		require.NoError(t, err)
+		assert.Equal(t, int64(9501), user.ExternalId)
+
+		// A failed trial opens the breaker again
+		now = now.Add(time.Minute)
+		_, err = typed.verifyDiscourseToken(ctx, "unknown-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_RESPONSE_ERROR), errcode.Code(err))
+		_, err = typed.verifyDiscourseToken(ctx, "unknown-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_UNAVAILABLE), errcode.Code(err))
+		assert.Equal(t, int32(4), hits.Load())
+
+		// A successful trial closes it
+		down.Store(false)
+		now = now.Add(time.Minute)
+		_, err = typed.verifyDiscourseToken(ctx, "unknown-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+		_, err = typed.verifyDiscourseToken(ctx, "other-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+		assert.Equal(t, int32(6), hits.Load())
+	})
+
+	t.Run("only 401 and 403 reject a key", func(t *testing.T) {
+		typed.discourseBreaker = newCircuitBreaker(4, time.Minute)
+		hits.Store(0)
+
+		for _, key := range []string{"throttled-key", "slow-key", "throttled-key"} {
+			_, err := typed.verifyDiscourseToken(ctx, key)
+			assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_RESPONSE_ERROR), errcode.Code(err), key)
+		}
+		// Not cached, and counted as failures
+		assert.Equal(t, int32(3), hits.Load())
+		_, err := typed.verifyDiscourseToken(ctx, "throttled-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_RESPONSE_ERROR), errcode.Code(err))
+		_, err = typed.verifyDiscourseToken(ctx, "bad-key-2")
+		assert.Equal(t, int32(errcode.ERR_AUTH_DISCOURSE_UNAVAILABLE), errcode.Code(err))
+	})
+
+	t.Run("cancelled requests don't count as failures", func(t *testing.T) {
+		typed.discourseBreaker = newCircuitBreaker(1, time.Minute)
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		for i := 0; i < 3; i++ {
+			_, err := typed.verifyDiscourseToken(cancelled, "cancelled-key")
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+		_, err := typed.verifyDiscourseToken(ctx, "cancelled-key")
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+	})
+
+	t.Run("cache keys are scoped by user", func(t *testing.T) {
+		require.NoError(t, typed.redis.CacheDiscourseUser(ctx, typed.cfg.Discourse, "hash-a", &rbdb.DiscourseUser{ExternalId: 9502}))
+		require.NoError(t, typed.redis.CacheDiscourseUser(ctx, typed.cfg.Discourse, "hash-b", &rbdb.DiscourseUser{ExternalId: 9503}))
+		require.NoError(t, typed.redis.InvalidateDiscourseTokens(ctx, 9502))
+
+		cached, err := typed.redis.GetCachedDiscourseToken(ctx, "hash-a")
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+		cached, err = typed.redis.GetCachedDiscourseToken(ctx, "hash-b")
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+		assert.Equal(t, int64(9503), cached.User.ExternalId)
+	})
+}
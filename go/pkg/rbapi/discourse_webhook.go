@@ -0,0 +1,233 @@
+package rbapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+const maxDiscourseWebhookBodySize = 1 << 20
+
+// discourseWebhookPayload holds the parts of the Discourse webhook payloads we care about
+type discourseWebhookPayload struct {
+	User      *discourseWebhookUser      `json:"user"`
+	GroupUser *discourseWebhookGroupUser `json:"group_user"`
+}
+
+type discourseWebhookUser struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+type discourseWebhookGroupUser struct {
+	UserID  int64 `json:"user_id"`
+	GroupID int64 `json:"group_id"`
+}
+
+// discourseWebhookHandler receives Discourse webhooks to keep User records in sync with the forum
+func discourseWebhookHandler(db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if cfg.Discourse.WebhookSecret == "" {
+			logger.Error("Discourse webhook received but not configured", zap.Error(errcode.ERR_DISCOURSE_WEBHOOK_NOT_CONFIGURED))
+			http.Error(w, "Webhook not configured", http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxDiscourseWebhookBodySize))
+		if err != nil {
+			logger.Error("Failed to read Discourse webhook body", zap.Error(err))
+			http.Error(w, "Failed to read request body", http.StatusBadRequest)
+			return
+		}
+
+		signature := r.Header.Get("X-Discourse-Event-Signature")
+		if err := verifyDiscourseWebhookSignature(body, signature, cfg.Discourse.WebhookSecret); err != nil {
+			logger.Error("Discourse webhook signature verification failed", zap.Error(err))
+			http.Error(w, "Invalid signature", http.StatusBadRequest)
+			return
+		}
+
+		eventID := r.Header.Get("X-Discourse-Event-Id")
+		eventName := r.Header.Get("X-Discourse-Event")
+		logger.Info("Received Discourse webhook",
+			zap.String("event_id", eventID),
+			zap.String("event", eventName))
+
+		// Ping events are sent when testing the webhook from the Discourse admin
+		if eventName != "ping" {
+			if eventID == "" || eventName == "" {
+				logger.Error("Discourse webhook without event headers", zap.Error(errcode.ERR_DISCOURSE_WEBHOOK_PAYLOAD_INVALID))
+				http.Error(w, "Missing event headers", http.StatusBadRequest)
+				return
+			}
+
+			// Processing errors are retried by the inbox, Discourse only redelivers events that could not be stored
+			if err := receiveWebhookEvent(r.Context(), db, logger, cfg, payments, rbdb.WebhookEvent_PROVIDER_DISCOURSE, eventID, eventName, body); err != nil {
+				logger.Error("Error storing Discourse webhook", zap.Error(err), zap.String("event", eventName))
+				http.Error(w, "Failed to store event", http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte("OK")); err != nil {
+			logger.Error("Failed to write response", zap.Error(err))
+		}
+	}
+}
+
+// verifyDiscourseWebhookSignature checks the "sha256=<hex>" HMAC signature sent by Discourse
+func verifyDiscourseWebhookSignature(body []byte, signature string, secret string) error {
+	sig, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return errcode.ERR_DISCOURSE_WEBHOOK_SIGNATURE_INVALID
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expectedSig := hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(sig), []byte(expectedSig)) {
+		return errcode.ERR_DISCOURSE_WEBHOOK_SIGNATURE_INVALID
+	}
+	return nil
+}
+
+// processDiscourseWebhookEvent applies a Discourse event from the webhook inbox to the database
+// The inbox stores each event ID once, the changes of an event are applied in one transaction
+func processDiscourseWebhookEvent(ctx context.Context, db *gorm.DB, logger *zap.Logger, eventName string, body []byte) error {
+	var payload discourseWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return errcode.ERR_DISCOURSE_WEBHOOK_PAYLOAD_INVALID.Wrap(err)
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		switch eventName {
+		case "user_created", "user_updated":
+			if payload.User == nil || payload.User.ID == 0 {
+				return errcode.ERR_DISCOURSE_WEBHOOK_PAYLOAD_INVALID.Wrap(fmt.Errorf("missing user in %s", eventName))
+			}
+			return syncDiscourseWebhookUser(tx, payload.User)
+
+		case "user_destroyed":
+			if payload.User == nil || payload.User.ID == 0 {
+				return errcode.ERR_DISCOURSE_WEBHOOK_PAYLOAD_INVALID.Wrap(fmt.Errorf("missing user in %s", eventName))
+			}
+			return anonymizeDiscourseWebhookUser(tx, payload.User.ID)
+
+		case "user_added_to_group", "user_removed_from_group":
+			if payload.GroupUser == nil || payload.GroupUser.UserID == 0 {
+				return errcode.ERR_DISCOURSE_WEBHOOK_PAYLOAD_INVALID.Wrap(fmt.Errorf("missing group user in %s", eventName))
+			}
+			kind := rbdb.Activity_KIND_USER_ADDED_TO_GROUP
+			if eventName == "user_removed_from_group" {
+				kind = rbdb.Activity_KIND_USER_REMOVED_FROM_GROUP
+			}
+			return recordDiscourseWebhookGroupChange(tx, payload.GroupUser, kind)
+
+		default:
+			logger.Debug("Ignoring Discourse webhook event", zap.String("event", eventName))
+			return nil
+		}
+	})
+}
+
+// syncDiscourseWebhookUser creates the user or updates its email and username from the forum
+func syncDiscourseWebhookUser(tx *gorm.DB, user *discourseWebhookUser) error {
+	var userOrm rbdb.UserORM
+	err := tx.Where(&rbdb.UserORM{DiscourseId: user.ID}).First(&userOrm).Error
+	if rbdb.IsRecordNotFoundError(err) {
+		userOrm = rbdb.UserORM{
+			DiscourseId: user.ID,
+			Email:       user.Email,
+			Username:    user.Username,
+		}
+		if err := tx.Create(&userOrm).Error; err != nil {
+			return rbdb.GormToErrcode(err)
+		}
+		return createUserActivity(tx, userOrm.Id, rbdb.Activity_KIND_USER_REGISTER, "")
+	}
+	if err != nil {
+		return rbdb.GormToErrcode(err)
+	}
+
+	// The email is only part of the payload when the webhook is allowed to see it
+	changed := []string{}
+	if user.Email != "" && userOrm.Email != user.Email {
+		userOrm.Email = user.Email
+		changed = append(changed, "email")
+	}
+	if user.Username != "" && userOrm.Username != user.Username {
+		userOrm.Username = user.Username
+		changed = append(changed, "username")
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if err := tx.Save(&userOrm).Error; err != nil {
+		return rbdb.GormToErrcode(err)
+	}
+	return createUserActivity(tx, userOrm.Id, rbdb.Activity_KIND_USER_UPDATED, strings.Join(changed, ","))
+}
+
+// anonymizeDiscourseWebhookUser clears the personal data of a user deleted from the forum.
+// The row itself is kept since licenses and payments reference it.
+func anonymizeDiscourseWebhookUser(tx *gorm.DB, discourseID int64) error {
+	var userOrm rbdb.UserORM
+	err := tx.Where(&rbdb.UserORM{DiscourseId: discourseID}).First(&userOrm).Error
+	if rbdb.IsRecordNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return rbdb.GormToErrcode(err)
+	}
+
+	userOrm.Email = ""
+	userOrm.Username = ""
+	if err := tx.Save(&userOrm).Error; err != nil {
+		return rbdb.GormToErrcode(err)
+	}
+	return createUserActivity(tx, userOrm.Id, rbdb.Activity_KIND_USER_ANONYMIZED, "")
+}
+
+// recordDiscourseWebhookGroupChange logs a group membership change of a known user
+func recordDiscourseWebhookGroupChange(tx *gorm.DB, groupUser *discourseWebhookGroupUser, kind rbdb.Activity_Kind) error {
+	var userOrm rbdb.UserORM
+	err := tx.Where(&rbdb.UserORM{DiscourseId: groupUser.UserID}).First(&userOrm).Error
+	if rbdb.IsRecordNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return rbdb.GormToErrcode(err)
+	}
+
+	return createUserActivity(tx, userOrm.Id, kind, fmt.Sprintf("group_id=%d", groupUser.GroupID))
+}
+
+func createUserActivity(tx *gorm.DB, userId int64, kind rbdb.Activity_Kind, details string) error {
+	activityOrm := &rbdb.ActivityORM{
+		Kind:    int32(kind),
+		UserId:  &userId,
+		Details: details,
+	}
+	return rbdb.GormToErrcode(tx.Create(activityOrm).Error)
+}
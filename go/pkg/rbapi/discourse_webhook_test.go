@@ -0,0 +1,156 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestDiscourseWebhook(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	server, svc, cleanup := TestingServer(t, ctx, ServerOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+	db := TestingSvcDB(t, svc)
+
+	svc.Config().Discourse.WebhookSecret = "webhook-secret"
+
+	url := fmt.Sprintf("http://%s/webhooks/discourse", server.ListenerAddr())
+	send := func(t *testing.T, eventID string, event string, body string, secret string) int {
+		t.Helper()
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(body))
+
+		req, err := http.NewRequest("POST", url, bytes.NewReader([]byte(body)))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Discourse-Event-Id", eventID)
+		req.Header.Set("X-Discourse-Event", event)
+		req.Header.Set("X-Discourse-Event-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	countActivities := func(t *testing.T, userId int64, kind rbdb.Activity_Kind) int64 {
+		t.Helper()
+		var count int64
+		err := db.Model(&rbdb.ActivityORM{}).Where("user_id = ? AND kind = ?", userId, int32(kind)).Count(&count).Error
+		require.NoError(t, err)
+		return count
+	}
+
+	t.Run("invalid signature is rejected", func(t *testing.T) {
+		status := send(t, "1", "user_created", `{"user":{"id":500,"username":"mallory"}}`, "wrong-secret")
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		var count int64
+		require.NoError(t, db.Model(&rbdb.UserORM{}).Where("discourse_id = ?", 500).Count(&count).Error)
+		assert.Zero(t, count)
+	})
+
+	t.Run("user created", func(t *testing.T) {
+		status := send(t, "2", "user_created", `{"user":{"id":500,"username":"alice","email":"alice@example.com"}}`, svc.Config().Discourse.WebhookSecret)
+		require.Equal(t, http.StatusOK, status)
+
+		user := rbdb.TestingGetUser(t, db, 500)
+		assert.Equal(t, "alice", user.Username)
+		assert.Equal(t, "alice@example.com", user.Email)
+		assert.Equal(t, int64(1), countActivities(t, user.Id, rbdb.Activity_KIND_USER_REGISTER))
+	})
+
+	t.Run("user updated", func(t *testing.T) {
+		status := send(t, "3", "user_updated", `{"user":{"id":500,"username":"alice2","email":"alice@example.com"}}`, svc.Config().Discourse.WebhookSecret)
+		require.Equal(t, http.StatusOK, status)
+
+		user := rbdb.TestingGetUser(t, db, 500)
+		assert.Equal(t, "alice2", user.Username)
+		assert.Equal(t, int64(1), countActivities(t, user.Id, rbdb.Activity_KIND_USER_UPDATED))
+	})
+
+	t.Run("duplicate events are processed once", func(t *testing.T) {
+		status := send(t, "3", "user_updated", `{"user":{"id":500,"username":"alice3"}}`, svc.Config().Discourse.WebhookSecret)
+		require.Equal(t, http.StatusOK, status)
+
+		user := rbdb.TestingGetUser(t, db, 500)
+		assert.Equal(t, "alice2", user.Username)
+		assert.Equal(t, int64(1), countActivities(t, user.Id, rbdb.Activity_KIND_USER_UPDATED))
+	})
+
+	t.Run("group membership change", func(t *testing.T) {
+		status := send(t, "4", "user_added_to_group", `{"group_user":{"user_id":500,"group_id":42}}`, svc.Config().Discourse.WebhookSecret)
+		require.Equal(t, http.StatusOK, status)
+
+		user := rbdb.TestingGetUser(t, db, 500)
+		assert.Equal(t, int64(1), countActivities(t, user.Id, rbdb.Activity_KIND_USER_ADDED_TO_GROUP))
+	})
+
+	t.Run("user destroyed", func(t *testing.T) {
+		status := send(t, "5", "user_destroyed", `{"user":{"id":500,"username":"alice2"}}`, svc.Config().Discourse.WebhookSecret)
+		require.Equal(t, http.StatusOK, status)
+
+		user := rbdb.TestingGetUser(t, db, 500)
+		assert.Empty(t, user.Username)
+		assert.Empty(t, user.Email)
+		assert.Equal(t, int64(1), countActivities(t, user.Id, rbdb.Activity_KIND_USER_ANONYMIZED))
+	})
+
+	t.Run("events go through the webhook inbox", func(t *testing.T) {
+		adminCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 1, Username: "admin", Admin: true})
+		loadEvent := func(t *testing.T, eventID string) *rbdb.WebhookEventORM {
+			t.Helper()
+			var eventOrm rbdb.WebhookEventORM
+			require.NoError(t, db.Where(&rbdb.WebhookEventORM{Provider: int32(rbdb.WebhookEvent_PROVIDER_DISCOURSE), EventId: eventID}).First(&eventOrm).Error)
+			return &eventOrm
+		}
+
+		// A processed event can be replayed, it stays processed
+		out, err := svc.AdminReplayWebhookEvent(adminCtx, &AdminReplayWebhookEvent_Input{Id: loadEvent(t, "2").Id})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.WebhookEvent_STATUS_PROCESSED, out.Event.Status)
+
+		// A failing event is stored and retried instead of being redelivered by Discourse
+		status := send(t, "6", "user_updated", `{"user":{"username":"nobody"}}`, svc.Config().Discourse.WebhookSecret)
+		require.Equal(t, http.StatusOK, status)
+		eventOrm := loadEvent(t, "6")
+		assert.Equal(t, int32(rbdb.WebhookEvent_STATUS_PENDING), eventOrm.Status)
+		assert.NotEmpty(t, eventOrm.LastError)
+
+		require.NoError(t, db.Model(eventOrm).Update("payload", `{"user":{"id":501,"username":"bob"}}`).Error)
+		out, err = svc.AdminReplayWebhookEvent(adminCtx, &AdminReplayWebhookEvent_Input{Id: eventOrm.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.WebhookEvent_STATUS_PROCESSED, out.Event.Status)
+		assert.Equal(t, "bob", rbdb.TestingGetUser(t, db, 501).Username)
+
+		// Events recorded before the inbox have no payload, a replay leaves them processed
+		now := time.Now().UTC()
+		legacyOrm := &rbdb.WebhookEventORM{
+			Provider:    int32(rbdb.WebhookEvent_PROVIDER_DISCOURSE),
+			EventId:     "legacy",
+			EventType:   "user_updated",
+			Status:      int32(rbdb.WebhookEvent_STATUS_PROCESSED),
+			Attempts:    1,
+			ProcessedAt: &now,
+		}
+		require.NoError(t, db.Create(legacyOrm).Error)
+		_, err = svc.AdminReplayWebhookEvent(adminCtx, &AdminReplayWebhookEvent_Input{Id: legacyOrm.Id})
+		assert.Equal(t, int32(errcode.ERR_WEBHOOK_EVENT_PAYLOAD_MISSING), errcode.Code(err))
+		assert.Equal(t, int32(rbdb.WebhookEvent_STATUS_PROCESSED), loadEvent(t, "legacy").Status)
+	})
+}
@@ -6,13 +6,11 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/rbdb"
 )
 
-const (
-	activateSecret = "LXwkAuZa9vfMsxW"
-	faultString    = "fault"
-)
+const faultString = "fault"
 
 type ActivateLicenseRequest struct {
 	LicenseKey string `json:"license_key"`
@@ -22,7 +20,7 @@ type ActivateLicenseRequest struct {
 }
 
 // activateLicense handles license activation
-func activateLicense(db *gorm.DB, redisStore *RedisStore) http.HandlerFunc {
+func activateLicense(db *gorm.DB, redisStore *RedisStore, cfg *config.Config) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req ActivateLicenseRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -30,7 +28,7 @@ func activateLicense(db *gorm.DB, redisStore *RedisStore) http.HandlerFunc {
 			return
 		}
 
-		if req.Secret != activateSecret {
+		if req.Secret != cfg.License.ActivateSecret {
 			// Leave it blank so people don't have any clue what's happening
 			return
 		}
@@ -63,7 +63,7 @@ func TestActivateLicense(t *testing.T) {
 		require.NoError(t, err)
 
 		reqBody := ActivateLicenseRequest{
-			Secret:     activateSecret,
+			Secret:     svc.Config().License.ActivateSecret,
 			LicenseKey: license.Key,
 		}
 		body, err := json.Marshal(reqBody)
@@ -91,7 +91,7 @@ func TestActivateLicense(t *testing.T) {
 
 	t.Run("invalid license key", func(t *testing.T) {
 		reqBody := ActivateLicenseRequest{
-			Secret:     activateSecret,
+			Secret:     svc.Config().License.ActivateSecret,
 			LicenseKey: "invalid-key",
 		}
 		body, err := json.Marshal(reqBody)
@@ -145,7 +145,7 @@ func TestActivateLicense(t *testing.T) {
 
 		// Try to activate expired license
 		reqBody := ActivateLicenseRequest{
-			Secret:     activateSecret,
+			Secret:     svc.Config().License.ActivateSecret,
 			LicenseKey: license.Key,
 		}
 		body, err := json.Marshal(reqBody)
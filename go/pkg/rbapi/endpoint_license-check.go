@@ -6,11 +6,10 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/rbdb"
 )
 
-const checkSecret = "BzkE4gdxc9z956v"
-
 type CheckLicenseRequest struct {
 	LicenseKey string `json:"license_key"`
 	UsageID    string `json:"usage_id,omitempty"`
@@ -20,7 +19,7 @@ type CheckLicenseRequest struct {
 }
 
 // checkLicense handles license checking
-func checkLicense(db *gorm.DB, redisStore *RedisStore) http.HandlerFunc {
+func checkLicense(db *gorm.DB, redisStore *RedisStore, cfg *config.Config) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req CheckLicenseRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -28,7 +27,7 @@ func checkLicense(db *gorm.DB, redisStore *RedisStore) http.HandlerFunc {
 			return
 		}
 
-		if req.Secret != checkSecret {
+		if req.Secret != cfg.License.CheckSecret {
 			// Leave it blank so people don't have any clue what's happening
 			return
 		}
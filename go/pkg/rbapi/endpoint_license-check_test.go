@@ -38,7 +38,7 @@ func TestCheckLicense(t *testing.T) {
 
 		// First activate the license
 		reqBodyActivate := ActivateLicenseRequest{
-			Secret:     activateSecret,
+			Secret:     svc.Config().License.ActivateSecret,
 			LicenseKey: license.Key,
 		}
 		body, err := json.Marshal(reqBodyActivate)
@@ -57,7 +57,7 @@ func TestCheckLicense(t *testing.T) {
 
 		// then perform a check
 		reqBodyCheck := CheckLicenseRequest{
-			Secret:     checkSecret,
+			Secret:     svc.Config().License.CheckSecret,
 			LicenseKey: license.Key,
 			UsageID:    respData.UsageID,
 		}
@@ -78,7 +78,7 @@ func TestCheckLicense(t *testing.T) {
 
 	t.Run("check with invalid license key", func(t *testing.T) {
 		reqBody := CheckLicenseRequest{
-			Secret:     checkSecret,
+			Secret:     svc.Config().License.CheckSecret,
 			LicenseKey: "invalid-key",
 			UsageID:    "1",
 		}
@@ -3,10 +3,12 @@ package rbapi
 import (
 	"encoding/json"
 	"net/http"
-	"net/url"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
 	"rslbot.com/go/pkg/rbdb"
 )
 
@@ -21,8 +23,8 @@ type UpdateOffsetsResponse struct {
 }
 
 // updateOffsets handles offset updates for a specific version
-// Requires Discourse SSO admin authentication
-func updateOffsets(db *gorm.DB) http.HandlerFunc {
+// Requires the session access token or an offsets:write API key of a user with the offsets:write permission
+func updateOffsets(db *gorm.DB, redisStore *RedisStore, cfg *config.Config) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
@@ -41,54 +43,61 @@ func updateOffsets(db *gorm.DB) http.HandlerFunc {
 		// Extract token from Bearer header
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// Verify SSO token
-		if !strings.HasPrefix(tokenString, "SSO_") {
-			response := UpdateOffsetsResponse{
-				Status:  "error",
-				Message: "invalid token format, SSO token required",
+		// Verify the session access token or the offsets:write API key
+		var userInfo *rbdb.DiscourseUser
+		switch {
+		case isAccessToken(tokenString):
+			session, err := redisStore.GetSessionByAccessToken(r.Context(), tokenString)
+			if err != nil {
+				response := UpdateOffsetsResponse{
+					Status:  "error",
+					Message: "invalid or expired session token",
+				}
+				w.WriteHeader(http.StatusUnauthorized)
+				json.NewEncoder(w).Encode(response)
+				return
 			}
-			w.WriteHeader(http.StatusUnauthorized)
-			json.NewEncoder(w).Encode(response)
-			return
-		}
-
-		// Extract SSO and signature from the token
-		parts := strings.Split(strings.TrimPrefix(tokenString, "SSO_"), ".")
-		if len(parts) != 2 {
-			response := UpdateOffsetsResponse{
-				Status:  "error",
-				Message: "invalid SSO token format",
+			userInfo = session.User
+		case isApiKey(tokenString):
+			keyUser, _, err := authenticateApiKey(r.Context(), db, cfg.Discourse, tokenString, ApiKeyScopeOffsetsWrite, time.Now().UTC())
+			if err != nil {
+				status, message := http.StatusUnauthorized, "invalid, expired or revoked API key"
+				switch errcode.Code(err) {
+				case int32(errcode.ERR_API_KEY_SCOPE_DENIED):
+					status, message = http.StatusForbidden, "API key lacks the offsets:write scope"
+				case int32(errcode.ERR_DISCOURSE_API_REQUEST), int32(errcode.ERR_DISCOURSE_API_RESPONSE), int32(errcode.ERR_DISCOURSE_RESPONSE_PARSE):
+					status, message = http.StatusBadGateway, "failed to load the API key owner from Discourse"
+				}
+				response := UpdateOffsetsResponse{
+					Status:  "error",
+					Message: message,
+				}
+				w.WriteHeader(status)
+				json.NewEncoder(w).Encode(response)
+				return
 			}
-			w.WriteHeader(http.StatusUnauthorized)
-			json.NewEncoder(w).Encode(response)
-			return
-		}
-
-		// URL decode the token part if needed
-		decodedToken := parts[0]
-		if unescaped, err := url.QueryUnescape(decodedToken); err == nil {
-			decodedToken = unescaped
-		}
-
-		// Verify SSO signature and get user info
-		userInfo, err := VerifySSO(decodedToken, parts[1], DiscourseSecret)
-		if err != nil {
+			userInfo = keyUser
+		default:
 			response := UpdateOffsetsResponse{
 				Status:  "error",
-				Message: "invalid SSO token",
+				Message: "invalid token format, session access token or API key required",
 			}
 			w.WriteHeader(http.StatusUnauthorized)
 			json.NewEncoder(w).Encode(response)
 			return
 		}
 
-		// Check if user is admin
-		if !userInfo.Admin {
+		// Same permission check as the RPCs
+		if err := authorizeUser(db, cfg.Roles, userInfo, PermissionOffsetsWrite); err != nil {
+			status, message := http.StatusInternalServerError, "failed to check permissions"
+			if err == errcode.ERR_RESTRICTED_AREA {
+				status, message = http.StatusForbidden, "offsets:write permission required"
+			}
 			response := UpdateOffsetsResponse{
 				Status:  "error",
-				Message: "admin access required",
+				Message: message,
 			}
-			w.WriteHeader(http.StatusForbidden)
+			w.WriteHeader(status)
 			json.NewEncoder(w).Encode(response)
 			return
 		}
@@ -127,7 +136,7 @@ func updateOffsets(db *gorm.DB) http.HandlerFunc {
 		}
 
 		// Update offsets in database
-		err = rbdb.UpdateOffset(db, req.Version, req.Offsets)
+		err := rbdb.UpdateOffset(db, req.Version, req.Offsets)
 		if err != nil {
 			response := UpdateOffsetsResponse{
 				Status:  "error",
@@ -0,0 +1,342 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// FakePaymentWebhookSignatureHeader carries the signature of the webhooks sent by the fake provider
+const FakePaymentWebhookSignatureHeader = "Fake-Webhook-Signature"
+
+const fakePaymentApprovePath = "/payment/fake/approve"
+
+// FakePaymentProvider simulates a payment provider in memory
+// Orders are approved with Approve instead of a checkout page. Each step queues the PayPal
+// webhook event it stands for, which DeliverEvents hands to the webhook processing.
+type FakePaymentProvider struct {
+	mu      sync.Mutex
+	baseURL string
+	secret  []byte
+	nextID  int
+	orders  map[string]*fakeOrder
+	refunds map[string]*fakeRefund // By request ID
+	events  []paypal.AnyEvent
+
+	// captureStatus is the status of the next captures, completed when empty
+	captureStatus PaymentCaptureStatus
+}
+
+type fakeOrder struct {
+	order   PaymentOrder
+	request PaymentOrderRequest
+}
+
+type fakeRefund struct {
+	captureID string
+	refund    PaymentRefund
+}
+
+// NewFakePaymentProvider returns a fake provider whose orders are approved on the API at baseURL
+func NewFakePaymentProvider(baseURL string) *FakePaymentProvider {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return &FakePaymentProvider{
+		baseURL: baseURL,
+		secret:  secret,
+		orders:  map[string]*fakeOrder{},
+		refunds: map[string]*fakeRefund{},
+	}
+}
+
+func (p *FakePaymentProvider) SandboxMode() bool {
+	return true
+}
+
+func (p *FakePaymentProvider) CreateOrder(ctx context.Context, in *PaymentOrderRequest) (*PaymentOrder, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := p.newID("FAKE-ORDER")
+	metadata := make(map[string]string, len(in.Metadata))
+	for key, value := range in.Metadata {
+		metadata[key] = value
+	}
+	p.orders[id] = &fakeOrder{
+		order: PaymentOrder{
+			ID:            id,
+			Status:        PaymentOrderCreated,
+			ApprovalURL:   p.baseURL + fakePaymentApprovePath + "?token=" + id,
+			Metadata:      metadata,
+			Currency:      rbdb.NormalizeCurrency(in.Currency),
+			AmountInCents: in.AmountInCents,
+			PayerEmail:    "buyer@example.com",
+			PayerName:     "Fake Buyer",
+		},
+		request: *in,
+	}
+	return copyFakeOrder(&p.orders[id].order), nil
+}
+
+// Approve approves an order as the payer would on the checkout page, and returns the return URL of the checkout
+func (p *FakePaymentProvider) Approve(orderID string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fake, ok := p.orders[orderID]
+	if !ok {
+		return "", errcode.ERR_PAYMENT_RETRIEVE_PAYPAL_ORDER.Wrap(fmt.Errorf("unknown order %s", orderID))
+	}
+	if fake.order.Status == PaymentOrderCreated {
+		fake.order.Status = PaymentOrderApproved
+		p.queueEvent(paypal.EventCheckoutOrderApproved, map[string]interface{}{
+			"id":     orderID,
+			"status": paypalOrderStatusApproved,
+		})
+	}
+	return fake.request.ReturnURL, nil
+}
+
+func (p *FakePaymentProvider) CaptureOrder(ctx context.Context, orderID string) (*PaymentOrder, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fake, ok := p.orders[orderID]
+	if !ok {
+		return nil, errcode.ERR_PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED.Wrap(fmt.Errorf("unknown order %s", orderID))
+	}
+
+	switch fake.order.Status {
+	case PaymentOrderApproved:
+		fake.order.Status = PaymentOrderCompleted
+		capture := PaymentCapture{
+			ID:     p.newID("FAKE-CAPTURE"),
+			Status: p.captureStatus,
+		}
+		if capture.Status == "" {
+			capture.Status = PaymentCaptureCompleted
+		}
+		fake.order.Captures = []PaymentCapture{capture}
+
+		// The capture webhook as PayPal sends it
+		eventType, status := paypal.EventPaymentCaptureCompleted, paypalCaptureStatusDone
+		switch capture.Status {
+		case PaymentCapturePending:
+			eventType, status = paypalEventCapturePending, paypalCaptureStatusPending
+		case PaymentCaptureDeclined:
+			eventType, status = paypal.EventPaymentCaptureDenied, paypalCaptureStatusDenied
+		}
+		p.queueEvent(eventType, map[string]interface{}{
+			"id":     capture.ID,
+			"status": status,
+			"amount": fakePayPalMoney(fake.order.AmountInCents, fake.order.Currency),
+			"links": []paypal.Link{
+				{Href: p.baseURL + "/v2/checkout/orders/" + orderID, Rel: "up", Method: http.MethodGet},
+			},
+		})
+	case PaymentOrderCompleted:
+		// Captured already, the capture is returned again like PayPal does for a repeated request ID
+	default:
+		return nil, errcode.ERR_PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED.Wrap(fmt.Errorf("order %s is %s", orderID, fake.order.Status))
+	}
+
+	return &PaymentOrder{
+		ID:       orderID,
+		Status:   fake.order.Status,
+		Captures: append([]PaymentCapture(nil), fake.order.Captures...),
+	}, nil
+}
+
+func (p *FakePaymentProvider) GetOrder(ctx context.Context, orderID string) (*PaymentOrder, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fake, ok := p.orders[orderID]
+	if !ok {
+		return nil, errcode.ERR_PAYMENT_RETRIEVE_PAYPAL_ORDER.Wrap(fmt.Errorf("unknown order %s", orderID))
+	}
+	return copyFakeOrder(&fake.order), nil
+}
+
+func (p *FakePaymentProvider) RefundCapture(ctx context.Context, captureID string, requestID string) (*PaymentRefund, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if fake, ok := p.refunds[requestID]; ok {
+		refund := fake.refund
+		return &refund, nil
+	}
+
+	order, capture := p.findCapture(captureID)
+	if capture == nil {
+		return nil, errcode.ERR_REFUND_REQUEST_PAYPAL_REFUND.Wrap(fmt.Errorf("unknown capture %s", captureID))
+	}
+	if capture.Status != PaymentCaptureCompleted {
+		return nil, errcode.ERR_REFUND_REQUEST_PAYPAL_REFUND.Wrap(fmt.Errorf("capture %s is %s", captureID, capture.Status))
+	}
+	capture.Status = PaymentCaptureRefunded
+
+	refund := PaymentRefund{ID: p.newID("FAKE-REFUND")}
+	p.refunds[requestID] = &fakeRefund{captureID: captureID, refund: refund}
+
+	p.queueEvent(paypal.EventPaymentCaptureRefunded, map[string]interface{}{
+		"id":          refund.ID,
+		"status":      "COMPLETED",
+		"create_time": time.Now().UTC(),
+		"amount":      fakePayPalMoney(order.AmountInCents, order.Currency),
+		"links": []paypal.Link{
+			{Href: p.baseURL + "/v2/payments/captures/" + captureID, Rel: "up", Method: http.MethodGet},
+		},
+	})
+	return &refund, nil
+}
+
+// VerifyWebhook checks the signature set by SignWebhook
+func (p *FakePaymentProvider) VerifyWebhook(r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_WEBHOOK_SIGNATURE_INVALID.Wrap(err)
+	}
+	r.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	signature, err := hex.DecodeString(r.Header.Get(FakePaymentWebhookSignatureHeader))
+	if err != nil || !hmac.Equal(signature, p.signature(body)) {
+		return errcode.ERR_PAYMENT_PAYPAL_WEBHOOK_SIGNATURE_INVALID.Wrap(fmt.Errorf("invalid fake webhook signature"))
+	}
+	return nil
+}
+
+// SignWebhook returns the signature header value of a webhook body
+func (p *FakePaymentProvider) SignWebhook(body []byte) string {
+	return hex.EncodeToString(p.signature(body))
+}
+
+// DeliverEvents hands the queued webhook events to deliver in order, until the queue is empty
+// Events queued while delivering, like the capture of an approved order, are delivered too.
+// An event failing to be delivered stays queued and its error is returned.
+func (p *FakePaymentProvider) DeliverEvents(ctx context.Context, deliver func(context.Context, paypal.AnyEvent) error) error {
+	for {
+		p.mu.Lock()
+		if len(p.events) == 0 {
+			p.mu.Unlock()
+			return nil
+		}
+		event := p.events[0]
+		p.events = p.events[1:]
+		p.mu.Unlock()
+
+		if err := deliver(ctx, event); err != nil {
+			p.mu.Lock()
+			p.events = append([]paypal.AnyEvent{event}, p.events...)
+			p.mu.Unlock()
+			return err
+		}
+	}
+}
+
+func (p *FakePaymentProvider) signature(body []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// newID returns a unique ID with a prefix, the lock must be held
+func (p *FakePaymentProvider) newID(prefix string) string {
+	p.nextID++
+	return fmt.Sprintf("%s-%d", prefix, p.nextID)
+}
+
+// queueEvent queues the webhook event of a resource, the lock must be held
+func (p *FakePaymentProvider) queueEvent(eventType string, resource interface{}) {
+	payload, err := json.Marshal(resource)
+	if err != nil {
+		panic(err)
+	}
+	p.events = append(p.events, paypal.AnyEvent{
+		Event: paypal.Event{
+			ID:         p.newID("FAKE-EVENT"),
+			CreateTime: time.Now().UTC(),
+			EventType:  eventType,
+		},
+		Resource: payload,
+	})
+}
+
+// findCapture returns a capture and its order, the lock must be held
+func (p *FakePaymentProvider) findCapture(captureID string) (*PaymentOrder, *PaymentCapture) {
+	for _, fake := range p.orders {
+		for i := range fake.order.Captures {
+			if fake.order.Captures[i].ID == captureID {
+				return &fake.order, &fake.order.Captures[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// copyFakeOrder copies an order, so that callers never share the state of the provider
+func copyFakeOrder(order *PaymentOrder) *PaymentOrder {
+	orderCopy := *order
+	orderCopy.Metadata = make(map[string]string, len(order.Metadata))
+	for key, value := range order.Metadata {
+		orderCopy.Metadata[key] = value
+	}
+	orderCopy.Captures = append([]PaymentCapture(nil), order.Captures...)
+	return &orderCopy
+}
+
+// fakePayPalMoney formats an amount like the PayPal webhooks do
+func fakePayPalMoney(amountInCents int64, currency string) paypal.Money {
+	currency = strings.ToUpper(currency)
+	return paypal.Money{Currency: currency, Value: formatPayPalAmount(amountInCents, currency)}
+}
+
+// fakePaymentApproveHandler stands in for the PayPal checkout page in dev mode
+// The order is approved, the webhooks are delivered through the webhook inbox, then the payer is sent back to the app
+func fakePaymentApproveHandler(provider *FakePaymentProvider, db *gorm.DB, logger *zap.Logger, cfg *config.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		orderID := r.URL.Query().Get("token")
+
+		returnURL, err := provider.Approve(orderID)
+		if err != nil {
+			logger.Error("Failed to approve fake order", zap.Error(err), zap.String("order_id", orderID))
+			http.Error(w, "Unknown order", http.StatusNotFound)
+			return
+		}
+
+		err = provider.DeliverEvents(ctx, func(ctx context.Context, event paypal.AnyEvent) error {
+			body, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			return receiveWebhookEvent(ctx, db, logger, cfg, provider, rbdb.WebhookEvent_PROVIDER_PAYPAL, event.ID, event.EventType, body)
+		})
+		if err != nil {
+			logger.Error("Failed to deliver fake webhooks", zap.Error(err), zap.String("order_id", orderID))
+			http.Error(w, "Failed to deliver webhooks", http.StatusInternalServerError)
+			return
+		}
+
+		http.Redirect(w, r, returnURL+"?token="+orderID, http.StatusFound)
+	}
+}
@@ -0,0 +1,138 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestFakePaymentProvider(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, _, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	userCtx := TestingSetContextToken(ctx, t)
+	deliver := func(ctx context.Context, event paypal.AnyEvent) error {
+		return processPayPalWebhookEvent(ctx, event, db, logger, svc.Config(), fake)
+	}
+
+	var orderID string
+	var paymentOrm *rbdb.PaymentORM
+
+	t.Run("purchase delivers the license", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+		orderID = out.OrderId
+		assert.Equal(t, "http://localhost"+fakePaymentApprovePath+"?token="+orderID, out.CheckoutUrl)
+
+		// Nothing happens until the payer approves
+		_, err = fake.CaptureOrder(ctx, orderID)
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED), errcode.Code(err))
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+
+		_, err = fake.Approve(orderID)
+		require.NoError(t, err)
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+
+		orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, orderID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_COMPLETED), orderOrm.Status)
+		require.NotEmpty(t, orderOrm.CaptureId)
+
+		paymentOrm, err = rbdb.GetPaymentByReference(db, orderOrm.CaptureId)
+		require.NoError(t, err)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_COMPLETED), paymentOrm.Status)
+		assert.True(t, paymentOrm.SandboxMode)
+		assert.Equal(t, "buyer@example.com", paymentOrm.BillingEmail)
+		require.NotNil(t, paymentOrm.LicenseKeyId)
+
+		// Capturing again neither charges nor delivers twice
+		_, err = fake.CaptureOrder(ctx, orderID)
+		require.NoError(t, err)
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+		var count int64
+		require.NoError(t, db.Model(&rbdb.PaymentORM{}).Where(&rbdb.PaymentORM{UserId: paymentOrm.UserId, Provider: int32(rbdb.Payment_PROVIDER_PAYPAL)}).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("refund revokes the license", func(t *testing.T) {
+		require.NotNil(t, paymentOrm)
+		refundID, err := refundPayPalCapture(ctx, fake, paymentOrm.ReferenceId, "refund-test")
+		require.NoError(t, err)
+		againID, err := refundPayPalCapture(ctx, fake, paymentOrm.ReferenceId, "refund-test")
+		require.NoError(t, err)
+		assert.Equal(t, refundID, againID)
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+
+		refunded, err := rbdb.GetPaymentByReference(db, paymentOrm.ReferenceId)
+		require.NoError(t, err)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_REFUNDED), refunded.Status)
+		assert.Equal(t, refunded.AmountInCents, refunded.RefundedInCents)
+
+		var licenseOrm rbdb.LicenseKeyORM
+		require.NoError(t, db.Where(&rbdb.LicenseKeyORM{Id: *refunded.LicenseKeyId}).First(&licenseOrm).Error)
+		assert.True(t, licenseOrm.Revoked)
+
+		_, err = refundPayPalCapture(ctx, fake, "FAKE-CAPTURE-UNKNOWN", "refund-unknown")
+		assert.Equal(t, int32(errcode.ERR_REFUND_REQUEST_PAYPAL_REFUND), errcode.Code(err))
+	})
+
+	t.Run("webhook signature", func(t *testing.T) {
+		handler := paypalWebhookHandler(db, logger, svc.Config(), fake)
+		body := []byte(`{"id":"WH-FAKE-SIGNED","event_type":"FAKE.UNHANDLED","resource":{}}`)
+
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/paypal", bytes.NewReader(body))
+		req.Header.Set(FakePaymentWebhookSignatureHeader, fake.SignWebhook(body))
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		req = httptest.NewRequest(http.MethodPost, "/webhooks/paypal", bytes.NewReader(body))
+		req.Header.Set(FakePaymentWebhookSignatureHeader, fake.SignWebhook([]byte("other body")))
+		rec = httptest.NewRecorder()
+		handler(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("dev approval page", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, fakePaymentApprovePath+"?token="+out.OrderId, nil)
+		rec := httptest.NewRecorder()
+		fakePaymentApproveHandler(fake, db, logger, svc.Config())(rec, req)
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, svc.Config().Checkout.SuccessURL+"?token="+out.OrderId, rec.Header().Get("Location"))
+
+		orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, out.OrderId)
+		require.NoError(t, err)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_COMPLETED), orderOrm.Status)
+
+		req = httptest.NewRequest(http.MethodGet, fakePaymentApprovePath+"?token=FAKE-ORDER-UNKNOWN", nil)
+		rec = httptest.NewRecorder()
+		fakePaymentApproveHandler(fake, db, logger, svc.Config())(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("subscriptions need PayPal", func(t *testing.T) {
+		_, err := svc.PaymentCreatePayPalSubscription(userCtx, &PaymentCreatePayPalSubscription_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_SUBSCRIPTIONS_UNAVAILABLE), errcode.Code(err))
+		_, _, err = ProvisionPayPalPlans(ctx, db, logger, fake)
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_SUBSCRIPTIONS_UNAVAILABLE), errcode.Code(err))
+	})
+}
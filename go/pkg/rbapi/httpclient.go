@@ -30,30 +30,246 @@ func (c *HTTPClient) AdminAddLicenseKey(ctx context.Context, input *AdminAddLice
 	return &result, err
 }
 
+func (c *HTTPClient) AdminApproveRefundRequest(ctx context.Context, input *AdminApproveRefundRequest_Input) (*AdminApproveRefundRequest_Output, error) {
+	var result AdminApproveRefundRequest_Output
+	err := c.doPost(ctx, "/admin/approve-refund-request", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminCreatePrice(ctx context.Context, input *AdminCreatePrice_Input) (*AdminCreatePrice_Output, error) {
+	var result AdminCreatePrice_Output
+	err := c.doPost(ctx, "/admin/create-price", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminCreateProduct(ctx context.Context, input *AdminCreateProduct_Input) (*AdminCreateProduct_Output, error) {
+	var result AdminCreateProduct_Output
+	err := c.doPost(ctx, "/admin/create-product", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminDeletePrice(ctx context.Context, input *AdminDeletePrice_Input) (*AdminDeletePrice_Output, error) {
+	var result AdminDeletePrice_Output
+	err := c.doPost(ctx, "/admin/delete-price", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminDeleteProduct(ctx context.Context, input *AdminDeleteProduct_Input) (*AdminDeleteProduct_Output, error) {
+	var result AdminDeleteProduct_Output
+	err := c.doPost(ctx, "/admin/delete-product", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminDenyRefundRequest(ctx context.Context, input *AdminDenyRefundRequest_Input) (*AdminDenyRefundRequest_Output, error) {
+	var result AdminDenyRefundRequest_Output
+	err := c.doPost(ctx, "/admin/deny-refund-request", input, &result)
+	return &result, err
+}
+
 func (c *HTTPClient) AdminGetActiveUsers(ctx context.Context, input *AdminGetActiveUsers_Input) (*AdminGetActiveUsers_Output, error) {
 	var result AdminGetActiveUsers_Output
 	err := c.doGet(ctx, "/admin/active-users", input, &result)
 	return &result, err
 }
 
+func (c *HTTPClient) AdminGetRevenue(ctx context.Context, input *AdminGetRevenue_Input) (*AdminGetRevenue_Output, error) {
+	var result AdminGetRevenue_Output
+	err := c.doPost(ctx, "/admin/revenue", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminGetTaxReport(ctx context.Context, input *AdminGetTaxReport_Input) (*AdminGetTaxReport_Output, error) {
+	var result AdminGetTaxReport_Output
+	err := c.doPost(ctx, "/admin/tax-report", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminGrantRole(ctx context.Context, input *AdminGrantRole_Input) (*AdminGrantRole_Output, error) {
+	var result AdminGrantRole_Output
+	err := c.doPost(ctx, "/admin/grant-role", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminImpersonateUser(ctx context.Context, input *AdminImpersonateUser_Input) (*AdminImpersonateUser_Output, error) {
+	var result AdminImpersonateUser_Output
+	err := c.doPost(ctx, "/admin/impersonate-user", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListCatalog(ctx context.Context, input *AdminListCatalog_Input) (*AdminListCatalog_Output, error) {
+	var result AdminListCatalog_Output
+	err := c.doPost(ctx, "/admin/catalog", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListExchangeRates(ctx context.Context, input *AdminListExchangeRates_Input) (*AdminListExchangeRates_Output, error) {
+	var result AdminListExchangeRates_Output
+	err := c.doPost(ctx, "/admin/exchange-rates", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListImpersonationAudits(ctx context.Context, input *AdminListImpersonationAudits_Input) (*AdminListImpersonationAudits_Output, error) {
+	var result AdminListImpersonationAudits_Output
+	err := c.doPost(ctx, "/admin/impersonation-audits", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListPayableCommissions(ctx context.Context, input *AdminListPayableCommissions_Input) (*AdminListPayableCommissions_Output, error) {
+	var result AdminListPayableCommissions_Output
+	err := c.doPost(ctx, "/admin/payable-commissions", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListRefundRequests(ctx context.Context, input *AdminListRefundRequests_Input) (*AdminListRefundRequests_Output, error) {
+	var result AdminListRefundRequests_Output
+	err := c.doPost(ctx, "/admin/refund-requests", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListRoles(ctx context.Context, input *AdminListRoles_Input) (*AdminListRoles_Output, error) {
+	var result AdminListRoles_Output
+	err := c.doPost(ctx, "/admin/roles", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListStuckOrders(ctx context.Context, input *AdminListStuckOrders_Input) (*AdminListStuckOrders_Output, error) {
+	var result AdminListStuckOrders_Output
+	err := c.doPost(ctx, "/admin/stuck-orders", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListTaxRates(ctx context.Context, input *AdminListTaxRates_Input) (*AdminListTaxRates_Output, error) {
+	var result AdminListTaxRates_Output
+	err := c.doPost(ctx, "/admin/tax-rates", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminListWebhookEvents(ctx context.Context, input *AdminListWebhookEvents_Input) (*AdminListWebhookEvents_Output, error) {
+	var result AdminListWebhookEvents_Output
+	err := c.doPost(ctx, "/admin/list-webhook-events", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminPayCommissions(ctx context.Context, input *AdminPayCommissions_Input) (*AdminPayCommissions_Output, error) {
+	var result AdminPayCommissions_Output
+	err := c.doPost(ctx, "/admin/pay-commissions", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminProvisionPayPalPlans(ctx context.Context, input *AdminProvisionPayPalPlans_Input) (*AdminProvisionPayPalPlans_Output, error) {
+	var result AdminProvisionPayPalPlans_Output
+	err := c.doPost(ctx, "/admin/provision-paypal-plans", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminRegenerateInvoices(ctx context.Context, input *AdminRegenerateInvoices_Input) (*AdminRegenerateInvoices_Output, error) {
+	var result AdminRegenerateInvoices_Output
+	err := c.doPost(ctx, "/admin/regenerate-invoices", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminReplayWebhookEvent(ctx context.Context, input *AdminReplayWebhookEvent_Input) (*AdminReplayWebhookEvent_Output, error) {
+	var result AdminReplayWebhookEvent_Output
+	err := c.doPost(ctx, "/admin/replay-webhook-event", input, &result)
+	return &result, err
+}
+
 func (c *HTTPClient) AdminRevokeLicense(ctx context.Context, input *AdminRevokeLicense_Input) (*AdminRevokeLicense_Output, error) {
 	var result AdminRevokeLicense_Output
 	err := c.doPost(ctx, "/admin/revoke-license-key", input, &result)
 	return &result, err
 }
 
+func (c *HTTPClient) AdminRevokeRole(ctx context.Context, input *AdminRevokeRole_Input) (*AdminRevokeRole_Output, error) {
+	var result AdminRevokeRole_Output
+	err := c.doPost(ctx, "/admin/revoke-role", input, &result)
+	return &result, err
+}
+
 func (c *HTTPClient) AdminSearchDatabase(ctx context.Context, input *AdminSearchDatabase_Input) (*AdminSearchDatabase_Output, error) {
 	var result AdminSearchDatabase_Output
 	err := c.doPost(ctx, "/admin/search-database", input, &result)
 	return &result, err
 }
 
+func (c *HTTPClient) AdminSetExchangeRate(ctx context.Context, input *AdminSetExchangeRate_Input) (*AdminSetExchangeRate_Output, error) {
+	var result AdminSetExchangeRate_Output
+	err := c.doPost(ctx, "/admin/set-exchange-rate", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminSetTaxRate(ctx context.Context, input *AdminSetTaxRate_Input) (*AdminSetTaxRate_Output, error) {
+	var result AdminSetTaxRate_Output
+	err := c.doPost(ctx, "/admin/set-tax-rate", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminSyncDiscourseGroup(ctx context.Context, input *AdminSyncDiscourseGroup_Input) (*AdminSyncDiscourseGroup_Output, error) {
+	var result AdminSyncDiscourseGroup_Output
+	err := c.doPost(ctx, "/admin/sync-discourse-group", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminUpdatePrice(ctx context.Context, input *AdminUpdatePrice_Input) (*AdminUpdatePrice_Output, error) {
+	var result AdminUpdatePrice_Output
+	err := c.doPost(ctx, "/admin/update-price", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AdminUpdateProduct(ctx context.Context, input *AdminUpdateProduct_Input) (*AdminUpdateProduct_Output, error) {
+	var result AdminUpdateProduct_Output
+	err := c.doPost(ctx, "/admin/update-product", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AuthExchangeSSO(ctx context.Context, input *AuthExchangeSSO_Input) (*AuthExchangeSSO_Output, error) {
+	var result AuthExchangeSSO_Output
+	err := c.doPost(ctx, "/auth/sso", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AuthRefreshSession(ctx context.Context, input *AuthRefreshSession_Input) (*AuthRefreshSession_Output, error) {
+	var result AuthRefreshSession_Output
+	err := c.doPost(ctx, "/auth/refresh", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) AuthStartSSO(ctx context.Context, input *AuthStartSSO_Input) (*AuthStartSSO_Output, error) {
+	var result AuthStartSSO_Output
+	err := c.doPost(ctx, "/auth/sso/start", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) UserCreateApiKey(ctx context.Context, input *UserCreateApiKey_Input) (*UserCreateApiKey_Output, error) {
+	var result UserCreateApiKey_Output
+	err := c.doPost(ctx, "/user/create-api-key", input, &result)
+	return &result, err
+}
+
 func (c *HTTPClient) UserGetSession(ctx context.Context, input *UserGetSession_Input) (*UserGetSession_Output, error) {
 	var result UserGetSession_Output
 	err := c.doGet(ctx, "/user/session", input, &result)
 	return &result, err
 }
 
+func (c *HTTPClient) UserListApiKeys(ctx context.Context, input *UserListApiKeys_Input) (*UserListApiKeys_Output, error) {
+	var result UserListApiKeys_Output
+	err := c.doGet(ctx, "/user/api-keys", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) UserLogoutEverywhere(ctx context.Context, input *UserLogoutEverywhere_Input) (*UserLogoutEverywhere_Output, error) {
+	var result UserLogoutEverywhere_Output
+	err := c.doPost(ctx, "/user/logout-everywhere", input, &result)
+	return &result, err
+}
+
+func (c *HTTPClient) UserRevokeApiKey(ctx context.Context, input *UserRevokeApiKey_Input) (*UserRevokeApiKey_Output, error) {
+	var result UserRevokeApiKey_Output
+	err := c.doPost(ctx, "/user/revoke-api-key", input, &result)
+	return &result, err
+}
+
 func (c *HTTPClient) Raw(ctx context.Context, method string, path string, input []byte) ([]byte, error) {
 	url := c.baseAPI + path
 	b := bytes.NewBuffer(input)
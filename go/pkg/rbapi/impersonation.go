@@ -0,0 +1,162 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// Impersonation tokens are opaque random strings like the session tokens, only their SHA-256 is stored in Redis
+const (
+	impersonationTokenPrefix = "rbi_"
+	impersonationKeyPrefix   = "impersonation:"
+)
+
+// impersonationRPCs are the RPCs an impersonation token can call, true for the ones changing the user data
+// Admin, auth, API key and logout RPCs are missing on purpose, an admin never gets more than the user had
+var impersonationRPCs = map[string]bool{
+	"/rslbot.api.Service/PaymentCreatePayPalCheckout":     true,
+	"/rslbot.api.Service/PaymentCreatePayPalSubscription": true,
+	"/rslbot.api.Service/PaymentCreateStripeCheckout":     true,
+	"/rslbot.api.Service/PaymentGetCheckoutStatus":        false,
+	"/rslbot.api.Service/PaymentWatchCheckoutStatus":      false,
+	"/rslbot.api.Service/UserCancelSubscription":          true,
+	"/rslbot.api.Service/UserGetInvoice":                  false,
+	"/rslbot.api.Service/UserGetLicenses":                 false,
+	"/rslbot.api.Service/UserGetPayment":                  false,
+	"/rslbot.api.Service/UserGetReferralCode":             true, // Creates the code on first call
+	"/rslbot.api.Service/UserGetSession":                  false,
+	"/rslbot.api.Service/UserGetSubscriptions":            false,
+	"/rslbot.api.Service/UserListApiKeys":                 false,
+	"/rslbot.api.Service/UserListCommissions":             false,
+	"/rslbot.api.Service/UserListPayments":                false,
+	"/rslbot.api.Service/UserRequestRefund":               true,
+	"/rslbot.api.Service/UserSyncDiscordRole":             true,
+}
+
+// impersonateMethod is recorded in the audit log when a token is created
+const impersonateMethod = "/rslbot.api.Service/AdminImpersonateUser"
+
+// Impersonation lets an admin call the user RPCs as another user, every call is recorded in the audit log
+type Impersonation struct {
+	ID        string              `json:"id"`
+	Admin     *rbdb.DiscourseUser `json:"admin"`
+	User      *rbdb.DiscourseUser `json:"user"`
+	UserId    int64               `json:"user_id"`
+	ReadWrite bool                `json:"read_write"`
+	Reason    string              `json:"reason"`
+	CreatedAt time.Time           `json:"created_at"`
+	ExpiresAt time.Time           `json:"expires_at"`
+}
+
+// isImpersonationToken tells if a bearer token is an impersonation token
+func isImpersonationToken(token string) bool {
+	return strings.HasPrefix(token, impersonationTokenPrefix)
+}
+
+// CreateImpersonation saves an impersonation until it expires and returns its token
+func (rs *RedisStore) CreateImpersonation(ctx context.Context, impersonation *Impersonation) (string, error) {
+	token, err := randomSessionToken(impersonationTokenPrefix)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(impersonation)
+	if err != nil {
+		return "", errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	ttl := impersonation.ExpiresAt.Sub(impersonation.CreatedAt)
+	if err := rs.client.Set(ctx, impersonationKeyPrefix+hashSessionToken(token), data, ttl).Err(); err != nil {
+		return "", errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return token, nil
+}
+
+// GetImpersonation returns the impersonation of a valid token
+func (rs *RedisStore) GetImpersonation(ctx context.Context, token string) (*Impersonation, error) {
+	data, err := rs.client.Get(ctx, impersonationKeyPrefix+hashSessionToken(token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(fmt.Errorf("unknown or expired impersonation token"))
+	}
+	if err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	var impersonation Impersonation
+	if err := json.Unmarshal(data, &impersonation); err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	if impersonation.Admin == nil || impersonation.User == nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(fmt.Errorf("impersonation %s has no admin or user", impersonation.ID))
+	}
+	return &impersonation, nil
+}
+
+// authenticateImpersonation checks that an impersonation token can call a method and records the call
+// Refused calls are recorded too, a call is refused when it can't be recorded
+func (svc *service) authenticateImpersonation(ctx context.Context, token string, path string) (context.Context, error) {
+	impersonation, err := svc.redis.GetImpersonation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	writes, known := impersonationRPCs[path]
+	var denied error
+	switch {
+	case !known:
+		denied = errcode.ERR_IMPERSONATION_METHOD_DENIED.Wrap(fmt.Errorf("%s can't be called while impersonating", path))
+	case writes && !impersonation.ReadWrite:
+		denied = errcode.ERR_IMPERSONATION_READ_ONLY.Wrap(fmt.Errorf("%s changes the user data", path))
+	default:
+		// The admin may have lost the permission since the token was created
+		denied = authorizeUser(svc.db, svc.cfg.Roles, impersonation.Admin, PermissionImpersonate)
+	}
+
+	if err := svc.auditImpersonation(impersonation, path, denied == nil); err != nil {
+		return nil, err
+	}
+	if denied != nil {
+		return nil, denied
+	}
+
+	ctx = context.WithValue(ctx, userInfoCtx, impersonation.User)
+	ctx = context.WithValue(ctx, impersonationCtx, impersonation)
+	return ctx, nil
+}
+
+// auditImpersonation records a call made with an impersonation
+func (svc *service) auditImpersonation(impersonation *Impersonation, method string, allowed bool) error {
+	err := rbdb.CreateImpersonationAudit(svc.db, &rbdb.ImpersonationAuditORM{
+		ImpersonationId:  impersonation.ID,
+		AdminDiscourseId: impersonation.Admin.ExternalId,
+		AdminUsername:    impersonation.Admin.Username,
+		Method:           method,
+		ReadWrite:        impersonation.ReadWrite,
+		Allowed:          allowed,
+		Reason:           impersonation.Reason,
+		UserId:           impersonation.UserId,
+	})
+	if err != nil {
+		svc.logger.Error("Failed to record an impersonated call",
+			zap.String("impersonation_id", impersonation.ID),
+			zap.String("admin", impersonation.Admin.Username),
+			zap.Int64("user_id", impersonation.UserId),
+			zap.String("method", method),
+			zap.Error(err))
+		return errcode.ERR_IMPERSONATION_AUDIT_FAILED.Wrap(err)
+	}
+	return nil
+}
+
+// impersonationFromContext returns the impersonation that authenticated the request, nil for other tokens
+func impersonationFromContext(ctx context.Context) *Impersonation {
+	impersonation, _ := ctx.Value(impersonationCtx).(*Impersonation)
+	return impersonation
+}
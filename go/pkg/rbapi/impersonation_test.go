@@ -0,0 +1,123 @@
+package rbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestImpersonation(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+		Config: TestingRolesConfig(),
+	})
+	defer cleanup()
+	typed := svc.(*service)
+	db := TestingSvcDB(t, svc)
+
+	_, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 9601, Username: "ops", Email: "ops@example.com"}, db)
+	require.NoError(t, err)
+	adminCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 9601, Username: "ops", Email: "ops@example.com", Admin: true})
+	supportCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 9603, Username: "helper", Groups: []string{typed.cfg.Roles.SupportGroup}})
+	target := CreateTestUserWithActiveLicense(t, svc, 9602)
+
+	impersonate := func(t *testing.T, readWrite bool) string {
+		t.Helper()
+		out, err := svc.AdminImpersonateUser(adminCtx, &AdminImpersonateUser_Input{UserId: target.User.Id, ReadWrite: readWrite, Reason: "ticket 42"})
+		require.NoError(t, err)
+		assert.Equal(t, target.User.Id, out.User.Id)
+		return out.AccessToken
+	}
+
+	t.Run("only admins can impersonate", func(t *testing.T) {
+		_, err := svc.AdminImpersonateUser(supportCtx, &AdminImpersonateUser_Input{UserId: target.User.Id})
+		assert.Equal(t, int32(errcode.ERR_RESTRICTED_AREA), errcode.Code(err))
+		_, err = svc.AdminListImpersonationAudits(supportCtx, &AdminListImpersonationAudits_Input{})
+		assert.Equal(t, int32(errcode.ERR_RESTRICTED_AREA), errcode.Code(err))
+		_, err = svc.AdminImpersonateUser(adminCtx, &AdminImpersonateUser_Input{})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+		_, err = svc.AdminImpersonateUser(adminCtx, &AdminImpersonateUser_Input{UserId: 999999})
+		assert.Equal(t, int32(errcode.ERR_USER_NOT_FOUND), errcode.Code(err))
+	})
+
+	t.Run("read-only tokens call the read RPCs as the user", func(t *testing.T) {
+		token := impersonate(t, false)
+
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "UserGetLicenses", token)
+		require.NoError(t, err)
+		licenses, err := svc.UserGetLicenses(authCtx, &UserGetLicenses_Input{})
+		require.NoError(t, err)
+		require.Len(t, licenses.Licenses, 1)
+		assert.Equal(t, target.Licenses[0].Id, licenses.Licenses[0].Id)
+
+		authCtx, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", token)
+		require.NoError(t, err)
+		session, err := svc.UserGetSession(authCtx, &UserGetSession_Input{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(9602), session.User.DiscourseId)
+		assert.Equal(t, "ops", session.ImpersonatedBy)
+		assert.False(t, session.ImpersonationReadWrite)
+
+		_, err = TestingAuthenticate(ctx, t, svc, "PaymentCreatePayPalCheckout", token)
+		assert.Equal(t, int32(errcode.ERR_IMPERSONATION_READ_ONLY), errcode.Code(err))
+		// Admin, key and logout RPCs are never reachable
+		for _, method := range []string{"AdminSearchDatabase", "UserCreateApiKey", "UserLogoutEverywhere"} {
+			_, err = TestingAuthenticate(ctx, t, svc, method, token)
+			assert.Equal(t, int32(errcode.ERR_IMPERSONATION_METHOD_DENIED), errcode.Code(err), method)
+		}
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", token+"x")
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+	})
+
+	t.Run("read-write tokens call the write RPCs", func(t *testing.T) {
+		token := impersonate(t, true)
+
+		_, err := TestingAuthenticate(ctx, t, svc, "PaymentCreatePayPalCheckout", token)
+		require.NoError(t, err)
+		_, err = TestingAuthenticate(ctx, t, svc, "AdminGrantRole", token)
+		assert.Equal(t, int32(errcode.ERR_IMPERSONATION_METHOD_DENIED), errcode.Code(err))
+	})
+
+	t.Run("every call is audited", func(t *testing.T) {
+		out, err := svc.AdminListImpersonationAudits(adminCtx, &AdminListImpersonationAudits_Input{UserId: target.User.Id})
+		require.NoError(t, err)
+		// 2 creations, 3 allowed calls and 5 refused ones, the unknown token isn't attributable
+		require.Len(t, out.Audits, 10)
+
+		allowed := map[string]int{}
+		for _, audit := range out.Audits {
+			assert.Equal(t, "ops", audit.AdminUsername)
+			assert.Equal(t, int64(9601), audit.AdminDiscourseId)
+			assert.Equal(t, "ticket 42", audit.Reason)
+			assert.Equal(t, int64(9602), audit.User.DiscourseId)
+			if audit.Allowed {
+				allowed[audit.Method]++
+			}
+		}
+		assert.Equal(t, map[string]int{
+			"/rslbot.api.Service/AdminImpersonateUser":        2,
+			"/rslbot.api.Service/UserGetLicenses":             1,
+			"/rslbot.api.Service/UserGetSession":              1,
+			"/rslbot.api.Service/PaymentCreatePayPalCheckout": 1,
+		}, allowed)
+
+		// Newest first
+		assert.Equal(t, "/rslbot.api.Service/AdminGrantRole", out.Audits[0].Method)
+		assert.True(t, out.Audits[0].ReadWrite)
+		assert.False(t, out.Audits[0].Allowed)
+
+		out, err = svc.AdminListImpersonationAudits(adminCtx, &AdminListImpersonationAudits_Input{AdminUsername: "helper"})
+		require.NoError(t, err)
+		assert.Empty(t, out.Audits)
+		out, err = svc.AdminListImpersonationAudits(adminCtx, &AdminListImpersonationAudits_Input{Limit: 3})
+		require.NoError(t, err)
+		assert.Len(t, out.Audits, 3)
+	})
+}
@@ -0,0 +1,204 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/internal/pdf"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// defaultInvoiceTemplate lays out the invoices, see loadInvoiceTemplate for the markup
+const defaultInvoiceTemplate = `# {{.Title}} {{.Number}}
+Issued on {{.IssuedAt}}
+{{- if .CreditedNumber}}
+Credits invoice {{.CreditedNumber}}
+{{- end}}
+
+## Seller
+{{.Seller.Name}}
+{{- range .Seller.Address}}
+{{.}}
+{{- end}}
+{{- if .Seller.Email}}
+{{.Seller.Email}}
+{{- end}}
+{{- if .Seller.TaxID}}
+VAT ID: {{.Seller.TaxID}}
+{{- end}}
+
+## Billed to
+{{if .BillingName}}{{.BillingName}}{{else}}{{.BillingEmail}}{{end}}
+{{- if and .BillingName .BillingEmail}}
+{{.BillingEmail}}
+{{- end}}
+
+---
+## Description	Amount
+{{.Description}}	{{.Amount}}
+---
+## Total	{{.Amount}}
+`
+
+var defaultInvoiceLayout = template.Must(template.New("invoice").Parse(defaultInvoiceTemplate))
+
+// loadInvoiceTemplate returns the layout of the invoices, the configured file is a text/template executed with an invoiceDocument
+// Each output line is a line of the document: "# " starts a title, "## " a bold line, "---" draws a rule
+// and a tab separates a right-aligned column
+func loadInvoiceTemplate(cfg config.Invoice) (*template.Template, error) {
+	if cfg.Template == "" {
+		return defaultInvoiceLayout, nil
+	}
+	text, err := os.ReadFile(cfg.Template)
+	if err != nil {
+		return nil, errcode.ERR_INVOICE_TEMPLATE.Wrap(err)
+	}
+	parsed, err := template.New("invoice").Parse(string(text))
+	if err != nil {
+		return nil, errcode.ERR_INVOICE_TEMPLATE.Wrap(err)
+	}
+	return parsed, nil
+}
+
+// invoiceDocument is the data of the invoice template
+type invoiceDocument struct {
+	Title          string
+	Number         string
+	IssuedAt       string
+	CreditedNumber string
+	Seller         struct {
+		Name    string
+		Address []string
+		Email   string
+		TaxID   string
+	}
+	BillingName  string
+	BillingEmail string
+	Description  string
+	Amount       string // Formatted with its currency, negative for credit notes
+}
+
+// Layout of the rendered invoices, in points
+const (
+	invoiceMargin     = 56.0
+	invoiceTitleSize  = 18.0
+	invoiceTextSize   = 10.0
+	invoiceLineFactor = 1.6
+)
+
+// renderInvoicePDF renders an invoice or a credit note, creditedNumber is the number of the invoice a credit note corrects
+func renderInvoicePDF(invoiceOrm *rbdb.InvoiceORM, seller config.Invoice, creditedNumber string) ([]byte, error) {
+	layout, err := loadInvoiceTemplate(seller)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := invoiceDocument{
+		Title:          "Invoice",
+		Number:         invoiceOrm.Number,
+		CreditedNumber: creditedNumber,
+		BillingName:    invoiceOrm.BillingName,
+		BillingEmail:   invoiceOrm.BillingEmail,
+		Description:    invoiceOrm.Description,
+		Amount:         formatAmount(invoiceOrm.AmountInCents, invoiceOrm.Currency) + " " + strings.ToUpper(invoiceOrm.Currency),
+	}
+	if rbdb.Invoice_Kind(invoiceOrm.Kind) == rbdb.Invoice_KIND_CREDIT_NOTE {
+		doc.Title = "Credit Note"
+		doc.Amount = "-" + doc.Amount
+	}
+	if invoiceOrm.IssuedAt != nil {
+		doc.IssuedAt = invoiceOrm.IssuedAt.UTC().Format(time.DateOnly)
+	}
+	doc.Seller.Name = seller.SellerName
+	doc.Seller.Address = seller.SellerAddress
+	doc.Seller.Email = seller.SellerEmail
+	doc.Seller.TaxID = seller.SellerTaxID
+
+	var text bytes.Buffer
+	if err := layout.Execute(&text, doc); err != nil {
+		return nil, errcode.ERR_INVOICE_TEMPLATE.Wrap(err)
+	}
+
+	document := pdf.New()
+	page := document.AddPage()
+	y := pdf.PageHeight - invoiceMargin
+	for _, line := range strings.Split(strings.TrimRight(text.String(), "\n"), "\n") {
+		font, size := pdf.Helvetica, invoiceTextSize
+		switch {
+		case strings.HasPrefix(line, "# "):
+			font, size, line = pdf.HelveticaBold, invoiceTitleSize, strings.TrimPrefix(line, "# ")
+		case strings.HasPrefix(line, "## "):
+			font, line = pdf.HelveticaBold, strings.TrimPrefix(line, "## ")
+		}
+
+		if y-size*invoiceLineFactor < invoiceMargin {
+			page = document.AddPage()
+			y = pdf.PageHeight - invoiceMargin
+		}
+		y -= size * invoiceLineFactor
+
+		if strings.TrimSpace(line) == "---" {
+			page.Line(invoiceMargin, y+size/2, pdf.PageWidth-invoiceMargin, y+size/2)
+			continue
+		}
+		left, right, hasRight := strings.Cut(line, "\t")
+		page.Text(invoiceMargin, y, font, size, left)
+		if hasRight {
+			right = strings.TrimSpace(right)
+			page.Text(pdf.PageWidth-invoiceMargin-pdf.CourierWidth(size, right), y, pdf.Courier, size, right)
+		}
+	}
+	return document.Bytes(), nil
+}
+
+// invoicePDF returns the document of an invoice, rendered and stored on first use or when forced
+func invoicePDF(db *gorm.DB, seller config.Invoice, invoiceOrm *rbdb.InvoiceORM, force bool) ([]byte, error) {
+	if len(invoiceOrm.Pdf) > 0 && !force {
+		return invoiceOrm.Pdf, nil
+	}
+
+	creditedNumber := ""
+	if invoiceOrm.CreditedInvoiceId != 0 {
+		creditedOrm, err := rbdb.GetInvoice(db, invoiceOrm.CreditedInvoiceId)
+		if err != nil {
+			return nil, err
+		}
+		creditedNumber = creditedOrm.Number
+	}
+
+	document, err := renderInvoicePDF(invoiceOrm, seller, creditedNumber)
+	if err != nil {
+		return nil, err
+	}
+	if err := rbdb.SaveInvoicePDF(db, invoiceOrm, document, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// invoicesToPB converts invoices for the API, without their document
+func invoicesToPB(ctx context.Context, invoicesOrm []*rbdb.InvoiceORM) ([]*rbdb.Invoice, error) {
+	invoices := make([]*rbdb.Invoice, 0, len(invoicesOrm))
+	for _, invoiceOrm := range invoicesOrm {
+		invoicePb, err := invoiceOrm.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_INVOICE_PROTOBUF_CONVERSION.Wrap(err)
+		}
+		invoicePb.Pdf = nil
+		invoices = append(invoices, &invoicePb)
+	}
+	return invoices, nil
+}
+
+// invoiceFilename is the name of the downloaded document of an invoice
+func invoiceFilename(invoiceOrm *rbdb.InvoiceORM) string {
+	return fmt.Sprintf("%s.pdf", invoiceOrm.Number)
+}
@@ -0,0 +1,228 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestInvoices(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, _ := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	userCtx := TestingSetContextToken(ctx, t)
+	session, err := svc.UserGetSession(userCtx, &UserGetSession_Input{})
+	require.NoError(t, err)
+	buyer := session.User
+
+	year := time.Now().UTC().Year()
+	invoiceNumber := func(prefix string, number int) string {
+		return fmt.Sprintf("%s-%d-%06d", prefix, year, number)
+	}
+	purchase := func(t *testing.T, referenceID string, status rbdb.Payment_Status) *rbdb.PaymentORM {
+		t.Helper()
+		payment := &rbdb.Payment{
+			Provider:      rbdb.Payment_PROVIDER_PAYPAL,
+			ReferenceId:   referenceID,
+			AmountInCents: 1900,
+			Currency:      "eur",
+			BillingName:   "Jane Doe",
+			BillingEmail:  "jane@example.com",
+		}
+		if status != rbdb.Payment_STATUS_UNSPECIFIED {
+			rbdb.SetPaymentStatus(payment, status, time.Now().UTC())
+		}
+		require.NoError(t, fulfillLicensePayment(ctx, db, logger, svc.Config(), payment, map[string]string{
+			"user_id":  fmt.Sprintf("%d", buyer.Id),
+			"duration": rbdb.LicenseKey_ONE_MONTH.String(),
+		}))
+		paymentOrm, err := rbdb.GetPaymentByReference(db, referenceID)
+		require.NoError(t, err)
+		return paymentOrm
+	}
+
+	var firstPayment *rbdb.PaymentORM
+	t.Run("completed payments get sequential invoices", func(t *testing.T) {
+		firstPayment = purchase(t, "CAP-invoice-1", rbdb.Payment_STATUS_UNSPECIFIED)
+		invoiceOrm, err := rbdb.GetPaymentInvoice(db, firstPayment.Id)
+		require.NoError(t, err)
+		assert.Equal(t, invoiceNumber("INV", 1), invoiceOrm.Number)
+		assert.Equal(t, "1-Month License", invoiceOrm.Description)
+		assert.Equal(t, int64(1900), invoiceOrm.AmountInCents)
+		assert.Equal(t, "Jane Doe", invoiceOrm.BillingName)
+		assert.Empty(t, invoiceOrm.Pdf)
+
+		secondPayment := purchase(t, "CAP-invoice-2", rbdb.Payment_STATUS_UNSPECIFIED)
+		invoiceOrm, err = rbdb.GetPaymentInvoice(db, secondPayment.Id)
+		require.NoError(t, err)
+		assert.Equal(t, invoiceNumber("INV", 2), invoiceOrm.Number)
+	})
+
+	t.Run("pending payments are invoiced once completed", func(t *testing.T) {
+		paymentOrm := purchase(t, "CAP-invoice-pending", rbdb.Payment_STATUS_PENDING)
+		_, err := rbdb.GetPaymentInvoice(db, paymentOrm.Id)
+		assert.Equal(t, int32(errcode.ERR_INVOICE_NOT_FOUND), errcode.Code(err))
+
+		_, completed, err := rbdb.CompletePayment(db, paymentOrm.Id, time.Now().UTC())
+		require.NoError(t, err)
+		require.True(t, completed)
+		invoiceOrm, err := rbdb.GetPaymentInvoice(db, paymentOrm.Id)
+		require.NoError(t, err)
+		assert.Equal(t, invoiceNumber("INV", 3), invoiceOrm.Number)
+	})
+
+	t.Run("rolled back issuing leaves no gap", func(t *testing.T) {
+		// A payment recorded without invoice, as before invoicing existed
+		payment, err := rbdb.DefaultCreatePayment(ctx, &rbdb.Payment{
+			Provider:      rbdb.Payment_PROVIDER_STRIPE,
+			ReferenceId:   "cs_invoice_legacy",
+			AmountInCents: 7900,
+			Currency:      "eur",
+			Status:        rbdb.Payment_STATUS_COMPLETED,
+			UserId:        buyer.Id,
+		}, db)
+		require.NoError(t, err)
+
+		rollback := errors.New("rollback")
+		err = db.Transaction(func(tx *gorm.DB) error {
+			invoiceOrm, err := rbdb.IssueInvoiceTx(tx, payment.Id, time.Now().UTC())
+			require.NoError(t, err)
+			assert.Equal(t, invoiceNumber("INV", 4), invoiceOrm.Number)
+			return rollback
+		})
+		require.ErrorIs(t, err, rollback)
+
+		_, err = svc.AdminRegenerateInvoices(ctx, &AdminRegenerateInvoices_Input{PaymentId: payment.Id})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		out, err := svc.AdminRegenerateInvoices(adminCtx, &AdminRegenerateInvoices_Input{PaymentId: payment.Id})
+		require.NoError(t, err)
+		require.Len(t, out.Invoices, 1)
+		assert.Equal(t, invoiceNumber("INV", 4), out.Invoices[0].Number)
+		assert.Empty(t, out.Invoices[0].Pdf)
+		assert.NotNil(t, out.Invoices[0].RenderedAt)
+	})
+
+	t.Run("a new year starts a new series", func(t *testing.T) {
+		nextYear := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		for i, referenceID := range []string{"cs_invoice_next_1", "cs_invoice_next_2"} {
+			payment, err := rbdb.DefaultCreatePayment(ctx, &rbdb.Payment{
+				Provider:      rbdb.Payment_PROVIDER_STRIPE,
+				ReferenceId:   referenceID,
+				AmountInCents: 1900,
+				Currency:      "eur",
+				Status:        rbdb.Payment_STATUS_COMPLETED,
+				UserId:        buyer.Id,
+			}, db)
+			require.NoError(t, err)
+			invoiceOrm, err := rbdb.IssueInvoiceTx(db, payment.Id, nextYear)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("INV-%d-%06d", year+1, i+1), invoiceOrm.Number)
+		}
+	})
+
+	t.Run("manual payments are not invoiced", func(t *testing.T) {
+		payment, err := rbdb.DefaultCreatePayment(ctx, &rbdb.Payment{
+			Provider:    rbdb.Payment_PROVIDER_MANUAL,
+			ReferenceId: "manual-invoice",
+			Status:      rbdb.Payment_STATUS_COMPLETED,
+			UserId:      buyer.Id,
+		}, db)
+		require.NoError(t, err)
+
+		_, err = svc.AdminRegenerateInvoices(adminCtx, &AdminRegenerateInvoices_Input{PaymentId: payment.Id})
+		assert.Equal(t, int32(errcode.ERR_INVOICE_NOT_ISSUABLE), errcode.Code(err))
+	})
+
+	t.Run("refunds issue credit notes", func(t *testing.T) {
+		_, applied, err := rbdb.RefundPayment(db, firstPayment.Id, "REF-1", 500, time.Now().UTC())
+		require.NoError(t, err)
+		require.True(t, applied)
+		_, applied, err = rbdb.RefundPayment(db, firstPayment.Id, "REF-1", 500, time.Now().UTC())
+		require.NoError(t, err)
+		require.False(t, applied)
+		_, applied, err = rbdb.RefundPayment(db, firstPayment.Id, "REF-2", 0, time.Now().UTC())
+		require.NoError(t, err)
+		require.True(t, applied)
+
+		invoicesOrm, err := rbdb.ListPaymentInvoices(db, firstPayment.Id)
+		require.NoError(t, err)
+		require.Len(t, invoicesOrm, 3)
+		invoiceOrm, firstNote, secondNote := invoicesOrm[0], invoicesOrm[1], invoicesOrm[2]
+		assert.Equal(t, int32(rbdb.Invoice_KIND_CREDIT_NOTE), firstNote.Kind)
+		assert.Equal(t, invoiceNumber("CN", 1), firstNote.Number)
+		assert.Equal(t, int64(500), firstNote.AmountInCents)
+		assert.Equal(t, invoiceOrm.Id, firstNote.CreditedInvoiceId)
+		assert.Equal(t, "REF-1", firstNote.RefundId)
+		assert.Equal(t, invoiceNumber("CN", 2), secondNote.Number)
+		assert.Equal(t, int64(1400), secondNote.AmountInCents)
+	})
+
+	t.Run("users download their invoices", func(t *testing.T) {
+		_, err := svc.UserGetInvoice(userCtx, &UserGetInvoice_Input{})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+
+		out, err := svc.UserGetInvoice(userCtx, &UserGetInvoice_Input{PaymentId: firstPayment.Id})
+		require.NoError(t, err)
+		assert.Equal(t, invoiceNumber("INV", 1), out.Invoice.Number)
+		assert.Equal(t, invoiceNumber("INV", 1)+".pdf", out.Filename)
+		assert.Empty(t, out.Invoice.Pdf)
+		assert.True(t, bytes.HasPrefix(out.Pdf, []byte("%PDF-1.4")))
+		assert.True(t, bytes.HasSuffix(out.Pdf, []byte("%%EOF\n")))
+		assert.Contains(t, string(out.Pdf), "(Invoice "+invoiceNumber("INV", 1)+")")
+		assert.Contains(t, string(out.Pdf), "(19.00 EUR)")
+		require.Len(t, out.CreditNotes, 2)
+
+		// The document is stored on first download
+		invoiceOrm, err := rbdb.GetPaymentInvoice(db, firstPayment.Id)
+		require.NoError(t, err)
+		assert.Equal(t, out.Pdf, invoiceOrm.Pdf)
+
+		note, err := svc.UserGetInvoice(userCtx, &UserGetInvoice_Input{InvoiceId: out.CreditNotes[0].Id})
+		require.NoError(t, err)
+		assert.Contains(t, string(note.Pdf), "(Credits invoice "+invoiceNumber("INV", 1)+")")
+		assert.Contains(t, string(note.Pdf), "(-5.00 EUR)")
+
+		// Other users can't see the invoice
+		_, err = rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 987654, Username: "other"}, db)
+		require.NoError(t, err)
+		otherCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 987654, Username: "other"})
+		_, err = svc.UserGetInvoice(otherCtx, &UserGetInvoice_Input{InvoiceId: invoiceOrm.Id})
+		assert.Equal(t, int32(errcode.ERR_INVOICE_NOT_FOUND), errcode.Code(err))
+	})
+
+	t.Run("regenerating applies the new seller details", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "invalid.tmpl")
+		require.NoError(t, os.WriteFile(invalid, []byte("{{.Missing"), 0o600))
+		_, err := loadInvoiceTemplate(config.Invoice{Template: invalid})
+		assert.Equal(t, int32(errcode.ERR_INVOICE_TEMPLATE), errcode.Code(err))
+
+		layout := filepath.Join(t.TempDir(), "invoice.tmpl")
+		require.NoError(t, os.WriteFile(layout, []byte("# {{.Title}} {{.Number}}\n## Seller\t{{.Seller.Name}}\n"), 0o600))
+		svc.Config().Invoice.Template = layout
+		svc.Config().Invoice.SellerName = "RSL Bot (Renamed)"
+
+		out, err := svc.AdminRegenerateInvoices(adminCtx, &AdminRegenerateInvoices_Input{PaymentId: firstPayment.Id})
+		require.NoError(t, err)
+		require.Len(t, out.Invoices, 3)
+		assert.Equal(t, invoiceNumber("INV", 1), out.Invoices[0].Number)
+
+		invoice, err := svc.UserGetInvoice(userCtx, &UserGetInvoice_Input{PaymentId: firstPayment.Id})
+		require.NoError(t, err)
+		assert.Contains(t, string(invoice.Pdf), `(RSL Bot \(Renamed\))`)
+		assert.NotContains(t, string(invoice.Pdf), "(19.00 EUR)")
+	})
+}
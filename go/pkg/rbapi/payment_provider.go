@@ -0,0 +1,371 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/plutov/paypal/v4"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PaymentProvider is the payment service behind the one-time license checkouts and their webhooks
+// Orders are created, approved by the payer, captured, then refunded by capture.
+// PayPalProvider is the production implementation, FakePaymentProvider simulates it in tests and in local dev mode
+type PaymentProvider interface {
+	// SandboxMode tells if the payments are test payments
+	SandboxMode() bool
+	// CreateOrder creates an order for the payer to approve at its approval URL
+	CreateOrder(ctx context.Context, in *PaymentOrderRequest) (*PaymentOrder, error)
+	// CaptureOrder captures an approved order, capturing the same order again does not charge twice
+	// The returned order may only carry its status and captures
+	CaptureOrder(ctx context.Context, orderID string) (*PaymentOrder, error)
+	// GetOrder looks up an order with its metadata, amount, payer and captures
+	GetOrder(ctx context.Context, orderID string) (*PaymentOrder, error)
+	// RefundCapture refunds the whole amount of a capture, the request ID makes retries of the same refund idempotent
+	RefundCapture(ctx context.Context, captureID string, requestID string) (*PaymentRefund, error)
+	// VerifyWebhook checks that a webhook request was sent by the provider, it may consume the request body
+	VerifyWebhook(r *http.Request) error
+}
+
+// PaymentOrderStatus is the status of an order on its provider
+type PaymentOrderStatus string
+
+const (
+	PaymentOrderCreated   PaymentOrderStatus = "created"   // Waiting for the payer
+	PaymentOrderApproved  PaymentOrderStatus = "approved"  // Approved by the payer, not captured yet
+	PaymentOrderCompleted PaymentOrderStatus = "completed" // Captured
+	PaymentOrderVoided    PaymentOrderStatus = "voided"    // Canceled or expired
+)
+
+// PaymentCaptureStatus is the status of a captured payment on its provider
+type PaymentCaptureStatus string
+
+const (
+	PaymentCaptureCompleted PaymentCaptureStatus = "completed"
+	PaymentCapturePending   PaymentCaptureStatus = "pending" // Funds held by the provider
+	PaymentCaptureDeclined  PaymentCaptureStatus = "declined"
+	PaymentCaptureRefunded  PaymentCaptureStatus = "refunded"
+)
+
+// PaymentOrderRequest describes the single item sold by a checkout order
+type PaymentOrderRequest struct {
+	ReferenceID   string
+	Metadata      map[string]string // Returned with the order, it tells what to deliver
+	Currency      string
+	AmountInCents int64 // Charged amount, tax included
+	TaxInCents    int64
+	ItemName      string
+	ItemImageURL  string
+	ReturnURL     string // Where the payer is sent after approving the order
+	CancelURL     string
+}
+
+// PaymentOrder is a checkout order as seen by its provider
+type PaymentOrder struct {
+	ID            string
+	Status        PaymentOrderStatus
+	ApprovalURL   string
+	Metadata      map[string]string
+	Currency      string
+	AmountInCents int64
+	PayerEmail    string
+	PayerName     string
+	Captures      []PaymentCapture
+}
+
+// PaymentCapture is a payment captured for an order
+type PaymentCapture struct {
+	ID     string
+	Status PaymentCaptureStatus
+}
+
+// PaymentRefund is a refund sent back to the payer
+type PaymentRefund struct {
+	ID string
+}
+
+// PayPalProvider implements PaymentProvider with the PayPal REST API
+// It also bills the PayPal subscriptions, which the other providers don't support
+type PayPalProvider struct {
+	clientID     string
+	clientSecret string
+	webhookID    string
+	sandbox      bool
+	apiBase      string
+}
+
+// NewPayPalProvider returns the PayPal provider of a configuration, the credentials are required
+func NewPayPalProvider(cfg config.PayPal) (*PayPalProvider, error) {
+	if cfg.ClientID == "" || cfg.ClientSecret == "" || cfg.WebhookID == "" {
+		return nil, fmt.Errorf("paypal client id, client secret and webhook id are required without fake payments")
+	}
+
+	apiBase := paypal.APIBaseLive
+	if cfg.Sandbox {
+		apiBase = paypal.APIBaseSandBox
+	}
+	return newPayPalProvider(cfg, apiBase), nil
+}
+
+// newPayPalProvider returns a PayPal provider calling the API at apiBase
+func newPayPalProvider(cfg config.PayPal, apiBase string) *PayPalProvider {
+	return &PayPalProvider{
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		webhookID:    cfg.WebhookID,
+		sandbox:      cfg.Sandbox,
+		apiBase:      apiBase,
+	}
+}
+
+// Client returns a new PayPal client with OAuth token
+func (p *PayPalProvider) Client(ctx context.Context) (*paypal.Client, error) {
+	client, err := paypal.NewClient(p.clientID, p.clientSecret, p.apiBase)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_CREATE_PAYPAL_OAUTH_TOKEN.Wrap(err)
+	}
+
+	// Get OAuth token
+	_, err = client.GetAccessToken(ctx)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_CREATE_PAYPAL_OAUTH_TOKEN.Wrap(err)
+	}
+
+	return client, nil
+}
+
+func (p *PayPalProvider) SandboxMode() bool {
+	return p.sandbox
+}
+
+func (p *PayPalProvider) CreateOrder(ctx context.Context, in *PaymentOrderRequest) (*PaymentOrder, error) {
+	ppClient, err := p.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The metadata is stored in the custom_id of the purchase unit
+	metadataJSON, err := json.Marshal(in.Metadata)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(err)
+	}
+
+	// Format amounts with the decimals of the currency
+	currency := strings.ToUpper(in.Currency)
+	amountString := formatPayPalAmount(in.AmountInCents, currency)
+	netString := formatPayPalAmount(in.AmountInCents-in.TaxInCents, currency)
+	taxString := formatPayPalAmount(in.TaxInCents, currency)
+
+	order, err := ppClient.CreateOrder(ctx, paypal.OrderIntentCapture,
+		[]paypal.PurchaseUnitRequest{
+			{
+				ReferenceID: in.ReferenceID,
+				CustomID:    string(metadataJSON),
+				Amount: &paypal.PurchaseUnitAmount{
+					Value:    amountString,
+					Currency: currency,
+					Breakdown: &paypal.PurchaseUnitAmountBreakdown{
+						ItemTotal: &paypal.Money{
+							Value:    netString,
+							Currency: currency,
+						},
+						TaxTotal: &paypal.Money{
+							Value:    taxString,
+							Currency: currency,
+						},
+					},
+				},
+				Items: []paypal.Item{
+					{
+						Name: in.ItemName,
+						UnitAmount: &paypal.Money{
+							Value:    netString,
+							Currency: currency,
+						},
+						Tax: &paypal.Money{
+							Value:    taxString,
+							Currency: currency,
+						},
+						Quantity: "1",
+						Category: paypal.ItemCategoryDigitalGood,
+						ImageURL: in.ItemImageURL,
+					},
+				},
+			},
+		},
+		nil,
+		&paypal.ApplicationContext{
+			ReturnURL:          in.ReturnURL,
+			CancelURL:          in.CancelURL,
+			UserAction:         paypal.UserActionPayNow,
+			ShippingPreference: paypal.ShippingPreferenceNoShipping,
+			LandingPage:        "BILLING",
+		},
+	)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_CREATE_PAYPAL_CHECKOUT_SESSION.Wrap(err)
+	}
+	return paypalPaymentOrder(order)
+}
+
+// CaptureOrder uses the order ID as request ID, so the webhook and the reconciliation cannot capture an order twice
+func (p *PayPalProvider) CaptureOrder(ctx context.Context, orderID string) (*PaymentOrder, error) {
+	ppClient, err := p.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	captureResult, err := ppClient.CaptureOrderWithPaypalRequestId(ctx, orderID, paypal.CaptureOrderRequest{}, "capture-"+orderID, nil)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED.Wrap(err)
+	}
+
+	order := &PaymentOrder{
+		ID:     captureResult.ID,
+		Status: paypalOrderStatus(captureResult.Status),
+	}
+	for _, unit := range captureResult.PurchaseUnits {
+		if unit.Payments != nil {
+			order.Captures = append(order.Captures, paypalPaymentCaptures(unit.Payments.Captures)...)
+		}
+	}
+	return order, nil
+}
+
+func (p *PayPalProvider) GetOrder(ctx context.Context, orderID string) (*PaymentOrder, error) {
+	ppClient, err := p.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := ppClient.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, errcode.ERR_PAYMENT_RETRIEVE_PAYPAL_ORDER.Wrap(err)
+	}
+	return paypalPaymentOrder(order)
+}
+
+func (p *PayPalProvider) RefundCapture(ctx context.Context, captureID string, requestID string) (*PaymentRefund, error) {
+	ppClient, err := p.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := ppClient.RefundCaptureWithPaypalRequestId(ctx, captureID, paypal.RefundCaptureRequest{}, requestID)
+	if err != nil {
+		return nil, errcode.ERR_REFUND_REQUEST_PAYPAL_REFUND.Wrap(err)
+	}
+	return &PaymentRefund{ID: refund.ID}, nil
+}
+
+// VerifyWebhook asks PayPal to verify the signature headers against the configured webhook ID
+func (p *PayPalProvider) VerifyWebhook(r *http.Request) error {
+	ppClient, err := p.Client(r.Context())
+	if err != nil {
+		return err
+	}
+
+	verifyResponse, err := ppClient.VerifyWebhookSignature(r.Context(), r, p.webhookID)
+	if err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_WEBHOOK_SIGNATURE_INVALID.Wrap(err)
+	}
+	if verifyResponse == nil || verifyResponse.VerificationStatus != "SUCCESS" {
+		return errcode.ERR_PAYMENT_PAYPAL_WEBHOOK_SIGNATURE_INVALID.Wrap(fmt.Errorf("signature not verified by PayPal"))
+	}
+	return nil
+}
+
+// paypalPaymentOrder converts a PayPal order, only its first purchase unit is read
+func paypalPaymentOrder(order *paypal.Order) (*PaymentOrder, error) {
+	out := &PaymentOrder{
+		ID:     order.ID,
+		Status: paypalOrderStatus(order.Status),
+	}
+	for _, link := range order.Links {
+		if link.Rel == "approve" {
+			out.ApprovalURL = link.Href
+			break
+		}
+	}
+
+	if order.Payer != nil {
+		out.PayerEmail = order.Payer.EmailAddress
+		if order.Payer.Name != nil {
+			out.PayerName = strings.TrimSpace(fmt.Sprintf("%s %s", order.Payer.Name.GivenName, order.Payer.Name.Surname))
+		}
+	}
+
+	if len(order.PurchaseUnits) == 0 {
+		return out, nil
+	}
+	unit := order.PurchaseUnits[0]
+	if unit.CustomID != "" {
+		if err := json.Unmarshal([]byte(unit.CustomID), &out.Metadata); err != nil {
+			return nil, errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(err)
+		}
+	}
+	// Amount and currency as charged by PayPal (PayPal uses string for amount)
+	if unit.Amount != nil {
+		out.Currency = rbdb.NormalizeCurrency(unit.Amount.Currency)
+		out.AmountInCents = paypalAmountToCents(unit.Amount.Value, out.Currency)
+	}
+	if unit.Payments != nil {
+		out.Captures = paypalPaymentCaptures(unit.Payments.Captures)
+	}
+	return out, nil
+}
+
+func paypalPaymentCaptures(captures []paypal.CaptureAmount) []PaymentCapture {
+	out := make([]PaymentCapture, 0, len(captures))
+	for _, capture := range captures {
+		out = append(out, PaymentCapture{ID: capture.ID, Status: paypalCaptureStatus(capture.Status)})
+	}
+	return out
+}
+
+// paypalOrderStatus maps the status of a PayPal order, unknown statuses are kept lower-cased
+func paypalOrderStatus(status string) PaymentOrderStatus {
+	switch status {
+	case paypalOrderStatusCreated, paypalOrderStatusSaved, paypalOrderStatusAction:
+		return PaymentOrderCreated
+	case paypalOrderStatusApproved:
+		return PaymentOrderApproved
+	case paypalOrderStatusCompleted:
+		return PaymentOrderCompleted
+	case paypalOrderStatusVoided:
+		return PaymentOrderVoided
+	default:
+		return PaymentOrderStatus(strings.ToLower(status))
+	}
+}
+
+// paypalCaptureStatus maps the status of a PayPal capture, unknown statuses are kept lower-cased
+func paypalCaptureStatus(status string) PaymentCaptureStatus {
+	switch status {
+	case paypalCaptureStatusDone:
+		return PaymentCaptureCompleted
+	case paypalCaptureStatusPending:
+		return PaymentCapturePending
+	case paypalCaptureStatusDenied, paypalCaptureStatusFailed:
+		return PaymentCaptureDeclined
+	case paypalCaptureStatusRefunded, paypalCaptureStatusPartiallyRefunded:
+		return PaymentCaptureRefunded
+	default:
+		return PaymentCaptureStatus(strings.ToLower(status))
+	}
+}
+
+// paypalSubscriptionProvider returns the PayPal provider billing the subscriptions
+// The other providers only handle one-time checkouts
+func paypalSubscriptionProvider(payments PaymentProvider) (*PayPalProvider, error) {
+	provider, ok := payments.(*PayPalProvider)
+	if !ok {
+		return nil, errcode.ERR_PAYMENT_SUBSCRIPTIONS_UNAVAILABLE.Wrap(fmt.Errorf("payment provider %T", payments))
+	}
+	return provider, nil
+}
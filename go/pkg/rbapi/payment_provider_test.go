@@ -0,0 +1,150 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+)
+
+func TestPayPalProvider(t *testing.T) {
+	ctx := context.Background()
+
+	var created paypal.PurchaseUnitRequest
+	var refundRequestID string
+	order := map[string]interface{}{
+		"id":     "ORDER-1",
+		"status": "COMPLETED",
+		"purchase_units": []interface{}{map[string]interface{}{
+			"custom_id": `{"user_id":"7","sandbox_mode":"true"}`,
+			"amount":    map[string]interface{}{"currency_code": "JPY", "value": "3000"},
+			"payments": map[string]interface{}{"captures": []interface{}{
+				map[string]interface{}{"id": "CAP-1", "status": "PENDING"},
+			}},
+		}},
+		"payer": map[string]interface{}{
+			"email_address": "buyer@example.com",
+			"name":          map[string]interface{}{"given_name": "Test", "surname": "Buyer"},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "A21_fake", "expires_in": 3600})
+	})
+	mux.HandleFunc("POST /v2/checkout/orders", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			PurchaseUnits []paypal.PurchaseUnitRequest `json:"purchase_units"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Len(t, request.PurchaseUnits, 1)
+		created = request.PurchaseUnits[0]
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "ORDER-1",
+			"status": "CREATED",
+			"links": []interface{}{
+				map[string]interface{}{"rel": "approve", "href": "https://www.sandbox.paypal.com/checkoutnow?token=ORDER-1"},
+			},
+		})
+	})
+	mux.HandleFunc("GET /v2/checkout/orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(order)
+	})
+	mux.HandleFunc("POST /v2/checkout/orders/{id}/capture", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(order)
+	})
+	mux.HandleFunc("POST /v2/payments/captures/{id}/refund", func(w http.ResponseWriter, r *http.Request) {
+		refundRequestID = r.Header.Get("PayPal-Request-Id")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "REF-" + r.PathValue("id"), "status": "COMPLETED"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := newPayPalProvider(config.PayPal{ClientID: "client", ClientSecret: "secret", Sandbox: true}, server.URL)
+
+	t.Run("credentials are required", func(t *testing.T) {
+		_, err := NewPayPalProvider(config.PayPal{ClientID: "client", WebhookID: "webhook"})
+		assert.Error(t, err)
+		live, err := NewPayPalProvider(config.PayPal{ClientID: "client", ClientSecret: "secret", WebhookID: "webhook"})
+		require.NoError(t, err)
+		assert.False(t, live.SandboxMode())
+		assert.Equal(t, paypal.APIBaseLive, live.apiBase)
+	})
+
+	t.Run("orders are created in the format of PayPal", func(t *testing.T) {
+		out, err := provider.CreateOrder(ctx, &PaymentOrderRequest{
+			ReferenceID:   "ref_7",
+			Metadata:      map[string]string{"user_id": "7"},
+			Currency:      "eur",
+			AmountInCents: 1900,
+			TaxInCents:    303,
+			ItemName:      "EB2 - 1-Month License",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ORDER-1", out.ID)
+		assert.Equal(t, PaymentOrderCreated, out.Status)
+		assert.Equal(t, "https://www.sandbox.paypal.com/checkoutnow?token=ORDER-1", out.ApprovalURL)
+
+		assert.Equal(t, `{"user_id":"7"}`, created.CustomID)
+		assert.Equal(t, "EUR", created.Amount.Currency)
+		assert.Equal(t, "19.00", created.Amount.Value)
+		assert.Equal(t, "15.97", created.Amount.Breakdown.ItemTotal.Value)
+		assert.Equal(t, "3.03", created.Amount.Breakdown.TaxTotal.Value)
+		require.Len(t, created.Items, 1)
+		assert.Equal(t, "15.97", created.Items[0].UnitAmount.Value)
+		assert.Equal(t, "3.03", created.Items[0].Tax.Value)
+
+		// Currencies without decimals
+		_, err = provider.CreateOrder(ctx, &PaymentOrderRequest{Currency: "jpy", AmountInCents: 3000})
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", created.Amount.Currency)
+		assert.Equal(t, "3000", created.Amount.Value)
+	})
+
+	t.Run("orders are read back", func(t *testing.T) {
+		out, err := provider.GetOrder(ctx, "ORDER-1")
+		require.NoError(t, err)
+		assert.Equal(t, PaymentOrderCompleted, out.Status)
+		assert.Equal(t, map[string]string{"user_id": "7", "sandbox_mode": "true"}, out.Metadata)
+		assert.Equal(t, "jpy", out.Currency)
+		assert.Equal(t, int64(3000), out.AmountInCents)
+		assert.Equal(t, "buyer@example.com", out.PayerEmail)
+		assert.Equal(t, "Test Buyer", out.PayerName)
+		assert.Equal(t, []PaymentCapture{{ID: "CAP-1", Status: PaymentCapturePending}}, out.Captures)
+
+		captured, err := provider.CaptureOrder(ctx, "ORDER-1")
+		require.NoError(t, err)
+		assert.Equal(t, PaymentOrderCompleted, captured.Status)
+		assert.Equal(t, []PaymentCapture{{ID: "CAP-1", Status: PaymentCapturePending}}, captured.Captures)
+
+		order["status"] = "PAYER_ACTION_REQUIRED"
+		out, err = provider.GetOrder(ctx, "ORDER-1")
+		require.NoError(t, err)
+		assert.Equal(t, PaymentOrderCreated, out.Status)
+
+		order["status"] = "UNKNOWN"
+		out, err = provider.GetOrder(ctx, "ORDER-1")
+		require.NoError(t, err)
+		assert.Equal(t, PaymentOrderStatus("unknown"), out.Status)
+	})
+
+	t.Run("captures are refunded with the request ID", func(t *testing.T) {
+		refund, err := provider.RefundCapture(ctx, "CAP-1", "refund-request-1")
+		require.NoError(t, err)
+		assert.Equal(t, "REF-CAP-1", refund.ID)
+		assert.Equal(t, "refund-request-1", refundRequestID)
+	})
+
+	t.Run("unreachable PayPal", func(t *testing.T) {
+		down := newPayPalProvider(config.PayPal{ClientID: "client", ClientSecret: "secret"}, "http://127.0.0.1:1")
+		_, err := down.GetOrder(ctx, "ORDER-1")
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_CREATE_PAYPAL_OAUTH_TOKEN), errcode.Code(err))
+	})
+}
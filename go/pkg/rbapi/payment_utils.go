@@ -1,8 +1,15 @@
 package rbapi
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"time"
 
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
 	"rslbot.com/go/pkg/rbdb"
 )
 
@@ -11,56 +18,349 @@ const (
 	// Base URLs for redirects
 	PaymentSuccessBaseURL = "http://localhost:8080/payment/success"
 	PaymentCancelBaseURL  = "http://localhost:8080/payment/cancel"
-	PriceNotAvailable     = -1
+
+	// All paid licenses are PREMIUM tier, checkouts are priced from the catalog prices of this tier
+	paidLicenseTier = rbdb.LicenseKey_TIER_PREMIUM
 )
 
-// getPriceInCentsForDuration determines the price based on license duration
-// Centralized pricing for all payment providers
-func getPriceInCentsForDuration(duration rbdb.LicenseKey_Duration) int64 {
-	switch duration {
-	case rbdb.LicenseKey_ONE_WEEK:
-		return 950
-	case rbdb.LicenseKey_ONE_MONTH:
-		return 1900
-	case rbdb.LicenseKey_SIX_MONTHS:
-		return 7900
-	case rbdb.LicenseKey_ONE_YEAR:
-		return 12900
-	case rbdb.LicenseKey_LIFETIME:
-		return 19900
-	default:
-		return PriceNotAvailable
+// licenseCheckout describes a validated license purchase, shared by all payment providers
+type licenseCheckout struct {
+	User          *rbdb.User
+	Duration      rbdb.LicenseKey_Duration
+	IsRenewal     bool
+	RenewalKeyId  int64
+	AmountInCents int64
+	Currency      string // Lowercase ISO 4217 code
+	DisplayName   string
+	ImageURL      string
+	ReferralCode  string // Set by applyReferralCode
+}
+
+// prepareLicenseCheckout validates a checkout request for the current user
+// It takes either a license duration for new licenses or a renewal key ID for renewals,
+// the price is in the given currency, or in the currency of the country code, see findCheckoutPrice
+func (svc *service) prepareLicenseCheckout(ctx context.Context, duration rbdb.LicenseKey_Duration, renewalKeyId int64, currency string, countryCode string) (*licenseCheckout, error) {
+	// Get user info from context
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return nil, errcode.ERR_GET_USER_FROM_CTX.Wrap(err)
+	}
+
+	// Validate input parameters - need either duration or renewal key ID
+	if renewalKeyId == 0 && duration == rbdb.LicenseKey_UNSPECIFIED {
+		return nil, errcode.ERR_MISSING_INPUT.Wrap(fmt.Errorf("must provide either license duration or renewal key ID"))
+	}
+
+	checkout := &licenseCheckout{
+		IsRenewal:    renewalKeyId > 0,
+		RenewalKeyId: renewalKeyId,
+		Duration:     duration,
+	}
+
+	// Handle renewal case - verify license
+	if checkout.IsRenewal {
+		// Find the license by ID
+		var licenseKeyORM rbdb.LicenseKeyORM
+		if err := svc.db.
+			Preload("User").
+			Where(&rbdb.LicenseKeyORM{Id: renewalKeyId}).
+			First(&licenseKeyORM).
+			Error; err != nil {
+			if rbdb.IsRecordNotFoundError(err) {
+				return nil, errcode.ERR_LICENSE_NOT_FOUND.Wrap(fmt.Errorf("license with ID %d not found", renewalKeyId))
+			}
+			return nil, rbdb.GormToErrcode(err)
+		}
+
+		// Convert to protobuf
+		licenseToRenew, err := licenseKeyORM.ToPB(ctx)
+		if err != nil {
+			return nil, errcode.ERR_LICENSE_PROTOBUF_CONVERSION.Wrap(err)
+		}
+
+		// Validate the license is not revoked
+		if licenseToRenew.Revoked {
+			return nil, errcode.ERR_LICENSE_REVOKED.Wrap(fmt.Errorf("license with ID %d", renewalKeyId))
+		}
+
+		// Make sure the license belongs to the current user
+		if licenseToRenew.User.DiscourseId != discourseUser.ExternalId {
+			return nil, errcode.ERR_AUTH_NO_PERMISSION.Wrap(fmt.Errorf("license %d", renewalKeyId))
+		}
+
+		// Check if the license is expired
+		if !rbdb.IsLicenseExpired(&licenseToRenew) {
+			return nil, errcode.ERR_LICENSE_NOT_YET_EXPIRED.Wrap(fmt.Errorf("license: %d - %s", licenseToRenew.Id, licenseToRenew.Key))
+		}
+
+		// For renewals, use the same duration as the original license
+		checkout.Duration = licenseToRenew.Duration
+	}
+
+	// Get the price for the selected duration from the catalog
+	price, err := findCheckoutPrice(svc.db, checkout.Duration, currency, countryCode, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	checkout.AmountInCents = price.AmountInCents
+	checkout.Currency = price.Currency
+	checkout.DisplayName = price.DisplayName
+	if checkout.IsRenewal {
+		checkout.DisplayName += " Renewal"
+	}
+	if price.Product != nil {
+		checkout.ImageURL = price.Product.ImageUrl
+	}
+
+	// Try loading from database
+	checkout.User, err = svc.loadOrCreateUser(ctx, discourseUser)
+	if err != nil {
+		return nil, errcode.ERR_LOAD_OR_CREATE_USER.Wrap(err)
+	}
+
+	return checkout, nil
+}
+
+// metadata returns the metadata attached to the provider checkout, read back by fulfillLicensePayment
+func (c *licenseCheckout) metadata(sandboxMode bool) map[string]string {
+	metadata := map[string]string{
+		"user_id":      fmt.Sprintf("%d", c.User.Id),
+		"is_renewal":   strconv.FormatBool(c.IsRenewal),
+		"duration":     c.Duration.String(),
+		"sandbox_mode": strconv.FormatBool(sandboxMode),
+	}
+
+	// If this is a renewal, include the license key ID
+	if c.IsRenewal && c.RenewalKeyId > 0 {
+		metadata["license_id"] = fmt.Sprintf("%d", c.RenewalKeyId)
+	}
+	if c.ReferralCode != "" {
+		metadata[referralMetadataKey] = c.ReferralCode
+	}
+
+	return metadata
+}
+
+// fulfillLicensePayment records a completed or pending payment and generates or renews the license it paid for
+// The payment must have its provider, reference, amount and billing fields set, the rest comes from the checkout metadata
+func fulfillLicensePayment(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payment *rbdb.Payment, metadata map[string]string) error {
+	var licenseKey *rbdb.LicenseKey
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		licenseKey, _, err = fulfillLicensePaymentTx(ctx, tx, cfg, payment, metadata)
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed to fulfill license payment", zap.Error(err),
+			zap.String("provider", payment.Provider.String()),
+			zap.String("reference_id", payment.ReferenceId))
+		return err
+	}
+
+	logger.Info("License payment fulfilled",
+		zap.String("provider", payment.Provider.String()),
+		zap.String("license_key", licenseKey.Key),
+		zap.Bool("is_renewal", payment.IsRenewal))
+
+	syncUserDiscourseGroup(ctx, db, logger, cfg.Discourse, licenseKey.UserId)
+	return nil
+}
+
+// fulfillLicensePaymentTx is the transactional part of fulfillLicensePayment
+// It returns the generated or renewed license and the created payment, so callers can link more records to them
+func fulfillLicensePaymentTx(ctx context.Context, tx *gorm.DB, cfg *config.Config, payment *rbdb.Payment, metadata map[string]string) (*rbdb.LicenseKey, *rbdb.Payment, error) {
+	// Extract user ID
+	userIDStr, hasUserID := metadata["user_id"]
+	if !hasUserID || userIDStr == "" {
+		return nil, nil, errcode.ERR_PAYMENT_METADATA_INVALID.Wrap(fmt.Errorf("missing user_id for payment %s", payment.ReferenceId))
+	}
+
+	userId, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return nil, nil, errcode.ERR_USER_ID_FROM_STRING_CONVERSION.Wrap(err)
+	}
+
+	// Find the user
+	var userORM rbdb.UserORM
+	err = tx.Where(&rbdb.UserORM{Id: userId}).First(&userORM).Error
+	if err != nil {
+		if rbdb.IsRecordNotFoundError(err) {
+			return nil, nil, errcode.ERR_USER_NOT_FOUND.Wrap(fmt.Errorf("%d", userId))
+		}
+		return nil, nil, rbdb.GormToErrcode(err)
+	}
+	payment.UserId = userORM.Id
+	payment.Currency = rbdb.NormalizeCurrency(payment.Currency)
+	if err := attributeReferralTx(tx, payment, metadata); err != nil {
+		return nil, nil, err
+	}
+
+	// Providers holding the funds record the payment as pending, everything else was paid
+	if payment.Status == rbdb.Payment_STATUS_UNSPECIFIED {
+		rbdb.SetPaymentStatus(payment, rbdb.Payment_STATUS_COMPLETED, time.Now().UTC())
 	}
+
+	// Process the payment based on whether it's a renewal or new license
+	if metadata["is_renewal"] == "true" {
+		// Handle license renewal
+		licenseIDStr, hasLicenseID := metadata["license_id"]
+		if !hasLicenseID || licenseIDStr == "" {
+			return nil, nil, errcode.ERR_PAYMENT_METADATA_INVALID.Wrap(fmt.Errorf("missing license_id for renewal, payment %s", payment.ReferenceId))
+		}
+
+		licenseID, err := strconv.ParseInt(licenseIDStr, 10, 64)
+		if err != nil {
+			return nil, nil, errcode.ERR_LICENSE_KEY_ID_FROM_STRING_CONVERSION.Wrap(err)
+		}
+
+		// Find the license to renew
+		var licenseKeyORM rbdb.LicenseKeyORM
+		if err := tx.Where(&rbdb.LicenseKeyORM{Id: licenseID}).First(&licenseKeyORM).Error; err != nil {
+			if rbdb.IsRecordNotFoundError(err) {
+				return nil, nil, errcode.ERR_LICENSE_NOT_FOUND.Wrap(fmt.Errorf("license with ID %d not found", licenseID))
+			}
+			return nil, nil, rbdb.GormToErrcode(err)
+		}
+
+		// Ensure license belongs to the right user
+		if licenseKeyORM.UserId != userId {
+			return nil, nil, errcode.ERR_AUTH_NO_PERMISSION.Wrap(fmt.Errorf("license %d", licenseID))
+		}
+
+		// Create payment first
+		payment.IsRenewal = true
+		payment.LicenseDuration = rbdb.LicenseKey_Duration(licenseKeyORM.Duration)
+		createdPayment, err := rbdb.DefaultCreatePayment(ctx, payment, tx)
+		if err != nil {
+			return nil, nil, rbdb.GormToErrcode(err)
+		}
+		if _, err := rbdb.IssueInvoiceTx(tx, createdPayment.Id, time.Now().UTC()); err != nil {
+			return nil, nil, err
+		}
+		if err := recordReferralCommissionTx(tx, cfg.Referral, createdPayment.Id); err != nil {
+			return nil, nil, err
+		}
+
+		updatedLicense, err := rbdb.RenewLicense(tx, licenseID, userId, createdPayment.Id, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Create license purchase activity
+		if err := createPaymentReceivedActivity(tx, userId, updatedLicense.Id, createdPayment.Id); err != nil {
+			return nil, nil, err
+		}
+		return updatedLicense, createdPayment, nil
+	}
+
+	// Handle new license creation
+	durationStr, hasDuration := metadata["duration"]
+	if !hasDuration || durationStr == "" {
+		return nil, nil, errcode.ERR_PAYMENT_METADATA_INVALID.Wrap(fmt.Errorf("missing duration for payment %s", payment.ReferenceId))
+	}
+
+	// Parse license duration
+	value, exists := rbdb.LicenseKey_Duration_value[durationStr]
+	if !exists {
+		return nil, nil, errcode.ERR_PAYMENT_METADATA_INVALID.Wrap(fmt.Errorf("invalid duration: %s", durationStr))
+	}
+
+	licenseDuration := rbdb.LicenseKey_Duration(value)
+	if licenseDuration == rbdb.LicenseKey_UNSPECIFIED {
+		return nil, nil, errcode.ERR_PAYMENT_METADATA_INVALID.Wrap(fmt.Errorf("duration UNSPECIFIED for payment %s", payment.ReferenceId))
+	}
+
+	// Create payment first
+	payment.IsRenewal = false
+	payment.LicenseDuration = licenseDuration
+	createdPayment, err := rbdb.DefaultCreatePayment(ctx, payment, tx)
+	if err != nil {
+		return nil, nil, rbdb.GormToErrcode(err)
+	}
+	if _, err := rbdb.IssueInvoiceTx(tx, createdPayment.Id, time.Now().UTC()); err != nil {
+		return nil, nil, err
+	}
+	if err := recordReferralCommissionTx(tx, cfg.Referral, createdPayment.Id); err != nil {
+		return nil, nil, err
+	}
+
+	// Generate new license
+	licenseKey, err := rbdb.GenerateLicense(tx, userId, createdPayment.Id, licenseDuration, paidLicenseTier, true)
+	if err != nil {
+		return nil, nil, errcode.ERR_GENERATE_LICENSE.Wrap(err)
+	}
+
+	// Create license purchase activity
+	if err := createPaymentReceivedActivity(tx, userId, licenseKey.Id, createdPayment.Id); err != nil {
+		return nil, nil, err
+	}
+	return licenseKey, createdPayment, nil
 }
 
-// GenerateLicenseStrings creates human-friendly strings for license checkout
-// Returns both a name and description for the Stripe product data
-func GenerateLicenseDisplayName(duration rbdb.LicenseKey_Duration, isRenewal bool, renewalKeyId int64, isSubscription bool) string {
-	// Format durations in a friendly way
-	var durationText string
-
-	switch duration {
-	case rbdb.LicenseKey_LIFETIME:
-		durationText = "Lifetime"
-	case rbdb.LicenseKey_ONE_WEEK:
-		durationText = "1-Week"
-	case rbdb.LicenseKey_ONE_MONTH:
-		durationText = "1-Month"
-	case rbdb.LicenseKey_SIX_MONTHS:
-		durationText = "6-Month"
-	case rbdb.LicenseKey_ONE_YEAR:
-		durationText = "1-Year"
-	default:
-		durationText = "Unknown Duration"
-	}
-
-	// Create primary name string (appears in the line item)
-	var name string
-	if isRenewal && renewalKeyId > 0 {
-		name = fmt.Sprintf("EB2 - %s License Renewal", durationText)
-	} else {
-		name = fmt.Sprintf("EB2 - %s License", durationText)
-	}
-
-	return name
+// startSubscriptionPayment fulfills the first payment of a subscription and records the subscription
+// subscriptionOrm carries the provider fields, its user, license and duration come from the fulfilled license
+func startSubscriptionPayment(ctx context.Context, db *gorm.DB, cfg *config.Config, payment *rbdb.Payment, metadata map[string]string, subscriptionOrm *rbdb.SubscriptionORM) (*rbdb.LicenseKey, error) {
+	var licenseKey *rbdb.LicenseKey
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var createdPayment *rbdb.Payment
+		var err error
+		licenseKey, createdPayment, err = fulfillLicensePaymentTx(ctx, tx, cfg, payment, metadata)
+		if err != nil {
+			return err
+		}
+
+		subscriptionOrm.Provider = int32(payment.Provider)
+		subscriptionOrm.Duration = int32(licenseKey.Duration)
+		subscriptionOrm.SandboxMode = payment.SandboxMode
+		subscriptionOrm.UserId = licenseKey.UserId
+		subscriptionOrm.LicenseKeyId = licenseKey.Id
+		return rbdb.CreateSubscription(tx, subscriptionOrm, createdPayment.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return licenseKey, nil
+}
+
+// renewSubscriptionPayment records a renewal payment of a subscription and extends its license
+// Renewals are credited to the referrer of the first payment of the subscription, like the renewals of one-time purchases
+func renewSubscriptionPayment(ctx context.Context, db *gorm.DB, cfg *config.Config, payment *rbdb.Payment, subscriptionOrm *rbdb.SubscriptionORM, periodStart time.Time, periodEnd time.Time) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var firstPayments []rbdb.PaymentORM
+		if err := tx.Where("subscription_id = ?", subscriptionOrm.Id).Order("id").Limit(1).Find(&firstPayments).Error; err != nil {
+			return rbdb.GormToErrcode(err)
+		}
+		if len(firstPayments) > 0 {
+			payment.ReferralCode = firstPayments[0].ReferralCode
+			payment.ReferrerId = firstPayments[0].ReferrerId
+		}
+
+		payment.UserId = subscriptionOrm.UserId
+		payment.IsRenewal = true
+		payment.LicenseDuration = rbdb.LicenseKey_Duration(subscriptionOrm.Duration)
+		rbdb.SetPaymentStatus(payment, rbdb.Payment_STATUS_COMPLETED, time.Now().UTC())
+		createdPayment, err := rbdb.DefaultCreatePayment(ctx, payment, tx)
+		if err != nil {
+			return rbdb.GormToErrcode(err)
+		}
+		if _, err := rbdb.IssueInvoiceTx(tx, createdPayment.Id, time.Now().UTC()); err != nil {
+			return err
+		}
+		if err := recordReferralCommissionTx(tx, cfg.Referral, createdPayment.Id); err != nil {
+			return err
+		}
+
+		_, err = rbdb.RenewSubscription(tx, subscriptionOrm.Id, createdPayment.Id, periodStart, periodEnd)
+		return err
+	})
+}
+
+func createPaymentReceivedActivity(tx *gorm.DB, userId int64, licenseKeyId int64, paymentId int64) error {
+	licenseActivityORM := &rbdb.ActivityORM{
+		Kind:         int32(rbdb.Activity_KIND_PAYMENT_RECEIVED),
+		UserId:       &userId,
+		LicenseKeyId: &licenseKeyId,
+		PaymentId:    &paymentId,
+	}
+	return rbdb.GormToErrcode(tx.Create(&licenseActivityORM).Error)
 }
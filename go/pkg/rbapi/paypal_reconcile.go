@@ -0,0 +1,147 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PayPal checkout reconciliation policy
+const (
+	paypalOrderStaleAfter                = 15 * time.Minute // Time given to the webhooks before an order is checked
+	paypalOrderExpiry                    = 72 * time.Hour   // PayPal drops the orders never approved after 3 days
+	paypalReconcileBatchSize             = 50
+	paypalOrderStatusCreated             = "CREATED"
+	paypalOrderStatusSaved               = "SAVED"
+	paypalOrderStatusApproved            = "APPROVED"
+	paypalOrderStatusVoided              = "VOIDED"
+	paypalOrderStatusCompleted           = "COMPLETED"
+	paypalOrderStatusAction              = "PAYER_ACTION_REQUIRED"
+	paypalCaptureStatusDone              = "COMPLETED"
+	paypalCaptureStatusPending           = "PENDING"
+	paypalCaptureStatusDenied            = "DECLINED"
+	paypalCaptureStatusFailed            = "FAILED"
+	paypalCaptureStatusRefunded          = "REFUNDED"
+	paypalCaptureStatusPartiallyRefunded = "PARTIALLY_REFUNDED"
+)
+
+// reconcilePayPalOrders checks the stale checkout orders against PayPal, it returns the number of checked orders
+// Approved orders are captured, and captured orders get their license through the same path as the webhooks
+func reconcilePayPalOrders(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider) (int, error) {
+	now := time.Now().UTC()
+	ordersOrm, err := rbdb.ListStaleCheckoutOrders(db, rbdb.Payment_PROVIDER_PAYPAL, payments.SandboxMode(), now.Add(-paypalOrderStaleAfter), paypalReconcileBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(ordersOrm) == 0 {
+		return 0, nil
+	}
+
+	for _, orderOrm := range ordersOrm {
+		orderLogger := logger.With(zap.String("order_id", orderOrm.ProviderOrderId), zap.Int64("user_id", orderOrm.UserId))
+		checkErr := reconcilePayPalOrder(ctx, db, orderLogger, cfg, payments, orderOrm, now)
+		if checkErr != nil {
+			orderLogger.Warn("PayPal order reconciliation failed", zap.Error(checkErr))
+		}
+		if err := rbdb.RecordCheckoutOrderCheck(db, orderOrm, checkErr, time.Now().UTC()); err != nil {
+			return 0, err
+		}
+	}
+	return len(ordersOrm), nil
+}
+
+// reconcilePayPalOrder moves a checkout order forward from its status on PayPal
+func reconcilePayPalOrder(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, orderOrm *rbdb.CheckoutOrderORM, now time.Time) error {
+	orderID := orderOrm.ProviderOrderId
+	order, err := payments.GetOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	switch order.Status {
+	case PaymentOrderCreated:
+		// The buyer did not approve the order (yet)
+		if orderOrm.CreatedAt != nil && now.Sub(*orderOrm.CreatedAt) > paypalOrderExpiry {
+			logger.Info("Abandoned PayPal order voided", zap.String("status", string(order.Status)))
+			return advancePayPalCheckoutOrder(db, orderID, rbdb.CheckoutOrder_STATUS_VOIDED, "")
+		}
+		return nil
+	case PaymentOrderVoided:
+		logger.Info("PayPal order voided", zap.String("status", string(order.Status)))
+		return advancePayPalCheckoutOrder(db, orderID, rbdb.CheckoutOrder_STATUS_VOIDED, "")
+	case PaymentOrderApproved:
+		// The capture of the approval webhook failed or never happened
+		if err := advancePayPalCheckoutOrder(db, orderID, rbdb.CheckoutOrder_STATUS_APPROVED, ""); err != nil {
+			return err
+		}
+		if _, err := payments.CaptureOrder(ctx, orderID); err != nil {
+			return err
+		}
+		logger.Info("Approved PayPal order captured by the reconciliation")
+
+		// The capture response misses the order details needed to deliver the license
+		order, err = payments.GetOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if order.Status != PaymentOrderCompleted {
+			return errcode.ERR_PAYMENT_PAYPAL_ORDER_CAPTURE_FAILED.Wrap(fmt.Errorf("order status after capture: %s", order.Status))
+		}
+		return settlePayPalOrder(ctx, db, logger, cfg, payments, order)
+	case PaymentOrderCompleted:
+		return settlePayPalOrder(ctx, db, logger, cfg, payments, order)
+	default:
+		return errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(fmt.Errorf("unexpected status %q for order %s", order.Status, orderID))
+	}
+}
+
+// settlePayPalOrder delivers the license of a captured order, unless its capture was declined
+func settlePayPalOrder(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, order *PaymentOrder) error {
+	if len(order.Captures) == 0 {
+		return errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(fmt.Errorf("missing capture for order %s", order.ID))
+	}
+	capture := order.Captures[0]
+	if err := advancePayPalCheckoutOrder(db, order.ID, rbdb.CheckoutOrder_STATUS_CAPTURED, capture.ID); err != nil {
+		return err
+	}
+
+	switch capture.Status {
+	case PaymentCaptureCompleted, PaymentCapturePending:
+		logger.Info("Delivering PayPal order from the reconciliation", zap.String("capture_id", capture.ID), zap.String("capture_status", string(capture.Status)))
+		return settlePayPalCapture(ctx, db, logger, cfg, payments, order.ID, capture.ID, capture.Status == PaymentCapturePending, order)
+	case PaymentCaptureDeclined:
+		logger.Info("PayPal order capture declined", zap.String("capture_id", capture.ID), zap.String("capture_status", string(capture.Status)))
+		return advancePayPalCheckoutOrder(db, order.ID, rbdb.CheckoutOrder_STATUS_FAILED, capture.ID)
+	default:
+		// Refunded before delivery, the refund webhooks are left in charge
+		return errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(fmt.Errorf("unexpected capture status %q for order %s", capture.Status, order.ID))
+	}
+}
+
+// runPayPalReconcileLoop periodically reconciles the stale PayPal orders until the context is done
+func runPayPalReconcileLoop(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			checked, err := reconcilePayPalOrders(ctx, db, logger, cfg, payments)
+			if err != nil {
+				logger.Error("PayPal order reconciliation failed", zap.Error(err))
+				continue
+			}
+			if checked > 0 {
+				logger.Info("PayPal order reconciliation done", zap.Int("checked", checked))
+			}
+		}
+	}
+}
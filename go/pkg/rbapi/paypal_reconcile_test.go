@@ -0,0 +1,211 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestPayPalReconcile(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	userCtx := TestingSetContextToken(ctx, t)
+
+	checkout := func(t *testing.T) string {
+		t.Helper()
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+		return out.OrderId
+	}
+	// The webhooks queued by the fake are never delivered, as if they were lost
+	approve := func(t *testing.T, orderID string) {
+		t.Helper()
+		_, err := fake.Approve(orderID)
+		require.NoError(t, err)
+	}
+	captureID := func(t *testing.T, orderID string) string {
+		t.Helper()
+		order, err := fake.GetOrder(ctx, orderID)
+		require.NoError(t, err)
+		require.Len(t, order.Captures, 1)
+		return order.Captures[0].ID
+	}
+	loadOrder := func(t *testing.T, orderID string) *rbdb.CheckoutOrderORM {
+		t.Helper()
+		orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, orderID)
+		require.NoError(t, err)
+		return orderOrm
+	}
+	age := func(t *testing.T, orderID string, created time.Duration, updated time.Duration) {
+		t.Helper()
+		now := time.Now().UTC()
+		require.NoError(t, db.Model(&rbdb.CheckoutOrderORM{}).Where("provider_order_id = ?", orderID).UpdateColumns(map[string]interface{}{
+			"created_at": now.Add(-created),
+			"updated_at": now.Add(-updated),
+		}).Error)
+	}
+	countPayments := func(t *testing.T, captureID string) int64 {
+		t.Helper()
+		var count int64
+		require.NoError(t, db.Model(&rbdb.PaymentORM{}).Where("reference_id = ?", captureID).Count(&count).Error)
+		return count
+	}
+	sendEvent := func(t *testing.T, eventType string, resource map[string]interface{}) error {
+		t.Helper()
+		raw, err := json.Marshal(resource)
+		require.NoError(t, err)
+		event := paypal.AnyEvent{
+			Event:    paypal.Event{ID: "WH-" + eventType, EventType: eventType},
+			Resource: raw,
+		}
+		return processPayPalWebhookEvent(context.Background(), event, db, logger, svc.Config(), fake)
+	}
+
+	var firstOrderID string
+	t.Run("checkout records the order", func(t *testing.T) {
+		orderID := checkout(t)
+		firstOrderID = orderID
+		orderOrm := loadOrder(t, orderID)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_CREATED), orderOrm.Status)
+		assert.Equal(t, int32(rbdb.LicenseKey_ONE_MONTH), orderOrm.LicenseDuration)
+		assert.NotZero(t, orderOrm.UserId)
+		assert.NotZero(t, orderOrm.AmountInCents)
+		assert.True(t, orderOrm.SandboxMode)
+
+		// Recent orders are left to the webhooks
+		checked, err := reconcilePayPalOrders(ctx, db, logger, svc.Config(), fake)
+		require.NoError(t, err)
+		assert.Equal(t, 0, checked)
+
+		// Orders not approved yet are checked and left as they are
+		age(t, orderID, time.Hour, time.Hour)
+		checked, err = reconcilePayPalOrders(ctx, db, logger, svc.Config(), fake)
+		require.NoError(t, err)
+		assert.Equal(t, 1, checked)
+		orderOrm = loadOrder(t, orderID)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_CREATED), orderOrm.Status)
+		assert.NotNil(t, orderOrm.LastCheckedAt)
+		assert.Zero(t, orderOrm.ReconcileAttempts)
+	})
+
+	t.Run("approved order without webhook is captured and delivered", func(t *testing.T) {
+		orderID := firstOrderID
+		approve(t, orderID)
+		age(t, orderID, time.Hour, time.Hour)
+
+		checked, err := reconcilePayPalOrders(ctx, db, logger, svc.Config(), fake)
+		require.NoError(t, err)
+		assert.Equal(t, 1, checked)
+
+		orderOrm := loadOrder(t, orderID)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_COMPLETED), orderOrm.Status)
+		assert.Equal(t, captureID(t, orderID), orderOrm.CaptureId)
+		assert.NotNil(t, orderOrm.CompletedAt)
+		assert.Equal(t, int64(1), countPayments(t, orderOrm.CaptureId))
+
+		var payment rbdb.PaymentORM
+		require.NoError(t, db.Where(&rbdb.PaymentORM{ReferenceId: orderOrm.CaptureId}).First(&payment).Error)
+		assert.Equal(t, orderOrm.UserId, payment.UserId)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_COMPLETED), payment.Status)
+
+		// The late capture webhook does not deliver twice
+		require.NoError(t, sendEvent(t, paypal.EventPaymentCaptureCompleted, map[string]interface{}{
+			"id": orderOrm.CaptureId,
+			"links": []interface{}{
+				map[string]interface{}{"rel": "up", "href": "http://localhost/v2/checkout/orders/" + orderID},
+			},
+		}))
+		assert.Equal(t, int64(1), countPayments(t, orderOrm.CaptureId))
+	})
+
+	t.Run("approval webhook captures the order", func(t *testing.T) {
+		orderID := checkout(t)
+		approve(t, orderID)
+
+		require.NoError(t, sendEvent(t, paypal.EventCheckoutOrderApproved, map[string]interface{}{"id": orderID}))
+		orderOrm := loadOrder(t, orderID)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_CAPTURED), orderOrm.Status)
+		assert.Equal(t, captureID(t, orderID), orderOrm.CaptureId)
+
+		require.NoError(t, sendEvent(t, paypal.EventPaymentCaptureCompleted, map[string]interface{}{
+			"id": orderOrm.CaptureId,
+			"links": []interface{}{
+				map[string]interface{}{"rel": "up", "href": "http://localhost/v2/checkout/orders/" + orderID},
+			},
+		}))
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_COMPLETED), loadOrder(t, orderID).Status)
+		assert.Equal(t, int64(1), countPayments(t, orderOrm.CaptureId))
+	})
+
+	t.Run("declined capture fails the order", func(t *testing.T) {
+		orderID := checkout(t)
+		approve(t, orderID)
+		fake.captureStatus = PaymentCaptureDeclined
+		defer func() { fake.captureStatus = "" }()
+		age(t, orderID, time.Hour, time.Hour)
+
+		_, err := reconcilePayPalOrders(ctx, db, logger, svc.Config(), fake)
+		require.NoError(t, err)
+		orderOrm := loadOrder(t, orderID)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_FAILED), orderOrm.Status)
+		assert.Equal(t, int64(0), countPayments(t, orderOrm.CaptureId))
+	})
+
+	t.Run("abandoned order is voided", func(t *testing.T) {
+		orderID := checkout(t)
+		age(t, orderID, paypalOrderExpiry+time.Hour, time.Hour)
+
+		_, err := reconcilePayPalOrders(ctx, db, logger, svc.Config(), fake)
+		require.NoError(t, err)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_VOIDED), loadOrder(t, orderID).Status)
+	})
+
+	var stuckOrderID string
+	t.Run("failed checks are recorded", func(t *testing.T) {
+		orderID := checkout(t)
+		stuckOrderID = orderID
+		fake.mu.Lock()
+		fake.orders[orderID].order.Status = "unknown"
+		fake.mu.Unlock()
+		age(t, orderID, 2*time.Hour, time.Hour)
+
+		_, err := reconcilePayPalOrders(ctx, db, logger, svc.Config(), fake)
+		require.NoError(t, err)
+		orderOrm := loadOrder(t, orderID)
+		assert.Equal(t, int32(rbdb.CheckoutOrder_STATUS_CREATED), orderOrm.Status)
+		assert.Equal(t, int32(1), orderOrm.ReconcileAttempts)
+		assert.Contains(t, orderOrm.LastError, "unknown")
+	})
+
+	t.Run("admin report of stuck orders", func(t *testing.T) {
+		_, err := svc.AdminListStuckOrders(ctx, &AdminListStuckOrders_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		// Only the order failing its checks is still pending, it was created two hours ago
+		out, err := svc.AdminListStuckOrders(adminCtx, &AdminListStuckOrders_Input{OlderThanMinutes: 30})
+		require.NoError(t, err)
+		require.Len(t, out.Counts, 1)
+		assert.Equal(t, rbdb.CheckoutOrder_STATUS_CREATED, out.Counts[0].Status)
+		assert.Equal(t, int32(1), out.Counts[0].Orders)
+		require.Len(t, out.Orders, 1)
+		assert.Equal(t, stuckOrderID, out.Orders[0].ProviderOrderId)
+		assert.Equal(t, int32(1), out.Orders[0].ReconcileAttempts)
+
+		out, err = svc.AdminListStuckOrders(adminCtx, &AdminListStuckOrders_Input{OlderThanMinutes: 180})
+		require.NoError(t, err)
+		assert.Empty(t, out.Counts)
+		assert.Empty(t, out.Orders)
+	})
+}
@@ -0,0 +1,309 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PayPal refund and dispute webhook event types missing from the SDK
+const (
+	paypalEventCaptureReversed = "PAYMENT.CAPTURE.REVERSED"
+	paypalEventSaleRefunded    = "PAYMENT.SALE.REFUNDED"
+	paypalEventDisputeCreated  = "CUSTOMER.DISPUTE.CREATED"
+	paypalEventDisputeUpdated  = "CUSTOMER.DISPUTE.UPDATED"
+	paypalEventDisputeResolved = "CUSTOMER.DISPUTE.RESOLVED"
+)
+
+// Dispute outcomes where the buyer keeps the money
+var paypalDisputeBuyerOutcomes = map[string]bool{
+	"RESOLVED_BUYER_FAVOUR": true,
+	"ACCEPTED":              true,
+}
+
+// PayPalRefund is the subset of the PayPal Refund resource sent with PAYMENT.CAPTURE.REFUNDED and REVERSED
+type PayPalRefund struct {
+	ID         string        `json:"id"`
+	Status     string        `json:"status"`
+	Amount     *paypal.Money `json:"amount"`
+	CreateTime time.Time     `json:"create_time"`
+	Links      []paypal.Link `json:"links"`
+}
+
+// captureID returns the ID of the refunded capture, from the "up" link of the refund
+func (r *PayPalRefund) captureID() string {
+	for _, link := range r.Links {
+		if link.Rel != "up" {
+			continue
+		}
+		parts := strings.Split(strings.TrimSuffix(link.Href, "/"), "/")
+		return parts[len(parts)-1]
+	}
+	return ""
+}
+
+// PayPalSaleRefund is the subset of the PayPal v1 Refund resource sent with PAYMENT.SALE.REFUNDED
+type PayPalSaleRefund struct {
+	ID         string    `json:"id"`
+	SaleID     string    `json:"sale_id"`
+	CreateTime time.Time `json:"create_time"`
+	Amount     struct {
+		Total    string `json:"total"`
+		Currency string `json:"currency"`
+	} `json:"amount"`
+}
+
+// PayPalDispute is the subset of the PayPal Dispute resource sent with CUSTOMER.DISPUTE.* events
+type PayPalDispute struct {
+	DisputeID            string    `json:"dispute_id"`
+	Status               string    `json:"status"`
+	Reason               string    `json:"reason"`
+	CreateTime           time.Time `json:"create_time"`
+	UpdateTime           time.Time `json:"update_time"`
+	DisputedTransactions []struct {
+		SellerTransactionID string `json:"seller_transaction_id"`
+	} `json:"disputed_transactions"`
+	DisputeOutcome struct {
+		OutcomeCode string `json:"outcome_code"`
+	} `json:"dispute_outcome"`
+}
+
+// eventTime returns t, or now when the provider did not send it
+func eventTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now().UTC()
+	}
+	return t.UTC()
+}
+
+// handlePayPalCaptureRefunded takes back the license time of a refunded or reversed capture
+// Reversals (chargebacks settled by PayPal) always take back the whole remaining amount
+func handlePayPalCaptureRefunded(ctx context.Context, event paypal.AnyEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	var refund PayPalRefund
+	if err := json.Unmarshal(event.Resource, &refund); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+	}
+
+	captureID := refund.captureID()
+	if captureID == "" {
+		return errcode.ERR_PAYMENT_PAYPAL_CAPTURE_ID_MISSING.Wrap(fmt.Errorf("refund %s in event %s", refund.ID, event.ID))
+	}
+
+	amountInCents := int64(0)
+	if refund.Amount != nil && event.EventType == paypal.EventPaymentCaptureRefunded {
+		amountInCents = paypalAmountToCents(refund.Amount.Value, refund.Amount.Currency)
+	}
+
+	refundID := refund.ID
+	if refundID == "" {
+		refundID = event.ID
+	}
+	return refundPayPalPayment(ctx, db, logger, cfg, captureID, refundID, amountInCents, eventTime(refund.CreateTime))
+}
+
+// handlePayPalCaptureDenied fails a pending capture that PayPal finally denied and takes back its license
+func handlePayPalCaptureDenied(ctx context.Context, event paypal.AnyEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	var capture struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(event.Resource, &capture); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+	}
+	if capture.ID == "" {
+		return errcode.ERR_PAYMENT_PAYPAL_CAPTURE_ID_MISSING.Wrap(fmt.Errorf("from event ID: %s", event.ID))
+	}
+
+	paymentOrm, err := rbdb.GetPaymentByReference(db, capture.ID)
+	if errcode.Code(err) == int32(errcode.ERR_PAYMENT_NOT_FOUND) {
+		logger.Info("Denial of unknown PayPal payment", zap.String("capture_id", capture.ID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	paymentOrm, applied, err := rbdb.FailPayment(db, paymentOrm.Id, "capture_denied", time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if !applied {
+		logger.Info("PayPal capture denial already processed", zap.String("capture_id", capture.ID), zap.Int64("payment_id", paymentOrm.Id))
+		return nil
+	}
+
+	logger.Info("PayPal payment failed", zap.String("capture_id", capture.ID), zap.Int64("payment_id", paymentOrm.Id))
+	syncLicenseAccessAfterRefund(ctx, db, logger, cfg, paymentOrm.UserId)
+	return nil
+}
+
+// handlePayPalSaleRefunded takes back the license time of a refunded subscription payment
+func handlePayPalSaleRefunded(ctx context.Context, event paypal.AnyEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	var refund PayPalSaleRefund
+	if err := json.Unmarshal(event.Resource, &refund); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+	}
+	if refund.SaleID == "" {
+		return errcode.ERR_PAYMENT_PAYPAL_CAPTURE_ID_MISSING.Wrap(fmt.Errorf("refund %s in event %s", refund.ID, event.ID))
+	}
+
+	return refundPayPalPayment(ctx, db, logger, cfg, refund.SaleID, refund.ID, paypalAmountToCents(refund.Amount.Total, refund.Amount.Currency), eventTime(refund.CreateTime))
+}
+
+// refundPayPalPayment applies a refund to the payment recorded for a capture or a sale
+// An amount of 0 refunds what is left of the payment
+func refundPayPalPayment(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, referenceID string, refundID string, amountInCents int64, refundedAt time.Time) error {
+	paymentOrm, err := rbdb.GetPaymentByReference(db, referenceID)
+	if errcode.Code(err) == int32(errcode.ERR_PAYMENT_NOT_FOUND) {
+		// Payments made before the license system, or never fulfilled
+		logger.Info("Refund for unknown PayPal payment", zap.String("reference_id", referenceID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	paymentOrm, applied, err := rbdb.RefundPayment(db, paymentOrm.Id, refundID, amountInCents, refundedAt)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		logger.Info("PayPal refund already processed", zap.String("refund_id", refundID), zap.Int64("payment_id", paymentOrm.Id))
+		return nil
+	}
+
+	logger.Info("PayPal payment refunded",
+		zap.String("reference_id", referenceID),
+		zap.String("refund_id", refundID),
+		zap.Int64("refunded_in_cents", paymentOrm.RefundedInCents),
+		zap.Int64("amount_in_cents", paymentOrm.AmountInCents))
+
+	syncLicenseAccessAfterRefund(ctx, db, logger, cfg, paymentOrm.UserId)
+	return nil
+}
+
+// handlePayPalDispute freezes the license of a disputed payment and settles it once the dispute is resolved
+func handlePayPalDispute(ctx context.Context, event paypal.AnyEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	var dispute PayPalDispute
+	if err := json.Unmarshal(event.Resource, &dispute); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+	}
+	if dispute.DisputeID == "" {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(fmt.Errorf("missing dispute ID in event %s", event.ID))
+	}
+
+	resolved := event.EventType == paypalEventDisputeResolved || dispute.Status == "RESOLVED"
+	buyerWon := paypalDisputeBuyerOutcomes[dispute.DisputeOutcome.OutcomeCode]
+
+	for _, transaction := range dispute.DisputedTransactions {
+		paymentOrm, err := rbdb.GetPaymentByReference(db, transaction.SellerTransactionID)
+		if errcode.Code(err) == int32(errcode.ERR_PAYMENT_NOT_FOUND) {
+			logger.Info("Dispute for unknown PayPal payment",
+				zap.String("dispute_id", dispute.DisputeID),
+				zap.String("reference_id", transaction.SellerTransactionID))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Disputes can be resolved without a prior CREATED delivery, so both steps are applied
+		_, opened, err := rbdb.OpenPaymentDispute(db, paymentOrm.Id, dispute.DisputeID, eventTime(dispute.CreateTime))
+		if err != nil {
+			return err
+		}
+		settled := false
+		if resolved {
+			_, settled, err = rbdb.ResolvePaymentDispute(db, paymentOrm.Id, dispute.DisputeID, buyerWon, eventTime(dispute.UpdateTime))
+			if err != nil {
+				return err
+			}
+		}
+		if !opened && !settled {
+			continue
+		}
+
+		logger.Info("PayPal dispute processed",
+			zap.String("dispute_id", dispute.DisputeID),
+			zap.String("reference_id", transaction.SellerTransactionID),
+			zap.String("reason", dispute.Reason),
+			zap.Bool("resolved", resolved),
+			zap.String("outcome", dispute.DisputeOutcome.OutcomeCode))
+
+		syncLicenseAccessAfterRefund(ctx, db, logger, cfg, paymentOrm.UserId)
+	}
+	return nil
+}
+
+// syncLicenseAccessAfterRefund updates the community accesses of a user whose license was revoked or shortened
+// Failures are only logged, like the other best-effort syncs
+func syncLicenseAccessAfterRefund(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, userId int64) {
+	syncUserDiscourseGroup(ctx, db, logger, cfg.Discourse, userId)
+
+	if !cfg.Discord.RemoveRoleOnRefund {
+		return
+	}
+
+	// The Discord role is reserved to lifetime license holders
+	var lifetimeCount int64
+	err := db.Model(&rbdb.LicenseKeyORM{}).
+		Where("user_id = ? AND duration = ? AND revoked = ?", userId, int32(rbdb.LicenseKey_LIFETIME), false).
+		Count(&lifetimeCount).Error
+	if err != nil {
+		logger.Warn("Discord role removal: failed to check licenses", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+	if lifetimeCount > 0 {
+		return
+	}
+
+	var userOrm rbdb.UserORM
+	if err := db.Where(&rbdb.UserORM{Id: userId}).First(&userOrm).Error; err != nil {
+		logger.Warn("Discord role removal: failed to load user", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+
+	discordID, err := GetDiscordIDFromDiscourse(ctx, cfg.Discourse, userOrm.DiscourseId)
+	if err != nil || discordID == "" {
+		logger.Info("Discord role removal: no linked Discord account", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+
+	if err := RemoveDiscordRole(ctx, cfg.Discord, discordID); err != nil {
+		logger.Warn("Discord role removal failed", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+
+	activityOrm := &rbdb.ActivityORM{
+		Kind:    int32(rbdb.Activity_KIND_DISCORD_ROLE_REMOVED),
+		UserId:  &userId,
+		Details: fmt.Sprintf("discord_id=%s", discordID),
+	}
+	if err := db.Create(activityOrm).Error; err != nil {
+		logger.Warn("Discord role removal: failed to record activity", zap.Error(err), zap.Int64("user_id", userId))
+		return
+	}
+
+	logger.Info("Discord role removed after refund", zap.Int64("user_id", userId))
+}
+
+// refundPayPalCapture refunds the whole amount of a PayPal capture and returns the refund ID
+// The request ID makes retries of the same refund idempotent on PayPal
+func refundPayPalCapture(ctx context.Context, payments PaymentProvider, captureID string, requestID string) (string, error) {
+	refund, err := payments.RefundCapture(ctx, captureID, requestID)
+	if err != nil {
+		return "", err
+	}
+	if refund.ID == "" {
+		return "", errcode.ERR_REFUND_REQUEST_PAYPAL_REFUND.Wrap(fmt.Errorf("no refund ID for capture %s", captureID))
+	}
+	return refund.ID, nil
+}
@@ -0,0 +1,248 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestPayPalRefunds(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, _, _ := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	// purchase fulfills a PayPal capture the way the capture webhook does
+	purchase := func(t *testing.T, discourseID int64, captureID string, duration rbdb.LicenseKey_Duration) *rbdb.PaymentORM {
+		t.Helper()
+
+		user, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{
+			DiscourseId: discourseID,
+			Username:    fmt.Sprintf("buyer_%d", discourseID),
+		}, db)
+		require.NoError(t, err)
+
+		price, err := rbdb.FindActivePrice(db, paidLicenseTier, duration, defaultCurrency, time.Now().UTC())
+		require.NoError(t, err)
+
+		payment := &rbdb.Payment{
+			Provider:      rbdb.Payment_PROVIDER_PAYPAL,
+			ReferenceId:   captureID,
+			AmountInCents: price.AmountInCents,
+			Currency:      "eur",
+		}
+		require.NoError(t, fulfillLicensePayment(ctx, db, logger, svc.Config(), payment, map[string]string{
+			"user_id":  fmt.Sprintf("%d", user.Id),
+			"duration": duration.String(),
+		}))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, captureID)
+		require.NoError(t, err)
+		return paymentOrm
+	}
+	sendEvent := func(t *testing.T, eventType string, resource map[string]interface{}) {
+		t.Helper()
+
+		raw, err := json.Marshal(resource)
+		require.NoError(t, err)
+		event := paypal.AnyEvent{
+			Event:    paypal.Event{ID: "WH-" + eventType, EventType: eventType},
+			Resource: raw,
+		}
+		require.NoError(t, processPayPalWebhookEvent(ctx, event, db, logger, svc.Config(), svc.Payments()))
+	}
+	refund := func(refundID string, captureID string, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":     refundID,
+			"status": "COMPLETED",
+			"amount": map[string]interface{}{"value": value, "currency_code": "EUR"},
+			"links": []interface{}{
+				map[string]interface{}{"rel": "self", "href": "https://api.sandbox.paypal.com/v2/payments/refunds/" + refundID},
+				map[string]interface{}{"rel": "up", "href": "https://api.sandbox.paypal.com/v2/payments/captures/" + captureID},
+			},
+		}
+	}
+	dispute := func(disputeID string, captureID string, status string, outcome string) map[string]interface{} {
+		return map[string]interface{}{
+			"dispute_id": disputeID,
+			"status":     status,
+			"reason":     "MERCHANDISE_OR_SERVICE_NOT_RECEIVED",
+			"disputed_transactions": []interface{}{
+				map[string]interface{}{"seller_transaction_id": captureID},
+			},
+			"dispute_outcome": map[string]interface{}{"outcome_code": outcome},
+		}
+	}
+	loadLicense := func(t *testing.T, paymentOrm *rbdb.PaymentORM) *rbdb.LicenseKey {
+		t.Helper()
+		require.NotNil(t, paymentOrm.LicenseKeyId)
+		license, err := rbdb.DefaultReadLicenseKey(ctx, &rbdb.LicenseKey{Id: *paymentOrm.LicenseKeyId}, db)
+		require.NoError(t, err)
+		return license
+	}
+	countActivities := func(t *testing.T, paymentOrm *rbdb.PaymentORM, kind rbdb.Activity_Kind) int64 {
+		t.Helper()
+		var count int64
+		require.NoError(t, db.Model(&rbdb.ActivityORM{}).
+			Where("payment_id = ? AND kind = ?", paymentOrm.Id, int32(kind)).
+			Count(&count).Error)
+		return count
+	}
+
+	t.Run("partial refund shortens the license", func(t *testing.T) {
+		paymentOrm := purchase(t, 9101, "CAPTURE-MONTH", rbdb.LicenseKey_ONE_MONTH)
+		before, ok := rbdb.LicenseExpiresAt(loadLicense(t, paymentOrm))
+		require.True(t, ok)
+
+		sendEvent(t, paypal.EventPaymentCaptureRefunded, refund("REFUND-1", "CAPTURE-MONTH", "9.50"))
+		// Duplicate deliveries are ignored
+		sendEvent(t, paypal.EventPaymentCaptureRefunded, refund("REFUND-1", "CAPTURE-MONTH", "9.50"))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, "CAPTURE-MONTH")
+		require.NoError(t, err)
+		assert.Equal(t, int64(950), paymentOrm.RefundedInCents)
+		assert.NotNil(t, paymentOrm.RefundedAt)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_PARTIALLY_REFUNDED), paymentOrm.Status)
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_PAYMENT_REFUNDED))
+
+		license := loadLicense(t, paymentOrm)
+		assert.False(t, license.Revoked)
+		after, ok := rbdb.LicenseExpiresAt(license)
+		require.True(t, ok)
+		period := before.Sub(before.AddDate(0, -1, 0))
+		assert.InDelta(t, float64(period/2), float64(before.Sub(after)), float64(48*time.Hour))
+	})
+
+	t.Run("remaining refund revokes the license", func(t *testing.T) {
+		sendEvent(t, paypal.EventPaymentCaptureRefunded, refund("REFUND-2", "CAPTURE-MONTH", "9.50"))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, "CAPTURE-MONTH")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1900), paymentOrm.RefundedInCents)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_REFUNDED), paymentOrm.Status)
+		assert.True(t, loadLicense(t, paymentOrm).Revoked)
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_LICENSE_REVOKED))
+	})
+
+	t.Run("partial refund keeps a lifetime license", func(t *testing.T) {
+		paymentOrm := purchase(t, 9102, "CAPTURE-LIFETIME", rbdb.LicenseKey_LIFETIME)
+
+		sendEvent(t, paypal.EventPaymentCaptureRefunded, refund("REFUND-3", "CAPTURE-LIFETIME", "50.00"))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, paymentOrm.ReferenceId)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5000), paymentOrm.RefundedInCents)
+		assert.False(t, loadLicense(t, paymentOrm).Revoked)
+	})
+
+	t.Run("reversal takes back the remaining amount", func(t *testing.T) {
+		sendEvent(t, paypalEventCaptureReversed, refund("REVERSAL-1", "CAPTURE-LIFETIME", "149.00"))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, "CAPTURE-LIFETIME")
+		require.NoError(t, err)
+		assert.Equal(t, paymentOrm.AmountInCents, paymentOrm.RefundedInCents)
+		assert.True(t, loadLicense(t, paymentOrm).Revoked)
+	})
+
+	t.Run("dispute won by the seller restores the license", func(t *testing.T) {
+		paymentOrm := purchase(t, 9103, "CAPTURE-DISPUTE-SELLER", rbdb.LicenseKey_ONE_YEAR)
+
+		sendEvent(t, paypalEventDisputeCreated, dispute("PP-D-1", "CAPTURE-DISPUTE-SELLER", "OPEN", ""))
+		paymentOrm, err := rbdb.GetPaymentByReference(db, paymentOrm.ReferenceId)
+		require.NoError(t, err)
+		assert.Equal(t, "PP-D-1", paymentOrm.DisputeId)
+		assert.NotNil(t, paymentOrm.DisputedAt)
+		assert.True(t, loadLicense(t, paymentOrm).Revoked)
+
+		sendEvent(t, paypalEventDisputeResolved, dispute("PP-D-1", "CAPTURE-DISPUTE-SELLER", "RESOLVED", "RESOLVED_SELLER_FAVOUR"))
+		paymentOrm, err = rbdb.GetPaymentByReference(db, paymentOrm.ReferenceId)
+		require.NoError(t, err)
+		assert.False(t, loadLicense(t, paymentOrm).Revoked)
+		assert.Equal(t, int64(0), paymentOrm.RefundedInCents)
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_LICENSE_RESTORED))
+	})
+
+	t.Run("dispute won by the buyer refunds the payment", func(t *testing.T) {
+		paymentOrm := purchase(t, 9104, "CAPTURE-DISPUTE-BUYER", rbdb.LicenseKey_ONE_YEAR)
+
+		// Resolution delivered without the creation event
+		sendEvent(t, paypalEventDisputeResolved, dispute("PP-D-2", "CAPTURE-DISPUTE-BUYER", "RESOLVED", "RESOLVED_BUYER_FAVOUR"))
+		paymentOrm, err := rbdb.GetPaymentByReference(db, paymentOrm.ReferenceId)
+		require.NoError(t, err)
+		assert.Equal(t, "PP-D-2", paymentOrm.DisputeId)
+		assert.Equal(t, paymentOrm.AmountInCents, paymentOrm.RefundedInCents)
+		assert.True(t, loadLicense(t, paymentOrm).Revoked)
+	})
+
+	t.Run("denied capture fails the payment", func(t *testing.T) {
+		paymentOrm := purchase(t, 9105, "CAPTURE-DENIED", rbdb.LicenseKey_ONE_MONTH)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_COMPLETED), paymentOrm.Status)
+		assert.NotNil(t, paymentOrm.CompletedAt)
+
+		sendEvent(t, paypal.EventPaymentCaptureDenied, map[string]interface{}{"id": "CAPTURE-DENIED", "status": "DECLINED"})
+		assert.True(t, loadLicense(t, paymentOrm).Revoked)
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, "CAPTURE-DENIED")
+		require.NoError(t, err)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_FAILED), paymentOrm.Status)
+		assert.NotNil(t, paymentOrm.FailedAt)
+		assert.Equal(t, int64(0), paymentOrm.RefundedInCents)
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_PAYMENT_FAILED))
+
+		// A failed payment cannot be refunded
+		sendEvent(t, paypal.EventPaymentCaptureRefunded, refund("REFUND-DENIED", "CAPTURE-DENIED", "19.00"))
+		paymentOrm, err = rbdb.GetPaymentByReference(db, "CAPTURE-DENIED")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), paymentOrm.RefundedInCents)
+	})
+
+	t.Run("pending payment completes", func(t *testing.T) {
+		paymentOrm := purchase(t, 9106, "CAPTURE-PENDING", rbdb.LicenseKey_ONE_WEEK)
+		paymentOrm.Status = int32(rbdb.Payment_STATUS_PENDING)
+		paymentOrm.CompletedAt = nil
+		require.NoError(t, db.Save(paymentOrm).Error)
+
+		paymentOrm, completed, err := rbdb.CompletePayment(db, paymentOrm.Id, time.Now().UTC())
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, int32(rbdb.Payment_STATUS_COMPLETED), paymentOrm.Status)
+		assert.NotNil(t, paymentOrm.CompletedAt)
+
+		_, completed, err = rbdb.CompletePayment(db, paymentOrm.Id, time.Now().UTC())
+		require.NoError(t, err)
+		assert.False(t, completed)
+	})
+
+	t.Run("revenue excludes refunds and failed payments", func(t *testing.T) {
+		totals, err := rbdb.GetRevenue(db, time.Time{}, time.Time{}, false)
+		require.NoError(t, err)
+
+		var paypalTotal *rbdb.RevenueTotal
+		for _, total := range totals {
+			if total.Provider == int32(rbdb.Payment_PROVIDER_PAYPAL) {
+				paypalTotal = total
+			}
+		}
+		require.NotNil(t, paypalTotal)
+
+		// Month and lifetime fully refunded, year won by the seller, year refunded after the dispute, week completed
+		assert.Equal(t, int32(5), paypalTotal.Payments)
+		assert.Equal(t, int64(1900+19900+12900+12900+950), paypalTotal.GrossInCents)
+		assert.Equal(t, int64(1900+19900+12900), paypalTotal.RefundedInCents)
+
+		failed, err := rbdb.CountPaymentsByStatus(db, rbdb.Payment_STATUS_FAILED, time.Time{}, time.Time{}, false)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), failed)
+	})
+
+	t.Run("unknown capture is ignored", func(t *testing.T) {
+		sendEvent(t, paypal.EventPaymentCaptureRefunded, refund("REFUND-X", "CAPTURE-UNKNOWN", "1.00"))
+	})
+}
@@ -0,0 +1,352 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// PayPal subscription webhook event types, not all of them have a constant in the SDK
+const (
+	paypalEventSubscriptionActivated     = "BILLING.SUBSCRIPTION.ACTIVATED"
+	paypalEventSubscriptionCancelled     = "BILLING.SUBSCRIPTION.CANCELLED"
+	paypalEventSubscriptionExpired       = "BILLING.SUBSCRIPTION.EXPIRED"
+	paypalEventSubscriptionSuspended     = "BILLING.SUBSCRIPTION.SUSPENDED"
+	paypalEventSubscriptionPaymentFailed = "BILLING.SUBSCRIPTION.PAYMENT.FAILED"
+	paypalEventPaymentSaleCompleted      = "PAYMENT.SALE.COMPLETED"
+)
+
+const (
+	paypalPlanCurrency = "EUR"
+	paypalProductName  = "RSL Bot License"
+)
+
+// paypalRecurringDurations are the license durations sold as PayPal subscriptions
+var paypalRecurringDurations = []rbdb.LicenseKey_Duration{
+	rbdb.LicenseKey_ONE_MONTH,
+	rbdb.LicenseKey_ONE_YEAR,
+}
+
+// PayPalSale is the subset of the PayPal Sale resource sent with PAYMENT.SALE.COMPLETED
+type PayPalSale struct {
+	ID                 string    `json:"id"`
+	State              string    `json:"state"`
+	BillingAgreementID string    `json:"billing_agreement_id"`
+	CreateTime         time.Time `json:"create_time"`
+	Amount             struct {
+		Total    string `json:"total"`
+		Currency string `json:"currency"`
+	} `json:"amount"`
+}
+
+// paypalPlanFrequency maps a license duration to a PayPal billing cycle frequency
+func paypalPlanFrequency(duration rbdb.LicenseKey_Duration) (paypal.Frequency, bool) {
+	switch duration {
+	case rbdb.LicenseKey_ONE_MONTH:
+		return paypal.Frequency{IntervalUnit: paypal.IntervalUnitMonth, IntervalCount: 1}, true
+	case rbdb.LicenseKey_ONE_YEAR:
+		return paypal.Frequency{IntervalUnit: paypal.IntervalUnitYear, IntervalCount: 1}, true
+	default:
+		return paypal.Frequency{}, false
+	}
+}
+
+// paypalAmountToCents converts a PayPal decimal amount string to cents of its currency
+func paypalAmountToCents(value string, currency string) int64 {
+	amountInCents, err := parseAmount(value, currency)
+	if err != nil {
+		return 0
+	}
+	return amountInCents
+}
+
+// formatPayPalAmount formats cents as the decimal string expected by PayPal
+func formatPayPalAmount(amountInCents int64, currency string) string {
+	return formatAmount(amountInCents, currency)
+}
+
+// ProvisionPayPalPlans makes sure an active PayPal plan matches the price of each recurring duration
+// Plans are only created when missing or when the price changed, the previous plan is then deactivated
+// so it keeps billing its existing subscribers but can't be subscribed to anymore
+func ProvisionPayPalPlans(ctx context.Context, db *gorm.DB, logger *zap.Logger, payments PaymentProvider) ([]*rbdb.BillingPlanORM, int, error) {
+	provider, err := paypalSubscriptionProvider(payments)
+	if err != nil {
+		return nil, 0, err
+	}
+	paypalClient, err := provider.Client(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	productID, err := rbdb.GetBillingPlanProductID(db, rbdb.Payment_PROVIDER_PAYPAL, provider.SandboxMode())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	plans := []*rbdb.BillingPlanORM{}
+	created := 0
+	for _, duration := range paypalRecurringDurations {
+		price, err := rbdb.FindActivePrice(db, paidLicenseTier, duration, paypalPlanCurrency, time.Now().UTC())
+		if err != nil {
+			return nil, 0, err
+		}
+		amountInCents := price.AmountInCents
+
+		currentPlan, err := rbdb.GetActiveBillingPlan(db, rbdb.Payment_PROVIDER_PAYPAL, duration, provider.SandboxMode())
+		if err != nil && errcode.Code(err) != int32(errcode.ERR_PAYMENT_BILLING_PLAN_NOT_FOUND) {
+			return nil, 0, err
+		}
+		if currentPlan != nil && currentPlan.AmountInCents == amountInCents && currentPlan.Currency == paypalPlanCurrency {
+			plans = append(plans, currentPlan)
+			continue
+		}
+
+		// All plans are attached to a single product
+		if productID == "" {
+			product, err := paypalClient.CreateProduct(ctx, paypal.Product{
+				Name:     paypalProductName,
+				Type:     paypal.ProductTypeService,
+				Category: paypal.ProductCategorySoftware,
+				HomeUrl:  "https://rslbot.com",
+			})
+			if err != nil {
+				return nil, 0, errcode.ERR_PAYMENT_PAYPAL_PLAN_PROVISIONING.Wrap(err)
+			}
+			productID = product.ID
+		}
+
+		frequency, _ := paypalPlanFrequency(duration)
+		plan, err := paypalClient.CreateSubscriptionPlan(ctx, paypal.SubscriptionPlan{
+			ProductId: productID,
+			Name:      price.DisplayName,
+			Status:    paypal.SubscriptionPlanStatusActive,
+			BillingCycles: []paypal.BillingCycle{
+				{
+					PricingScheme: paypal.PricingScheme{
+						FixedPrice: paypal.Money{
+							Currency: paypalPlanCurrency,
+							Value:    formatPayPalAmount(amountInCents, paypalPlanCurrency),
+						},
+					},
+					Frequency:   frequency,
+					TenureType:  paypal.TenureTypeRegular,
+					Sequence:    1,
+					TotalCycles: 0, // Renew until canceled
+				},
+			},
+			PaymentPreferences: &paypal.PaymentPreferences{
+				AutoBillOutstanding:     true,
+				SetupFee:                &paypal.Money{Currency: paypalPlanCurrency, Value: "0"},
+				SetupFeeFailureAction:   paypal.SetupFeeFailureActionCancel,
+				PaymentFailureThreshold: 3,
+			},
+		})
+		if err != nil {
+			return nil, 0, errcode.ERR_PAYMENT_PAYPAL_PLAN_PROVISIONING.Wrap(err)
+		}
+
+		planOrm := &rbdb.BillingPlanORM{
+			Provider:          int32(rbdb.Payment_PROVIDER_PAYPAL),
+			Duration:          int32(duration),
+			SandboxMode:       provider.SandboxMode(),
+			ProviderProductId: productID,
+			ProviderPlanId:    plan.ID,
+			AmountInCents:     amountInCents,
+			Currency:          paypalPlanCurrency,
+		}
+		if err := rbdb.ActivateBillingPlan(db, planOrm); err != nil {
+			return nil, 0, err
+		}
+		created++
+
+		logger.Info("PayPal plan provisioned",
+			zap.String("duration", duration.String()),
+			zap.String("plan_id", plan.ID),
+			zap.Int64("amount_in_cents", amountInCents))
+
+		if currentPlan != nil {
+			if err := paypalClient.DeactivateSubscriptionPlans(ctx, currentPlan.ProviderPlanId); err != nil {
+				return nil, 0, errcode.ERR_PAYMENT_PAYPAL_PLAN_PROVISIONING.Wrap(err)
+			}
+		}
+		plans = append(plans, planOrm)
+	}
+
+	return plans, created, nil
+}
+
+// CancelPayPalSubscription cancels a subscription on PayPal, no further payment is collected
+func CancelPayPalSubscription(ctx context.Context, payments PaymentProvider, subscriptionID string) error {
+	provider, err := paypalSubscriptionProvider(payments)
+	if err != nil {
+		return errcode.ERR_SUBSCRIPTION_CANCEL.Wrap(err)
+	}
+	paypalClient, err := provider.Client(ctx)
+	if err != nil {
+		return errcode.ERR_SUBSCRIPTION_CANCEL.Wrap(err)
+	}
+
+	if err := paypalClient.CancelSubscription(ctx, subscriptionID, "Canceled by the user"); err != nil {
+		return errcode.ERR_SUBSCRIPTION_CANCEL.Wrap(err)
+	}
+	return nil
+}
+
+// handlePayPalSaleCompleted creates the subscription on its first payment and renews the license on the next ones
+func handlePayPalSaleCompleted(ctx context.Context, event paypal.AnyEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider) error {
+	var sale PayPalSale
+	if err := json.Unmarshal(event.Resource, &sale); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+	}
+
+	// One-time payments are handled from their capture
+	if sale.BillingAgreementID == "" {
+		return nil
+	}
+	if sale.ID == "" {
+		return errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(fmt.Errorf("missing sale ID in event %s", event.ID))
+	}
+
+	// Check if this sale has already been processed
+	var existingPayment rbdb.PaymentORM
+	err := db.Where(&rbdb.PaymentORM{ReferenceId: sale.ID}).First(&existingPayment).Error
+	if err == nil {
+		logger.Info("Payment already processed", zap.String("sale_id", sale.ID), zap.Int64("payment_id", existingPayment.Id))
+		return nil
+	} else if !rbdb.IsRecordNotFoundError(err) {
+		return rbdb.GormToErrcode(err)
+	}
+
+	// Sales don't carry the checkout metadata nor the billing period, they are read from the subscription
+	provider, err := paypalSubscriptionProvider(payments)
+	if err != nil {
+		return err
+	}
+	paypalClient, err := provider.Client(ctx)
+	if err != nil {
+		return err
+	}
+	details, err := paypalClient.GetSubscriptionDetails(ctx, sale.BillingAgreementID)
+	if err != nil {
+		return errcode.ERR_PAYMENT_RETRIEVE_PAYPAL_SUBSCRIPTION.Wrap(err)
+	}
+
+	var metadata map[string]string
+	if err := json.Unmarshal([]byte(details.CustomID), &metadata); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_METADATA_ERROR.Wrap(err)
+	}
+
+	payment := &rbdb.Payment{
+		Provider:      rbdb.Payment_PROVIDER_PAYPAL,
+		ReferenceId:   sale.ID,
+		AmountInCents: paypalAmountToCents(sale.Amount.Total, sale.Amount.Currency),
+		Currency:      rbdb.NormalizeCurrency(sale.Amount.Currency),
+		SandboxMode:   metadata["sandbox_mode"] == "true",
+	}
+	customerID := ""
+	if details.Subscriber != nil {
+		customerID = details.Subscriber.PayerID
+		payment.BillingEmail = details.Subscriber.EmailAddress
+		payment.BillingName = strings.TrimSpace(fmt.Sprintf("%s %s",
+			details.Subscriber.Name.GivenName,
+			details.Subscriber.Name.Surname))
+	}
+
+	periodStart := sale.CreateTime.UTC()
+	if periodStart.IsZero() {
+		periodStart = time.Now().UTC()
+	}
+	periodEnd := details.BillingInfo.NextBillingTime.UTC()
+
+	subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, sale.BillingAgreementID)
+	if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_NOT_FOUND) {
+		licenseKey, err := startSubscriptionPayment(ctx, db, cfg, payment, metadata, &rbdb.SubscriptionORM{
+			ProviderSubscriptionId: sale.BillingAgreementID,
+			ProviderCustomerId:     customerID,
+			CurrentPeriodStart:     &periodStart,
+			CurrentPeriodEnd:       &periodEnd,
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Info("PayPal subscription started",
+			zap.String("subscription_id", sale.BillingAgreementID),
+			zap.String("license_key", licenseKey.Key))
+
+		syncUserDiscourseGroup(ctx, db, logger, cfg.Discourse, licenseKey.UserId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := renewSubscriptionPayment(ctx, db, cfg, payment, subscriptionOrm, periodStart, periodEnd); err != nil {
+		return err
+	}
+
+	logger.Info("PayPal subscription renewed",
+		zap.String("subscription_id", sale.BillingAgreementID),
+		zap.String("sale_id", sale.ID),
+		zap.Time("period_end", periodEnd))
+
+	syncUserDiscourseGroup(ctx, db, logger, cfg.Discourse, subscriptionOrm.UserId)
+	return nil
+}
+
+// handlePayPalSubscriptionEvent applies a BILLING.SUBSCRIPTION.* event to the matching subscription
+func handlePayPalSubscriptionEvent(event paypal.AnyEvent, db *gorm.DB, logger *zap.Logger) error {
+	var details paypal.SubscriptionDetailResp
+	if err := json.Unmarshal(event.Resource, &details); err != nil {
+		return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+	}
+
+	subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, details.ID)
+	if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_NOT_FOUND) {
+		// The subscription is recorded on its first payment, events before it have nothing to update
+		logger.Info("PayPal event for unknown subscription",
+			zap.String("event_type", event.EventType),
+			zap.String("subscription_id", details.ID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType {
+	case paypalEventSubscriptionPaymentFailed, paypalEventSubscriptionSuspended:
+		if err := rbdb.MarkSubscriptionPaymentFailed(db, subscriptionOrm.Id); err != nil {
+			return err
+		}
+	case paypalEventSubscriptionCancelled, paypalEventSubscriptionExpired:
+		canceledAt := details.StatusUpdateTime.UTC()
+		if canceledAt.IsZero() {
+			canceledAt = time.Now().UTC()
+		}
+
+		// Subscriptions canceled from our API are already marked
+		_, err := rbdb.CancelSubscription(db, subscriptionOrm.Id, canceledAt)
+		if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_ALREADY_CANCELED) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	logger.Info("PayPal subscription updated",
+		zap.String("event_type", event.EventType),
+		zap.String("subscription_id", details.ID))
+	return nil
+}
@@ -0,0 +1,277 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// fakePayPal emulates the catalog, billing plans and subscriptions endpoints of the PayPal API
+type fakePayPal struct {
+	mu            sync.Mutex
+	products      int
+	plans         []paypal.SubscriptionPlan
+	deactivated   []string
+	subscriptions []paypal.SubscriptionBase
+	canceled      []string
+}
+
+func (f *fakePayPal) handler(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "A21_fake",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("POST /v1/catalogs/products", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.products++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": fmt.Sprintf("PROD-%d", f.products)})
+	})
+	mux.HandleFunc("POST /v1/billing/plans", func(w http.ResponseWriter, r *http.Request) {
+		var plan paypal.SubscriptionPlan
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&plan))
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		plan.ID = fmt.Sprintf("P-%d", len(f.plans)+1)
+		f.plans = append(f.plans, plan)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": plan.ID, "status": plan.Status})
+	})
+	mux.HandleFunc("POST /v1/billing/plans/{id}/deactivate", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.deactivated = append(f.deactivated, r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /v1/billing/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		var subscription paypal.SubscriptionBase
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&subscription))
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.subscriptions = append(f.subscriptions, subscription)
+		id := fmt.Sprintf("I-%d", len(f.subscriptions))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     id,
+			"status": "APPROVAL_PENDING",
+			"links": []interface{}{
+				map[string]interface{}{"rel": "approve", "href": "https://www.sandbox.paypal.com/webapps/billing/subscriptions?ba_token=" + id},
+			},
+		})
+	})
+	mux.HandleFunc("GET /v1/billing/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		// Subscriptions are approved from the last created one
+		subscription := f.subscriptions[len(f.subscriptions)-1]
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":        r.PathValue("id"),
+			"status":    "ACTIVE",
+			"plan_id":   subscription.PlanID,
+			"custom_id": subscription.CustomID,
+			"subscriber": map[string]interface{}{
+				"payer_id":      "PAYER1",
+				"email_address": "buyer@example.com",
+				"name":          map[string]interface{}{"given_name": "Test", "surname": "Buyer"},
+			},
+			"billing_info": map[string]interface{}{
+				"next_billing_time": time.Now().AddDate(0, 1, 0).UTC().Format(time.RFC3339),
+			},
+		})
+	})
+	mux.HandleFunc("POST /v1/billing/subscriptions/{id}/cancel", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.canceled = append(f.canceled, r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return mux
+}
+
+func TestPayPalSubscription(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+
+	fake := &fakePayPal{}
+	server := httptest.NewServer(fake.handler(t))
+	defer server.Close()
+
+	// Subscriptions are only billed by PayPal
+	provider := newPayPalProvider(config.PayPal{ClientID: "client", ClientSecret: "secret", Sandbox: true}, server.URL)
+	svc, db, _, _ := TestingPaymentService(t, ServiceOpts{
+		Logger:          logger,
+		PaymentProvider: provider,
+	})
+
+	ctx = TestingSetContextToken(ctx, t)
+	session, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+
+	sendEvent := func(t *testing.T, eventType string, resource map[string]interface{}) {
+		t.Helper()
+
+		raw, err := json.Marshal(resource)
+		require.NoError(t, err)
+		event := paypal.AnyEvent{
+			Event:    paypal.Event{ID: "WH-" + eventType, EventType: eventType},
+			Resource: raw,
+		}
+		require.NoError(t, processPayPalWebhookEvent(context.Background(), event, db, logger, svc.Config(), provider))
+	}
+	sale := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                   id,
+			"state":                "completed",
+			"billing_agreement_id": "I-1",
+			"create_time":          time.Now().UTC().Format(time.RFC3339),
+			"amount":               map[string]interface{}{"total": "19.00", "currency": "EUR"},
+		}
+	}
+	loadSubscription := func(t *testing.T) *rbdb.SubscriptionORM {
+		t.Helper()
+		subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, "I-1")
+		require.NoError(t, err)
+		return subscriptionOrm
+	}
+
+	t.Run("subscribing requires a provisioned plan", func(t *testing.T) {
+		_, err := svc.PaymentCreatePayPalSubscription(ctx, &PaymentCreatePayPalSubscription_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.Error(t, err)
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_BILLING_PLAN_NOT_FOUND), errcode.Code(err))
+	})
+
+	t.Run("plans are provisioned from the price table", func(t *testing.T) {
+		plans, created, err := ProvisionPayPalPlans(context.Background(), db, logger, provider)
+		require.NoError(t, err)
+		assert.Equal(t, 2, created)
+		require.Len(t, plans, 2)
+		assert.Equal(t, int64(1900), plans[0].AmountInCents)
+		assert.Equal(t, int64(12900), plans[1].AmountInCents)
+		require.Len(t, fake.plans, 2)
+		assert.Equal(t, "19.00", fake.plans[0].BillingCycles[0].PricingScheme.FixedPrice.Value)
+		assert.Equal(t, paypal.IntervalUnitYear, fake.plans[1].BillingCycles[0].Frequency.IntervalUnit)
+
+		// Provisioning again keeps the existing plans
+		_, created, err = ProvisionPayPalPlans(context.Background(), db, logger, provider)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Equal(t, 1, fake.products)
+	})
+
+	t.Run("price change replaces the plan", func(t *testing.T) {
+		require.NoError(t, db.Model(&rbdb.BillingPlanORM{}).
+			Where("provider_plan_id = ?", "P-1").
+			Update("amount_in_cents", 1500).Error)
+
+		plans, created, err := ProvisionPayPalPlans(context.Background(), db, logger, provider)
+		require.NoError(t, err)
+		assert.Equal(t, 1, created)
+		assert.Equal(t, "P-3", plans[0].ProviderPlanId)
+		assert.Equal(t, []string{"P-1"}, fake.deactivated)
+		assert.Equal(t, 1, fake.products)
+	})
+
+	t.Run("create subscription", func(t *testing.T) {
+		_, err := svc.PaymentCreatePayPalSubscription(ctx, &PaymentCreatePayPalSubscription_Input{
+			LicenseDuration: rbdb.LicenseKey_LIFETIME,
+		})
+		require.Error(t, err)
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_RECURRING_DURATION_UNSUPPORTED), errcode.Code(err))
+
+		out, err := svc.PaymentCreatePayPalSubscription(ctx, &PaymentCreatePayPalSubscription_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "I-1", out.SubscriptionId)
+		assert.Contains(t, out.CheckoutUrl, "ba_token=I-1")
+
+		require.Len(t, fake.subscriptions, 1)
+		assert.Equal(t, "P-3", fake.subscriptions[0].PlanID)
+		var metadata map[string]string
+		require.NoError(t, json.Unmarshal([]byte(fake.subscriptions[0].CustomID), &metadata))
+		assert.Equal(t, fmt.Sprintf("%d", session.User.Id), metadata["user_id"])
+		assert.Equal(t, "ONE_MONTH", metadata["duration"])
+	})
+
+	var firstExpiration time.Time
+	t.Run("first sale starts the subscription", func(t *testing.T) {
+		sendEvent(t, paypalEventSubscriptionActivated, map[string]interface{}{"id": "I-1", "status": "ACTIVE"})
+		sendEvent(t, paypalEventPaymentSaleCompleted, sale("SALE-1"))
+
+		subscriptionOrm := loadSubscription(t)
+		assert.Equal(t, int32(rbdb.Subscription_STATUS_ACTIVE), subscriptionOrm.Status)
+		assert.Equal(t, session.User.Id, subscriptionOrm.UserId)
+		assert.Equal(t, "PAYER1", subscriptionOrm.ProviderCustomerId)
+
+		var paymentOrm rbdb.PaymentORM
+		require.NoError(t, db.Where(&rbdb.PaymentORM{ReferenceId: "SALE-1"}).First(&paymentOrm).Error)
+		assert.Equal(t, int64(1900), paymentOrm.AmountInCents)
+		assert.Equal(t, "Test Buyer", paymentOrm.BillingName)
+		require.NotNil(t, paymentOrm.SubscriptionId)
+		assert.Equal(t, subscriptionOrm.Id, *paymentOrm.SubscriptionId)
+
+		license, err := rbdb.DefaultReadLicenseKey(context.Background(), &rbdb.LicenseKey{Id: subscriptionOrm.LicenseKeyId}, db)
+		require.NoError(t, err)
+		var ok bool
+		firstExpiration, ok = rbdb.LicenseExpiresAt(license)
+		require.True(t, ok)
+
+		_, err = svc.PaymentCreatePayPalSubscription(ctx, &PaymentCreatePayPalSubscription_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.Error(t, err)
+		assert.Equal(t, int32(errcode.ERR_SUBSCRIPTION_ALREADY_ACTIVE), errcode.Code(err))
+	})
+
+	t.Run("next sale renews the license", func(t *testing.T) {
+		sendEvent(t, paypalEventPaymentSaleCompleted, sale("SALE-2"))
+		// Duplicate deliveries are ignored
+		sendEvent(t, paypalEventPaymentSaleCompleted, sale("SALE-2"))
+
+		subscriptionOrm := loadSubscription(t)
+		license, err := rbdb.DefaultReadLicenseKey(context.Background(), &rbdb.LicenseKey{Id: subscriptionOrm.LicenseKeyId}, db)
+		require.NoError(t, err)
+		expiration, ok := rbdb.LicenseExpiresAt(license)
+		require.True(t, ok)
+		assert.WithinDuration(t, firstExpiration.AddDate(0, 1, 0), expiration, time.Second)
+	})
+
+	t.Run("failed payment marks the subscription past due", func(t *testing.T) {
+		sendEvent(t, paypalEventSubscriptionPaymentFailed, map[string]interface{}{"id": "I-1", "status": "ACTIVE"})
+		assert.Equal(t, int32(rbdb.Subscription_STATUS_PAST_DUE), loadSubscription(t).Status)
+	})
+
+	t.Run("user cancels the subscription", func(t *testing.T) {
+		subscriptionOrm := loadSubscription(t)
+		canceled, err := svc.UserCancelSubscription(ctx, &UserCancelSubscription_Input{SubscriptionId: subscriptionOrm.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.Subscription_STATUS_CANCELED, canceled.Subscription.Status)
+		assert.Equal(t, []string{"I-1"}, fake.canceled)
+
+		// PayPal confirms the cancellation
+		sendEvent(t, paypalEventSubscriptionCancelled, map[string]interface{}{"id": "I-1", "status": "CANCELLED"})
+		assert.Equal(t, int32(rbdb.Subscription_STATUS_CANCELED), loadSubscription(t).Status)
+	})
+}
@@ -0,0 +1,123 @@
+package rbapi
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// Permission is what an RPC requires, each admin handler declares its permission with svc.authorize
+type Permission string
+
+const (
+	PermissionSupportRead   Permission = "support:read"      // Search, active users, webhook events, stuck orders, catalog
+	PermissionBillingRead   Permission = "billing:read"      // Revenue, tax reports, refund requests, commissions, rates
+	PermissionBillingWrite  Permission = "billing:write"     // Refund decisions, commission payouts, invoices
+	PermissionLicensesWrite Permission = "licenses:write"    // Manual license keys and revocations
+	PermissionOffsetsWrite  Permission = "offsets:write"     // Offset uploads
+	PermissionCatalogWrite  Permission = "catalog:write"     // Products, prices, exchange and tax rates, PayPal plans
+	PermissionOpsWrite      Permission = "ops:write"         // Webhook replays and Discourse group syncs
+	PermissionRolesWrite    Permission = "roles:write"       // Role grants
+	PermissionImpersonate   Permission = "users:impersonate" // Impersonation tokens and their audit log, only for admins
+)
+
+// rolePermissions are the permissions of each role, admins have them all
+var rolePermissions = map[rbdb.UserRole_Role][]Permission{
+	rbdb.UserRole_ROLE_SUPPORT: {
+		PermissionSupportRead,
+	},
+	rbdb.UserRole_ROLE_BILLING: {
+		PermissionSupportRead,
+		PermissionBillingRead,
+		PermissionBillingWrite,
+	},
+	rbdb.UserRole_ROLE_OFFSETS_MAINTAINER: {
+		PermissionOffsetsWrite,
+	},
+}
+
+// roleGroups returns the Discourse group of each role, an empty group gives nothing
+// They are empty by default, a forum group only gives a role once it is configured
+// Discourse admins always have the admin role
+func roleGroups(cfg config.Roles) map[rbdb.UserRole_Role]string {
+	return map[rbdb.UserRole_Role]string{
+		rbdb.UserRole_ROLE_SUPPORT:            cfg.SupportGroup,
+		rbdb.UserRole_ROLE_BILLING:            cfg.BillingGroup,
+		rbdb.UserRole_ROLE_OFFSETS_MAINTAINER: cfg.OffsetsMaintainerGroup,
+	}
+}
+
+// userRoles returns the roles of a user, from the Discourse admin flag, its Discourse groups and the local grants
+func userRoles(db *gorm.DB, cfg config.Roles, discourseUser *rbdb.DiscourseUser) (map[rbdb.UserRole_Role]bool, error) {
+	roles := map[rbdb.UserRole_Role]bool{}
+	if discourseUser.Admin {
+		roles[rbdb.UserRole_ROLE_ADMIN] = true
+	}
+	for role, group := range roleGroups(cfg) {
+		if group == "" {
+			continue
+		}
+		for _, userGroup := range discourseUser.Groups {
+			if userGroup == group {
+				roles[role] = true
+			}
+		}
+	}
+
+	granted, err := rbdb.GetRolesByDiscourseId(db, discourseUser.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range granted {
+		roles[role] = true
+	}
+	return roles, nil
+}
+
+// hasPermission tells if one of the roles grants a permission
+func hasPermission(roles map[rbdb.UserRole_Role]bool, permission Permission) bool {
+	if roles[rbdb.UserRole_ROLE_ADMIN] {
+		return true
+	}
+	for role := range roles {
+		for _, granted := range rolePermissions[role] {
+			if granted == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// authorizeUser checks that a user has a permission, it is shared by the RPCs and the HTTP handlers
+func authorizeUser(db *gorm.DB, cfg config.Roles, discourseUser *rbdb.DiscourseUser, permission Permission) error {
+	roles, err := userRoles(db, cfg, discourseUser)
+	if err != nil {
+		return err
+	}
+	if !hasPermission(roles, permission) {
+		return errcode.ERR_RESTRICTED_AREA
+	}
+	return nil
+}
+
+// authorize checks that the authenticated user has a permission, ERR_RESTRICTED_AREA otherwise
+func (svc *service) authorize(ctx context.Context, permission Permission) error {
+	discourseUser, err := discourseUserFromContext(ctx)
+	if err != nil {
+		return errcode.ERR_RESTRICTED_AREA
+	}
+	return authorizeUser(svc.db, svc.cfg.Roles, discourseUser, permission)
+}
+
+// validateRole checks a role given to the role RPCs
+func validateRole(role rbdb.UserRole_Role) error {
+	if _, ok := rbdb.UserRole_Role_name[int32(role)]; !ok || role == rbdb.UserRole_ROLE_UNSPECIFIED {
+		return errcode.ERR_ROLE_UNKNOWN.Wrap(fmt.Errorf("%d", role))
+	}
+	return nil
+}
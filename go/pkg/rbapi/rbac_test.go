@@ -0,0 +1,127 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestRoles(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+		Config: TestingRolesConfig(),
+	})
+	defer cleanup()
+	typed := svc.(*service)
+	db := TestingSvcDB(t, svc)
+	roles := &typed.cfg.Roles
+
+	adminCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 1, Username: "admin", Admin: true})
+	userCtx := func(discourseID int64, groups ...string) context.Context {
+		return context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: discourseID, Username: "staff", Groups: groups})
+	}
+	staff, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 9401, Username: "staff"}, db)
+	require.NoError(t, err)
+
+	t.Run("Discourse admins have every permission", func(t *testing.T) {
+		for _, permission := range []Permission{PermissionSupportRead, PermissionBillingWrite, PermissionCatalogWrite, PermissionRolesWrite} {
+			assert.NoError(t, typed.authorize(adminCtx, permission))
+		}
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, typed.authorize(ctx, PermissionSupportRead))
+	})
+
+	t.Run("Discourse groups give roles", func(t *testing.T) {
+		supportCtx := userCtx(9402, "trust_level_1", roles.SupportGroup)
+		_, err := svc.AdminGetActiveUsers(supportCtx, &AdminGetActiveUsers_Input{})
+		assert.NoError(t, err)
+		_, err = svc.AdminListRefundRequests(supportCtx, &AdminListRefundRequests_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		billingCtx := userCtx(9403, roles.BillingGroup)
+		_, err = svc.AdminListRefundRequests(billingCtx, &AdminListRefundRequests_Input{})
+		assert.NoError(t, err)
+		_, err = svc.AdminGetActiveUsers(billingCtx, &AdminGetActiveUsers_Input{})
+		assert.NoError(t, err)
+		_, err = svc.AdminCreateProduct(billingCtx, &AdminCreateProduct_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		// Disabled groups give nothing
+		prevGroup := roles.SupportGroup
+		roles.SupportGroup = ""
+		defer func() { roles.SupportGroup = prevGroup }()
+		_, err = svc.AdminGetActiveUsers(userCtx(9404, ""), &AdminGetActiveUsers_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminGetActiveUsers(userCtx(9404, prevGroup), &AdminGetActiveUsers_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+	})
+
+	t.Run("local grants", func(t *testing.T) {
+		staffCtx := userCtx(staff.DiscourseId)
+		_, err := svc.AdminListRefundRequests(staffCtx, &AdminListRefundRequests_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		_, err = svc.AdminGrantRole(staffCtx, &AdminGrantRole_Input{UserId: staff.Id, Role: rbdb.UserRole_ROLE_BILLING})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminGrantRole(adminCtx, &AdminGrantRole_Input{UserId: staff.Id, Role: rbdb.UserRole_Role(42)})
+		assert.Equal(t, int32(errcode.ERR_ROLE_UNKNOWN), errcode.Code(err))
+		_, err = svc.AdminGrantRole(adminCtx, &AdminGrantRole_Input{UserId: staff.Id + 1000, Role: rbdb.UserRole_ROLE_BILLING})
+		assert.Equal(t, int32(errcode.ERR_USER_NOT_FOUND), errcode.Code(err))
+
+		granted, err := svc.AdminGrantRole(adminCtx, &AdminGrantRole_Input{UserId: staff.Id, Role: rbdb.UserRole_ROLE_BILLING})
+		require.NoError(t, err)
+		assert.Equal(t, "admin", granted.UserRole.GrantedBy)
+		again, err := svc.AdminGrantRole(adminCtx, &AdminGrantRole_Input{UserId: staff.Id, Role: rbdb.UserRole_ROLE_BILLING})
+		require.NoError(t, err)
+		assert.Equal(t, granted.UserRole.Id, again.UserRole.Id)
+
+		_, err = svc.AdminListRefundRequests(staffCtx, &AdminListRefundRequests_Input{})
+		assert.NoError(t, err)
+
+		list, err := svc.AdminListRoles(adminCtx, &AdminListRoles_Input{UserId: staff.Id})
+		require.NoError(t, err)
+		require.Len(t, list.UserRoles, 1)
+		assert.Equal(t, "staff", list.UserRoles[0].User.Username)
+		require.Len(t, list.RoleGroups, 3)
+		assert.Equal(t, rbdb.UserRole_ROLE_SUPPORT, list.RoleGroups[0].Role)
+		assert.Equal(t, roles.SupportGroup, list.RoleGroups[0].DiscourseGroup)
+
+		_, err = svc.AdminRevokeRole(adminCtx, &AdminRevokeRole_Input{UserId: staff.Id, Role: rbdb.UserRole_ROLE_BILLING})
+		require.NoError(t, err)
+		_, err = svc.AdminRevokeRole(adminCtx, &AdminRevokeRole_Input{UserId: staff.Id, Role: rbdb.UserRole_ROLE_BILLING})
+		assert.Equal(t, int32(errcode.ERR_ROLE_NOT_GRANTED), errcode.Code(err))
+		_, err = svc.AdminListRefundRequests(staffCtx, &AdminListRefundRequests_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+	})
+
+	t.Run("offsets update shares the checks", func(t *testing.T) {
+		handler := updateOffsets(db, typed.redis, typed.cfg)
+		update := func(user *rbdb.DiscourseUser) int {
+			_, tokens, err := typed.redis.CreateSession(ctx, typed.cfg.Auth, user, time.Now().UTC())
+			require.NoError(t, err)
+			body := []byte(`{"version":"9.9.8","offsets":{"x":1}}`)
+			req := httptest.NewRequest(http.MethodPost, "/offsets/update", bytes.NewReader(body))
+			req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			return rec.Code
+		}
+
+		assert.Equal(t, http.StatusForbidden, update(&rbdb.DiscourseUser{ExternalId: 9405, Username: "support", Groups: []string{roles.SupportGroup}}))
+		assert.Equal(t, http.StatusOK, update(&rbdb.DiscourseUser{ExternalId: 9406, Username: "maintainer", Groups: []string{roles.OffsetsMaintainerGroup}}))
+
+		_, err := svc.AdminGrantRole(adminCtx, &AdminGrantRole_Input{UserId: staff.Id, Role: rbdb.UserRole_ROLE_OFFSETS_MAINTAINER})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, update(&rbdb.DiscourseUser{ExternalId: staff.DiscourseId, Username: "staff"}))
+	})
+}
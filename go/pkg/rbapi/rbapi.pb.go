@@ -10,7 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	_ "rslbot.com/go/pkg/errcode"
 	rbdb "rslbot.com/go/pkg/rbdb"
@@ -24,6 +24,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PaymentGetCheckoutStatus_Status int32
+
+const (
+	PaymentGetCheckoutStatus_STATUS_UNSPECIFIED    PaymentGetCheckoutStatus_Status = 0
+	PaymentGetCheckoutStatus_STATUS_PENDING        PaymentGetCheckoutStatus_Status = 1 // Waiting for the buyer approval or the capture
+	PaymentGetCheckoutStatus_STATUS_CAPTURED       PaymentGetCheckoutStatus_Status = 2 // Paid, the license is not issued yet
+	PaymentGetCheckoutStatus_STATUS_LICENSE_ISSUED PaymentGetCheckoutStatus_Status = 3
+	PaymentGetCheckoutStatus_STATUS_FAILED         PaymentGetCheckoutStatus_Status = 4 // Declined, voided or abandoned
+)
+
+// Enum value maps for PaymentGetCheckoutStatus_Status.
+var (
+	PaymentGetCheckoutStatus_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_CAPTURED",
+		3: "STATUS_LICENSE_ISSUED",
+		4: "STATUS_FAILED",
+	}
+	PaymentGetCheckoutStatus_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":    0,
+		"STATUS_PENDING":        1,
+		"STATUS_CAPTURED":       2,
+		"STATUS_LICENSE_ISSUED": 3,
+		"STATUS_FAILED":         4,
+	}
+)
+
+func (x PaymentGetCheckoutStatus_Status) Enum() *PaymentGetCheckoutStatus_Status {
+	p := new(PaymentGetCheckoutStatus_Status)
+	*p = x
+	return p
+}
+
+func (x PaymentGetCheckoutStatus_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentGetCheckoutStatus_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbapi_proto_enumTypes[0].Descriptor()
+}
+
+func (PaymentGetCheckoutStatus_Status) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbapi_proto_enumTypes[0]
+}
+
+func (x PaymentGetCheckoutStatus_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentGetCheckoutStatus_Status.Descriptor instead.
+func (PaymentGetCheckoutStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{39, 0}
+}
+
 type AdminAddLicenseKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -60,26 +115,26 @@ func (*AdminAddLicenseKey) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{0}
 }
 
-type AdminGetActiveUsers struct {
+type AdminApproveRefundRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminGetActiveUsers) Reset() {
-	*x = AdminGetActiveUsers{}
+func (x *AdminApproveRefundRequest) Reset() {
+	*x = AdminApproveRefundRequest{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminGetActiveUsers) String() string {
+func (x *AdminApproveRefundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminGetActiveUsers) ProtoMessage() {}
+func (*AdminApproveRefundRequest) ProtoMessage() {}
 
-func (x *AdminGetActiveUsers) ProtoReflect() protoreflect.Message {
+func (x *AdminApproveRefundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -91,31 +146,31 @@ func (x *AdminGetActiveUsers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminGetActiveUsers.ProtoReflect.Descriptor instead.
-func (*AdminGetActiveUsers) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminApproveRefundRequest.ProtoReflect.Descriptor instead.
+func (*AdminApproveRefundRequest) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{1}
 }
 
-type AdminRevokeLicense struct {
+type AdminCreatePrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminRevokeLicense) Reset() {
-	*x = AdminRevokeLicense{}
+func (x *AdminCreatePrice) Reset() {
+	*x = AdminCreatePrice{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminRevokeLicense) String() string {
+func (x *AdminCreatePrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRevokeLicense) ProtoMessage() {}
+func (*AdminCreatePrice) ProtoMessage() {}
 
-func (x *AdminRevokeLicense) ProtoReflect() protoreflect.Message {
+func (x *AdminCreatePrice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -127,31 +182,31 @@ func (x *AdminRevokeLicense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRevokeLicense.ProtoReflect.Descriptor instead.
-func (*AdminRevokeLicense) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminCreatePrice.ProtoReflect.Descriptor instead.
+func (*AdminCreatePrice) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{2}
 }
 
-type AdminSearchDatabase struct {
+type AdminCreateProduct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminSearchDatabase) Reset() {
-	*x = AdminSearchDatabase{}
+func (x *AdminCreateProduct) Reset() {
+	*x = AdminCreateProduct{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminSearchDatabase) String() string {
+func (x *AdminCreateProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSearchDatabase) ProtoMessage() {}
+func (*AdminCreateProduct) ProtoMessage() {}
 
-func (x *AdminSearchDatabase) ProtoReflect() protoreflect.Message {
+func (x *AdminCreateProduct) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -163,31 +218,31 @@ func (x *AdminSearchDatabase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSearchDatabase.ProtoReflect.Descriptor instead.
-func (*AdminSearchDatabase) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminCreateProduct.ProtoReflect.Descriptor instead.
+func (*AdminCreateProduct) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{3}
 }
 
-type PaymentCreatePayPalCheckout struct {
+type AdminDeletePrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PaymentCreatePayPalCheckout) Reset() {
-	*x = PaymentCreatePayPalCheckout{}
+func (x *AdminDeletePrice) Reset() {
+	*x = AdminDeletePrice{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentCreatePayPalCheckout) String() string {
+func (x *AdminDeletePrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentCreatePayPalCheckout) ProtoMessage() {}
+func (*AdminDeletePrice) ProtoMessage() {}
 
-func (x *PaymentCreatePayPalCheckout) ProtoReflect() protoreflect.Message {
+func (x *AdminDeletePrice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,31 +254,31 @@ func (x *PaymentCreatePayPalCheckout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentCreatePayPalCheckout.ProtoReflect.Descriptor instead.
-func (*PaymentCreatePayPalCheckout) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminDeletePrice.ProtoReflect.Descriptor instead.
+func (*AdminDeletePrice) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{4}
 }
 
-type ToolStatus struct {
+type AdminDeleteProduct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ToolStatus) Reset() {
-	*x = ToolStatus{}
+func (x *AdminDeleteProduct) Reset() {
+	*x = AdminDeleteProduct{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolStatus) String() string {
+func (x *AdminDeleteProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolStatus) ProtoMessage() {}
+func (*AdminDeleteProduct) ProtoMessage() {}
 
-func (x *ToolStatus) ProtoReflect() protoreflect.Message {
+func (x *AdminDeleteProduct) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,31 +290,31 @@ func (x *ToolStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolStatus.ProtoReflect.Descriptor instead.
-func (*ToolStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminDeleteProduct.ProtoReflect.Descriptor instead.
+func (*AdminDeleteProduct) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{5}
 }
 
-type UserGetLicenses struct {
+type AdminDenyRefundRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserGetLicenses) Reset() {
-	*x = UserGetLicenses{}
+func (x *AdminDenyRefundRequest) Reset() {
+	*x = AdminDenyRefundRequest{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGetLicenses) String() string {
+func (x *AdminDenyRefundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGetLicenses) ProtoMessage() {}
+func (*AdminDenyRefundRequest) ProtoMessage() {}
 
-func (x *UserGetLicenses) ProtoReflect() protoreflect.Message {
+func (x *AdminDenyRefundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -271,31 +326,31 @@ func (x *UserGetLicenses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGetLicenses.ProtoReflect.Descriptor instead.
-func (*UserGetLicenses) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminDenyRefundRequest.ProtoReflect.Descriptor instead.
+func (*AdminDenyRefundRequest) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{6}
 }
 
-type UserGetSession struct {
+type AdminGetActiveUsers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserGetSession) Reset() {
-	*x = UserGetSession{}
+func (x *AdminGetActiveUsers) Reset() {
+	*x = AdminGetActiveUsers{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGetSession) String() string {
+func (x *AdminGetActiveUsers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGetSession) ProtoMessage() {}
+func (*AdminGetActiveUsers) ProtoMessage() {}
 
-func (x *UserGetSession) ProtoReflect() protoreflect.Message {
+func (x *AdminGetActiveUsers) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -307,31 +362,31 @@ func (x *UserGetSession) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGetSession.ProtoReflect.Descriptor instead.
-func (*UserGetSession) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminGetActiveUsers.ProtoReflect.Descriptor instead.
+func (*AdminGetActiveUsers) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{7}
 }
 
-type UserLogout struct {
+type AdminGetRevenue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserLogout) Reset() {
-	*x = UserLogout{}
+func (x *AdminGetRevenue) Reset() {
+	*x = AdminGetRevenue{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLogout) String() string {
+func (x *AdminGetRevenue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLogout) ProtoMessage() {}
+func (*AdminGetRevenue) ProtoMessage() {}
 
-func (x *UserLogout) ProtoReflect() protoreflect.Message {
+func (x *AdminGetRevenue) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,31 +398,31 @@ func (x *UserLogout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLogout.ProtoReflect.Descriptor instead.
-func (*UserLogout) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminGetRevenue.ProtoReflect.Descriptor instead.
+func (*AdminGetRevenue) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{8}
 }
 
-type UserSyncDiscordRole struct {
+type AdminGetTaxReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserSyncDiscordRole) Reset() {
-	*x = UserSyncDiscordRole{}
+func (x *AdminGetTaxReport) Reset() {
+	*x = AdminGetTaxReport{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSyncDiscordRole) String() string {
+func (x *AdminGetTaxReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSyncDiscordRole) ProtoMessage() {}
+func (*AdminGetTaxReport) ProtoMessage() {}
 
-func (x *UserSyncDiscordRole) ProtoReflect() protoreflect.Message {
+func (x *AdminGetTaxReport) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,36 +434,31 @@ func (x *UserSyncDiscordRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSyncDiscordRole.ProtoReflect.Descriptor instead.
-func (*UserSyncDiscordRole) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminGetTaxReport.ProtoReflect.Descriptor instead.
+func (*AdminGetTaxReport) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{9}
 }
 
-type AdminAddLicenseKey_Input struct {
+type AdminGrantRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId    int64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserEmail string                   `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
-	Duration  rbdb.LicenseKey_Duration `protobuf:"varint,3,opt,name=duration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"duration,omitempty"`
-	Tier      rbdb.LicenseKey_Tier     `protobuf:"varint,4,opt,name=tier,proto3,enum=rslbot.db.LicenseKey_Tier" json:"tier,omitempty"`
 }
 
-func (x *AdminAddLicenseKey_Input) Reset() {
-	*x = AdminAddLicenseKey_Input{}
+func (x *AdminGrantRole) Reset() {
+	*x = AdminGrantRole{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminAddLicenseKey_Input) String() string {
+func (x *AdminGrantRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAddLicenseKey_Input) ProtoMessage() {}
+func (*AdminGrantRole) ProtoMessage() {}
 
-func (x *AdminAddLicenseKey_Input) ProtoReflect() protoreflect.Message {
+func (x *AdminGrantRole) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,61 +470,31 @@ func (x *AdminAddLicenseKey_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAddLicenseKey_Input.ProtoReflect.Descriptor instead.
-func (*AdminAddLicenseKey_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *AdminAddLicenseKey_Input) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminAddLicenseKey_Input) GetUserEmail() string {
-	if x != nil {
-		return x.UserEmail
-	}
-	return ""
-}
-
-func (x *AdminAddLicenseKey_Input) GetDuration() rbdb.LicenseKey_Duration {
-	if x != nil {
-		return x.Duration
-	}
-	return rbdb.LicenseKey_Duration(0)
-}
-
-func (x *AdminAddLicenseKey_Input) GetTier() rbdb.LicenseKey_Tier {
-	if x != nil {
-		return x.Tier
-	}
-	return rbdb.LicenseKey_Tier(0)
+// Deprecated: Use AdminGrantRole.ProtoReflect.Descriptor instead.
+func (*AdminGrantRole) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{10}
 }
 
-type AdminAddLicenseKey_Output struct {
+type AdminImpersonateUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	LicenseKey *rbdb.LicenseKey `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
 }
 
-func (x *AdminAddLicenseKey_Output) Reset() {
-	*x = AdminAddLicenseKey_Output{}
+func (x *AdminImpersonateUser) Reset() {
+	*x = AdminImpersonateUser{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminAddLicenseKey_Output) String() string {
+func (x *AdminImpersonateUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAddLicenseKey_Output) ProtoMessage() {}
+func (*AdminImpersonateUser) ProtoMessage() {}
 
-func (x *AdminAddLicenseKey_Output) ProtoReflect() protoreflect.Message {
+func (x *AdminImpersonateUser) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,38 +506,31 @@ func (x *AdminAddLicenseKey_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAddLicenseKey_Output.ProtoReflect.Descriptor instead.
-func (*AdminAddLicenseKey_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{0, 1}
-}
-
-func (x *AdminAddLicenseKey_Output) GetLicenseKey() *rbdb.LicenseKey {
-	if x != nil {
-		return x.LicenseKey
-	}
-	return nil
+// Deprecated: Use AdminImpersonateUser.ProtoReflect.Descriptor instead.
+func (*AdminImpersonateUser) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{11}
 }
 
-type AdminGetActiveUsers_Input struct {
+type AdminListCatalog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminGetActiveUsers_Input) Reset() {
-	*x = AdminGetActiveUsers_Input{}
+func (x *AdminListCatalog) Reset() {
+	*x = AdminListCatalog{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminGetActiveUsers_Input) String() string {
+func (x *AdminListCatalog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminGetActiveUsers_Input) ProtoMessage() {}
+func (*AdminListCatalog) ProtoMessage() {}
 
-func (x *AdminGetActiveUsers_Input) ProtoReflect() protoreflect.Message {
+func (x *AdminListCatalog) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,35 +542,31 @@ func (x *AdminGetActiveUsers_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminGetActiveUsers_Input.ProtoReflect.Descriptor instead.
-func (*AdminGetActiveUsers_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use AdminListCatalog.ProtoReflect.Descriptor instead.
+func (*AdminListCatalog) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{12}
 }
 
-type AdminGetActiveUsers_Output struct {
+type AdminListExchangeRates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FreeTier   int32 `protobuf:"varint,1,opt,name=free_tier,json=freeTier,proto3" json:"free_tier,omitempty"`
-	PaidTier   int32 `protobuf:"varint,2,opt,name=paid_tier,json=paidTier,proto3" json:"paid_tier,omitempty"`
-	TotalUsers int32 `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
 }
 
-func (x *AdminGetActiveUsers_Output) Reset() {
-	*x = AdminGetActiveUsers_Output{}
+func (x *AdminListExchangeRates) Reset() {
+	*x = AdminListExchangeRates{}
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminGetActiveUsers_Output) String() string {
+func (x *AdminListExchangeRates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminGetActiveUsers_Output) ProtoMessage() {}
+func (*AdminListExchangeRates) ProtoMessage() {}
 
-func (x *AdminGetActiveUsers_Output) ProtoReflect() protoreflect.Message {
+func (x *AdminListExchangeRates) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbapi_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -569,55 +578,68 @@ func (x *AdminGetActiveUsers_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminGetActiveUsers_Output.ProtoReflect.Descriptor instead.
-func (*AdminGetActiveUsers_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use AdminListExchangeRates.ProtoReflect.Descriptor instead.
+func (*AdminListExchangeRates) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AdminGetActiveUsers_Output) GetFreeTier() int32 {
-	if x != nil {
-		return x.FreeTier
-	}
-	return 0
+type AdminListImpersonationAudits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminGetActiveUsers_Output) GetPaidTier() int32 {
-	if x != nil {
-		return x.PaidTier
-	}
-	return 0
+func (x *AdminListImpersonationAudits) Reset() {
+	*x = AdminListImpersonationAudits{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminGetActiveUsers_Output) GetTotalUsers() int32 {
+func (x *AdminListImpersonationAudits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListImpersonationAudits) ProtoMessage() {}
+
+func (x *AdminListImpersonationAudits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[14]
 	if x != nil {
-		return x.TotalUsers
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type AdminRevokeLicense_Input struct {
+// Deprecated: Use AdminListImpersonationAudits.ProtoReflect.Descriptor instead.
+func (*AdminListImpersonationAudits) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{14}
+}
+
+type AdminListPayableCommissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *AdminRevokeLicense_Input) Reset() {
-	*x = AdminRevokeLicense_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[14]
+func (x *AdminListPayableCommissions) Reset() {
+	*x = AdminListPayableCommissions{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminRevokeLicense_Input) String() string {
+func (x *AdminListPayableCommissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRevokeLicense_Input) ProtoMessage() {}
+func (*AdminListPayableCommissions) ProtoMessage() {}
 
-func (x *AdminRevokeLicense_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[14]
+func (x *AdminListPayableCommissions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,41 +650,32 @@ func (x *AdminRevokeLicense_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRevokeLicense_Input.ProtoReflect.Descriptor instead.
-func (*AdminRevokeLicense_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *AdminRevokeLicense_Input) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use AdminListPayableCommissions.ProtoReflect.Descriptor instead.
+func (*AdminListPayableCommissions) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{15}
 }
 
-type AdminRevokeLicense_Output struct {
+type AdminListRefundRequests struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	LicenseKey *rbdb.LicenseKey `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
 }
 
-func (x *AdminRevokeLicense_Output) Reset() {
-	*x = AdminRevokeLicense_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[15]
+func (x *AdminListRefundRequests) Reset() {
+	*x = AdminListRefundRequests{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminRevokeLicense_Output) String() string {
+func (x *AdminListRefundRequests) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRevokeLicense_Output) ProtoMessage() {}
+func (*AdminListRefundRequests) ProtoMessage() {}
 
-func (x *AdminRevokeLicense_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[15]
+func (x *AdminListRefundRequests) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,41 +686,7149 @@ func (x *AdminRevokeLicense_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRevokeLicense_Output.ProtoReflect.Descriptor instead.
-func (*AdminRevokeLicense_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use AdminListRefundRequests.ProtoReflect.Descriptor instead.
+func (*AdminListRefundRequests) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AdminRevokeLicense_Output) GetLicenseKey() *rbdb.LicenseKey {
+type AdminListRoles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListRoles) Reset() {
+	*x = AdminListRoles{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListRoles) ProtoMessage() {}
+
+func (x *AdminListRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListRoles.ProtoReflect.Descriptor instead.
+func (*AdminListRoles) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{17}
+}
+
+type AdminListStuckOrders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListStuckOrders) Reset() {
+	*x = AdminListStuckOrders{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListStuckOrders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListStuckOrders) ProtoMessage() {}
+
+func (x *AdminListStuckOrders) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListStuckOrders.ProtoReflect.Descriptor instead.
+func (*AdminListStuckOrders) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{18}
+}
+
+type AdminListTaxRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListTaxRates) Reset() {
+	*x = AdminListTaxRates{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTaxRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTaxRates) ProtoMessage() {}
+
+func (x *AdminListTaxRates) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTaxRates.ProtoReflect.Descriptor instead.
+func (*AdminListTaxRates) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{19}
+}
+
+type AdminListWebhookEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListWebhookEvents) Reset() {
+	*x = AdminListWebhookEvents{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWebhookEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWebhookEvents) ProtoMessage() {}
+
+func (x *AdminListWebhookEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWebhookEvents.ProtoReflect.Descriptor instead.
+func (*AdminListWebhookEvents) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{20}
+}
+
+type AdminPayCommissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminPayCommissions) Reset() {
+	*x = AdminPayCommissions{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPayCommissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPayCommissions) ProtoMessage() {}
+
+func (x *AdminPayCommissions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPayCommissions.ProtoReflect.Descriptor instead.
+func (*AdminPayCommissions) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{21}
+}
+
+type AdminProvisionPayPalPlans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminProvisionPayPalPlans) Reset() {
+	*x = AdminProvisionPayPalPlans{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminProvisionPayPalPlans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProvisionPayPalPlans) ProtoMessage() {}
+
+func (x *AdminProvisionPayPalPlans) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProvisionPayPalPlans.ProtoReflect.Descriptor instead.
+func (*AdminProvisionPayPalPlans) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{22}
+}
+
+type AdminRegenerateInvoices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminRegenerateInvoices) Reset() {
+	*x = AdminRegenerateInvoices{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRegenerateInvoices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRegenerateInvoices) ProtoMessage() {}
+
+func (x *AdminRegenerateInvoices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRegenerateInvoices.ProtoReflect.Descriptor instead.
+func (*AdminRegenerateInvoices) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{23}
+}
+
+type AdminReplayWebhookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminReplayWebhookEvent) Reset() {
+	*x = AdminReplayWebhookEvent{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReplayWebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReplayWebhookEvent) ProtoMessage() {}
+
+func (x *AdminReplayWebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReplayWebhookEvent.ProtoReflect.Descriptor instead.
+func (*AdminReplayWebhookEvent) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{24}
+}
+
+type AdminRevokeLicense struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminRevokeLicense) Reset() {
+	*x = AdminRevokeLicense{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeLicense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeLicense) ProtoMessage() {}
+
+func (x *AdminRevokeLicense) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeLicense.ProtoReflect.Descriptor instead.
+func (*AdminRevokeLicense) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{25}
+}
+
+type AdminRevokeRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminRevokeRole) Reset() {
+	*x = AdminRevokeRole{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeRole) ProtoMessage() {}
+
+func (x *AdminRevokeRole) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeRole.ProtoReflect.Descriptor instead.
+func (*AdminRevokeRole) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{26}
+}
+
+type AdminSearchDatabase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminSearchDatabase) Reset() {
+	*x = AdminSearchDatabase{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchDatabase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchDatabase) ProtoMessage() {}
+
+func (x *AdminSearchDatabase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchDatabase.ProtoReflect.Descriptor instead.
+func (*AdminSearchDatabase) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{27}
+}
+
+type AdminSetExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminSetExchangeRate) Reset() {
+	*x = AdminSetExchangeRate{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetExchangeRate) ProtoMessage() {}
+
+func (x *AdminSetExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetExchangeRate.ProtoReflect.Descriptor instead.
+func (*AdminSetExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{28}
+}
+
+type AdminSetTaxRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminSetTaxRate) Reset() {
+	*x = AdminSetTaxRate{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetTaxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetTaxRate) ProtoMessage() {}
+
+func (x *AdminSetTaxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetTaxRate.ProtoReflect.Descriptor instead.
+func (*AdminSetTaxRate) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{29}
+}
+
+type AdminSyncDiscourseGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminSyncDiscourseGroup) Reset() {
+	*x = AdminSyncDiscourseGroup{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSyncDiscourseGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSyncDiscourseGroup) ProtoMessage() {}
+
+func (x *AdminSyncDiscourseGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSyncDiscourseGroup.ProtoReflect.Descriptor instead.
+func (*AdminSyncDiscourseGroup) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{30}
+}
+
+type AdminUpdatePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminUpdatePrice) Reset() {
+	*x = AdminUpdatePrice{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdatePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdatePrice) ProtoMessage() {}
+
+func (x *AdminUpdatePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdatePrice.ProtoReflect.Descriptor instead.
+func (*AdminUpdatePrice) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{31}
+}
+
+type AdminUpdateProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminUpdateProduct) Reset() {
+	*x = AdminUpdateProduct{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateProduct) ProtoMessage() {}
+
+func (x *AdminUpdateProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateProduct.ProtoReflect.Descriptor instead.
+func (*AdminUpdateProduct) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{32}
+}
+
+type AuthExchangeSSO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuthExchangeSSO) Reset() {
+	*x = AuthExchangeSSO{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthExchangeSSO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthExchangeSSO) ProtoMessage() {}
+
+func (x *AuthExchangeSSO) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthExchangeSSO.ProtoReflect.Descriptor instead.
+func (*AuthExchangeSSO) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{33}
+}
+
+type AuthRefreshSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuthRefreshSession) Reset() {
+	*x = AuthRefreshSession{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRefreshSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRefreshSession) ProtoMessage() {}
+
+func (x *AuthRefreshSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRefreshSession.ProtoReflect.Descriptor instead.
+func (*AuthRefreshSession) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{34}
+}
+
+type AuthStartSSO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuthStartSSO) Reset() {
+	*x = AuthStartSSO{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthStartSSO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthStartSSO) ProtoMessage() {}
+
+func (x *AuthStartSSO) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthStartSSO.ProtoReflect.Descriptor instead.
+func (*AuthStartSSO) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{35}
+}
+
+type PaymentCreatePayPalCheckout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PaymentCreatePayPalCheckout) Reset() {
+	*x = PaymentCreatePayPalCheckout{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreatePayPalCheckout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreatePayPalCheckout) ProtoMessage() {}
+
+func (x *PaymentCreatePayPalCheckout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreatePayPalCheckout.ProtoReflect.Descriptor instead.
+func (*PaymentCreatePayPalCheckout) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{36}
+}
+
+type PaymentCreatePayPalSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PaymentCreatePayPalSubscription) Reset() {
+	*x = PaymentCreatePayPalSubscription{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreatePayPalSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreatePayPalSubscription) ProtoMessage() {}
+
+func (x *PaymentCreatePayPalSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreatePayPalSubscription.ProtoReflect.Descriptor instead.
+func (*PaymentCreatePayPalSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{37}
+}
+
+type PaymentCreateStripeCheckout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PaymentCreateStripeCheckout) Reset() {
+	*x = PaymentCreateStripeCheckout{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreateStripeCheckout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreateStripeCheckout) ProtoMessage() {}
+
+func (x *PaymentCreateStripeCheckout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreateStripeCheckout.ProtoReflect.Descriptor instead.
+func (*PaymentCreateStripeCheckout) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{38}
+}
+
+type PaymentGetCheckoutStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PaymentGetCheckoutStatus) Reset() {
+	*x = PaymentGetCheckoutStatus{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentGetCheckoutStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentGetCheckoutStatus) ProtoMessage() {}
+
+func (x *PaymentGetCheckoutStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentGetCheckoutStatus.ProtoReflect.Descriptor instead.
+func (*PaymentGetCheckoutStatus) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{39}
+}
+
+type PaymentWatchCheckoutStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PaymentWatchCheckoutStatus) Reset() {
+	*x = PaymentWatchCheckoutStatus{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentWatchCheckoutStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentWatchCheckoutStatus) ProtoMessage() {}
+
+func (x *PaymentWatchCheckoutStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentWatchCheckoutStatus.ProtoReflect.Descriptor instead.
+func (*PaymentWatchCheckoutStatus) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{40}
+}
+
+type PublicListPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublicListPrices) Reset() {
+	*x = PublicListPrices{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicListPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicListPrices) ProtoMessage() {}
+
+func (x *PublicListPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicListPrices.ProtoReflect.Descriptor instead.
+func (*PublicListPrices) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{41}
+}
+
+type ToolStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ToolStatus) Reset() {
+	*x = ToolStatus{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolStatus) ProtoMessage() {}
+
+func (x *ToolStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolStatus.ProtoReflect.Descriptor instead.
+func (*ToolStatus) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{42}
+}
+
+type UserCancelSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserCancelSubscription) Reset() {
+	*x = UserCancelSubscription{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCancelSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCancelSubscription) ProtoMessage() {}
+
+func (x *UserCancelSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCancelSubscription.ProtoReflect.Descriptor instead.
+func (*UserCancelSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{43}
+}
+
+type UserCreateApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserCreateApiKey) Reset() {
+	*x = UserCreateApiKey{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCreateApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateApiKey) ProtoMessage() {}
+
+func (x *UserCreateApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateApiKey.ProtoReflect.Descriptor instead.
+func (*UserCreateApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{44}
+}
+
+type UserGetInvoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetInvoice) Reset() {
+	*x = UserGetInvoice{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetInvoice) ProtoMessage() {}
+
+func (x *UserGetInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetInvoice.ProtoReflect.Descriptor instead.
+func (*UserGetInvoice) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{45}
+}
+
+type UserGetLicenses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetLicenses) Reset() {
+	*x = UserGetLicenses{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetLicenses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetLicenses) ProtoMessage() {}
+
+func (x *UserGetLicenses) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetLicenses.ProtoReflect.Descriptor instead.
+func (*UserGetLicenses) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{46}
+}
+
+type UserGetPayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetPayment) Reset() {
+	*x = UserGetPayment{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetPayment) ProtoMessage() {}
+
+func (x *UserGetPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetPayment.ProtoReflect.Descriptor instead.
+func (*UserGetPayment) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{47}
+}
+
+type UserGetReferralCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetReferralCode) Reset() {
+	*x = UserGetReferralCode{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetReferralCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetReferralCode) ProtoMessage() {}
+
+func (x *UserGetReferralCode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetReferralCode.ProtoReflect.Descriptor instead.
+func (*UserGetReferralCode) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{48}
+}
+
+type UserGetSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetSession) Reset() {
+	*x = UserGetSession{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetSession) ProtoMessage() {}
+
+func (x *UserGetSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetSession.ProtoReflect.Descriptor instead.
+func (*UserGetSession) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{49}
+}
+
+type UserGetSubscriptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetSubscriptions) Reset() {
+	*x = UserGetSubscriptions{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetSubscriptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetSubscriptions) ProtoMessage() {}
+
+func (x *UserGetSubscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetSubscriptions.ProtoReflect.Descriptor instead.
+func (*UserGetSubscriptions) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{50}
+}
+
+type UserListApiKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserListApiKeys) Reset() {
+	*x = UserListApiKeys{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListApiKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListApiKeys) ProtoMessage() {}
+
+func (x *UserListApiKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListApiKeys.ProtoReflect.Descriptor instead.
+func (*UserListApiKeys) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{51}
+}
+
+type UserListCommissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserListCommissions) Reset() {
+	*x = UserListCommissions{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListCommissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListCommissions) ProtoMessage() {}
+
+func (x *UserListCommissions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListCommissions.ProtoReflect.Descriptor instead.
+func (*UserListCommissions) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{52}
+}
+
+type UserListPayments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserListPayments) Reset() {
+	*x = UserListPayments{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListPayments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListPayments) ProtoMessage() {}
+
+func (x *UserListPayments) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListPayments.ProtoReflect.Descriptor instead.
+func (*UserListPayments) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{53}
+}
+
+type UserLogout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserLogout) Reset() {
+	*x = UserLogout{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLogout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLogout) ProtoMessage() {}
+
+func (x *UserLogout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLogout.ProtoReflect.Descriptor instead.
+func (*UserLogout) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{54}
+}
+
+type UserLogoutEverywhere struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserLogoutEverywhere) Reset() {
+	*x = UserLogoutEverywhere{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLogoutEverywhere) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLogoutEverywhere) ProtoMessage() {}
+
+func (x *UserLogoutEverywhere) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLogoutEverywhere.ProtoReflect.Descriptor instead.
+func (*UserLogoutEverywhere) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{55}
+}
+
+type UserRequestRefund struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserRequestRefund) Reset() {
+	*x = UserRequestRefund{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRequestRefund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRequestRefund) ProtoMessage() {}
+
+func (x *UserRequestRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRequestRefund.ProtoReflect.Descriptor instead.
+func (*UserRequestRefund) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{56}
+}
+
+type UserRevokeApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserRevokeApiKey) Reset() {
+	*x = UserRevokeApiKey{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRevokeApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRevokeApiKey) ProtoMessage() {}
+
+func (x *UserRevokeApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRevokeApiKey.ProtoReflect.Descriptor instead.
+func (*UserRevokeApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{57}
+}
+
+type UserSyncDiscordRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserSyncDiscordRole) Reset() {
+	*x = UserSyncDiscordRole{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSyncDiscordRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSyncDiscordRole) ProtoMessage() {}
+
+func (x *UserSyncDiscordRole) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSyncDiscordRole.ProtoReflect.Descriptor instead.
+func (*UserSyncDiscordRole) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{58}
+}
+
+type AdminAddLicenseKey_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail string                   `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Duration  rbdb.LicenseKey_Duration `protobuf:"varint,3,opt,name=duration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"duration,omitempty"`
+	Tier      rbdb.LicenseKey_Tier     `protobuf:"varint,4,opt,name=tier,proto3,enum=rslbot.db.LicenseKey_Tier" json:"tier,omitempty"`
+}
+
+func (x *AdminAddLicenseKey_Input) Reset() {
+	*x = AdminAddLicenseKey_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAddLicenseKey_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAddLicenseKey_Input) ProtoMessage() {}
+
+func (x *AdminAddLicenseKey_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAddLicenseKey_Input.ProtoReflect.Descriptor instead.
+func (*AdminAddLicenseKey_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AdminAddLicenseKey_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminAddLicenseKey_Input) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *AdminAddLicenseKey_Input) GetDuration() rbdb.LicenseKey_Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return rbdb.LicenseKey_Duration(0)
+}
+
+func (x *AdminAddLicenseKey_Input) GetTier() rbdb.LicenseKey_Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return rbdb.LicenseKey_Tier(0)
+}
+
+type AdminAddLicenseKey_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LicenseKey *rbdb.LicenseKey `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+}
+
+func (x *AdminAddLicenseKey_Output) Reset() {
+	*x = AdminAddLicenseKey_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAddLicenseKey_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAddLicenseKey_Output) ProtoMessage() {}
+
+func (x *AdminAddLicenseKey_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAddLicenseKey_Output.ProtoReflect.Descriptor instead.
+func (*AdminAddLicenseKey_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AdminAddLicenseKey_Output) GetLicenseKey() *rbdb.LicenseKey {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return nil
+}
+
+type AdminApproveRefundRequest_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundRequestId int64  `protobuf:"varint,1,opt,name=refund_request_id,json=refundRequestId,proto3" json:"refund_request_id,omitempty"`
+	Note            string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"` // Optional, kept on the request
+}
+
+func (x *AdminApproveRefundRequest_Input) Reset() {
+	*x = AdminApproveRefundRequest_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminApproveRefundRequest_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminApproveRefundRequest_Input) ProtoMessage() {}
+
+func (x *AdminApproveRefundRequest_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminApproveRefundRequest_Input.ProtoReflect.Descriptor instead.
+func (*AdminApproveRefundRequest_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *AdminApproveRefundRequest_Input) GetRefundRequestId() int64 {
+	if x != nil {
+		return x.RefundRequestId
+	}
+	return 0
+}
+
+func (x *AdminApproveRefundRequest_Input) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminApproveRefundRequest_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundRequest *rbdb.RefundRequest `protobuf:"bytes,1,opt,name=refund_request,json=refundRequest,proto3" json:"refund_request,omitempty"`
+	Payment       *rbdb.Payment       `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"` // Refunded, with its license revoked
+}
+
+func (x *AdminApproveRefundRequest_Output) Reset() {
+	*x = AdminApproveRefundRequest_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminApproveRefundRequest_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminApproveRefundRequest_Output) ProtoMessage() {}
+
+func (x *AdminApproveRefundRequest_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminApproveRefundRequest_Output.ProtoReflect.Descriptor instead.
+func (*AdminApproveRefundRequest_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *AdminApproveRefundRequest_Output) GetRefundRequest() *rbdb.RefundRequest {
+	if x != nil {
+		return x.RefundRequest
+	}
+	return nil
+}
+
+func (x *AdminApproveRefundRequest_Output) GetPayment() *rbdb.Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type AdminCreatePrice_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price *rbdb.Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"` // The product ID is required, the ID is ignored
+}
+
+func (x *AdminCreatePrice_Input) Reset() {
+	*x = AdminCreatePrice_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreatePrice_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreatePrice_Input) ProtoMessage() {}
+
+func (x *AdminCreatePrice_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreatePrice_Input.ProtoReflect.Descriptor instead.
+func (*AdminCreatePrice_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *AdminCreatePrice_Input) GetPrice() *rbdb.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type AdminCreatePrice_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price *rbdb.Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *AdminCreatePrice_Output) Reset() {
+	*x = AdminCreatePrice_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreatePrice_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreatePrice_Output) ProtoMessage() {}
+
+func (x *AdminCreatePrice_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreatePrice_Output.ProtoReflect.Descriptor instead.
+func (*AdminCreatePrice_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *AdminCreatePrice_Output) GetPrice() *rbdb.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type AdminCreateProduct_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *rbdb.Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // The ID is ignored
+}
+
+func (x *AdminCreateProduct_Input) Reset() {
+	*x = AdminCreateProduct_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateProduct_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateProduct_Input) ProtoMessage() {}
+
+func (x *AdminCreateProduct_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateProduct_Input.ProtoReflect.Descriptor instead.
+func (*AdminCreateProduct_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *AdminCreateProduct_Input) GetProduct() *rbdb.Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AdminCreateProduct_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *rbdb.Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+}
+
+func (x *AdminCreateProduct_Output) Reset() {
+	*x = AdminCreateProduct_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateProduct_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateProduct_Output) ProtoMessage() {}
+
+func (x *AdminCreateProduct_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateProduct_Output.ProtoReflect.Descriptor instead.
+func (*AdminCreateProduct_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *AdminCreateProduct_Output) GetProduct() *rbdb.Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AdminDeletePrice_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminDeletePrice_Input) Reset() {
+	*x = AdminDeletePrice_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeletePrice_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeletePrice_Input) ProtoMessage() {}
+
+func (x *AdminDeletePrice_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeletePrice_Input.ProtoReflect.Descriptor instead.
+func (*AdminDeletePrice_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *AdminDeletePrice_Input) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminDeletePrice_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminDeletePrice_Output) Reset() {
+	*x = AdminDeletePrice_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeletePrice_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeletePrice_Output) ProtoMessage() {}
+
+func (x *AdminDeletePrice_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeletePrice_Output.ProtoReflect.Descriptor instead.
+func (*AdminDeletePrice_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{4, 1}
+}
+
+type AdminDeleteProduct_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Products with prices can't be deleted, deactivate them instead
+}
+
+func (x *AdminDeleteProduct_Input) Reset() {
+	*x = AdminDeleteProduct_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteProduct_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteProduct_Input) ProtoMessage() {}
+
+func (x *AdminDeleteProduct_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteProduct_Input.ProtoReflect.Descriptor instead.
+func (*AdminDeleteProduct_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *AdminDeleteProduct_Input) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminDeleteProduct_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminDeleteProduct_Output) Reset() {
+	*x = AdminDeleteProduct_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteProduct_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteProduct_Output) ProtoMessage() {}
+
+func (x *AdminDeleteProduct_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteProduct_Output.ProtoReflect.Descriptor instead.
+func (*AdminDeleteProduct_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{5, 1}
+}
+
+type AdminDenyRefundRequest_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundRequestId int64  `protobuf:"varint,1,opt,name=refund_request_id,json=refundRequestId,proto3" json:"refund_request_id,omitempty"`
+	Note            string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"` // Reason of the denial
+}
+
+func (x *AdminDenyRefundRequest_Input) Reset() {
+	*x = AdminDenyRefundRequest_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDenyRefundRequest_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDenyRefundRequest_Input) ProtoMessage() {}
+
+func (x *AdminDenyRefundRequest_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDenyRefundRequest_Input.ProtoReflect.Descriptor instead.
+func (*AdminDenyRefundRequest_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *AdminDenyRefundRequest_Input) GetRefundRequestId() int64 {
+	if x != nil {
+		return x.RefundRequestId
+	}
+	return 0
+}
+
+func (x *AdminDenyRefundRequest_Input) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminDenyRefundRequest_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundRequest *rbdb.RefundRequest `protobuf:"bytes,1,opt,name=refund_request,json=refundRequest,proto3" json:"refund_request,omitempty"`
+}
+
+func (x *AdminDenyRefundRequest_Output) Reset() {
+	*x = AdminDenyRefundRequest_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDenyRefundRequest_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDenyRefundRequest_Output) ProtoMessage() {}
+
+func (x *AdminDenyRefundRequest_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDenyRefundRequest_Output.ProtoReflect.Descriptor instead.
+func (*AdminDenyRefundRequest_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *AdminDenyRefundRequest_Output) GetRefundRequest() *rbdb.RefundRequest {
+	if x != nil {
+		return x.RefundRequest
+	}
+	return nil
+}
+
+type AdminGetActiveUsers_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminGetActiveUsers_Input) Reset() {
+	*x = AdminGetActiveUsers_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetActiveUsers_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetActiveUsers_Input) ProtoMessage() {}
+
+func (x *AdminGetActiveUsers_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetActiveUsers_Input.ProtoReflect.Descriptor instead.
+func (*AdminGetActiveUsers_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type AdminGetActiveUsers_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreeTier   int32 `protobuf:"varint,1,opt,name=free_tier,json=freeTier,proto3" json:"free_tier,omitempty"`
+	PaidTier   int32 `protobuf:"varint,2,opt,name=paid_tier,json=paidTier,proto3" json:"paid_tier,omitempty"`
+	TotalUsers int32 `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+}
+
+func (x *AdminGetActiveUsers_Output) Reset() {
+	*x = AdminGetActiveUsers_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetActiveUsers_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetActiveUsers_Output) ProtoMessage() {}
+
+func (x *AdminGetActiveUsers_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetActiveUsers_Output.ProtoReflect.Descriptor instead.
+func (*AdminGetActiveUsers_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *AdminGetActiveUsers_Output) GetFreeTier() int32 {
+	if x != nil {
+		return x.FreeTier
+	}
+	return 0
+}
+
+func (x *AdminGetActiveUsers_Output) GetPaidTier() int32 {
+	if x != nil {
+		return x.PaidTier
+	}
+	return 0
+}
+
+func (x *AdminGetActiveUsers_Output) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+type AdminGetRevenue_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // Optional, payments created at or after
+	To             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // Optional, payments created before
+	IncludeSandbox bool                   `protobuf:"varint,3,opt,name=include_sandbox,json=includeSandbox,proto3" json:"include_sandbox,omitempty"`
+}
+
+func (x *AdminGetRevenue_Input) Reset() {
+	*x = AdminGetRevenue_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetRevenue_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetRevenue_Input) ProtoMessage() {}
+
+func (x *AdminGetRevenue_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetRevenue_Input.ProtoReflect.Descriptor instead.
+func (*AdminGetRevenue_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *AdminGetRevenue_Input) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AdminGetRevenue_Input) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AdminGetRevenue_Input) GetIncludeSandbox() bool {
+	if x != nil {
+		return x.IncludeSandbox
+	}
+	return false
+}
+
+type AdminGetRevenue_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Totals          []*AdminGetRevenue_Output_Total `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"` // Completed and refunded payments, failed and pending payments are not revenue
+	PendingPayments int32                           `protobuf:"varint,2,opt,name=pending_payments,json=pendingPayments,proto3" json:"pending_payments,omitempty"`
+	FailedPayments  int32                           `protobuf:"varint,3,opt,name=failed_payments,json=failedPayments,proto3" json:"failed_payments,omitempty"`
+	BaseCurrency    string                          `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	NetInBaseCents  int64                           `protobuf:"varint,5,opt,name=net_in_base_cents,json=netInBaseCents,proto3" json:"net_in_base_cents,omitempty"` // Net of all the totals converted to the base currency
+	MissingRates    []string                        `protobuf:"bytes,6,rep,name=missing_rates,json=missingRates,proto3" json:"missing_rates,omitempty"`            // Currencies without an exchange rate, left out of net_in_base_cents
+}
+
+func (x *AdminGetRevenue_Output) Reset() {
+	*x = AdminGetRevenue_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetRevenue_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetRevenue_Output) ProtoMessage() {}
+
+func (x *AdminGetRevenue_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetRevenue_Output.ProtoReflect.Descriptor instead.
+func (*AdminGetRevenue_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *AdminGetRevenue_Output) GetTotals() []*AdminGetRevenue_Output_Total {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *AdminGetRevenue_Output) GetPendingPayments() int32 {
+	if x != nil {
+		return x.PendingPayments
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output) GetFailedPayments() int32 {
+	if x != nil {
+		return x.FailedPayments
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *AdminGetRevenue_Output) GetNetInBaseCents() int64 {
+	if x != nil {
+		return x.NetInBaseCents
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output) GetMissingRates() []string {
+	if x != nil {
+		return x.MissingRates
+	}
+	return nil
+}
+
+type AdminGetRevenue_Output_Total struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider        rbdb.Payment_Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=rslbot.db.Payment_Provider" json:"provider,omitempty"`
+	Currency        string                `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Payments        int32                 `protobuf:"varint,3,opt,name=payments,proto3" json:"payments,omitempty"`
+	GrossInCents    int64                 `protobuf:"varint,4,opt,name=gross_in_cents,json=grossInCents,proto3" json:"gross_in_cents,omitempty"`
+	RefundedInCents int64                 `protobuf:"varint,5,opt,name=refunded_in_cents,json=refundedInCents,proto3" json:"refunded_in_cents,omitempty"`
+	NetInCents      int64                 `protobuf:"varint,6,opt,name=net_in_cents,json=netInCents,proto3" json:"net_in_cents,omitempty"`
+	NetInBaseCents  int64                 `protobuf:"varint,7,opt,name=net_in_base_cents,json=netInBaseCents,proto3" json:"net_in_base_cents,omitempty"` // Net converted to the base currency, 0 without an exchange rate
+}
+
+func (x *AdminGetRevenue_Output_Total) Reset() {
+	*x = AdminGetRevenue_Output_Total{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetRevenue_Output_Total) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetRevenue_Output_Total) ProtoMessage() {}
+
+func (x *AdminGetRevenue_Output_Total) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetRevenue_Output_Total.ProtoReflect.Descriptor instead.
+func (*AdminGetRevenue_Output_Total) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{8, 1, 0}
+}
+
+func (x *AdminGetRevenue_Output_Total) GetProvider() rbdb.Payment_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return rbdb.Payment_Provider(0)
+}
+
+func (x *AdminGetRevenue_Output_Total) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AdminGetRevenue_Output_Total) GetPayments() int32 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output_Total) GetGrossInCents() int64 {
+	if x != nil {
+		return x.GrossInCents
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output_Total) GetRefundedInCents() int64 {
+	if x != nil {
+		return x.RefundedInCents
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output_Total) GetNetInCents() int64 {
+	if x != nil {
+		return x.NetInCents
+	}
+	return 0
+}
+
+func (x *AdminGetRevenue_Output_Total) GetNetInBaseCents() int64 {
+	if x != nil {
+		return x.NetInBaseCents
+	}
+	return 0
+}
+
+type AdminGetTaxReport_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year           int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Quarter        int32 `protobuf:"varint,2,opt,name=quarter,proto3" json:"quarter,omitempty"` // 1 to 4
+	IncludeSandbox bool  `protobuf:"varint,3,opt,name=include_sandbox,json=includeSandbox,proto3" json:"include_sandbox,omitempty"`
+}
+
+func (x *AdminGetTaxReport_Input) Reset() {
+	*x = AdminGetTaxReport_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTaxReport_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTaxReport_Input) ProtoMessage() {}
+
+func (x *AdminGetTaxReport_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTaxReport_Input.ProtoReflect.Descriptor instead.
+func (*AdminGetTaxReport_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *AdminGetTaxReport_Input) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Input) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Input) GetIncludeSandbox() bool {
+	if x != nil {
+		return x.IncludeSandbox
+	}
+	return false
+}
+
+type AdminGetTaxReport_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Totals []*AdminGetTaxReport_Output_Total `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"` // Settled payments created in the quarter, by country and currency
+}
+
+func (x *AdminGetTaxReport_Output) Reset() {
+	*x = AdminGetTaxReport_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTaxReport_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTaxReport_Output) ProtoMessage() {}
+
+func (x *AdminGetTaxReport_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTaxReport_Output.ProtoReflect.Descriptor instead.
+func (*AdminGetTaxReport_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *AdminGetTaxReport_Output) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AdminGetTaxReport_Output) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AdminGetTaxReport_Output) GetTotals() []*AdminGetTaxReport_Output_Total {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type AdminGetTaxReport_Output_Total struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryCode        string `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // Empty for payments without assessed tax
+	Currency           string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Payments           int32  `protobuf:"varint,3,opt,name=payments,proto3" json:"payments,omitempty"`
+	GrossInCents       int64  `protobuf:"varint,4,opt,name=gross_in_cents,json=grossInCents,proto3" json:"gross_in_cents,omitempty"`
+	TaxInCents         int64  `protobuf:"varint,5,opt,name=tax_in_cents,json=taxInCents,proto3" json:"tax_in_cents,omitempty"`
+	RefundedInCents    int64  `protobuf:"varint,6,opt,name=refunded_in_cents,json=refundedInCents,proto3" json:"refunded_in_cents,omitempty"`
+	RefundedTaxInCents int64  `protobuf:"varint,7,opt,name=refunded_tax_in_cents,json=refundedTaxInCents,proto3" json:"refunded_tax_in_cents,omitempty"` // Tax part of the refunds, in proportion of the refunded amount
+	NetTaxInCents      int64  `protobuf:"varint,8,opt,name=net_tax_in_cents,json=netTaxInCents,proto3" json:"net_tax_in_cents,omitempty"`                // Tax due, tax_in_cents minus refunded_tax_in_cents
+}
+
+func (x *AdminGetTaxReport_Output_Total) Reset() {
+	*x = AdminGetTaxReport_Output_Total{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetTaxReport_Output_Total) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetTaxReport_Output_Total) ProtoMessage() {}
+
+func (x *AdminGetTaxReport_Output_Total) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetTaxReport_Output_Total.ProtoReflect.Descriptor instead.
+func (*AdminGetTaxReport_Output_Total) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{9, 1, 0}
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetPayments() int32 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetGrossInCents() int64 {
+	if x != nil {
+		return x.GrossInCents
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetTaxInCents() int64 {
+	if x != nil {
+		return x.TaxInCents
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetRefundedInCents() int64 {
+	if x != nil {
+		return x.RefundedInCents
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetRefundedTaxInCents() int64 {
+	if x != nil {
+		return x.RefundedTaxInCents
+	}
+	return 0
+}
+
+func (x *AdminGetTaxReport_Output_Total) GetNetTaxInCents() int64 {
+	if x != nil {
+		return x.NetTaxInCents
+	}
+	return 0
+}
+
+type AdminGrantRole_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64              `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role   rbdb.UserRole_Role `protobuf:"varint,2,opt,name=role,proto3,enum=rslbot.db.UserRole_Role" json:"role,omitempty"`
+}
+
+func (x *AdminGrantRole_Input) Reset() {
+	*x = AdminGrantRole_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGrantRole_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGrantRole_Input) ProtoMessage() {}
+
+func (x *AdminGrantRole_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGrantRole_Input.ProtoReflect.Descriptor instead.
+func (*AdminGrantRole_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *AdminGrantRole_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminGrantRole_Input) GetRole() rbdb.UserRole_Role {
+	if x != nil {
+		return x.Role
+	}
+	return rbdb.UserRole_Role(0)
+}
+
+type AdminGrantRole_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserRole *rbdb.UserRole `protobuf:"bytes,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"` // The existing grant when the user already had the role
+}
+
+func (x *AdminGrantRole_Output) Reset() {
+	*x = AdminGrantRole_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGrantRole_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGrantRole_Output) ProtoMessage() {}
+
+func (x *AdminGrantRole_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGrantRole_Output.ProtoReflect.Descriptor instead.
+func (*AdminGrantRole_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *AdminGrantRole_Output) GetUserRole() *rbdb.UserRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return nil
+}
+
+type AdminImpersonateUser_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReadWrite bool   `protobuf:"varint,2,opt,name=read_write,json=readWrite,proto3" json:"read_write,omitempty"` // Also allow the RPCs changing the user data, read-only by default
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                         // Recorded in the audit log, e.g. the support ticket
+}
+
+func (x *AdminImpersonateUser_Input) Reset() {
+	*x = AdminImpersonateUser_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminImpersonateUser_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminImpersonateUser_Input) ProtoMessage() {}
+
+func (x *AdminImpersonateUser_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminImpersonateUser_Input.ProtoReflect.Descriptor instead.
+func (*AdminImpersonateUser_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *AdminImpersonateUser_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminImpersonateUser_Input) GetReadWrite() bool {
+	if x != nil {
+		return x.ReadWrite
+	}
+	return false
+}
+
+func (x *AdminImpersonateUser_Input) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminImpersonateUser_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // Bearer token acting as the user, it can't be refreshed
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	User        *rbdb.User             `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *AdminImpersonateUser_Output) Reset() {
+	*x = AdminImpersonateUser_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminImpersonateUser_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminImpersonateUser_Output) ProtoMessage() {}
+
+func (x *AdminImpersonateUser_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminImpersonateUser_Output.ProtoReflect.Descriptor instead.
+func (*AdminImpersonateUser_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *AdminImpersonateUser_Output) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AdminImpersonateUser_Output) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AdminImpersonateUser_Output) GetUser() *rbdb.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AdminListCatalog_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListCatalog_Input) Reset() {
+	*x = AdminListCatalog_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListCatalog_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListCatalog_Input) ProtoMessage() {}
+
+func (x *AdminListCatalog_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListCatalog_Input.ProtoReflect.Descriptor instead.
+func (*AdminListCatalog_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type AdminListCatalog_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products []*rbdb.Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Prices   []*rbdb.Price   `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"` // With their product, inactive and expired ones included
+}
+
+func (x *AdminListCatalog_Output) Reset() {
+	*x = AdminListCatalog_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListCatalog_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListCatalog_Output) ProtoMessage() {}
+
+func (x *AdminListCatalog_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListCatalog_Output.ProtoReflect.Descriptor instead.
+func (*AdminListCatalog_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *AdminListCatalog_Output) GetProducts() []*rbdb.Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *AdminListCatalog_Output) GetPrices() []*rbdb.Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type AdminListExchangeRates_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListExchangeRates_Input) Reset() {
+	*x = AdminListExchangeRates_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListExchangeRates_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListExchangeRates_Input) ProtoMessage() {}
+
+func (x *AdminListExchangeRates_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListExchangeRates_Input.ProtoReflect.Descriptor instead.
+func (*AdminListExchangeRates_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type AdminListExchangeRates_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseCurrency string               `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	Rates        []*rbdb.ExchangeRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+}
+
+func (x *AdminListExchangeRates_Output) Reset() {
+	*x = AdminListExchangeRates_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListExchangeRates_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListExchangeRates_Output) ProtoMessage() {}
+
+func (x *AdminListExchangeRates_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListExchangeRates_Output.ProtoReflect.Descriptor instead.
+func (*AdminListExchangeRates_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *AdminListExchangeRates_Output) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *AdminListExchangeRates_Output) GetRates() []*rbdb.ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type AdminListImpersonationAudits_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // Optional, the calls made as this user
+	AdminUsername string `protobuf:"bytes,2,opt,name=admin_username,json=adminUsername,proto3" json:"admin_username,omitempty"` // Optional, the calls made by this admin
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                     // Defaults to 100
+}
+
+func (x *AdminListImpersonationAudits_Input) Reset() {
+	*x = AdminListImpersonationAudits_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListImpersonationAudits_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListImpersonationAudits_Input) ProtoMessage() {}
+
+func (x *AdminListImpersonationAudits_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListImpersonationAudits_Input.ProtoReflect.Descriptor instead.
+func (*AdminListImpersonationAudits_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *AdminListImpersonationAudits_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminListImpersonationAudits_Input) GetAdminUsername() string {
+	if x != nil {
+		return x.AdminUsername
+	}
+	return ""
+}
+
+func (x *AdminListImpersonationAudits_Input) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminListImpersonationAudits_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audits []*rbdb.ImpersonationAudit `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits,omitempty"` // Newest first, with their user
+}
+
+func (x *AdminListImpersonationAudits_Output) Reset() {
+	*x = AdminListImpersonationAudits_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListImpersonationAudits_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListImpersonationAudits_Output) ProtoMessage() {}
+
+func (x *AdminListImpersonationAudits_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListImpersonationAudits_Output.ProtoReflect.Descriptor instead.
+func (*AdminListImpersonationAudits_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *AdminListImpersonationAudits_Output) GetAudits() []*rbdb.ImpersonationAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type AdminListPayableCommissions_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListPayableCommissions_Input) Reset() {
+	*x = AdminListPayableCommissions_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListPayableCommissions_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListPayableCommissions_Input) ProtoMessage() {}
+
+func (x *AdminListPayableCommissions_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListPayableCommissions_Input.ProtoReflect.Descriptor instead.
+func (*AdminListPayableCommissions_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type AdminListPayableCommissions_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payables []*AdminListPayableCommissions_Output_Payable `protobuf:"bytes,1,rep,name=payables,proto3" json:"payables,omitempty"` // Referrers owed a payout, largest amounts first
+}
+
+func (x *AdminListPayableCommissions_Output) Reset() {
+	*x = AdminListPayableCommissions_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListPayableCommissions_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListPayableCommissions_Output) ProtoMessage() {}
+
+func (x *AdminListPayableCommissions_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListPayableCommissions_Output.ProtoReflect.Descriptor instead.
+func (*AdminListPayableCommissions_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *AdminListPayableCommissions_Output) GetPayables() []*AdminListPayableCommissions_Output_Payable {
+	if x != nil {
+		return x.Payables
+	}
+	return nil
+}
+
+type AdminListPayableCommissions_Output_Payable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referrer      *rbdb.User `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Currency      string     `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	AmountInCents int64      `protobuf:"varint,3,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"` // Payable earnings minus payable reversals
+	Commissions   int32      `protobuf:"varint,4,opt,name=commissions,proto3" json:"commissions,omitempty"`
+}
+
+func (x *AdminListPayableCommissions_Output_Payable) Reset() {
+	*x = AdminListPayableCommissions_Output_Payable{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListPayableCommissions_Output_Payable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListPayableCommissions_Output_Payable) ProtoMessage() {}
+
+func (x *AdminListPayableCommissions_Output_Payable) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListPayableCommissions_Output_Payable.ProtoReflect.Descriptor instead.
+func (*AdminListPayableCommissions_Output_Payable) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{15, 1, 0}
+}
+
+func (x *AdminListPayableCommissions_Output_Payable) GetReferrer() *rbdb.User {
+	if x != nil {
+		return x.Referrer
+	}
+	return nil
+}
+
+func (x *AdminListPayableCommissions_Output_Payable) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AdminListPayableCommissions_Output_Payable) GetAmountInCents() int64 {
+	if x != nil {
+		return x.AmountInCents
+	}
+	return 0
+}
+
+func (x *AdminListPayableCommissions_Output_Payable) GetCommissions() int32 {
+	if x != nil {
+		return x.Commissions
+	}
+	return 0
+}
+
+type AdminListRefundRequests_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status rbdb.RefundRequest_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rslbot.db.RefundRequest_Status" json:"status,omitempty"` // Defaults to STATUS_PENDING
+	Limit  int32                     `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                       // Defaults to 50
+}
+
+func (x *AdminListRefundRequests_Input) Reset() {
+	*x = AdminListRefundRequests_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListRefundRequests_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListRefundRequests_Input) ProtoMessage() {}
+
+func (x *AdminListRefundRequests_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListRefundRequests_Input.ProtoReflect.Descriptor instead.
+func (*AdminListRefundRequests_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *AdminListRefundRequests_Input) GetStatus() rbdb.RefundRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return rbdb.RefundRequest_Status(0)
+}
+
+func (x *AdminListRefundRequests_Input) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminListRefundRequests_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundRequests []*rbdb.RefundRequest `protobuf:"bytes,1,rep,name=refund_requests,json=refundRequests,proto3" json:"refund_requests,omitempty"` // With their payment and user, oldest first
+}
+
+func (x *AdminListRefundRequests_Output) Reset() {
+	*x = AdminListRefundRequests_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListRefundRequests_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListRefundRequests_Output) ProtoMessage() {}
+
+func (x *AdminListRefundRequests_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListRefundRequests_Output.ProtoReflect.Descriptor instead.
+func (*AdminListRefundRequests_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *AdminListRefundRequests_Output) GetRefundRequests() []*rbdb.RefundRequest {
+	if x != nil {
+		return x.RefundRequests
+	}
+	return nil
+}
+
+type AdminListRoles_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional, all the grants by default
+}
+
+func (x *AdminListRoles_Input) Reset() {
+	*x = AdminListRoles_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListRoles_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListRoles_Input) ProtoMessage() {}
+
+func (x *AdminListRoles_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListRoles_Input.ProtoReflect.Descriptor instead.
+func (*AdminListRoles_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *AdminListRoles_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AdminListRoles_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserRoles  []*rbdb.UserRole                   `protobuf:"bytes,1,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`    // Local grants only, with their user
+	RoleGroups []*AdminListRoles_Output_RoleGroup `protobuf:"bytes,2,rep,name=role_groups,json=roleGroups,proto3" json:"role_groups,omitempty"` // Discourse groups giving a role
+}
+
+func (x *AdminListRoles_Output) Reset() {
+	*x = AdminListRoles_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListRoles_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListRoles_Output) ProtoMessage() {}
+
+func (x *AdminListRoles_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListRoles_Output.ProtoReflect.Descriptor instead.
+func (*AdminListRoles_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *AdminListRoles_Output) GetUserRoles() []*rbdb.UserRole {
+	if x != nil {
+		return x.UserRoles
+	}
+	return nil
+}
+
+func (x *AdminListRoles_Output) GetRoleGroups() []*AdminListRoles_Output_RoleGroup {
+	if x != nil {
+		return x.RoleGroups
+	}
+	return nil
+}
+
+type AdminListRoles_Output_RoleGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role           rbdb.UserRole_Role `protobuf:"varint,1,opt,name=role,proto3,enum=rslbot.db.UserRole_Role" json:"role,omitempty"`
+	DiscourseGroup string             `protobuf:"bytes,2,opt,name=discourse_group,json=discourseGroup,proto3" json:"discourse_group,omitempty"`
+}
+
+func (x *AdminListRoles_Output_RoleGroup) Reset() {
+	*x = AdminListRoles_Output_RoleGroup{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListRoles_Output_RoleGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListRoles_Output_RoleGroup) ProtoMessage() {}
+
+func (x *AdminListRoles_Output_RoleGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListRoles_Output_RoleGroup.ProtoReflect.Descriptor instead.
+func (*AdminListRoles_Output_RoleGroup) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{17, 1, 0}
+}
+
+func (x *AdminListRoles_Output_RoleGroup) GetRole() rbdb.UserRole_Role {
+	if x != nil {
+		return x.Role
+	}
+	return rbdb.UserRole_Role(0)
+}
+
+func (x *AdminListRoles_Output_RoleGroup) GetDiscourseGroup() string {
+	if x != nil {
+		return x.DiscourseGroup
+	}
+	return ""
+}
+
+type AdminListStuckOrders_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OlderThanMinutes int32 `protobuf:"varint,1,opt,name=older_than_minutes,json=olderThanMinutes,proto3" json:"older_than_minutes,omitempty"` // Defaults to 60
+	Limit            int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                                 // Defaults to 50
+}
+
+func (x *AdminListStuckOrders_Input) Reset() {
+	*x = AdminListStuckOrders_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListStuckOrders_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListStuckOrders_Input) ProtoMessage() {}
+
+func (x *AdminListStuckOrders_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListStuckOrders_Input.ProtoReflect.Descriptor instead.
+func (*AdminListStuckOrders_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *AdminListStuckOrders_Input) GetOlderThanMinutes() int32 {
+	if x != nil {
+		return x.OlderThanMinutes
+	}
+	return 0
+}
+
+func (x *AdminListStuckOrders_Input) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminListStuckOrders_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts []*AdminListStuckOrders_Output_Count `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"` // Stuck orders in each pending status
+	Orders []*rbdb.CheckoutOrder                `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"` // Oldest first
+}
+
+func (x *AdminListStuckOrders_Output) Reset() {
+	*x = AdminListStuckOrders_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListStuckOrders_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListStuckOrders_Output) ProtoMessage() {}
+
+func (x *AdminListStuckOrders_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListStuckOrders_Output.ProtoReflect.Descriptor instead.
+func (*AdminListStuckOrders_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *AdminListStuckOrders_Output) GetCounts() []*AdminListStuckOrders_Output_Count {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *AdminListStuckOrders_Output) GetOrders() []*rbdb.CheckoutOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type AdminListStuckOrders_Output_Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status rbdb.CheckoutOrder_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rslbot.db.CheckoutOrder_Status" json:"status,omitempty"`
+	Orders int32                     `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *AdminListStuckOrders_Output_Count) Reset() {
+	*x = AdminListStuckOrders_Output_Count{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListStuckOrders_Output_Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListStuckOrders_Output_Count) ProtoMessage() {}
+
+func (x *AdminListStuckOrders_Output_Count) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListStuckOrders_Output_Count.ProtoReflect.Descriptor instead.
+func (*AdminListStuckOrders_Output_Count) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{18, 1, 0}
+}
+
+func (x *AdminListStuckOrders_Output_Count) GetStatus() rbdb.CheckoutOrder_Status {
+	if x != nil {
+		return x.Status
+	}
+	return rbdb.CheckoutOrder_Status(0)
+}
+
+func (x *AdminListStuckOrders_Output_Count) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+type AdminListTaxRates_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminListTaxRates_Input) Reset() {
+	*x = AdminListTaxRates_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTaxRates_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTaxRates_Input) ProtoMessage() {}
+
+func (x *AdminListTaxRates_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTaxRates_Input.ProtoReflect.Descriptor instead.
+func (*AdminListTaxRates_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type AdminListTaxRates_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rates []*rbdb.TaxRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+}
+
+func (x *AdminListTaxRates_Output) Reset() {
+	*x = AdminListTaxRates_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTaxRates_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTaxRates_Output) ProtoMessage() {}
+
+func (x *AdminListTaxRates_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTaxRates_Output.ProtoReflect.Descriptor instead.
+func (*AdminListTaxRates_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *AdminListTaxRates_Output) GetRates() []*rbdb.TaxRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type AdminListWebhookEvents_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   rbdb.WebhookEvent_Status   `protobuf:"varint,1,opt,name=status,proto3,enum=rslbot.db.WebhookEvent_Status" json:"status,omitempty"`       // Optional, FAILED lists the events the inbox gave up on
+	Provider rbdb.WebhookEvent_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=rslbot.db.WebhookEvent_Provider" json:"provider,omitempty"` // Optional
+	Limit    int32                      `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                            // Defaults to 50
+}
+
+func (x *AdminListWebhookEvents_Input) Reset() {
+	*x = AdminListWebhookEvents_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWebhookEvents_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWebhookEvents_Input) ProtoMessage() {}
+
+func (x *AdminListWebhookEvents_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWebhookEvents_Input.ProtoReflect.Descriptor instead.
+func (*AdminListWebhookEvents_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *AdminListWebhookEvents_Input) GetStatus() rbdb.WebhookEvent_Status {
+	if x != nil {
+		return x.Status
+	}
+	return rbdb.WebhookEvent_Status(0)
+}
+
+func (x *AdminListWebhookEvents_Input) GetProvider() rbdb.WebhookEvent_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return rbdb.WebhookEvent_Provider(0)
+}
+
+func (x *AdminListWebhookEvents_Input) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminListWebhookEvents_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*rbdb.WebhookEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Latest first
+}
+
+func (x *AdminListWebhookEvents_Output) Reset() {
+	*x = AdminListWebhookEvents_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWebhookEvents_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWebhookEvents_Output) ProtoMessage() {}
+
+func (x *AdminListWebhookEvents_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWebhookEvents_Output.ProtoReflect.Descriptor instead.
+func (*AdminListWebhookEvents_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *AdminListWebhookEvents_Output) GetEvents() []*rbdb.WebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AdminPayCommissions_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Referrer
+	Currency        string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	PayoutReference string `protobuf:"bytes,3,opt,name=payout_reference,json=payoutReference,proto3" json:"payout_reference,omitempty"` // Reference of the payout sent to the referrer
+}
+
+func (x *AdminPayCommissions_Input) Reset() {
+	*x = AdminPayCommissions_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPayCommissions_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPayCommissions_Input) ProtoMessage() {}
+
+func (x *AdminPayCommissions_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPayCommissions_Input.ProtoReflect.Descriptor instead.
+func (*AdminPayCommissions_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *AdminPayCommissions_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminPayCommissions_Input) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AdminPayCommissions_Input) GetPayoutReference() string {
+	if x != nil {
+		return x.PayoutReference
+	}
+	return ""
+}
+
+type AdminPayCommissions_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaidInCents int64              `protobuf:"varint,1,opt,name=paid_in_cents,json=paidInCents,proto3" json:"paid_in_cents,omitempty"`
+	Commissions []*rbdb.Commission `protobuf:"bytes,2,rep,name=commissions,proto3" json:"commissions,omitempty"` // Commissions settled by the payout
+}
+
+func (x *AdminPayCommissions_Output) Reset() {
+	*x = AdminPayCommissions_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPayCommissions_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPayCommissions_Output) ProtoMessage() {}
+
+func (x *AdminPayCommissions_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPayCommissions_Output.ProtoReflect.Descriptor instead.
+func (*AdminPayCommissions_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *AdminPayCommissions_Output) GetPaidInCents() int64 {
+	if x != nil {
+		return x.PaidInCents
+	}
+	return 0
+}
+
+func (x *AdminPayCommissions_Output) GetCommissions() []*rbdb.Commission {
+	if x != nil {
+		return x.Commissions
+	}
+	return nil
+}
+
+type AdminProvisionPayPalPlans_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminProvisionPayPalPlans_Input) Reset() {
+	*x = AdminProvisionPayPalPlans_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminProvisionPayPalPlans_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProvisionPayPalPlans_Input) ProtoMessage() {}
+
+func (x *AdminProvisionPayPalPlans_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProvisionPayPalPlans_Input.ProtoReflect.Descriptor instead.
+func (*AdminProvisionPayPalPlans_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type AdminProvisionPayPalPlans_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plans   []*rbdb.BillingPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`      // Active plans after provisioning
+	Created int32               `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // Number of plans created on PayPal by this call
+}
+
+func (x *AdminProvisionPayPalPlans_Output) Reset() {
+	*x = AdminProvisionPayPalPlans_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminProvisionPayPalPlans_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProvisionPayPalPlans_Output) ProtoMessage() {}
+
+func (x *AdminProvisionPayPalPlans_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProvisionPayPalPlans_Output.ProtoReflect.Descriptor instead.
+func (*AdminProvisionPayPalPlans_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *AdminProvisionPayPalPlans_Output) GetPlans() []*rbdb.BillingPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *AdminProvisionPayPalPlans_Output) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+type AdminRegenerateInvoices_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId int64 `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // Issues the missing invoice of a completed payment, then renders all its documents again
+}
+
+func (x *AdminRegenerateInvoices_Input) Reset() {
+	*x = AdminRegenerateInvoices_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRegenerateInvoices_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRegenerateInvoices_Input) ProtoMessage() {}
+
+func (x *AdminRegenerateInvoices_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRegenerateInvoices_Input.ProtoReflect.Descriptor instead.
+func (*AdminRegenerateInvoices_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *AdminRegenerateInvoices_Input) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type AdminRegenerateInvoices_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoices []*rbdb.Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"` // Invoice and credit notes of the payment, without their PDF
+}
+
+func (x *AdminRegenerateInvoices_Output) Reset() {
+	*x = AdminRegenerateInvoices_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRegenerateInvoices_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRegenerateInvoices_Output) ProtoMessage() {}
+
+func (x *AdminRegenerateInvoices_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRegenerateInvoices_Output.ProtoReflect.Descriptor instead.
+func (*AdminRegenerateInvoices_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *AdminRegenerateInvoices_Output) GetInvoices() []*rbdb.Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type AdminReplayWebhookEvent_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminReplayWebhookEvent_Input) Reset() {
+	*x = AdminReplayWebhookEvent_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReplayWebhookEvent_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReplayWebhookEvent_Input) ProtoMessage() {}
+
+func (x *AdminReplayWebhookEvent_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReplayWebhookEvent_Input.ProtoReflect.Descriptor instead.
+func (*AdminReplayWebhookEvent_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *AdminReplayWebhookEvent_Input) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminReplayWebhookEvent_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *rbdb.WebhookEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"` // Event after the replay attempt
+}
+
+func (x *AdminReplayWebhookEvent_Output) Reset() {
+	*x = AdminReplayWebhookEvent_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReplayWebhookEvent_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReplayWebhookEvent_Output) ProtoMessage() {}
+
+func (x *AdminReplayWebhookEvent_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReplayWebhookEvent_Output.ProtoReflect.Descriptor instead.
+func (*AdminReplayWebhookEvent_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{24, 1}
+}
+
+func (x *AdminReplayWebhookEvent_Output) GetEvent() *rbdb.WebhookEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type AdminRevokeLicense_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *AdminRevokeLicense_Input) Reset() {
+	*x = AdminRevokeLicense_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeLicense_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeLicense_Input) ProtoMessage() {}
+
+func (x *AdminRevokeLicense_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeLicense_Input.ProtoReflect.Descriptor instead.
+func (*AdminRevokeLicense_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *AdminRevokeLicense_Input) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type AdminRevokeLicense_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LicenseKey *rbdb.LicenseKey `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+}
+
+func (x *AdminRevokeLicense_Output) Reset() {
+	*x = AdminRevokeLicense_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeLicense_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeLicense_Output) ProtoMessage() {}
+
+func (x *AdminRevokeLicense_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeLicense_Output.ProtoReflect.Descriptor instead.
+func (*AdminRevokeLicense_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *AdminRevokeLicense_Output) GetLicenseKey() *rbdb.LicenseKey {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return nil
+}
+
+type AdminRevokeRole_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64              `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role   rbdb.UserRole_Role `protobuf:"varint,2,opt,name=role,proto3,enum=rslbot.db.UserRole_Role" json:"role,omitempty"`
+}
+
+func (x *AdminRevokeRole_Input) Reset() {
+	*x = AdminRevokeRole_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeRole_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeRole_Input) ProtoMessage() {}
+
+func (x *AdminRevokeRole_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeRole_Input.ProtoReflect.Descriptor instead.
+func (*AdminRevokeRole_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *AdminRevokeRole_Input) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminRevokeRole_Input) GetRole() rbdb.UserRole_Role {
+	if x != nil {
+		return x.Role
+	}
+	return rbdb.UserRole_Role(0)
+}
+
+type AdminRevokeRole_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminRevokeRole_Output) Reset() {
+	*x = AdminRevokeRole_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeRole_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeRole_Output) ProtoMessage() {}
+
+func (x *AdminRevokeRole_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeRole_Output.ProtoReflect.Descriptor instead.
+func (*AdminRevokeRole_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{26, 1}
+}
+
+type AdminSearchDatabase_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchTerm    string              `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	PaymentStatus rbdb.Payment_Status `protobuf:"varint,2,opt,name=payment_status,json=paymentStatus,proto3,enum=rslbot.db.Payment_Status" json:"payment_status,omitempty"` // Optional, only return payments with this status
+}
+
+func (x *AdminSearchDatabase_Input) Reset() {
+	*x = AdminSearchDatabase_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchDatabase_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchDatabase_Input) ProtoMessage() {}
+
+func (x *AdminSearchDatabase_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchDatabase_Input.ProtoReflect.Descriptor instead.
+func (*AdminSearchDatabase_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *AdminSearchDatabase_Input) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *AdminSearchDatabase_Input) GetPaymentStatus() rbdb.Payment_Status {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return rbdb.Payment_Status(0)
+}
+
+type AdminSearchDatabase_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users         []*rbdb.User         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	LicenseKeys   []*rbdb.LicenseKey   `protobuf:"bytes,2,rep,name=license_keys,json=licenseKeys,proto3" json:"license_keys,omitempty"`
+	Payments      []*rbdb.Payment      `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+	Subscriptions []*rbdb.Subscription `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *AdminSearchDatabase_Output) Reset() {
+	*x = AdminSearchDatabase_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchDatabase_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchDatabase_Output) ProtoMessage() {}
+
+func (x *AdminSearchDatabase_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchDatabase_Output.ProtoReflect.Descriptor instead.
+func (*AdminSearchDatabase_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *AdminSearchDatabase_Output) GetUsers() []*rbdb.User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminSearchDatabase_Output) GetLicenseKeys() []*rbdb.LicenseKey {
+	if x != nil {
+		return x.LicenseKeys
+	}
+	return nil
+}
+
+func (x *AdminSearchDatabase_Output) GetPayments() []*rbdb.Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *AdminSearchDatabase_Output) GetSubscriptions() []*rbdb.Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type AdminSetExchangeRate_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code
+	Rate     float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`       // Units of the currency worth one unit of the base currency
+}
+
+func (x *AdminSetExchangeRate_Input) Reset() {
+	*x = AdminSetExchangeRate_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetExchangeRate_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetExchangeRate_Input) ProtoMessage() {}
+
+func (x *AdminSetExchangeRate_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetExchangeRate_Input.ProtoReflect.Descriptor instead.
+func (*AdminSetExchangeRate_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *AdminSetExchangeRate_Input) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AdminSetExchangeRate_Input) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type AdminSetExchangeRate_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate *rbdb.ExchangeRate `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *AdminSetExchangeRate_Output) Reset() {
+	*x = AdminSetExchangeRate_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetExchangeRate_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetExchangeRate_Output) ProtoMessage() {}
+
+func (x *AdminSetExchangeRate_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetExchangeRate_Output.ProtoReflect.Descriptor instead.
+func (*AdminSetExchangeRate_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *AdminSetExchangeRate_Output) GetRate() *rbdb.ExchangeRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type AdminSetTaxRate_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryCode string  `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // ISO 3166-1 alpha-2 code
+	Rate        float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`                                // 0.2 for 20%, 0 for countries without VAT on digital goods
+}
+
+func (x *AdminSetTaxRate_Input) Reset() {
+	*x = AdminSetTaxRate_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetTaxRate_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetTaxRate_Input) ProtoMessage() {}
+
+func (x *AdminSetTaxRate_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetTaxRate_Input.ProtoReflect.Descriptor instead.
+func (*AdminSetTaxRate_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *AdminSetTaxRate_Input) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *AdminSetTaxRate_Input) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type AdminSetTaxRate_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate *rbdb.TaxRate `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *AdminSetTaxRate_Output) Reset() {
+	*x = AdminSetTaxRate_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetTaxRate_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetTaxRate_Output) ProtoMessage() {}
+
+func (x *AdminSetTaxRate_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetTaxRate_Output.ProtoReflect.Descriptor instead.
+func (*AdminSetTaxRate_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *AdminSetTaxRate_Output) GetRate() *rbdb.TaxRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type AdminSyncDiscourseGroup_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *AdminSyncDiscourseGroup_Input) Reset() {
+	*x = AdminSyncDiscourseGroup_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSyncDiscourseGroup_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSyncDiscourseGroup_Input) ProtoMessage() {}
+
+func (x *AdminSyncDiscourseGroup_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSyncDiscourseGroup_Input.ProtoReflect.Descriptor instead.
+func (*AdminSyncDiscourseGroup_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *AdminSyncDiscourseGroup_Input) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AdminSyncDiscourseGroup_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group            string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	AddedUsernames   []string `protobuf:"bytes,2,rep,name=added_usernames,json=addedUsernames,proto3" json:"added_usernames,omitempty"`
+	RemovedUsernames []string `protobuf:"bytes,3,rep,name=removed_usernames,json=removedUsernames,proto3" json:"removed_usernames,omitempty"`
+	Unchanged        int32    `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	DryRun           bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *AdminSyncDiscourseGroup_Output) Reset() {
+	*x = AdminSyncDiscourseGroup_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSyncDiscourseGroup_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSyncDiscourseGroup_Output) ProtoMessage() {}
+
+func (x *AdminSyncDiscourseGroup_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSyncDiscourseGroup_Output.ProtoReflect.Descriptor instead.
+func (*AdminSyncDiscourseGroup_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *AdminSyncDiscourseGroup_Output) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *AdminSyncDiscourseGroup_Output) GetAddedUsernames() []string {
+	if x != nil {
+		return x.AddedUsernames
+	}
+	return nil
+}
+
+func (x *AdminSyncDiscourseGroup_Output) GetRemovedUsernames() []string {
+	if x != nil {
+		return x.RemovedUsernames
+	}
+	return nil
+}
+
+func (x *AdminSyncDiscourseGroup_Output) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *AdminSyncDiscourseGroup_Output) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AdminUpdatePrice_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price *rbdb.Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"` // Replaces all the fields of the price with this ID
+}
+
+func (x *AdminUpdatePrice_Input) Reset() {
+	*x = AdminUpdatePrice_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdatePrice_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdatePrice_Input) ProtoMessage() {}
+
+func (x *AdminUpdatePrice_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdatePrice_Input.ProtoReflect.Descriptor instead.
+func (*AdminUpdatePrice_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *AdminUpdatePrice_Input) GetPrice() *rbdb.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type AdminUpdatePrice_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price *rbdb.Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *AdminUpdatePrice_Output) Reset() {
+	*x = AdminUpdatePrice_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdatePrice_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdatePrice_Output) ProtoMessage() {}
+
+func (x *AdminUpdatePrice_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdatePrice_Output.ProtoReflect.Descriptor instead.
+func (*AdminUpdatePrice_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *AdminUpdatePrice_Output) GetPrice() *rbdb.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type AdminUpdateProduct_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *rbdb.Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // Replaces all the fields of the product with this ID
+}
+
+func (x *AdminUpdateProduct_Input) Reset() {
+	*x = AdminUpdateProduct_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateProduct_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateProduct_Input) ProtoMessage() {}
+
+func (x *AdminUpdateProduct_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateProduct_Input.ProtoReflect.Descriptor instead.
+func (*AdminUpdateProduct_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *AdminUpdateProduct_Input) GetProduct() *rbdb.Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AdminUpdateProduct_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *rbdb.Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+}
+
+func (x *AdminUpdateProduct_Output) Reset() {
+	*x = AdminUpdateProduct_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateProduct_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateProduct_Output) ProtoMessage() {}
+
+func (x *AdminUpdateProduct_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateProduct_Output.ProtoReflect.Descriptor instead.
+func (*AdminUpdateProduct_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *AdminUpdateProduct_Output) GetProduct() *rbdb.Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AuthExchangeSSO_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sso string `protobuf:"bytes,1,opt,name=sso,proto3" json:"sso,omitempty"` // Base64 payload returned by Discourse
+	Sig string `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"` // Hex HMAC of the payload
+}
+
+func (x *AuthExchangeSSO_Input) Reset() {
+	*x = AuthExchangeSSO_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthExchangeSSO_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthExchangeSSO_Input) ProtoMessage() {}
+
+func (x *AuthExchangeSSO_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthExchangeSSO_Input.ProtoReflect.Descriptor instead.
+func (*AuthExchangeSSO_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *AuthExchangeSSO_Input) GetSso() string {
+	if x != nil {
+		return x.Sso
+	}
+	return ""
+}
+
+func (x *AuthExchangeSSO_Input) GetSig() string {
+	if x != nil {
+		return x.Sig
+	}
+	return ""
+}
+
+type AuthExchangeSSO_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken           string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	User                  *rbdb.User             `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *AuthExchangeSSO_Output) Reset() {
+	*x = AuthExchangeSSO_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthExchangeSSO_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthExchangeSSO_Output) ProtoMessage() {}
+
+func (x *AuthExchangeSSO_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthExchangeSSO_Output.ProtoReflect.Descriptor instead.
+func (*AuthExchangeSSO_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *AuthExchangeSSO_Output) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AuthExchangeSSO_Output) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthExchangeSSO_Output) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthExchangeSSO_Output) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthExchangeSSO_Output) GetUser() *rbdb.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AuthRefreshSession_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *AuthRefreshSession_Input) Reset() {
+	*x = AuthRefreshSession_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRefreshSession_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRefreshSession_Input) ProtoMessage() {}
+
+func (x *AuthRefreshSession_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRefreshSession_Input.ProtoReflect.Descriptor instead.
+func (*AuthRefreshSession_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *AuthRefreshSession_Input) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type AuthRefreshSession_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken           string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // The previous access and refresh tokens of the session stop working
+	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+}
+
+func (x *AuthRefreshSession_Output) Reset() {
+	*x = AuthRefreshSession_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRefreshSession_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRefreshSession_Output) ProtoMessage() {}
+
+func (x *AuthRefreshSession_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRefreshSession_Output.ProtoReflect.Descriptor instead.
+func (*AuthRefreshSession_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *AuthRefreshSession_Output) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AuthRefreshSession_Output) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthRefreshSession_Output) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthRefreshSession_Output) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+type AuthStartSSO_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnSsoUrl string `protobuf:"bytes,1,opt,name=return_sso_url,json=returnSsoUrl,proto3" json:"return_sso_url,omitempty"` // Where Discourse sends the SSO response, an absolute http(s) URL on the frontend or CLI loopback origin
+}
+
+func (x *AuthStartSSO_Input) Reset() {
+	*x = AuthStartSSO_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthStartSSO_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthStartSSO_Input) ProtoMessage() {}
+
+func (x *AuthStartSSO_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthStartSSO_Input.ProtoReflect.Descriptor instead.
+func (*AuthStartSSO_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *AuthStartSSO_Input) GetReturnSsoUrl() string {
+	if x != nil {
+		return x.ReturnSsoUrl
+	}
+	return ""
+}
+
+type AuthStartSSO_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SsoUrl    string                 `protobuf:"bytes,1,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`          // Discourse login URL carrying a signed request with a single use nonce
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // The SSO response must be exchanged before
+}
+
+func (x *AuthStartSSO_Output) Reset() {
+	*x = AuthStartSSO_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthStartSSO_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthStartSSO_Output) ProtoMessage() {}
+
+func (x *AuthStartSSO_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthStartSSO_Output.ProtoReflect.Descriptor instead.
+func (*AuthStartSSO_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{35, 1}
+}
+
+func (x *AuthStartSSO_Output) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *AuthStartSSO_Output) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PaymentCreatePayPalCheckout_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LicenseDuration rbdb.LicenseKey_Duration `protobuf:"varint,1,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
+	RenewalKeyId    int64                    `protobuf:"varint,2,opt,name=renewal_key_id,json=renewalKeyId,proto3" json:"renewal_key_id,omitempty"`
+	Currency        string                   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                             // Optional ISO 4217 code, must have a price
+	CountryCode     string                   `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`    // Optional ISO 3166 billing country, picks the currency of the country when it has a price and the VAT rate
+	ReferralCode    string                   `protobuf:"bytes,5,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"` // Optional, unknown codes and the buyer's own code are ignored
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) Reset() {
+	*x = PaymentCreatePayPalCheckout_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreatePayPalCheckout_Input) ProtoMessage() {}
+
+func (x *PaymentCreatePayPalCheckout_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreatePayPalCheckout_Input.ProtoReflect.Descriptor instead.
+func (*PaymentCreatePayPalCheckout_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) GetLicenseDuration() rbdb.LicenseKey_Duration {
+	if x != nil {
+		return x.LicenseDuration
+	}
+	return rbdb.LicenseKey_Duration(0)
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) GetRenewalKeyId() int64 {
+	if x != nil {
+		return x.RenewalKeyId
+	}
+	return 0
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *PaymentCreatePayPalCheckout_Input) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+type PaymentCreatePayPalCheckout_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId     string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CheckoutUrl string `protobuf:"bytes,2,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+}
+
+func (x *PaymentCreatePayPalCheckout_Output) Reset() {
+	*x = PaymentCreatePayPalCheckout_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreatePayPalCheckout_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreatePayPalCheckout_Output) ProtoMessage() {}
+
+func (x *PaymentCreatePayPalCheckout_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreatePayPalCheckout_Output.ProtoReflect.Descriptor instead.
+func (*PaymentCreatePayPalCheckout_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *PaymentCreatePayPalCheckout_Output) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PaymentCreatePayPalCheckout_Output) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+type PaymentCreatePayPalSubscription_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LicenseDuration rbdb.LicenseKey_Duration `protobuf:"varint,1,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
+	RenewalKeyId    int64                    `protobuf:"varint,2,opt,name=renewal_key_id,json=renewalKeyId,proto3" json:"renewal_key_id,omitempty"`
+}
+
+func (x *PaymentCreatePayPalSubscription_Input) Reset() {
+	*x = PaymentCreatePayPalSubscription_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreatePayPalSubscription_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreatePayPalSubscription_Input) ProtoMessage() {}
+
+func (x *PaymentCreatePayPalSubscription_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreatePayPalSubscription_Input.ProtoReflect.Descriptor instead.
+func (*PaymentCreatePayPalSubscription_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *PaymentCreatePayPalSubscription_Input) GetLicenseDuration() rbdb.LicenseKey_Duration {
+	if x != nil {
+		return x.LicenseDuration
+	}
+	return rbdb.LicenseKey_Duration(0)
+}
+
+func (x *PaymentCreatePayPalSubscription_Input) GetRenewalKeyId() int64 {
+	if x != nil {
+		return x.RenewalKeyId
+	}
+	return 0
+}
+
+type PaymentCreatePayPalSubscription_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	CheckoutUrl    string `protobuf:"bytes,2,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+}
+
+func (x *PaymentCreatePayPalSubscription_Output) Reset() {
+	*x = PaymentCreatePayPalSubscription_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreatePayPalSubscription_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreatePayPalSubscription_Output) ProtoMessage() {}
+
+func (x *PaymentCreatePayPalSubscription_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreatePayPalSubscription_Output.ProtoReflect.Descriptor instead.
+func (*PaymentCreatePayPalSubscription_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *PaymentCreatePayPalSubscription_Output) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *PaymentCreatePayPalSubscription_Output) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+type PaymentCreateStripeCheckout_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LicenseDuration rbdb.LicenseKey_Duration `protobuf:"varint,1,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
+	RenewalKeyId    int64                    `protobuf:"varint,2,opt,name=renewal_key_id,json=renewalKeyId,proto3" json:"renewal_key_id,omitempty"`
+	Recurring       bool                     `protobuf:"varint,3,opt,name=recurring,proto3" json:"recurring,omitempty"`                          // Start an auto-renewing subscription instead of a one-time payment
+	Currency        string                   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                             // Optional ISO 4217 code, must have a price
+	CountryCode     string                   `protobuf:"bytes,5,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`    // Optional ISO 3166 code, picks the currency of the country when it has a price
+	ReferralCode    string                   `protobuf:"bytes,6,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"` // Optional, unknown codes and the buyer's own code are ignored
+}
+
+func (x *PaymentCreateStripeCheckout_Input) Reset() {
+	*x = PaymentCreateStripeCheckout_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreateStripeCheckout_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreateStripeCheckout_Input) ProtoMessage() {}
+
+func (x *PaymentCreateStripeCheckout_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreateStripeCheckout_Input.ProtoReflect.Descriptor instead.
+func (*PaymentCreateStripeCheckout_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *PaymentCreateStripeCheckout_Input) GetLicenseDuration() rbdb.LicenseKey_Duration {
+	if x != nil {
+		return x.LicenseDuration
+	}
+	return rbdb.LicenseKey_Duration(0)
+}
+
+func (x *PaymentCreateStripeCheckout_Input) GetRenewalKeyId() int64 {
+	if x != nil {
+		return x.RenewalKeyId
+	}
+	return 0
+}
+
+func (x *PaymentCreateStripeCheckout_Input) GetRecurring() bool {
+	if x != nil {
+		return x.Recurring
+	}
+	return false
+}
+
+func (x *PaymentCreateStripeCheckout_Input) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentCreateStripeCheckout_Input) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *PaymentCreateStripeCheckout_Input) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+type PaymentCreateStripeCheckout_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId   string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CheckoutUrl string `protobuf:"bytes,2,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+}
+
+func (x *PaymentCreateStripeCheckout_Output) Reset() {
+	*x = PaymentCreateStripeCheckout_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCreateStripeCheckout_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCreateStripeCheckout_Output) ProtoMessage() {}
+
+func (x *PaymentCreateStripeCheckout_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCreateStripeCheckout_Output.ProtoReflect.Descriptor instead.
+func (*PaymentCreateStripeCheckout_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{38, 1}
+}
+
+func (x *PaymentCreateStripeCheckout_Output) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PaymentCreateStripeCheckout_Output) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+type PaymentGetCheckoutStatus_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // Order ID returned by PaymentCreatePayPalCheckout
+}
+
+func (x *PaymentGetCheckoutStatus_Input) Reset() {
+	*x = PaymentGetCheckoutStatus_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentGetCheckoutStatus_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentGetCheckoutStatus_Input) ProtoMessage() {}
+
+func (x *PaymentGetCheckoutStatus_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentGetCheckoutStatus_Input.ProtoReflect.Descriptor instead.
+func (*PaymentGetCheckoutStatus_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *PaymentGetCheckoutStatus_Input) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type PaymentGetCheckoutStatus_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      PaymentGetCheckoutStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rslbot.api.PaymentGetCheckoutStatus_Status" json:"status,omitempty"`
+	OrderStatus rbdb.CheckoutOrder_Status       `protobuf:"varint,2,opt,name=order_status,json=orderStatus,proto3,enum=rslbot.db.CheckoutOrder_Status" json:"order_status,omitempty"`
+	LicenseKey  *rbdb.LicenseKey                `protobuf:"bytes,3,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"` // Generated or renewed license, once issued
+	PaymentId   int64                           `protobuf:"varint,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`   // Once issued
+}
+
+func (x *PaymentGetCheckoutStatus_Output) Reset() {
+	*x = PaymentGetCheckoutStatus_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentGetCheckoutStatus_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentGetCheckoutStatus_Output) ProtoMessage() {}
+
+func (x *PaymentGetCheckoutStatus_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentGetCheckoutStatus_Output.ProtoReflect.Descriptor instead.
+func (*PaymentGetCheckoutStatus_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{39, 1}
+}
+
+func (x *PaymentGetCheckoutStatus_Output) GetStatus() PaymentGetCheckoutStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentGetCheckoutStatus_STATUS_UNSPECIFIED
+}
+
+func (x *PaymentGetCheckoutStatus_Output) GetOrderStatus() rbdb.CheckoutOrder_Status {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return rbdb.CheckoutOrder_Status(0)
+}
+
+func (x *PaymentGetCheckoutStatus_Output) GetLicenseKey() *rbdb.LicenseKey {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return nil
+}
+
+func (x *PaymentGetCheckoutStatus_Output) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type PaymentWatchCheckoutStatus_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *PaymentWatchCheckoutStatus_Input) Reset() {
+	*x = PaymentWatchCheckoutStatus_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentWatchCheckoutStatus_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentWatchCheckoutStatus_Input) ProtoMessage() {}
+
+func (x *PaymentWatchCheckoutStatus_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentWatchCheckoutStatus_Input.ProtoReflect.Descriptor instead.
+func (*PaymentWatchCheckoutStatus_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *PaymentWatchCheckoutStatus_Input) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type PublicListPrices_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency    string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`                          // Optional, ISO 4217 code
+	CountryCode string `protobuf:"bytes,2,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // Optional, ISO 3166 code used without currency, falls back to the default currency
+}
+
+func (x *PublicListPrices_Input) Reset() {
+	*x = PublicListPrices_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicListPrices_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicListPrices_Input) ProtoMessage() {}
+
+func (x *PublicListPrices_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicListPrices_Input.ProtoReflect.Descriptor instead.
+func (*PublicListPrices_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *PublicListPrices_Input) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PublicListPrices_Input) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type PublicListPrices_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prices []*rbdb.Price `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"` // Prices that can be bought now, with their product
+}
+
+func (x *PublicListPrices_Output) Reset() {
+	*x = PublicListPrices_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicListPrices_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicListPrices_Output) ProtoMessage() {}
+
+func (x *PublicListPrices_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicListPrices_Output.ProtoReflect.Descriptor instead.
+func (*PublicListPrices_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{41, 1}
+}
+
+func (x *PublicListPrices_Output) GetPrices() []*rbdb.Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type ToolStatus_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ToolStatus_Input) Reset() {
+	*x = ToolStatus_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolStatus_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolStatus_Input) ProtoMessage() {}
+
+func (x *ToolStatus_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolStatus_Input.ProtoReflect.Descriptor instead.
+func (*ToolStatus_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{42, 0}
+}
+
+type ToolStatus_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EverythingIsOk bool `protobuf:"varint,1,opt,name=everything_is_ok,json=everythingIsOk,proto3" json:"everything_is_ok,omitempty"`
+}
+
+func (x *ToolStatus_Output) Reset() {
+	*x = ToolStatus_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolStatus_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolStatus_Output) ProtoMessage() {}
+
+func (x *ToolStatus_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolStatus_Output.ProtoReflect.Descriptor instead.
+func (*ToolStatus_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{42, 1}
+}
+
+func (x *ToolStatus_Output) GetEverythingIsOk() bool {
+	if x != nil {
+		return x.EverythingIsOk
+	}
+	return false
+}
+
+type UserCancelSubscription_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId int64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (x *UserCancelSubscription_Input) Reset() {
+	*x = UserCancelSubscription_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCancelSubscription_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCancelSubscription_Input) ProtoMessage() {}
+
+func (x *UserCancelSubscription_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCancelSubscription_Input.ProtoReflect.Descriptor instead.
+func (*UserCancelSubscription_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *UserCancelSubscription_Input) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type UserCancelSubscription_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *rbdb.Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *UserCancelSubscription_Output) Reset() {
+	*x = UserCancelSubscription_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCancelSubscription_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCancelSubscription_Output) ProtoMessage() {}
+
+func (x *UserCancelSubscription_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCancelSubscription_Output.ProtoReflect.Descriptor instead.
+func (*UserCancelSubscription_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *UserCancelSubscription_Output) GetSubscription() *rbdb.Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type UserCreateApiKey_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`                                       // licenses:read, licenses:write, offsets:write or payments:read
+	ExpiresInDays int32    `protobuf:"varint,3,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"` // Defaults to 90, at most 365
+}
+
+func (x *UserCreateApiKey_Input) Reset() {
+	*x = UserCreateApiKey_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCreateApiKey_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateApiKey_Input) ProtoMessage() {}
+
+func (x *UserCreateApiKey_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateApiKey_Input.ProtoReflect.Descriptor instead.
+func (*UserCreateApiKey_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *UserCreateApiKey_Input) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserCreateApiKey_Input) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UserCreateApiKey_Input) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+type UserCreateApiKey_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Only returned once, send it as a bearer token
+	ApiKey *rbdb.ApiKey `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+}
+
+func (x *UserCreateApiKey_Output) Reset() {
+	*x = UserCreateApiKey_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCreateApiKey_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateApiKey_Output) ProtoMessage() {}
+
+func (x *UserCreateApiKey_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateApiKey_Output.ProtoReflect.Descriptor instead.
+func (*UserCreateApiKey_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{44, 1}
+}
+
+func (x *UserCreateApiKey_Output) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UserCreateApiKey_Output) GetApiKey() *rbdb.ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type UserGetInvoice_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId int64 `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	PaymentId int64 `protobuf:"varint,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // Without invoice_id, the invoice of the payment
+}
+
+func (x *UserGetInvoice_Input) Reset() {
+	*x = UserGetInvoice_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetInvoice_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetInvoice_Input) ProtoMessage() {}
+
+func (x *UserGetInvoice_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetInvoice_Input.ProtoReflect.Descriptor instead.
+func (*UserGetInvoice_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *UserGetInvoice_Input) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *UserGetInvoice_Input) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type UserGetInvoice_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoice     *rbdb.Invoice   `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"` // Without its PDF
+	Pdf         []byte          `protobuf:"bytes,2,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	Filename    string          `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	CreditNotes []*rbdb.Invoice `protobuf:"bytes,4,rep,name=credit_notes,json=creditNotes,proto3" json:"credit_notes,omitempty"` // Credit notes of an invoice, without their PDF
+}
+
+func (x *UserGetInvoice_Output) Reset() {
+	*x = UserGetInvoice_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetInvoice_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetInvoice_Output) ProtoMessage() {}
+
+func (x *UserGetInvoice_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetInvoice_Output.ProtoReflect.Descriptor instead.
+func (*UserGetInvoice_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{45, 1}
+}
+
+func (x *UserGetInvoice_Output) GetInvoice() *rbdb.Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *UserGetInvoice_Output) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *UserGetInvoice_Output) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UserGetInvoice_Output) GetCreditNotes() []*rbdb.Invoice {
+	if x != nil {
+		return x.CreditNotes
+	}
+	return nil
+}
+
+type UserGetLicenses_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetLicenses_Input) Reset() {
+	*x = UserGetLicenses_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetLicenses_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetLicenses_Input) ProtoMessage() {}
+
+func (x *UserGetLicenses_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetLicenses_Input.ProtoReflect.Descriptor instead.
+func (*UserGetLicenses_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type UserGetLicenses_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Licenses []*rbdb.LicenseKey `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+}
+
+func (x *UserGetLicenses_Output) Reset() {
+	*x = UserGetLicenses_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetLicenses_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetLicenses_Output) ProtoMessage() {}
+
+func (x *UserGetLicenses_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetLicenses_Output.ProtoReflect.Descriptor instead.
+func (*UserGetLicenses_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{46, 1}
+}
+
+func (x *UserGetLicenses_Output) GetLicenses() []*rbdb.LicenseKey {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+type UserGetPayment_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId int64 `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+}
+
+func (x *UserGetPayment_Input) Reset() {
+	*x = UserGetPayment_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetPayment_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetPayment_Input) ProtoMessage() {}
+
+func (x *UserGetPayment_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetPayment_Input.ProtoReflect.Descriptor instead.
+func (*UserGetPayment_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *UserGetPayment_Input) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type UserGetPayment_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment  *rbdb.Payment   `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`   // With its license
+	Invoices []*rbdb.Invoice `protobuf:"bytes,2,rep,name=invoices,proto3" json:"invoices,omitempty"` // Invoice and credit notes, without their PDF
+}
+
+func (x *UserGetPayment_Output) Reset() {
+	*x = UserGetPayment_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetPayment_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetPayment_Output) ProtoMessage() {}
+
+func (x *UserGetPayment_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetPayment_Output.ProtoReflect.Descriptor instead.
+func (*UserGetPayment_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{47, 1}
+}
+
+func (x *UserGetPayment_Output) GetPayment() *rbdb.Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *UserGetPayment_Output) GetInvoices() []*rbdb.Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type UserGetReferralCode_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetReferralCode_Input) Reset() {
+	*x = UserGetReferralCode_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetReferralCode_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetReferralCode_Input) ProtoMessage() {}
+
+func (x *UserGetReferralCode_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetReferralCode_Input.ProtoReflect.Descriptor instead.
+func (*UserGetReferralCode_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{48, 0}
+}
+
+type UserGetReferralCode_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code           string  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                             // Created on first use
+	CommissionRate float64 `protobuf:"fixed64,2,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"` // Share of the referred payments net of tax
+}
+
+func (x *UserGetReferralCode_Output) Reset() {
+	*x = UserGetReferralCode_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetReferralCode_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetReferralCode_Output) ProtoMessage() {}
+
+func (x *UserGetReferralCode_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetReferralCode_Output.ProtoReflect.Descriptor instead.
+func (*UserGetReferralCode_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{48, 1}
+}
+
+func (x *UserGetReferralCode_Output) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UserGetReferralCode_Output) GetCommissionRate() float64 {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return 0
+}
+
+type UserGetSession_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetSession_Input) Reset() {
+	*x = UserGetSession_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetSession_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetSession_Input) ProtoMessage() {}
+
+func (x *UserGetSession_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetSession_Input.ProtoReflect.Descriptor instead.
+func (*UserGetSession_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{49, 0}
+}
+
+type UserGetSession_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User                   *rbdb.User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ImpersonatedBy         string     `protobuf:"bytes,2,opt,name=impersonated_by,json=impersonatedBy,proto3" json:"impersonated_by,omitempty"` // Username of the admin when the token is an impersonation token
+	ImpersonationReadWrite bool       `protobuf:"varint,3,opt,name=impersonation_read_write,json=impersonationReadWrite,proto3" json:"impersonation_read_write,omitempty"`
+}
+
+func (x *UserGetSession_Output) Reset() {
+	*x = UserGetSession_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetSession_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetSession_Output) ProtoMessage() {}
+
+func (x *UserGetSession_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetSession_Output.ProtoReflect.Descriptor instead.
+func (*UserGetSession_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{49, 1}
+}
+
+func (x *UserGetSession_Output) GetUser() *rbdb.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserGetSession_Output) GetImpersonatedBy() string {
+	if x != nil {
+		return x.ImpersonatedBy
+	}
+	return ""
+}
+
+func (x *UserGetSession_Output) GetImpersonationReadWrite() bool {
+	if x != nil {
+		return x.ImpersonationReadWrite
+	}
+	return false
+}
+
+type UserGetSubscriptions_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserGetSubscriptions_Input) Reset() {
+	*x = UserGetSubscriptions_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetSubscriptions_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetSubscriptions_Input) ProtoMessage() {}
+
+func (x *UserGetSubscriptions_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetSubscriptions_Input.ProtoReflect.Descriptor instead.
+func (*UserGetSubscriptions_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{50, 0}
+}
+
+type UserGetSubscriptions_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*rbdb.Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *UserGetSubscriptions_Output) Reset() {
+	*x = UserGetSubscriptions_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetSubscriptions_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetSubscriptions_Output) ProtoMessage() {}
+
+func (x *UserGetSubscriptions_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetSubscriptions_Output.ProtoReflect.Descriptor instead.
+func (*UserGetSubscriptions_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{50, 1}
+}
+
+func (x *UserGetSubscriptions_Output) GetSubscriptions() []*rbdb.Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type UserListApiKeys_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UserListApiKeys_Input) Reset() {
+	*x = UserListApiKeys_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListApiKeys_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListApiKeys_Input) ProtoMessage() {}
+
+func (x *UserListApiKeys_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListApiKeys_Input.ProtoReflect.Descriptor instead.
+func (*UserListApiKeys_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type UserListApiKeys_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*rbdb.ApiKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"` // Newest first, revoked and expired keys included
+}
+
+func (x *UserListApiKeys_Output) Reset() {
+	*x = UserListApiKeys_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListApiKeys_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListApiKeys_Output) ProtoMessage() {}
+
+func (x *UserListApiKeys_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListApiKeys_Output.ProtoReflect.Descriptor instead.
+func (*UserListApiKeys_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{51, 1}
+}
+
+func (x *UserListApiKeys_Output) GetApiKeys() []*rbdb.ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type UserListCommissions_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 50
+}
+
+func (x *UserListCommissions_Input) Reset() {
+	*x = UserListCommissions_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListCommissions_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListCommissions_Input) ProtoMessage() {}
+
+func (x *UserListCommissions_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListCommissions_Input.ProtoReflect.Descriptor instead.
+func (*UserListCommissions_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *UserListCommissions_Input) GetLimit() int32 {
 	if x != nil {
-		return x.LicenseKey
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type AdminSearchDatabase_Input struct {
+type UserListCommissions_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SearchTerm string `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Commissions []*rbdb.Commission                    `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions,omitempty"` // Latest first
+	Balances    []*UserListCommissions_Output_Balance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`       // Of all the commissions, by currency
 }
 
-func (x *AdminSearchDatabase_Input) Reset() {
-	*x = AdminSearchDatabase_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[16]
+func (x *UserListCommissions_Output) Reset() {
+	*x = UserListCommissions_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminSearchDatabase_Input) String() string {
+func (x *UserListCommissions_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSearchDatabase_Input) ProtoMessage() {}
+func (*UserListCommissions_Output) ProtoMessage() {}
 
-func (x *AdminSearchDatabase_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[16]
+func (x *UserListCommissions_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,44 +7839,51 @@ func (x *AdminSearchDatabase_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSearchDatabase_Input.ProtoReflect.Descriptor instead.
-func (*AdminSearchDatabase_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use UserListCommissions_Output.ProtoReflect.Descriptor instead.
+func (*UserListCommissions_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{52, 1}
 }
 
-func (x *AdminSearchDatabase_Input) GetSearchTerm() string {
+func (x *UserListCommissions_Output) GetCommissions() []*rbdb.Commission {
 	if x != nil {
-		return x.SearchTerm
+		return x.Commissions
 	}
-	return ""
+	return nil
 }
 
-type AdminSearchDatabase_Output struct {
+func (x *UserListCommissions_Output) GetBalances() []*UserListCommissions_Output_Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type UserListCommissions_Output_Balance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users         []*rbdb.User         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	LicenseKeys   []*rbdb.LicenseKey   `protobuf:"bytes,2,rep,name=license_keys,json=licenseKeys,proto3" json:"license_keys,omitempty"`
-	Payments      []*rbdb.Payment      `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
-	Subscriptions []*rbdb.Subscription `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Currency       string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	PendingInCents int64  `protobuf:"varint,2,opt,name=pending_in_cents,json=pendingInCents,proto3" json:"pending_in_cents,omitempty"`
+	PayableInCents int64  `protobuf:"varint,3,opt,name=payable_in_cents,json=payableInCents,proto3" json:"payable_in_cents,omitempty"`
+	PaidInCents    int64  `protobuf:"varint,4,opt,name=paid_in_cents,json=paidInCents,proto3" json:"paid_in_cents,omitempty"`
 }
 
-func (x *AdminSearchDatabase_Output) Reset() {
-	*x = AdminSearchDatabase_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[17]
+func (x *UserListCommissions_Output_Balance) Reset() {
+	*x = UserListCommissions_Output_Balance{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminSearchDatabase_Output) String() string {
+func (x *UserListCommissions_Output_Balance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSearchDatabase_Output) ProtoMessage() {}
+func (*UserListCommissions_Output_Balance) ProtoMessage() {}
 
-func (x *AdminSearchDatabase_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[17]
+func (x *UserListCommissions_Output_Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,63 +7894,63 @@ func (x *AdminSearchDatabase_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSearchDatabase_Output.ProtoReflect.Descriptor instead.
-func (*AdminSearchDatabase_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use UserListCommissions_Output_Balance.ProtoReflect.Descriptor instead.
+func (*UserListCommissions_Output_Balance) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{52, 1, 0}
 }
 
-func (x *AdminSearchDatabase_Output) GetUsers() []*rbdb.User {
+func (x *UserListCommissions_Output_Balance) GetCurrency() string {
 	if x != nil {
-		return x.Users
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *AdminSearchDatabase_Output) GetLicenseKeys() []*rbdb.LicenseKey {
+func (x *UserListCommissions_Output_Balance) GetPendingInCents() int64 {
 	if x != nil {
-		return x.LicenseKeys
+		return x.PendingInCents
 	}
-	return nil
+	return 0
 }
 
-func (x *AdminSearchDatabase_Output) GetPayments() []*rbdb.Payment {
+func (x *UserListCommissions_Output_Balance) GetPayableInCents() int64 {
 	if x != nil {
-		return x.Payments
+		return x.PayableInCents
 	}
-	return nil
+	return 0
 }
 
-func (x *AdminSearchDatabase_Output) GetSubscriptions() []*rbdb.Subscription {
+func (x *UserListCommissions_Output_Balance) GetPaidInCents() int64 {
 	if x != nil {
-		return x.Subscriptions
+		return x.PaidInCents
 	}
-	return nil
+	return 0
 }
 
-type PaymentCreatePayPalCheckout_Input struct {
+type UserListPayments_Input struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LicenseDuration rbdb.LicenseKey_Duration `protobuf:"varint,1,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
-	RenewalKeyId    int64                    `protobuf:"varint,2,opt,name=renewal_key_id,json=renewalKeyId,proto3" json:"renewal_key_id,omitempty"`
+	Limit    int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                       // Defaults to 20
+	BeforeId int64 `protobuf:"varint,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // Optional, next_before_id of the previous page
 }
 
-func (x *PaymentCreatePayPalCheckout_Input) Reset() {
-	*x = PaymentCreatePayPalCheckout_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[18]
+func (x *UserListPayments_Input) Reset() {
+	*x = UserListPayments_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentCreatePayPalCheckout_Input) String() string {
+func (x *UserListPayments_Input) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentCreatePayPalCheckout_Input) ProtoMessage() {}
+func (*UserListPayments_Input) ProtoMessage() {}
 
-func (x *PaymentCreatePayPalCheckout_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[18]
+func (x *UserListPayments_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,49 +7961,50 @@ func (x *PaymentCreatePayPalCheckout_Input) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentCreatePayPalCheckout_Input.ProtoReflect.Descriptor instead.
-func (*PaymentCreatePayPalCheckout_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use UserListPayments_Input.ProtoReflect.Descriptor instead.
+func (*UserListPayments_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{53, 0}
 }
 
-func (x *PaymentCreatePayPalCheckout_Input) GetLicenseDuration() rbdb.LicenseKey_Duration {
+func (x *UserListPayments_Input) GetLimit() int32 {
 	if x != nil {
-		return x.LicenseDuration
+		return x.Limit
 	}
-	return rbdb.LicenseKey_Duration(0)
+	return 0
 }
 
-func (x *PaymentCreatePayPalCheckout_Input) GetRenewalKeyId() int64 {
+func (x *UserListPayments_Input) GetBeforeId() int64 {
 	if x != nil {
-		return x.RenewalKeyId
+		return x.BeforeId
 	}
 	return 0
 }
 
-type PaymentCreatePayPalCheckout_Output struct {
+type UserListPayments_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId     string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CheckoutUrl string `protobuf:"bytes,2,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+	Payments     []*rbdb.Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`                                // With their license, latest first
+	NextBeforeId int64           `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"` // 0 on the last page
+	Total        int32           `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *PaymentCreatePayPalCheckout_Output) Reset() {
-	*x = PaymentCreatePayPalCheckout_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[19]
+func (x *UserListPayments_Output) Reset() {
+	*x = UserListPayments_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentCreatePayPalCheckout_Output) String() string {
+func (x *UserListPayments_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentCreatePayPalCheckout_Output) ProtoMessage() {}
+func (*UserListPayments_Output) ProtoMessage() {}
 
-func (x *PaymentCreatePayPalCheckout_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[19]
+func (x *UserListPayments_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,46 +8015,53 @@ func (x *PaymentCreatePayPalCheckout_Output) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentCreatePayPalCheckout_Output.ProtoReflect.Descriptor instead.
-func (*PaymentCreatePayPalCheckout_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use UserListPayments_Output.ProtoReflect.Descriptor instead.
+func (*UserListPayments_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{53, 1}
 }
 
-func (x *PaymentCreatePayPalCheckout_Output) GetOrderId() string {
+func (x *UserListPayments_Output) GetPayments() []*rbdb.Payment {
 	if x != nil {
-		return x.OrderId
+		return x.Payments
 	}
-	return ""
+	return nil
 }
 
-func (x *PaymentCreatePayPalCheckout_Output) GetCheckoutUrl() string {
+func (x *UserListPayments_Output) GetNextBeforeId() int64 {
 	if x != nil {
-		return x.CheckoutUrl
+		return x.NextBeforeId
 	}
-	return ""
+	return 0
 }
 
-type ToolStatus_Input struct {
+func (x *UserListPayments_Output) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UserLogout_Input struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ToolStatus_Input) Reset() {
-	*x = ToolStatus_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[20]
+func (x *UserLogout_Input) Reset() {
+	*x = UserLogout_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolStatus_Input) String() string {
+func (x *UserLogout_Input) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolStatus_Input) ProtoMessage() {}
+func (*UserLogout_Input) ProtoMessage() {}
 
-func (x *ToolStatus_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[20]
+func (x *UserLogout_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,34 +8072,34 @@ func (x *ToolStatus_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolStatus_Input.ProtoReflect.Descriptor instead.
-func (*ToolStatus_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use UserLogout_Input.ProtoReflect.Descriptor instead.
+func (*UserLogout_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{54, 0}
 }
 
-type ToolStatus_Output struct {
+type UserLogout_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EverythingIsOk bool `protobuf:"varint,1,opt,name=everything_is_ok,json=everythingIsOk,proto3" json:"everything_is_ok,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *ToolStatus_Output) Reset() {
-	*x = ToolStatus_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[21]
+func (x *UserLogout_Output) Reset() {
+	*x = UserLogout_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToolStatus_Output) String() string {
+func (x *UserLogout_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolStatus_Output) ProtoMessage() {}
+func (*UserLogout_Output) ProtoMessage() {}
 
-func (x *ToolStatus_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[21]
+func (x *UserLogout_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,39 +8110,39 @@ func (x *ToolStatus_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolStatus_Output.ProtoReflect.Descriptor instead.
-func (*ToolStatus_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use UserLogout_Output.ProtoReflect.Descriptor instead.
+func (*UserLogout_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{54, 1}
 }
 
-func (x *ToolStatus_Output) GetEverythingIsOk() bool {
+func (x *UserLogout_Output) GetSuccess() bool {
 	if x != nil {
-		return x.EverythingIsOk
+		return x.Success
 	}
 	return false
 }
 
-type UserGetLicenses_Input struct {
+type UserLogoutEverywhere_Input struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserGetLicenses_Input) Reset() {
-	*x = UserGetLicenses_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[22]
+func (x *UserLogoutEverywhere_Input) Reset() {
+	*x = UserLogoutEverywhere_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGetLicenses_Input) String() string {
+func (x *UserLogoutEverywhere_Input) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGetLicenses_Input) ProtoMessage() {}
+func (*UserLogoutEverywhere_Input) ProtoMessage() {}
 
-func (x *UserGetLicenses_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[22]
+func (x *UserLogoutEverywhere_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,34 +8153,34 @@ func (x *UserGetLicenses_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGetLicenses_Input.ProtoReflect.Descriptor instead.
-func (*UserGetLicenses_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use UserLogoutEverywhere_Input.ProtoReflect.Descriptor instead.
+func (*UserLogoutEverywhere_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{55, 0}
 }
 
-type UserGetLicenses_Output struct {
+type UserLogoutEverywhere_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Licenses []*rbdb.LicenseKey `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	RevokedSessions int32 `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
 }
 
-func (x *UserGetLicenses_Output) Reset() {
-	*x = UserGetLicenses_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[23]
+func (x *UserLogoutEverywhere_Output) Reset() {
+	*x = UserLogoutEverywhere_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGetLicenses_Output) String() string {
+func (x *UserLogoutEverywhere_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGetLicenses_Output) ProtoMessage() {}
+func (*UserLogoutEverywhere_Output) ProtoMessage() {}
 
-func (x *UserGetLicenses_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[23]
+func (x *UserLogoutEverywhere_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,39 +8191,42 @@ func (x *UserGetLicenses_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGetLicenses_Output.ProtoReflect.Descriptor instead.
-func (*UserGetLicenses_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use UserLogoutEverywhere_Output.ProtoReflect.Descriptor instead.
+func (*UserLogoutEverywhere_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{55, 1}
 }
 
-func (x *UserGetLicenses_Output) GetLicenses() []*rbdb.LicenseKey {
+func (x *UserLogoutEverywhere_Output) GetRevokedSessions() int32 {
 	if x != nil {
-		return x.Licenses
+		return x.RevokedSessions
 	}
-	return nil
+	return 0
 }
 
-type UserGetSession_Input struct {
+type UserRequestRefund_Input struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PaymentId int64  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *UserGetSession_Input) Reset() {
-	*x = UserGetSession_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[24]
+func (x *UserRequestRefund_Input) Reset() {
+	*x = UserRequestRefund_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGetSession_Input) String() string {
+func (x *UserRequestRefund_Input) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGetSession_Input) ProtoMessage() {}
+func (*UserRequestRefund_Input) ProtoMessage() {}
 
-func (x *UserGetSession_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[24]
+func (x *UserRequestRefund_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,34 +8237,48 @@ func (x *UserGetSession_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGetSession_Input.ProtoReflect.Descriptor instead.
-func (*UserGetSession_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use UserRequestRefund_Input.ProtoReflect.Descriptor instead.
+func (*UserRequestRefund_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{56, 0}
 }
 
-type UserGetSession_Output struct {
+func (x *UserRequestRefund_Input) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *UserRequestRefund_Input) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UserRequestRefund_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *rbdb.User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	RefundRequest *rbdb.RefundRequest `protobuf:"bytes,1,opt,name=refund_request,json=refundRequest,proto3" json:"refund_request,omitempty"` // Pending until an admin approves or denies it
 }
 
-func (x *UserGetSession_Output) Reset() {
-	*x = UserGetSession_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[25]
+func (x *UserRequestRefund_Output) Reset() {
+	*x = UserRequestRefund_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGetSession_Output) String() string {
+func (x *UserRequestRefund_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGetSession_Output) ProtoMessage() {}
+func (*UserRequestRefund_Output) ProtoMessage() {}
 
-func (x *UserGetSession_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[25]
+func (x *UserRequestRefund_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,39 +8289,41 @@ func (x *UserGetSession_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGetSession_Output.ProtoReflect.Descriptor instead.
-func (*UserGetSession_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use UserRequestRefund_Output.ProtoReflect.Descriptor instead.
+func (*UserRequestRefund_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{56, 1}
 }
 
-func (x *UserGetSession_Output) GetUser() *rbdb.User {
+func (x *UserRequestRefund_Output) GetRefundRequest() *rbdb.RefundRequest {
 	if x != nil {
-		return x.User
+		return x.RefundRequest
 	}
 	return nil
 }
 
-type UserLogout_Input struct {
+type UserRevokeApiKey_Input struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ApiKeyId int64 `protobuf:"varint,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
 }
 
-func (x *UserLogout_Input) Reset() {
-	*x = UserLogout_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[26]
+func (x *UserRevokeApiKey_Input) Reset() {
+	*x = UserRevokeApiKey_Input{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLogout_Input) String() string {
+func (x *UserRevokeApiKey_Input) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLogout_Input) ProtoMessage() {}
+func (*UserRevokeApiKey_Input) ProtoMessage() {}
 
-func (x *UserLogout_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[26]
+func (x *UserRevokeApiKey_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,34 +8334,41 @@ func (x *UserLogout_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLogout_Input.ProtoReflect.Descriptor instead.
-func (*UserLogout_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use UserRevokeApiKey_Input.ProtoReflect.Descriptor instead.
+func (*UserRevokeApiKey_Input) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{57, 0}
 }
 
-type UserLogout_Output struct {
+func (x *UserRevokeApiKey_Input) GetApiKeyId() int64 {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return 0
+}
+
+type UserRevokeApiKey_Output struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ApiKey *rbdb.ApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 }
 
-func (x *UserLogout_Output) Reset() {
-	*x = UserLogout_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[27]
+func (x *UserRevokeApiKey_Output) Reset() {
+	*x = UserRevokeApiKey_Output{}
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLogout_Output) String() string {
+func (x *UserRevokeApiKey_Output) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLogout_Output) ProtoMessage() {}
+func (*UserRevokeApiKey_Output) ProtoMessage() {}
 
-func (x *UserLogout_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[27]
+func (x *UserRevokeApiKey_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,16 +8379,16 @@ func (x *UserLogout_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLogout_Output.ProtoReflect.Descriptor instead.
-func (*UserLogout_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use UserRevokeApiKey_Output.ProtoReflect.Descriptor instead.
+func (*UserRevokeApiKey_Output) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{57, 1}
 }
 
-func (x *UserLogout_Output) GetSuccess() bool {
+func (x *UserRevokeApiKey_Output) GetApiKey() *rbdb.ApiKey {
 	if x != nil {
-		return x.Success
+		return x.ApiKey
 	}
-	return false
+	return nil
 }
 
 type UserSyncDiscordRole_Input struct {
@@ -1237,7 +8399,7 @@ type UserSyncDiscordRole_Input struct {
 
 func (x *UserSyncDiscordRole_Input) Reset() {
 	*x = UserSyncDiscordRole_Input{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[28]
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1249,7 +8411,7 @@ func (x *UserSyncDiscordRole_Input) String() string {
 func (*UserSyncDiscordRole_Input) ProtoMessage() {}
 
 func (x *UserSyncDiscordRole_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[28]
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +8424,7 @@ func (x *UserSyncDiscordRole_Input) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSyncDiscordRole_Input.ProtoReflect.Descriptor instead.
 func (*UserSyncDiscordRole_Input) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{9, 0}
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{58, 0}
 }
 
 type UserSyncDiscordRole_Output struct {
@@ -1279,7 +8441,7 @@ type UserSyncDiscordRole_Output struct {
 
 func (x *UserSyncDiscordRole_Output) Reset() {
 	*x = UserSyncDiscordRole_Output{}
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[29]
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1291,7 +8453,7 @@ func (x *UserSyncDiscordRole_Output) String() string {
 func (*UserSyncDiscordRole_Output) ProtoMessage() {}
 
 func (x *UserSyncDiscordRole_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_rslbot_rbapi_proto_msgTypes[29]
+	mi := &file_proto_rslbot_rbapi_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +8466,7 @@ func (x *UserSyncDiscordRole_Output) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSyncDiscordRole_Output.ProtoReflect.Descriptor instead.
 func (*UserSyncDiscordRole_Output) Descriptor() ([]byte, []int) {
-	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{9, 1}
+	return file_proto_rslbot_rbapi_proto_rawDescGZIP(), []int{58, 1}
 }
 
 func (x *UserSyncDiscordRole_Output) GetSuccess() bool {
@@ -1371,26 +8533,347 @@ var file_proto_rslbot_rbapi_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e,
 	0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65,
-	0x79, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x63, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x66, 0x72, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x66, 0x72, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x69,
-	0x64, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x69, 0x64, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x71, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x1a, 0x19, 0x0a,
-	0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x40, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
-	0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x0a,
-	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x9a, 0x02, 0x0a, 0x13, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x1a, 0x28, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x1a, 0xd8, 0x01, 0x0a,
+	0x79, 0x22, 0xdd, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x47, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x1a, 0x77, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x75, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x2f, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x26,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x30, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x1a,
+	0x35, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x1a, 0x36, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x35,
+	0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x1a, 0x17, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x08, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x37, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x1a, 0x17, 0x0a, 0x05, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x1a, 0x08, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xac,
+	0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x47, 0x0a, 0x05, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x1a, 0x49, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0e,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x63,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x65,
+	0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x65,
+	0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x69, 0x64, 0x54, 0x69,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0xd0, 0x05, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x1a, 0x8c, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53,
+	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x1a, 0xad, 0x04, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x40, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x06, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x11,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x61,
+	0x73, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x97, 0x02, 0x0a,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x73, 0x73,
+	0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x61, 0x73,
+	0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd3, 0x04, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x5e, 0x0a, 0x05,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61,
+	0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x61, 0x72,
+	0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73,
+	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x1a, 0xdd, 0x03, 0x0a,
+	0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x42, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x1a, 0xb2, 0x02, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x67, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e, 0x43, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x78, 0x49, 0x6e, 0x43,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x31, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x78,
+	0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x61, 0x78, 0x49, 0x6e, 0x43, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e,
+	0x65, 0x74, 0x54, 0x61, 0x78, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9c, 0x01, 0x0a,
+	0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x1a,
+	0x4e, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x1a,
+	0x3a, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x14,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x1a, 0x57, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x8b, 0x01,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x7f, 0x0a, 0x10, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x1a,
+	0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x62, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x16,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x5c, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2d,
+	0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x22, 0xbe, 0x01,
+	0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x1a, 0x5d,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x3f, 0x0a,
+	0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0xa4,
+	0x02, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0xfb, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x61,
+	0x79, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x9c, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x1a, 0x56, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x4b, 0x0a, 0x06, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x20, 0x0a, 0x05, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0xee, 0x01, 0x0a, 0x06,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0a, 0x72, 0x6f,
+	0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x62, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xc1, 0x02, 0x0a,
+	0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x4b, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x54, 0x68, 0x61, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x1a, 0xdb, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x45, 0x0a,
+	0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x58, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x50, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x78,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x32,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x93, 0x01,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x1a, 0x39, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2f, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xe5,
+	0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x67, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a,
+	0x65, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x61, 0x69,
+	0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x50, 0x0a, 0x06,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64,
+	0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70,
+	0x6c, 0x61, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x7b,
+	0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x26, 0x0a, 0x05, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x1a, 0x38, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x17, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x17, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x1a,
+	0x37, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x1a, 0x19,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x40, 0x0a, 0x06, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x6b, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x4e,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x1a, 0x08,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x13, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x1a, 0x6a, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xd8, 0x01, 0x0a,
 	0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
 	0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x38,
@@ -1404,71 +8887,584 @@ var file_proto_rslbot_rbapi_proto_rawDesc = []byte{
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x1b, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0x78, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c,
-	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65,
-	0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x69, 0x63, 0x65,
-	0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72,
-	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x49,
-	0x64, 0x1a, 0x46, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x1a, 0x37, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x1a, 0x35, 0x0a, 0x06, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x22, 0x83, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78,
+	0x52, 0x61, 0x74, 0x65, 0x1a, 0x3e, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x1a, 0x30, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x26,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x1a, 0x20, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x1a, 0xab, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x75, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x2f, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x30, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x1a, 0x35, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x1a, 0x36, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22,
+	0xde, 0x02, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x53, 0x4f, 0x1a, 0x2b, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x73, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x73, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x67,
+	0x1a, 0x9d, 0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x51,
+	0x0a, 0x17, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x53, 0x0a, 0x18, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0xbd, 0x02, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2c, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xf8, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x51, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x14, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x53, 0x0a, 0x18, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x53,
+	0x4f, 0x1a, 0x2d, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x73, 0x73, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x73, 0x6f, 0x55, 0x72, 0x6c,
+	0x1a, 0x5c, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x73,
+	0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x73, 0x6f,
+	0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xc4,
+	0x02, 0x0a, 0x1b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0xdc,
+	0x01, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x46, 0x0a,
+	0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xf1, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x78, 0x0a, 0x05, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x49, 0x64, 0x1a, 0x54, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
 	0x75, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x49, 0x0a, 0x0a, 0x54, 0x6f, 0x6f,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x32, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x76,
-	0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x6f, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67,
-	0x49, 0x73, 0x4f, 0x6b, 0x22, 0x57, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x3b, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xe6, 0x02, 0x0a, 0x1b, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x70,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0xfa, 0x01, 0x0a, 0x05, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x4a, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x55,
+	0x72, 0x6c, 0x22, 0xa2, 0x03, 0x0a, 0x18, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a,
+	0x22, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x1a, 0xe8, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x43,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
 	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x4b, 0x65, 0x79, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x48, 0x0a,
-	0x0e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a,
-	0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2d, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22,
+	0x4b, 0x65, 0x79, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x77,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x40, 0x0a, 0x1a, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x22, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x46,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x32, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x28, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x0a, 0x54, 0x6f,
+	0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x32, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e,
+	0x67, 0x49, 0x73, 0x4f, 0x6b, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x30, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x1a, 0x45, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3b, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x1a, 0x5b,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x1a, 0x46, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x1a, 0x45, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x9b, 0x01,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x0f, 0x55,
+	0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0x07,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x3b, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x31, 0x0a, 0x08, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x26, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a,
+	0x66, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x07,
+	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x45, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0xac,
+	0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x90, 0x01, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x22, 0x68, 0x0a,
+	0x14, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x47,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x36, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a,
+	0x08, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x13,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x1d, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x1a, 0xad, 0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x1a, 0x9d, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x43,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x43, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x1a, 0x74, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a,
+	0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x39, 0x0a, 0x0a, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x22, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x54, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x45, 0x76, 0x65, 0x72, 0x79, 0x77, 0x68, 0x65, 0x72, 0x65, 0x1a, 0x07, 0x0a, 0x05,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x33, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x1a, 0x3e, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x1a, 0x49, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6f, 0x0a, 0x10, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x1a,
+	0x25, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x1a, 0x34, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x2a, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0xdb, 0x01, 0x0a,
+	0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0xba, 0x01,
 	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x07, 0x0a, 0x05, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0xba, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x12, 0x68, 0x61, 0x73, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x5f,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72,
-	0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x32, 0xf8, 0x09, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a,
-	0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x68, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x68, 0x61, 0x73, 0x4c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x6f,
+	0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x32, 0x8c, 0x3e, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x4b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62,
-	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x4c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x64, 0x2d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2d,
-	0x6b, 0x65, 0x79, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x73,
+	0x4b, 0x65, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64,
+	0x64, 0x2d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2d, 0x6b, 0x65, 0x79, 0x12, 0xa0, 0x01,
+	0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01,
+	0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x2d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x7b, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x83, 0x01,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x12, 0x7b, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x83, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x25, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22,
+	0x15, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x94, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x6e, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01,
+	0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6e, 0x79, 0x2d, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x81, 0x01,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x26, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x73, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x7c, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x2e, 0x72, 0x73,
 	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
+	0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x24, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01,
+	0x2a, 0x22, 0x11, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x61, 0x78, 0x2d, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x73, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x14, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x74, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x22, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22,
+	0x0e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12,
+	0x8f, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0xa7, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x2e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x1b,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2e, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x61,
+	0x79, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x93, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22,
+	0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x2d, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x75, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x75, 0x63, 0x6b, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x7b, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x78, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x78,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x61, 0x78, 0x2d, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x94,
+	0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x2d, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x70, 0x61, 0x79, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0xa0, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2b, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x61, 0x79, 0x70, 0x61, 0x6c, 0x2d, 0x70, 0x6c, 0x61,
+	0x6e, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a,
+	0x22, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x2d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x98, 0x01, 0x0a,
+	0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x2d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x2d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
 	0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x24,
 	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
 	0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x49,
@@ -1477,69 +9473,285 @@ var file_proto_rslbot_rbapi_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72,
 	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2d, 0x6b, 0x65,
-	0x79, 0x12, 0x87, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x79, 0x12, 0x77, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x13, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62,
 	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x1b,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
-	0x50, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x2e, 0x72, 0x73,
+	0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65,
+	0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x2e,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x73, 0x65, 0x74, 0x2d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x78, 0x52,
+	0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x73, 0x65, 0x74, 0x2d, 0x74, 0x61, 0x78, 0x2d, 0x72, 0x61, 0x74, 0x65, 0x12, 0x98, 0x01,
+	0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x29, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x7b, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a,
+	0x22, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x41,
+	0x75, 0x74, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x53, 0x4f, 0x12, 0x21,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x53, 0x4f, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x53, 0x4f, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a,
+	0x22, 0x09, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x73, 0x73, 0x6f, 0x12, 0x7b, 0x0a, 0x12, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x6b, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x53, 0x4f, 0x12, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x53, 0x4f, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x53, 0x4f, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x73, 0x73, 0x6f, 0x2f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0xa8, 0x01, 0x0a, 0x1b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x79, 0x50, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22,
+	0x1f, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x70, 0x61, 0x6c,
+	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x12, 0xb8, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x79, 0x50, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x32, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x70, 0x61, 0x79, 0x70, 0x61, 0x6c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa8, 0x01, 0x0a, 0x1b,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x70, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x2e, 0x72, 0x73,
 	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x43, 0x68, 0x65, 0x63,
 	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2e, 0x2e, 0x72, 0x73, 0x6c,
 	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x50, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
 	0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
 	0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x70, 0x61, 0x79, 0x70, 0x61, 0x6c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x5a, 0x0a, 0x0a, 0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x70, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x73, 0x74, 0x72, 0x69, 0x70, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x18, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x2b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xa1,
+	0x01, 0x0a, 0x1a, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x12, 0x1e, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x30, 0x01, 0x12, 0x73, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x0a, 0x54, 0x6f, 0x6f, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x2d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7c, 0x0a, 0x10, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
+	0x2a, 0x22, 0x14, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d,
+	0x61, 0x70, 0x69, 0x2d, 0x6b, 0x65, 0x79, 0x12, 0x6c, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
 	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x16, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x63, 0x65,
-	0x6e, 0x73, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x15, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
-	0x12, 0x1c, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1d,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x55, 0x73, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e,
+	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x70,
+	0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x21, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0x7f, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x26,
 	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x14, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x0c, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x73,
-	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e,
-	0x63, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x6f, 0x6c, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x22, 0x17, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x42, 0x7e, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x42, 0x0a, 0x52,
-	0x62, 0x61, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x17, 0x72, 0x73, 0x6c,
-	0x62, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72,
-	0x62, 0x61, 0x70, 0x69, 0xa2, 0x02, 0x03, 0x52, 0x41, 0x58, 0xaa, 0x02, 0x0a, 0x52, 0x73, 0x6c,
-	0x62, 0x6f, 0x74, 0x2e, 0x41, 0x70, 0x69, 0xca, 0x02, 0x0a, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74,
-	0x5c, 0x41, 0x70, 0x69, 0xe2, 0x02, 0x16, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x41, 0x70,
-	0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b,
-	0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x73, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x16,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5f, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x0c, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x72, 0x79, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x12, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x72, 0x79, 0x77, 0x68, 0x65,
+	0x72, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x45, 0x76, 0x65, 0x72, 0x79, 0x77, 0x68, 0x65, 0x72, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x17, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x2d, 0x65, 0x76, 0x65, 0x72, 0x79, 0x77, 0x68, 0x65,
+	0x72, 0x65, 0x12, 0x7f, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x23, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x24, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x12, 0x7c, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2d, 0x6b, 0x65,
+	0x79, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x26, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x22, 0x17, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x64, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x42, 0x7e, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x42, 0x0a, 0x52, 0x62, 0x61,
+	0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x17, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x62, 0x61,
+	0x70, 0x69, 0xa2, 0x02, 0x03, 0x52, 0x41, 0x58, 0xaa, 0x02, 0x0a, 0x52, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x41, 0x70, 0x69, 0xca, 0x02, 0x0a, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x41,
+	0x70, 0x69, 0xe2, 0x02, 0x16, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x52, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1554,82 +9766,428 @@ func file_proto_rslbot_rbapi_proto_rawDescGZIP() []byte {
 	return file_proto_rslbot_rbapi_proto_rawDescData
 }
 
-var file_proto_rslbot_rbapi_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_proto_rslbot_rbapi_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_rslbot_rbapi_proto_msgTypes = make([]protoimpl.MessageInfo, 182)
 var file_proto_rslbot_rbapi_proto_goTypes = []any{
-	(*AdminAddLicenseKey)(nil),                 // 0: rslbot.api.AdminAddLicenseKey
-	(*AdminGetActiveUsers)(nil),                // 1: rslbot.api.AdminGetActiveUsers
-	(*AdminRevokeLicense)(nil),                 // 2: rslbot.api.AdminRevokeLicense
-	(*AdminSearchDatabase)(nil),                // 3: rslbot.api.AdminSearchDatabase
-	(*PaymentCreatePayPalCheckout)(nil),        // 4: rslbot.api.PaymentCreatePayPalCheckout
-	(*ToolStatus)(nil),                         // 5: rslbot.api.ToolStatus
-	(*UserGetLicenses)(nil),                    // 6: rslbot.api.UserGetLicenses
-	(*UserGetSession)(nil),                     // 7: rslbot.api.UserGetSession
-	(*UserLogout)(nil),                         // 8: rslbot.api.UserLogout
-	(*UserSyncDiscordRole)(nil),                // 9: rslbot.api.UserSyncDiscordRole
-	(*AdminAddLicenseKey_Input)(nil),           // 10: rslbot.api.AdminAddLicenseKey.Input
-	(*AdminAddLicenseKey_Output)(nil),          // 11: rslbot.api.AdminAddLicenseKey.Output
-	(*AdminGetActiveUsers_Input)(nil),          // 12: rslbot.api.AdminGetActiveUsers.Input
-	(*AdminGetActiveUsers_Output)(nil),         // 13: rslbot.api.AdminGetActiveUsers.Output
-	(*AdminRevokeLicense_Input)(nil),           // 14: rslbot.api.AdminRevokeLicense.Input
-	(*AdminRevokeLicense_Output)(nil),          // 15: rslbot.api.AdminRevokeLicense.Output
-	(*AdminSearchDatabase_Input)(nil),          // 16: rslbot.api.AdminSearchDatabase.Input
-	(*AdminSearchDatabase_Output)(nil),         // 17: rslbot.api.AdminSearchDatabase.Output
-	(*PaymentCreatePayPalCheckout_Input)(nil),  // 18: rslbot.api.PaymentCreatePayPalCheckout.Input
-	(*PaymentCreatePayPalCheckout_Output)(nil), // 19: rslbot.api.PaymentCreatePayPalCheckout.Output
-	(*ToolStatus_Input)(nil),                   // 20: rslbot.api.ToolStatus.Input
-	(*ToolStatus_Output)(nil),                  // 21: rslbot.api.ToolStatus.Output
-	(*UserGetLicenses_Input)(nil),              // 22: rslbot.api.UserGetLicenses.Input
-	(*UserGetLicenses_Output)(nil),             // 23: rslbot.api.UserGetLicenses.Output
-	(*UserGetSession_Input)(nil),               // 24: rslbot.api.UserGetSession.Input
-	(*UserGetSession_Output)(nil),              // 25: rslbot.api.UserGetSession.Output
-	(*UserLogout_Input)(nil),                   // 26: rslbot.api.UserLogout.Input
-	(*UserLogout_Output)(nil),                  // 27: rslbot.api.UserLogout.Output
-	(*UserSyncDiscordRole_Input)(nil),          // 28: rslbot.api.UserSyncDiscordRole.Input
-	(*UserSyncDiscordRole_Output)(nil),         // 29: rslbot.api.UserSyncDiscordRole.Output
-	(rbdb.LicenseKey_Duration)(0),              // 30: rslbot.db.LicenseKey.Duration
-	(rbdb.LicenseKey_Tier)(0),                  // 31: rslbot.db.LicenseKey.Tier
-	(*rbdb.LicenseKey)(nil),                    // 32: rslbot.db.LicenseKey
-	(*rbdb.User)(nil),                          // 33: rslbot.db.User
-	(*rbdb.Payment)(nil),                       // 34: rslbot.db.Payment
-	(*rbdb.Subscription)(nil),                  // 35: rslbot.db.Subscription
+	(PaymentGetCheckoutStatus_Status)(0),               // 0: rslbot.api.PaymentGetCheckoutStatus.Status
+	(*AdminAddLicenseKey)(nil),                         // 1: rslbot.api.AdminAddLicenseKey
+	(*AdminApproveRefundRequest)(nil),                  // 2: rslbot.api.AdminApproveRefundRequest
+	(*AdminCreatePrice)(nil),                           // 3: rslbot.api.AdminCreatePrice
+	(*AdminCreateProduct)(nil),                         // 4: rslbot.api.AdminCreateProduct
+	(*AdminDeletePrice)(nil),                           // 5: rslbot.api.AdminDeletePrice
+	(*AdminDeleteProduct)(nil),                         // 6: rslbot.api.AdminDeleteProduct
+	(*AdminDenyRefundRequest)(nil),                     // 7: rslbot.api.AdminDenyRefundRequest
+	(*AdminGetActiveUsers)(nil),                        // 8: rslbot.api.AdminGetActiveUsers
+	(*AdminGetRevenue)(nil),                            // 9: rslbot.api.AdminGetRevenue
+	(*AdminGetTaxReport)(nil),                          // 10: rslbot.api.AdminGetTaxReport
+	(*AdminGrantRole)(nil),                             // 11: rslbot.api.AdminGrantRole
+	(*AdminImpersonateUser)(nil),                       // 12: rslbot.api.AdminImpersonateUser
+	(*AdminListCatalog)(nil),                           // 13: rslbot.api.AdminListCatalog
+	(*AdminListExchangeRates)(nil),                     // 14: rslbot.api.AdminListExchangeRates
+	(*AdminListImpersonationAudits)(nil),               // 15: rslbot.api.AdminListImpersonationAudits
+	(*AdminListPayableCommissions)(nil),                // 16: rslbot.api.AdminListPayableCommissions
+	(*AdminListRefundRequests)(nil),                    // 17: rslbot.api.AdminListRefundRequests
+	(*AdminListRoles)(nil),                             // 18: rslbot.api.AdminListRoles
+	(*AdminListStuckOrders)(nil),                       // 19: rslbot.api.AdminListStuckOrders
+	(*AdminListTaxRates)(nil),                          // 20: rslbot.api.AdminListTaxRates
+	(*AdminListWebhookEvents)(nil),                     // 21: rslbot.api.AdminListWebhookEvents
+	(*AdminPayCommissions)(nil),                        // 22: rslbot.api.AdminPayCommissions
+	(*AdminProvisionPayPalPlans)(nil),                  // 23: rslbot.api.AdminProvisionPayPalPlans
+	(*AdminRegenerateInvoices)(nil),                    // 24: rslbot.api.AdminRegenerateInvoices
+	(*AdminReplayWebhookEvent)(nil),                    // 25: rslbot.api.AdminReplayWebhookEvent
+	(*AdminRevokeLicense)(nil),                         // 26: rslbot.api.AdminRevokeLicense
+	(*AdminRevokeRole)(nil),                            // 27: rslbot.api.AdminRevokeRole
+	(*AdminSearchDatabase)(nil),                        // 28: rslbot.api.AdminSearchDatabase
+	(*AdminSetExchangeRate)(nil),                       // 29: rslbot.api.AdminSetExchangeRate
+	(*AdminSetTaxRate)(nil),                            // 30: rslbot.api.AdminSetTaxRate
+	(*AdminSyncDiscourseGroup)(nil),                    // 31: rslbot.api.AdminSyncDiscourseGroup
+	(*AdminUpdatePrice)(nil),                           // 32: rslbot.api.AdminUpdatePrice
+	(*AdminUpdateProduct)(nil),                         // 33: rslbot.api.AdminUpdateProduct
+	(*AuthExchangeSSO)(nil),                            // 34: rslbot.api.AuthExchangeSSO
+	(*AuthRefreshSession)(nil),                         // 35: rslbot.api.AuthRefreshSession
+	(*AuthStartSSO)(nil),                               // 36: rslbot.api.AuthStartSSO
+	(*PaymentCreatePayPalCheckout)(nil),                // 37: rslbot.api.PaymentCreatePayPalCheckout
+	(*PaymentCreatePayPalSubscription)(nil),            // 38: rslbot.api.PaymentCreatePayPalSubscription
+	(*PaymentCreateStripeCheckout)(nil),                // 39: rslbot.api.PaymentCreateStripeCheckout
+	(*PaymentGetCheckoutStatus)(nil),                   // 40: rslbot.api.PaymentGetCheckoutStatus
+	(*PaymentWatchCheckoutStatus)(nil),                 // 41: rslbot.api.PaymentWatchCheckoutStatus
+	(*PublicListPrices)(nil),                           // 42: rslbot.api.PublicListPrices
+	(*ToolStatus)(nil),                                 // 43: rslbot.api.ToolStatus
+	(*UserCancelSubscription)(nil),                     // 44: rslbot.api.UserCancelSubscription
+	(*UserCreateApiKey)(nil),                           // 45: rslbot.api.UserCreateApiKey
+	(*UserGetInvoice)(nil),                             // 46: rslbot.api.UserGetInvoice
+	(*UserGetLicenses)(nil),                            // 47: rslbot.api.UserGetLicenses
+	(*UserGetPayment)(nil),                             // 48: rslbot.api.UserGetPayment
+	(*UserGetReferralCode)(nil),                        // 49: rslbot.api.UserGetReferralCode
+	(*UserGetSession)(nil),                             // 50: rslbot.api.UserGetSession
+	(*UserGetSubscriptions)(nil),                       // 51: rslbot.api.UserGetSubscriptions
+	(*UserListApiKeys)(nil),                            // 52: rslbot.api.UserListApiKeys
+	(*UserListCommissions)(nil),                        // 53: rslbot.api.UserListCommissions
+	(*UserListPayments)(nil),                           // 54: rslbot.api.UserListPayments
+	(*UserLogout)(nil),                                 // 55: rslbot.api.UserLogout
+	(*UserLogoutEverywhere)(nil),                       // 56: rslbot.api.UserLogoutEverywhere
+	(*UserRequestRefund)(nil),                          // 57: rslbot.api.UserRequestRefund
+	(*UserRevokeApiKey)(nil),                           // 58: rslbot.api.UserRevokeApiKey
+	(*UserSyncDiscordRole)(nil),                        // 59: rslbot.api.UserSyncDiscordRole
+	(*AdminAddLicenseKey_Input)(nil),                   // 60: rslbot.api.AdminAddLicenseKey.Input
+	(*AdminAddLicenseKey_Output)(nil),                  // 61: rslbot.api.AdminAddLicenseKey.Output
+	(*AdminApproveRefundRequest_Input)(nil),            // 62: rslbot.api.AdminApproveRefundRequest.Input
+	(*AdminApproveRefundRequest_Output)(nil),           // 63: rslbot.api.AdminApproveRefundRequest.Output
+	(*AdminCreatePrice_Input)(nil),                     // 64: rslbot.api.AdminCreatePrice.Input
+	(*AdminCreatePrice_Output)(nil),                    // 65: rslbot.api.AdminCreatePrice.Output
+	(*AdminCreateProduct_Input)(nil),                   // 66: rslbot.api.AdminCreateProduct.Input
+	(*AdminCreateProduct_Output)(nil),                  // 67: rslbot.api.AdminCreateProduct.Output
+	(*AdminDeletePrice_Input)(nil),                     // 68: rslbot.api.AdminDeletePrice.Input
+	(*AdminDeletePrice_Output)(nil),                    // 69: rslbot.api.AdminDeletePrice.Output
+	(*AdminDeleteProduct_Input)(nil),                   // 70: rslbot.api.AdminDeleteProduct.Input
+	(*AdminDeleteProduct_Output)(nil),                  // 71: rslbot.api.AdminDeleteProduct.Output
+	(*AdminDenyRefundRequest_Input)(nil),               // 72: rslbot.api.AdminDenyRefundRequest.Input
+	(*AdminDenyRefundRequest_Output)(nil),              // 73: rslbot.api.AdminDenyRefundRequest.Output
+	(*AdminGetActiveUsers_Input)(nil),                  // 74: rslbot.api.AdminGetActiveUsers.Input
+	(*AdminGetActiveUsers_Output)(nil),                 // 75: rslbot.api.AdminGetActiveUsers.Output
+	(*AdminGetRevenue_Input)(nil),                      // 76: rslbot.api.AdminGetRevenue.Input
+	(*AdminGetRevenue_Output)(nil),                     // 77: rslbot.api.AdminGetRevenue.Output
+	(*AdminGetRevenue_Output_Total)(nil),               // 78: rslbot.api.AdminGetRevenue.Output.Total
+	(*AdminGetTaxReport_Input)(nil),                    // 79: rslbot.api.AdminGetTaxReport.Input
+	(*AdminGetTaxReport_Output)(nil),                   // 80: rslbot.api.AdminGetTaxReport.Output
+	(*AdminGetTaxReport_Output_Total)(nil),             // 81: rslbot.api.AdminGetTaxReport.Output.Total
+	(*AdminGrantRole_Input)(nil),                       // 82: rslbot.api.AdminGrantRole.Input
+	(*AdminGrantRole_Output)(nil),                      // 83: rslbot.api.AdminGrantRole.Output
+	(*AdminImpersonateUser_Input)(nil),                 // 84: rslbot.api.AdminImpersonateUser.Input
+	(*AdminImpersonateUser_Output)(nil),                // 85: rslbot.api.AdminImpersonateUser.Output
+	(*AdminListCatalog_Input)(nil),                     // 86: rslbot.api.AdminListCatalog.Input
+	(*AdminListCatalog_Output)(nil),                    // 87: rslbot.api.AdminListCatalog.Output
+	(*AdminListExchangeRates_Input)(nil),               // 88: rslbot.api.AdminListExchangeRates.Input
+	(*AdminListExchangeRates_Output)(nil),              // 89: rslbot.api.AdminListExchangeRates.Output
+	(*AdminListImpersonationAudits_Input)(nil),         // 90: rslbot.api.AdminListImpersonationAudits.Input
+	(*AdminListImpersonationAudits_Output)(nil),        // 91: rslbot.api.AdminListImpersonationAudits.Output
+	(*AdminListPayableCommissions_Input)(nil),          // 92: rslbot.api.AdminListPayableCommissions.Input
+	(*AdminListPayableCommissions_Output)(nil),         // 93: rslbot.api.AdminListPayableCommissions.Output
+	(*AdminListPayableCommissions_Output_Payable)(nil), // 94: rslbot.api.AdminListPayableCommissions.Output.Payable
+	(*AdminListRefundRequests_Input)(nil),              // 95: rslbot.api.AdminListRefundRequests.Input
+	(*AdminListRefundRequests_Output)(nil),             // 96: rslbot.api.AdminListRefundRequests.Output
+	(*AdminListRoles_Input)(nil),                       // 97: rslbot.api.AdminListRoles.Input
+	(*AdminListRoles_Output)(nil),                      // 98: rslbot.api.AdminListRoles.Output
+	(*AdminListRoles_Output_RoleGroup)(nil),            // 99: rslbot.api.AdminListRoles.Output.RoleGroup
+	(*AdminListStuckOrders_Input)(nil),                 // 100: rslbot.api.AdminListStuckOrders.Input
+	(*AdminListStuckOrders_Output)(nil),                // 101: rslbot.api.AdminListStuckOrders.Output
+	(*AdminListStuckOrders_Output_Count)(nil),          // 102: rslbot.api.AdminListStuckOrders.Output.Count
+	(*AdminListTaxRates_Input)(nil),                    // 103: rslbot.api.AdminListTaxRates.Input
+	(*AdminListTaxRates_Output)(nil),                   // 104: rslbot.api.AdminListTaxRates.Output
+	(*AdminListWebhookEvents_Input)(nil),               // 105: rslbot.api.AdminListWebhookEvents.Input
+	(*AdminListWebhookEvents_Output)(nil),              // 106: rslbot.api.AdminListWebhookEvents.Output
+	(*AdminPayCommissions_Input)(nil),                  // 107: rslbot.api.AdminPayCommissions.Input
+	(*AdminPayCommissions_Output)(nil),                 // 108: rslbot.api.AdminPayCommissions.Output
+	(*AdminProvisionPayPalPlans_Input)(nil),            // 109: rslbot.api.AdminProvisionPayPalPlans.Input
+	(*AdminProvisionPayPalPlans_Output)(nil),           // 110: rslbot.api.AdminProvisionPayPalPlans.Output
+	(*AdminRegenerateInvoices_Input)(nil),              // 111: rslbot.api.AdminRegenerateInvoices.Input
+	(*AdminRegenerateInvoices_Output)(nil),             // 112: rslbot.api.AdminRegenerateInvoices.Output
+	(*AdminReplayWebhookEvent_Input)(nil),              // 113: rslbot.api.AdminReplayWebhookEvent.Input
+	(*AdminReplayWebhookEvent_Output)(nil),             // 114: rslbot.api.AdminReplayWebhookEvent.Output
+	(*AdminRevokeLicense_Input)(nil),                   // 115: rslbot.api.AdminRevokeLicense.Input
+	(*AdminRevokeLicense_Output)(nil),                  // 116: rslbot.api.AdminRevokeLicense.Output
+	(*AdminRevokeRole_Input)(nil),                      // 117: rslbot.api.AdminRevokeRole.Input
+	(*AdminRevokeRole_Output)(nil),                     // 118: rslbot.api.AdminRevokeRole.Output
+	(*AdminSearchDatabase_Input)(nil),                  // 119: rslbot.api.AdminSearchDatabase.Input
+	(*AdminSearchDatabase_Output)(nil),                 // 120: rslbot.api.AdminSearchDatabase.Output
+	(*AdminSetExchangeRate_Input)(nil),                 // 121: rslbot.api.AdminSetExchangeRate.Input
+	(*AdminSetExchangeRate_Output)(nil),                // 122: rslbot.api.AdminSetExchangeRate.Output
+	(*AdminSetTaxRate_Input)(nil),                      // 123: rslbot.api.AdminSetTaxRate.Input
+	(*AdminSetTaxRate_Output)(nil),                     // 124: rslbot.api.AdminSetTaxRate.Output
+	(*AdminSyncDiscourseGroup_Input)(nil),              // 125: rslbot.api.AdminSyncDiscourseGroup.Input
+	(*AdminSyncDiscourseGroup_Output)(nil),             // 126: rslbot.api.AdminSyncDiscourseGroup.Output
+	(*AdminUpdatePrice_Input)(nil),                     // 127: rslbot.api.AdminUpdatePrice.Input
+	(*AdminUpdatePrice_Output)(nil),                    // 128: rslbot.api.AdminUpdatePrice.Output
+	(*AdminUpdateProduct_Input)(nil),                   // 129: rslbot.api.AdminUpdateProduct.Input
+	(*AdminUpdateProduct_Output)(nil),                  // 130: rslbot.api.AdminUpdateProduct.Output
+	(*AuthExchangeSSO_Input)(nil),                      // 131: rslbot.api.AuthExchangeSSO.Input
+	(*AuthExchangeSSO_Output)(nil),                     // 132: rslbot.api.AuthExchangeSSO.Output
+	(*AuthRefreshSession_Input)(nil),                   // 133: rslbot.api.AuthRefreshSession.Input
+	(*AuthRefreshSession_Output)(nil),                  // 134: rslbot.api.AuthRefreshSession.Output
+	(*AuthStartSSO_Input)(nil),                         // 135: rslbot.api.AuthStartSSO.Input
+	(*AuthStartSSO_Output)(nil),                        // 136: rslbot.api.AuthStartSSO.Output
+	(*PaymentCreatePayPalCheckout_Input)(nil),          // 137: rslbot.api.PaymentCreatePayPalCheckout.Input
+	(*PaymentCreatePayPalCheckout_Output)(nil),         // 138: rslbot.api.PaymentCreatePayPalCheckout.Output
+	(*PaymentCreatePayPalSubscription_Input)(nil),      // 139: rslbot.api.PaymentCreatePayPalSubscription.Input
+	(*PaymentCreatePayPalSubscription_Output)(nil),     // 140: rslbot.api.PaymentCreatePayPalSubscription.Output
+	(*PaymentCreateStripeCheckout_Input)(nil),          // 141: rslbot.api.PaymentCreateStripeCheckout.Input
+	(*PaymentCreateStripeCheckout_Output)(nil),         // 142: rslbot.api.PaymentCreateStripeCheckout.Output
+	(*PaymentGetCheckoutStatus_Input)(nil),             // 143: rslbot.api.PaymentGetCheckoutStatus.Input
+	(*PaymentGetCheckoutStatus_Output)(nil),            // 144: rslbot.api.PaymentGetCheckoutStatus.Output
+	(*PaymentWatchCheckoutStatus_Input)(nil),           // 145: rslbot.api.PaymentWatchCheckoutStatus.Input
+	(*PublicListPrices_Input)(nil),                     // 146: rslbot.api.PublicListPrices.Input
+	(*PublicListPrices_Output)(nil),                    // 147: rslbot.api.PublicListPrices.Output
+	(*ToolStatus_Input)(nil),                           // 148: rslbot.api.ToolStatus.Input
+	(*ToolStatus_Output)(nil),                          // 149: rslbot.api.ToolStatus.Output
+	(*UserCancelSubscription_Input)(nil),               // 150: rslbot.api.UserCancelSubscription.Input
+	(*UserCancelSubscription_Output)(nil),              // 151: rslbot.api.UserCancelSubscription.Output
+	(*UserCreateApiKey_Input)(nil),                     // 152: rslbot.api.UserCreateApiKey.Input
+	(*UserCreateApiKey_Output)(nil),                    // 153: rslbot.api.UserCreateApiKey.Output
+	(*UserGetInvoice_Input)(nil),                       // 154: rslbot.api.UserGetInvoice.Input
+	(*UserGetInvoice_Output)(nil),                      // 155: rslbot.api.UserGetInvoice.Output
+	(*UserGetLicenses_Input)(nil),                      // 156: rslbot.api.UserGetLicenses.Input
+	(*UserGetLicenses_Output)(nil),                     // 157: rslbot.api.UserGetLicenses.Output
+	(*UserGetPayment_Input)(nil),                       // 158: rslbot.api.UserGetPayment.Input
+	(*UserGetPayment_Output)(nil),                      // 159: rslbot.api.UserGetPayment.Output
+	(*UserGetReferralCode_Input)(nil),                  // 160: rslbot.api.UserGetReferralCode.Input
+	(*UserGetReferralCode_Output)(nil),                 // 161: rslbot.api.UserGetReferralCode.Output
+	(*UserGetSession_Input)(nil),                       // 162: rslbot.api.UserGetSession.Input
+	(*UserGetSession_Output)(nil),                      // 163: rslbot.api.UserGetSession.Output
+	(*UserGetSubscriptions_Input)(nil),                 // 164: rslbot.api.UserGetSubscriptions.Input
+	(*UserGetSubscriptions_Output)(nil),                // 165: rslbot.api.UserGetSubscriptions.Output
+	(*UserListApiKeys_Input)(nil),                      // 166: rslbot.api.UserListApiKeys.Input
+	(*UserListApiKeys_Output)(nil),                     // 167: rslbot.api.UserListApiKeys.Output
+	(*UserListCommissions_Input)(nil),                  // 168: rslbot.api.UserListCommissions.Input
+	(*UserListCommissions_Output)(nil),                 // 169: rslbot.api.UserListCommissions.Output
+	(*UserListCommissions_Output_Balance)(nil),         // 170: rslbot.api.UserListCommissions.Output.Balance
+	(*UserListPayments_Input)(nil),                     // 171: rslbot.api.UserListPayments.Input
+	(*UserListPayments_Output)(nil),                    // 172: rslbot.api.UserListPayments.Output
+	(*UserLogout_Input)(nil),                           // 173: rslbot.api.UserLogout.Input
+	(*UserLogout_Output)(nil),                          // 174: rslbot.api.UserLogout.Output
+	(*UserLogoutEverywhere_Input)(nil),                 // 175: rslbot.api.UserLogoutEverywhere.Input
+	(*UserLogoutEverywhere_Output)(nil),                // 176: rslbot.api.UserLogoutEverywhere.Output
+	(*UserRequestRefund_Input)(nil),                    // 177: rslbot.api.UserRequestRefund.Input
+	(*UserRequestRefund_Output)(nil),                   // 178: rslbot.api.UserRequestRefund.Output
+	(*UserRevokeApiKey_Input)(nil),                     // 179: rslbot.api.UserRevokeApiKey.Input
+	(*UserRevokeApiKey_Output)(nil),                    // 180: rslbot.api.UserRevokeApiKey.Output
+	(*UserSyncDiscordRole_Input)(nil),                  // 181: rslbot.api.UserSyncDiscordRole.Input
+	(*UserSyncDiscordRole_Output)(nil),                 // 182: rslbot.api.UserSyncDiscordRole.Output
+	(rbdb.LicenseKey_Duration)(0),                      // 183: rslbot.db.LicenseKey.Duration
+	(rbdb.LicenseKey_Tier)(0),                          // 184: rslbot.db.LicenseKey.Tier
+	(*rbdb.LicenseKey)(nil),                            // 185: rslbot.db.LicenseKey
+	(*rbdb.RefundRequest)(nil),                         // 186: rslbot.db.RefundRequest
+	(*rbdb.Payment)(nil),                               // 187: rslbot.db.Payment
+	(*rbdb.Price)(nil),                                 // 188: rslbot.db.Price
+	(*rbdb.Product)(nil),                               // 189: rslbot.db.Product
+	(*timestamppb.Timestamp)(nil),                      // 190: google.protobuf.Timestamp
+	(rbdb.Payment_Provider)(0),                         // 191: rslbot.db.Payment.Provider
+	(rbdb.UserRole_Role)(0),                            // 192: rslbot.db.UserRole.Role
+	(*rbdb.UserRole)(nil),                              // 193: rslbot.db.UserRole
+	(*rbdb.User)(nil),                                  // 194: rslbot.db.User
+	(*rbdb.ExchangeRate)(nil),                          // 195: rslbot.db.ExchangeRate
+	(*rbdb.ImpersonationAudit)(nil),                    // 196: rslbot.db.ImpersonationAudit
+	(rbdb.RefundRequest_Status)(0),                     // 197: rslbot.db.RefundRequest.Status
+	(*rbdb.CheckoutOrder)(nil),                         // 198: rslbot.db.CheckoutOrder
+	(rbdb.CheckoutOrder_Status)(0),                     // 199: rslbot.db.CheckoutOrder.Status
+	(*rbdb.TaxRate)(nil),                               // 200: rslbot.db.TaxRate
+	(rbdb.WebhookEvent_Status)(0),                      // 201: rslbot.db.WebhookEvent.Status
+	(rbdb.WebhookEvent_Provider)(0),                    // 202: rslbot.db.WebhookEvent.Provider
+	(*rbdb.WebhookEvent)(nil),                          // 203: rslbot.db.WebhookEvent
+	(*rbdb.Commission)(nil),                            // 204: rslbot.db.Commission
+	(*rbdb.BillingPlan)(nil),                           // 205: rslbot.db.BillingPlan
+	(*rbdb.Invoice)(nil),                               // 206: rslbot.db.Invoice
+	(rbdb.Payment_Status)(0),                           // 207: rslbot.db.Payment.Status
+	(*rbdb.Subscription)(nil),                          // 208: rslbot.db.Subscription
+	(*rbdb.ApiKey)(nil),                                // 209: rslbot.db.ApiKey
 }
 var file_proto_rslbot_rbapi_proto_depIdxs = []int32{
-	30, // 0: rslbot.api.AdminAddLicenseKey.Input.duration:type_name -> rslbot.db.LicenseKey.Duration
-	31, // 1: rslbot.api.AdminAddLicenseKey.Input.tier:type_name -> rslbot.db.LicenseKey.Tier
-	32, // 2: rslbot.api.AdminAddLicenseKey.Output.license_key:type_name -> rslbot.db.LicenseKey
-	32, // 3: rslbot.api.AdminRevokeLicense.Output.license_key:type_name -> rslbot.db.LicenseKey
-	33, // 4: rslbot.api.AdminSearchDatabase.Output.users:type_name -> rslbot.db.User
-	32, // 5: rslbot.api.AdminSearchDatabase.Output.license_keys:type_name -> rslbot.db.LicenseKey
-	34, // 6: rslbot.api.AdminSearchDatabase.Output.payments:type_name -> rslbot.db.Payment
-	35, // 7: rslbot.api.AdminSearchDatabase.Output.subscriptions:type_name -> rslbot.db.Subscription
-	30, // 8: rslbot.api.PaymentCreatePayPalCheckout.Input.license_duration:type_name -> rslbot.db.LicenseKey.Duration
-	32, // 9: rslbot.api.UserGetLicenses.Output.licenses:type_name -> rslbot.db.LicenseKey
-	33, // 10: rslbot.api.UserGetSession.Output.user:type_name -> rslbot.db.User
-	10, // 11: rslbot.api.Service.AdminAddLicenseKey:input_type -> rslbot.api.AdminAddLicenseKey.Input
-	12, // 12: rslbot.api.Service.AdminGetActiveUsers:input_type -> rslbot.api.AdminGetActiveUsers.Input
-	14, // 13: rslbot.api.Service.AdminRevokeLicense:input_type -> rslbot.api.AdminRevokeLicense.Input
-	16, // 14: rslbot.api.Service.AdminSearchDatabase:input_type -> rslbot.api.AdminSearchDatabase.Input
-	18, // 15: rslbot.api.Service.PaymentCreatePayPalCheckout:input_type -> rslbot.api.PaymentCreatePayPalCheckout.Input
-	20, // 16: rslbot.api.Service.ToolStatus:input_type -> rslbot.api.ToolStatus.Input
-	22, // 17: rslbot.api.Service.UserGetLicenses:input_type -> rslbot.api.UserGetLicenses.Input
-	24, // 18: rslbot.api.Service.UserGetSession:input_type -> rslbot.api.UserGetSession.Input
-	26, // 19: rslbot.api.Service.UserLogout:input_type -> rslbot.api.UserLogout.Input
-	28, // 20: rslbot.api.Service.UserSyncDiscordRole:input_type -> rslbot.api.UserSyncDiscordRole.Input
-	11, // 21: rslbot.api.Service.AdminAddLicenseKey:output_type -> rslbot.api.AdminAddLicenseKey.Output
-	13, // 22: rslbot.api.Service.AdminGetActiveUsers:output_type -> rslbot.api.AdminGetActiveUsers.Output
-	15, // 23: rslbot.api.Service.AdminRevokeLicense:output_type -> rslbot.api.AdminRevokeLicense.Output
-	17, // 24: rslbot.api.Service.AdminSearchDatabase:output_type -> rslbot.api.AdminSearchDatabase.Output
-	19, // 25: rslbot.api.Service.PaymentCreatePayPalCheckout:output_type -> rslbot.api.PaymentCreatePayPalCheckout.Output
-	21, // 26: rslbot.api.Service.ToolStatus:output_type -> rslbot.api.ToolStatus.Output
-	23, // 27: rslbot.api.Service.UserGetLicenses:output_type -> rslbot.api.UserGetLicenses.Output
-	25, // 28: rslbot.api.Service.UserGetSession:output_type -> rslbot.api.UserGetSession.Output
-	27, // 29: rslbot.api.Service.UserLogout:output_type -> rslbot.api.UserLogout.Output
-	29, // 30: rslbot.api.Service.UserSyncDiscordRole:output_type -> rslbot.api.UserSyncDiscordRole.Output
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	183, // 0: rslbot.api.AdminAddLicenseKey.Input.duration:type_name -> rslbot.db.LicenseKey.Duration
+	184, // 1: rslbot.api.AdminAddLicenseKey.Input.tier:type_name -> rslbot.db.LicenseKey.Tier
+	185, // 2: rslbot.api.AdminAddLicenseKey.Output.license_key:type_name -> rslbot.db.LicenseKey
+	186, // 3: rslbot.api.AdminApproveRefundRequest.Output.refund_request:type_name -> rslbot.db.RefundRequest
+	187, // 4: rslbot.api.AdminApproveRefundRequest.Output.payment:type_name -> rslbot.db.Payment
+	188, // 5: rslbot.api.AdminCreatePrice.Input.price:type_name -> rslbot.db.Price
+	188, // 6: rslbot.api.AdminCreatePrice.Output.price:type_name -> rslbot.db.Price
+	189, // 7: rslbot.api.AdminCreateProduct.Input.product:type_name -> rslbot.db.Product
+	189, // 8: rslbot.api.AdminCreateProduct.Output.product:type_name -> rslbot.db.Product
+	186, // 9: rslbot.api.AdminDenyRefundRequest.Output.refund_request:type_name -> rslbot.db.RefundRequest
+	190, // 10: rslbot.api.AdminGetRevenue.Input.from:type_name -> google.protobuf.Timestamp
+	190, // 11: rslbot.api.AdminGetRevenue.Input.to:type_name -> google.protobuf.Timestamp
+	78,  // 12: rslbot.api.AdminGetRevenue.Output.totals:type_name -> rslbot.api.AdminGetRevenue.Output.Total
+	191, // 13: rslbot.api.AdminGetRevenue.Output.Total.provider:type_name -> rslbot.db.Payment.Provider
+	190, // 14: rslbot.api.AdminGetTaxReport.Output.from:type_name -> google.protobuf.Timestamp
+	190, // 15: rslbot.api.AdminGetTaxReport.Output.to:type_name -> google.protobuf.Timestamp
+	81,  // 16: rslbot.api.AdminGetTaxReport.Output.totals:type_name -> rslbot.api.AdminGetTaxReport.Output.Total
+	192, // 17: rslbot.api.AdminGrantRole.Input.role:type_name -> rslbot.db.UserRole.Role
+	193, // 18: rslbot.api.AdminGrantRole.Output.user_role:type_name -> rslbot.db.UserRole
+	190, // 19: rslbot.api.AdminImpersonateUser.Output.expires_at:type_name -> google.protobuf.Timestamp
+	194, // 20: rslbot.api.AdminImpersonateUser.Output.user:type_name -> rslbot.db.User
+	189, // 21: rslbot.api.AdminListCatalog.Output.products:type_name -> rslbot.db.Product
+	188, // 22: rslbot.api.AdminListCatalog.Output.prices:type_name -> rslbot.db.Price
+	195, // 23: rslbot.api.AdminListExchangeRates.Output.rates:type_name -> rslbot.db.ExchangeRate
+	196, // 24: rslbot.api.AdminListImpersonationAudits.Output.audits:type_name -> rslbot.db.ImpersonationAudit
+	94,  // 25: rslbot.api.AdminListPayableCommissions.Output.payables:type_name -> rslbot.api.AdminListPayableCommissions.Output.Payable
+	194, // 26: rslbot.api.AdminListPayableCommissions.Output.Payable.referrer:type_name -> rslbot.db.User
+	197, // 27: rslbot.api.AdminListRefundRequests.Input.status:type_name -> rslbot.db.RefundRequest.Status
+	186, // 28: rslbot.api.AdminListRefundRequests.Output.refund_requests:type_name -> rslbot.db.RefundRequest
+	193, // 29: rslbot.api.AdminListRoles.Output.user_roles:type_name -> rslbot.db.UserRole
+	99,  // 30: rslbot.api.AdminListRoles.Output.role_groups:type_name -> rslbot.api.AdminListRoles.Output.RoleGroup
+	192, // 31: rslbot.api.AdminListRoles.Output.RoleGroup.role:type_name -> rslbot.db.UserRole.Role
+	102, // 32: rslbot.api.AdminListStuckOrders.Output.counts:type_name -> rslbot.api.AdminListStuckOrders.Output.Count
+	198, // 33: rslbot.api.AdminListStuckOrders.Output.orders:type_name -> rslbot.db.CheckoutOrder
+	199, // 34: rslbot.api.AdminListStuckOrders.Output.Count.status:type_name -> rslbot.db.CheckoutOrder.Status
+	200, // 35: rslbot.api.AdminListTaxRates.Output.rates:type_name -> rslbot.db.TaxRate
+	201, // 36: rslbot.api.AdminListWebhookEvents.Input.status:type_name -> rslbot.db.WebhookEvent.Status
+	202, // 37: rslbot.api.AdminListWebhookEvents.Input.provider:type_name -> rslbot.db.WebhookEvent.Provider
+	203, // 38: rslbot.api.AdminListWebhookEvents.Output.events:type_name -> rslbot.db.WebhookEvent
+	204, // 39: rslbot.api.AdminPayCommissions.Output.commissions:type_name -> rslbot.db.Commission
+	205, // 40: rslbot.api.AdminProvisionPayPalPlans.Output.plans:type_name -> rslbot.db.BillingPlan
+	206, // 41: rslbot.api.AdminRegenerateInvoices.Output.invoices:type_name -> rslbot.db.Invoice
+	203, // 42: rslbot.api.AdminReplayWebhookEvent.Output.event:type_name -> rslbot.db.WebhookEvent
+	185, // 43: rslbot.api.AdminRevokeLicense.Output.license_key:type_name -> rslbot.db.LicenseKey
+	192, // 44: rslbot.api.AdminRevokeRole.Input.role:type_name -> rslbot.db.UserRole.Role
+	207, // 45: rslbot.api.AdminSearchDatabase.Input.payment_status:type_name -> rslbot.db.Payment.Status
+	194, // 46: rslbot.api.AdminSearchDatabase.Output.users:type_name -> rslbot.db.User
+	185, // 47: rslbot.api.AdminSearchDatabase.Output.license_keys:type_name -> rslbot.db.LicenseKey
+	187, // 48: rslbot.api.AdminSearchDatabase.Output.payments:type_name -> rslbot.db.Payment
+	208, // 49: rslbot.api.AdminSearchDatabase.Output.subscriptions:type_name -> rslbot.db.Subscription
+	195, // 50: rslbot.api.AdminSetExchangeRate.Output.rate:type_name -> rslbot.db.ExchangeRate
+	200, // 51: rslbot.api.AdminSetTaxRate.Output.rate:type_name -> rslbot.db.TaxRate
+	188, // 52: rslbot.api.AdminUpdatePrice.Input.price:type_name -> rslbot.db.Price
+	188, // 53: rslbot.api.AdminUpdatePrice.Output.price:type_name -> rslbot.db.Price
+	189, // 54: rslbot.api.AdminUpdateProduct.Input.product:type_name -> rslbot.db.Product
+	189, // 55: rslbot.api.AdminUpdateProduct.Output.product:type_name -> rslbot.db.Product
+	190, // 56: rslbot.api.AuthExchangeSSO.Output.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	190, // 57: rslbot.api.AuthExchangeSSO.Output.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	194, // 58: rslbot.api.AuthExchangeSSO.Output.user:type_name -> rslbot.db.User
+	190, // 59: rslbot.api.AuthRefreshSession.Output.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	190, // 60: rslbot.api.AuthRefreshSession.Output.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	190, // 61: rslbot.api.AuthStartSSO.Output.expires_at:type_name -> google.protobuf.Timestamp
+	183, // 62: rslbot.api.PaymentCreatePayPalCheckout.Input.license_duration:type_name -> rslbot.db.LicenseKey.Duration
+	183, // 63: rslbot.api.PaymentCreatePayPalSubscription.Input.license_duration:type_name -> rslbot.db.LicenseKey.Duration
+	183, // 64: rslbot.api.PaymentCreateStripeCheckout.Input.license_duration:type_name -> rslbot.db.LicenseKey.Duration
+	0,   // 65: rslbot.api.PaymentGetCheckoutStatus.Output.status:type_name -> rslbot.api.PaymentGetCheckoutStatus.Status
+	199, // 66: rslbot.api.PaymentGetCheckoutStatus.Output.order_status:type_name -> rslbot.db.CheckoutOrder.Status
+	185, // 67: rslbot.api.PaymentGetCheckoutStatus.Output.license_key:type_name -> rslbot.db.LicenseKey
+	188, // 68: rslbot.api.PublicListPrices.Output.prices:type_name -> rslbot.db.Price
+	208, // 69: rslbot.api.UserCancelSubscription.Output.subscription:type_name -> rslbot.db.Subscription
+	209, // 70: rslbot.api.UserCreateApiKey.Output.api_key:type_name -> rslbot.db.ApiKey
+	206, // 71: rslbot.api.UserGetInvoice.Output.invoice:type_name -> rslbot.db.Invoice
+	206, // 72: rslbot.api.UserGetInvoice.Output.credit_notes:type_name -> rslbot.db.Invoice
+	185, // 73: rslbot.api.UserGetLicenses.Output.licenses:type_name -> rslbot.db.LicenseKey
+	187, // 74: rslbot.api.UserGetPayment.Output.payment:type_name -> rslbot.db.Payment
+	206, // 75: rslbot.api.UserGetPayment.Output.invoices:type_name -> rslbot.db.Invoice
+	194, // 76: rslbot.api.UserGetSession.Output.user:type_name -> rslbot.db.User
+	208, // 77: rslbot.api.UserGetSubscriptions.Output.subscriptions:type_name -> rslbot.db.Subscription
+	209, // 78: rslbot.api.UserListApiKeys.Output.api_keys:type_name -> rslbot.db.ApiKey
+	204, // 79: rslbot.api.UserListCommissions.Output.commissions:type_name -> rslbot.db.Commission
+	170, // 80: rslbot.api.UserListCommissions.Output.balances:type_name -> rslbot.api.UserListCommissions.Output.Balance
+	187, // 81: rslbot.api.UserListPayments.Output.payments:type_name -> rslbot.db.Payment
+	186, // 82: rslbot.api.UserRequestRefund.Output.refund_request:type_name -> rslbot.db.RefundRequest
+	209, // 83: rslbot.api.UserRevokeApiKey.Output.api_key:type_name -> rslbot.db.ApiKey
+	60,  // 84: rslbot.api.Service.AdminAddLicenseKey:input_type -> rslbot.api.AdminAddLicenseKey.Input
+	62,  // 85: rslbot.api.Service.AdminApproveRefundRequest:input_type -> rslbot.api.AdminApproveRefundRequest.Input
+	64,  // 86: rslbot.api.Service.AdminCreatePrice:input_type -> rslbot.api.AdminCreatePrice.Input
+	66,  // 87: rslbot.api.Service.AdminCreateProduct:input_type -> rslbot.api.AdminCreateProduct.Input
+	68,  // 88: rslbot.api.Service.AdminDeletePrice:input_type -> rslbot.api.AdminDeletePrice.Input
+	70,  // 89: rslbot.api.Service.AdminDeleteProduct:input_type -> rslbot.api.AdminDeleteProduct.Input
+	72,  // 90: rslbot.api.Service.AdminDenyRefundRequest:input_type -> rslbot.api.AdminDenyRefundRequest.Input
+	74,  // 91: rslbot.api.Service.AdminGetActiveUsers:input_type -> rslbot.api.AdminGetActiveUsers.Input
+	76,  // 92: rslbot.api.Service.AdminGetRevenue:input_type -> rslbot.api.AdminGetRevenue.Input
+	79,  // 93: rslbot.api.Service.AdminGetTaxReport:input_type -> rslbot.api.AdminGetTaxReport.Input
+	82,  // 94: rslbot.api.Service.AdminGrantRole:input_type -> rslbot.api.AdminGrantRole.Input
+	84,  // 95: rslbot.api.Service.AdminImpersonateUser:input_type -> rslbot.api.AdminImpersonateUser.Input
+	86,  // 96: rslbot.api.Service.AdminListCatalog:input_type -> rslbot.api.AdminListCatalog.Input
+	88,  // 97: rslbot.api.Service.AdminListExchangeRates:input_type -> rslbot.api.AdminListExchangeRates.Input
+	90,  // 98: rslbot.api.Service.AdminListImpersonationAudits:input_type -> rslbot.api.AdminListImpersonationAudits.Input
+	92,  // 99: rslbot.api.Service.AdminListPayableCommissions:input_type -> rslbot.api.AdminListPayableCommissions.Input
+	95,  // 100: rslbot.api.Service.AdminListRefundRequests:input_type -> rslbot.api.AdminListRefundRequests.Input
+	97,  // 101: rslbot.api.Service.AdminListRoles:input_type -> rslbot.api.AdminListRoles.Input
+	100, // 102: rslbot.api.Service.AdminListStuckOrders:input_type -> rslbot.api.AdminListStuckOrders.Input
+	103, // 103: rslbot.api.Service.AdminListTaxRates:input_type -> rslbot.api.AdminListTaxRates.Input
+	105, // 104: rslbot.api.Service.AdminListWebhookEvents:input_type -> rslbot.api.AdminListWebhookEvents.Input
+	107, // 105: rslbot.api.Service.AdminPayCommissions:input_type -> rslbot.api.AdminPayCommissions.Input
+	109, // 106: rslbot.api.Service.AdminProvisionPayPalPlans:input_type -> rslbot.api.AdminProvisionPayPalPlans.Input
+	111, // 107: rslbot.api.Service.AdminRegenerateInvoices:input_type -> rslbot.api.AdminRegenerateInvoices.Input
+	113, // 108: rslbot.api.Service.AdminReplayWebhookEvent:input_type -> rslbot.api.AdminReplayWebhookEvent.Input
+	115, // 109: rslbot.api.Service.AdminRevokeLicense:input_type -> rslbot.api.AdminRevokeLicense.Input
+	117, // 110: rslbot.api.Service.AdminRevokeRole:input_type -> rslbot.api.AdminRevokeRole.Input
+	119, // 111: rslbot.api.Service.AdminSearchDatabase:input_type -> rslbot.api.AdminSearchDatabase.Input
+	121, // 112: rslbot.api.Service.AdminSetExchangeRate:input_type -> rslbot.api.AdminSetExchangeRate.Input
+	123, // 113: rslbot.api.Service.AdminSetTaxRate:input_type -> rslbot.api.AdminSetTaxRate.Input
+	125, // 114: rslbot.api.Service.AdminSyncDiscourseGroup:input_type -> rslbot.api.AdminSyncDiscourseGroup.Input
+	127, // 115: rslbot.api.Service.AdminUpdatePrice:input_type -> rslbot.api.AdminUpdatePrice.Input
+	129, // 116: rslbot.api.Service.AdminUpdateProduct:input_type -> rslbot.api.AdminUpdateProduct.Input
+	131, // 117: rslbot.api.Service.AuthExchangeSSO:input_type -> rslbot.api.AuthExchangeSSO.Input
+	133, // 118: rslbot.api.Service.AuthRefreshSession:input_type -> rslbot.api.AuthRefreshSession.Input
+	135, // 119: rslbot.api.Service.AuthStartSSO:input_type -> rslbot.api.AuthStartSSO.Input
+	137, // 120: rslbot.api.Service.PaymentCreatePayPalCheckout:input_type -> rslbot.api.PaymentCreatePayPalCheckout.Input
+	139, // 121: rslbot.api.Service.PaymentCreatePayPalSubscription:input_type -> rslbot.api.PaymentCreatePayPalSubscription.Input
+	141, // 122: rslbot.api.Service.PaymentCreateStripeCheckout:input_type -> rslbot.api.PaymentCreateStripeCheckout.Input
+	143, // 123: rslbot.api.Service.PaymentGetCheckoutStatus:input_type -> rslbot.api.PaymentGetCheckoutStatus.Input
+	145, // 124: rslbot.api.Service.PaymentWatchCheckoutStatus:input_type -> rslbot.api.PaymentWatchCheckoutStatus.Input
+	146, // 125: rslbot.api.Service.PublicListPrices:input_type -> rslbot.api.PublicListPrices.Input
+	148, // 126: rslbot.api.Service.ToolStatus:input_type -> rslbot.api.ToolStatus.Input
+	150, // 127: rslbot.api.Service.UserCancelSubscription:input_type -> rslbot.api.UserCancelSubscription.Input
+	152, // 128: rslbot.api.Service.UserCreateApiKey:input_type -> rslbot.api.UserCreateApiKey.Input
+	154, // 129: rslbot.api.Service.UserGetInvoice:input_type -> rslbot.api.UserGetInvoice.Input
+	156, // 130: rslbot.api.Service.UserGetLicenses:input_type -> rslbot.api.UserGetLicenses.Input
+	158, // 131: rslbot.api.Service.UserGetPayment:input_type -> rslbot.api.UserGetPayment.Input
+	160, // 132: rslbot.api.Service.UserGetReferralCode:input_type -> rslbot.api.UserGetReferralCode.Input
+	162, // 133: rslbot.api.Service.UserGetSession:input_type -> rslbot.api.UserGetSession.Input
+	164, // 134: rslbot.api.Service.UserGetSubscriptions:input_type -> rslbot.api.UserGetSubscriptions.Input
+	166, // 135: rslbot.api.Service.UserListApiKeys:input_type -> rslbot.api.UserListApiKeys.Input
+	168, // 136: rslbot.api.Service.UserListCommissions:input_type -> rslbot.api.UserListCommissions.Input
+	171, // 137: rslbot.api.Service.UserListPayments:input_type -> rslbot.api.UserListPayments.Input
+	173, // 138: rslbot.api.Service.UserLogout:input_type -> rslbot.api.UserLogout.Input
+	175, // 139: rslbot.api.Service.UserLogoutEverywhere:input_type -> rslbot.api.UserLogoutEverywhere.Input
+	177, // 140: rslbot.api.Service.UserRequestRefund:input_type -> rslbot.api.UserRequestRefund.Input
+	179, // 141: rslbot.api.Service.UserRevokeApiKey:input_type -> rslbot.api.UserRevokeApiKey.Input
+	181, // 142: rslbot.api.Service.UserSyncDiscordRole:input_type -> rslbot.api.UserSyncDiscordRole.Input
+	61,  // 143: rslbot.api.Service.AdminAddLicenseKey:output_type -> rslbot.api.AdminAddLicenseKey.Output
+	63,  // 144: rslbot.api.Service.AdminApproveRefundRequest:output_type -> rslbot.api.AdminApproveRefundRequest.Output
+	65,  // 145: rslbot.api.Service.AdminCreatePrice:output_type -> rslbot.api.AdminCreatePrice.Output
+	67,  // 146: rslbot.api.Service.AdminCreateProduct:output_type -> rslbot.api.AdminCreateProduct.Output
+	69,  // 147: rslbot.api.Service.AdminDeletePrice:output_type -> rslbot.api.AdminDeletePrice.Output
+	71,  // 148: rslbot.api.Service.AdminDeleteProduct:output_type -> rslbot.api.AdminDeleteProduct.Output
+	73,  // 149: rslbot.api.Service.AdminDenyRefundRequest:output_type -> rslbot.api.AdminDenyRefundRequest.Output
+	75,  // 150: rslbot.api.Service.AdminGetActiveUsers:output_type -> rslbot.api.AdminGetActiveUsers.Output
+	77,  // 151: rslbot.api.Service.AdminGetRevenue:output_type -> rslbot.api.AdminGetRevenue.Output
+	80,  // 152: rslbot.api.Service.AdminGetTaxReport:output_type -> rslbot.api.AdminGetTaxReport.Output
+	83,  // 153: rslbot.api.Service.AdminGrantRole:output_type -> rslbot.api.AdminGrantRole.Output
+	85,  // 154: rslbot.api.Service.AdminImpersonateUser:output_type -> rslbot.api.AdminImpersonateUser.Output
+	87,  // 155: rslbot.api.Service.AdminListCatalog:output_type -> rslbot.api.AdminListCatalog.Output
+	89,  // 156: rslbot.api.Service.AdminListExchangeRates:output_type -> rslbot.api.AdminListExchangeRates.Output
+	91,  // 157: rslbot.api.Service.AdminListImpersonationAudits:output_type -> rslbot.api.AdminListImpersonationAudits.Output
+	93,  // 158: rslbot.api.Service.AdminListPayableCommissions:output_type -> rslbot.api.AdminListPayableCommissions.Output
+	96,  // 159: rslbot.api.Service.AdminListRefundRequests:output_type -> rslbot.api.AdminListRefundRequests.Output
+	98,  // 160: rslbot.api.Service.AdminListRoles:output_type -> rslbot.api.AdminListRoles.Output
+	101, // 161: rslbot.api.Service.AdminListStuckOrders:output_type -> rslbot.api.AdminListStuckOrders.Output
+	104, // 162: rslbot.api.Service.AdminListTaxRates:output_type -> rslbot.api.AdminListTaxRates.Output
+	106, // 163: rslbot.api.Service.AdminListWebhookEvents:output_type -> rslbot.api.AdminListWebhookEvents.Output
+	108, // 164: rslbot.api.Service.AdminPayCommissions:output_type -> rslbot.api.AdminPayCommissions.Output
+	110, // 165: rslbot.api.Service.AdminProvisionPayPalPlans:output_type -> rslbot.api.AdminProvisionPayPalPlans.Output
+	112, // 166: rslbot.api.Service.AdminRegenerateInvoices:output_type -> rslbot.api.AdminRegenerateInvoices.Output
+	114, // 167: rslbot.api.Service.AdminReplayWebhookEvent:output_type -> rslbot.api.AdminReplayWebhookEvent.Output
+	116, // 168: rslbot.api.Service.AdminRevokeLicense:output_type -> rslbot.api.AdminRevokeLicense.Output
+	118, // 169: rslbot.api.Service.AdminRevokeRole:output_type -> rslbot.api.AdminRevokeRole.Output
+	120, // 170: rslbot.api.Service.AdminSearchDatabase:output_type -> rslbot.api.AdminSearchDatabase.Output
+	122, // 171: rslbot.api.Service.AdminSetExchangeRate:output_type -> rslbot.api.AdminSetExchangeRate.Output
+	124, // 172: rslbot.api.Service.AdminSetTaxRate:output_type -> rslbot.api.AdminSetTaxRate.Output
+	126, // 173: rslbot.api.Service.AdminSyncDiscourseGroup:output_type -> rslbot.api.AdminSyncDiscourseGroup.Output
+	128, // 174: rslbot.api.Service.AdminUpdatePrice:output_type -> rslbot.api.AdminUpdatePrice.Output
+	130, // 175: rslbot.api.Service.AdminUpdateProduct:output_type -> rslbot.api.AdminUpdateProduct.Output
+	132, // 176: rslbot.api.Service.AuthExchangeSSO:output_type -> rslbot.api.AuthExchangeSSO.Output
+	134, // 177: rslbot.api.Service.AuthRefreshSession:output_type -> rslbot.api.AuthRefreshSession.Output
+	136, // 178: rslbot.api.Service.AuthStartSSO:output_type -> rslbot.api.AuthStartSSO.Output
+	138, // 179: rslbot.api.Service.PaymentCreatePayPalCheckout:output_type -> rslbot.api.PaymentCreatePayPalCheckout.Output
+	140, // 180: rslbot.api.Service.PaymentCreatePayPalSubscription:output_type -> rslbot.api.PaymentCreatePayPalSubscription.Output
+	142, // 181: rslbot.api.Service.PaymentCreateStripeCheckout:output_type -> rslbot.api.PaymentCreateStripeCheckout.Output
+	144, // 182: rslbot.api.Service.PaymentGetCheckoutStatus:output_type -> rslbot.api.PaymentGetCheckoutStatus.Output
+	144, // 183: rslbot.api.Service.PaymentWatchCheckoutStatus:output_type -> rslbot.api.PaymentGetCheckoutStatus.Output
+	147, // 184: rslbot.api.Service.PublicListPrices:output_type -> rslbot.api.PublicListPrices.Output
+	149, // 185: rslbot.api.Service.ToolStatus:output_type -> rslbot.api.ToolStatus.Output
+	151, // 186: rslbot.api.Service.UserCancelSubscription:output_type -> rslbot.api.UserCancelSubscription.Output
+	153, // 187: rslbot.api.Service.UserCreateApiKey:output_type -> rslbot.api.UserCreateApiKey.Output
+	155, // 188: rslbot.api.Service.UserGetInvoice:output_type -> rslbot.api.UserGetInvoice.Output
+	157, // 189: rslbot.api.Service.UserGetLicenses:output_type -> rslbot.api.UserGetLicenses.Output
+	159, // 190: rslbot.api.Service.UserGetPayment:output_type -> rslbot.api.UserGetPayment.Output
+	161, // 191: rslbot.api.Service.UserGetReferralCode:output_type -> rslbot.api.UserGetReferralCode.Output
+	163, // 192: rslbot.api.Service.UserGetSession:output_type -> rslbot.api.UserGetSession.Output
+	165, // 193: rslbot.api.Service.UserGetSubscriptions:output_type -> rslbot.api.UserGetSubscriptions.Output
+	167, // 194: rslbot.api.Service.UserListApiKeys:output_type -> rslbot.api.UserListApiKeys.Output
+	169, // 195: rslbot.api.Service.UserListCommissions:output_type -> rslbot.api.UserListCommissions.Output
+	172, // 196: rslbot.api.Service.UserListPayments:output_type -> rslbot.api.UserListPayments.Output
+	174, // 197: rslbot.api.Service.UserLogout:output_type -> rslbot.api.UserLogout.Output
+	176, // 198: rslbot.api.Service.UserLogoutEverywhere:output_type -> rslbot.api.UserLogoutEverywhere.Output
+	178, // 199: rslbot.api.Service.UserRequestRefund:output_type -> rslbot.api.UserRequestRefund.Output
+	180, // 200: rslbot.api.Service.UserRevokeApiKey:output_type -> rslbot.api.UserRevokeApiKey.Output
+	182, // 201: rslbot.api.Service.UserSyncDiscordRole:output_type -> rslbot.api.UserSyncDiscordRole.Output
+	143, // [143:202] is the sub-list for method output_type
+	84,  // [84:143] is the sub-list for method input_type
+	84,  // [84:84] is the sub-list for extension type_name
+	84,  // [84:84] is the sub-list for extension extendee
+	0,   // [0:84] is the sub-list for field type_name
 }
 
 func init() { file_proto_rslbot_rbapi_proto_init() }
@@ -1642,13 +10200,14 @@ func file_proto_rslbot_rbapi_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_rslbot_rbapi_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   30,
+			NumEnums:      1,
+			NumMessages:   182,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_rslbot_rbapi_proto_goTypes,
 		DependencyIndexes: file_proto_rslbot_rbapi_proto_depIdxs,
+		EnumInfos:         file_proto_rslbot_rbapi_proto_enumTypes,
 		MessageInfos:      file_proto_rslbot_rbapi_proto_msgTypes,
 	}.Build()
 	File_proto_rslbot_rbapi_proto = out.File
@@ -19,16 +19,65 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_AdminAddLicenseKey_FullMethodName          = "/rslbot.api.Service/AdminAddLicenseKey"
-	Service_AdminGetActiveUsers_FullMethodName         = "/rslbot.api.Service/AdminGetActiveUsers"
-	Service_AdminRevokeLicense_FullMethodName          = "/rslbot.api.Service/AdminRevokeLicense"
-	Service_AdminSearchDatabase_FullMethodName         = "/rslbot.api.Service/AdminSearchDatabase"
-	Service_PaymentCreatePayPalCheckout_FullMethodName = "/rslbot.api.Service/PaymentCreatePayPalCheckout"
-	Service_ToolStatus_FullMethodName                  = "/rslbot.api.Service/ToolStatus"
-	Service_UserGetLicenses_FullMethodName             = "/rslbot.api.Service/UserGetLicenses"
-	Service_UserGetSession_FullMethodName              = "/rslbot.api.Service/UserGetSession"
-	Service_UserLogout_FullMethodName                  = "/rslbot.api.Service/UserLogout"
-	Service_UserSyncDiscordRole_FullMethodName         = "/rslbot.api.Service/UserSyncDiscordRole"
+	Service_AdminAddLicenseKey_FullMethodName              = "/rslbot.api.Service/AdminAddLicenseKey"
+	Service_AdminApproveRefundRequest_FullMethodName       = "/rslbot.api.Service/AdminApproveRefundRequest"
+	Service_AdminCreatePrice_FullMethodName                = "/rslbot.api.Service/AdminCreatePrice"
+	Service_AdminCreateProduct_FullMethodName              = "/rslbot.api.Service/AdminCreateProduct"
+	Service_AdminDeletePrice_FullMethodName                = "/rslbot.api.Service/AdminDeletePrice"
+	Service_AdminDeleteProduct_FullMethodName              = "/rslbot.api.Service/AdminDeleteProduct"
+	Service_AdminDenyRefundRequest_FullMethodName          = "/rslbot.api.Service/AdminDenyRefundRequest"
+	Service_AdminGetActiveUsers_FullMethodName             = "/rslbot.api.Service/AdminGetActiveUsers"
+	Service_AdminGetRevenue_FullMethodName                 = "/rslbot.api.Service/AdminGetRevenue"
+	Service_AdminGetTaxReport_FullMethodName               = "/rslbot.api.Service/AdminGetTaxReport"
+	Service_AdminGrantRole_FullMethodName                  = "/rslbot.api.Service/AdminGrantRole"
+	Service_AdminImpersonateUser_FullMethodName            = "/rslbot.api.Service/AdminImpersonateUser"
+	Service_AdminListCatalog_FullMethodName                = "/rslbot.api.Service/AdminListCatalog"
+	Service_AdminListExchangeRates_FullMethodName          = "/rslbot.api.Service/AdminListExchangeRates"
+	Service_AdminListImpersonationAudits_FullMethodName    = "/rslbot.api.Service/AdminListImpersonationAudits"
+	Service_AdminListPayableCommissions_FullMethodName     = "/rslbot.api.Service/AdminListPayableCommissions"
+	Service_AdminListRefundRequests_FullMethodName         = "/rslbot.api.Service/AdminListRefundRequests"
+	Service_AdminListRoles_FullMethodName                  = "/rslbot.api.Service/AdminListRoles"
+	Service_AdminListStuckOrders_FullMethodName            = "/rslbot.api.Service/AdminListStuckOrders"
+	Service_AdminListTaxRates_FullMethodName               = "/rslbot.api.Service/AdminListTaxRates"
+	Service_AdminListWebhookEvents_FullMethodName          = "/rslbot.api.Service/AdminListWebhookEvents"
+	Service_AdminPayCommissions_FullMethodName             = "/rslbot.api.Service/AdminPayCommissions"
+	Service_AdminProvisionPayPalPlans_FullMethodName       = "/rslbot.api.Service/AdminProvisionPayPalPlans"
+	Service_AdminRegenerateInvoices_FullMethodName         = "/rslbot.api.Service/AdminRegenerateInvoices"
+	Service_AdminReplayWebhookEvent_FullMethodName         = "/rslbot.api.Service/AdminReplayWebhookEvent"
+	Service_AdminRevokeLicense_FullMethodName              = "/rslbot.api.Service/AdminRevokeLicense"
+	Service_AdminRevokeRole_FullMethodName                 = "/rslbot.api.Service/AdminRevokeRole"
+	Service_AdminSearchDatabase_FullMethodName             = "/rslbot.api.Service/AdminSearchDatabase"
+	Service_AdminSetExchangeRate_FullMethodName            = "/rslbot.api.Service/AdminSetExchangeRate"
+	Service_AdminSetTaxRate_FullMethodName                 = "/rslbot.api.Service/AdminSetTaxRate"
+	Service_AdminSyncDiscourseGroup_FullMethodName         = "/rslbot.api.Service/AdminSyncDiscourseGroup"
+	Service_AdminUpdatePrice_FullMethodName                = "/rslbot.api.Service/AdminUpdatePrice"
+	Service_AdminUpdateProduct_FullMethodName              = "/rslbot.api.Service/AdminUpdateProduct"
+	Service_AuthExchangeSSO_FullMethodName                 = "/rslbot.api.Service/AuthExchangeSSO"
+	Service_AuthRefreshSession_FullMethodName              = "/rslbot.api.Service/AuthRefreshSession"
+	Service_AuthStartSSO_FullMethodName                    = "/rslbot.api.Service/AuthStartSSO"
+	Service_PaymentCreatePayPalCheckout_FullMethodName     = "/rslbot.api.Service/PaymentCreatePayPalCheckout"
+	Service_PaymentCreatePayPalSubscription_FullMethodName = "/rslbot.api.Service/PaymentCreatePayPalSubscription"
+	Service_PaymentCreateStripeCheckout_FullMethodName     = "/rslbot.api.Service/PaymentCreateStripeCheckout"
+	Service_PaymentGetCheckoutStatus_FullMethodName        = "/rslbot.api.Service/PaymentGetCheckoutStatus"
+	Service_PaymentWatchCheckoutStatus_FullMethodName      = "/rslbot.api.Service/PaymentWatchCheckoutStatus"
+	Service_PublicListPrices_FullMethodName                = "/rslbot.api.Service/PublicListPrices"
+	Service_ToolStatus_FullMethodName                      = "/rslbot.api.Service/ToolStatus"
+	Service_UserCancelSubscription_FullMethodName          = "/rslbot.api.Service/UserCancelSubscription"
+	Service_UserCreateApiKey_FullMethodName                = "/rslbot.api.Service/UserCreateApiKey"
+	Service_UserGetInvoice_FullMethodName                  = "/rslbot.api.Service/UserGetInvoice"
+	Service_UserGetLicenses_FullMethodName                 = "/rslbot.api.Service/UserGetLicenses"
+	Service_UserGetPayment_FullMethodName                  = "/rslbot.api.Service/UserGetPayment"
+	Service_UserGetReferralCode_FullMethodName             = "/rslbot.api.Service/UserGetReferralCode"
+	Service_UserGetSession_FullMethodName                  = "/rslbot.api.Service/UserGetSession"
+	Service_UserGetSubscriptions_FullMethodName            = "/rslbot.api.Service/UserGetSubscriptions"
+	Service_UserListApiKeys_FullMethodName                 = "/rslbot.api.Service/UserListApiKeys"
+	Service_UserListCommissions_FullMethodName             = "/rslbot.api.Service/UserListCommissions"
+	Service_UserListPayments_FullMethodName                = "/rslbot.api.Service/UserListPayments"
+	Service_UserLogout_FullMethodName                      = "/rslbot.api.Service/UserLogout"
+	Service_UserLogoutEverywhere_FullMethodName            = "/rslbot.api.Service/UserLogoutEverywhere"
+	Service_UserRequestRefund_FullMethodName               = "/rslbot.api.Service/UserRequestRefund"
+	Service_UserRevokeApiKey_FullMethodName                = "/rslbot.api.Service/UserRevokeApiKey"
+	Service_UserSyncDiscordRole_FullMethodName             = "/rslbot.api.Service/UserSyncDiscordRole"
 )
 
 // ServiceClient is the client API for Service service.
@@ -36,14 +85,63 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
 	AdminAddLicenseKey(ctx context.Context, in *AdminAddLicenseKey_Input, opts ...grpc.CallOption) (*AdminAddLicenseKey_Output, error)
+	AdminApproveRefundRequest(ctx context.Context, in *AdminApproveRefundRequest_Input, opts ...grpc.CallOption) (*AdminApproveRefundRequest_Output, error)
+	AdminCreatePrice(ctx context.Context, in *AdminCreatePrice_Input, opts ...grpc.CallOption) (*AdminCreatePrice_Output, error)
+	AdminCreateProduct(ctx context.Context, in *AdminCreateProduct_Input, opts ...grpc.CallOption) (*AdminCreateProduct_Output, error)
+	AdminDeletePrice(ctx context.Context, in *AdminDeletePrice_Input, opts ...grpc.CallOption) (*AdminDeletePrice_Output, error)
+	AdminDeleteProduct(ctx context.Context, in *AdminDeleteProduct_Input, opts ...grpc.CallOption) (*AdminDeleteProduct_Output, error)
+	AdminDenyRefundRequest(ctx context.Context, in *AdminDenyRefundRequest_Input, opts ...grpc.CallOption) (*AdminDenyRefundRequest_Output, error)
 	AdminGetActiveUsers(ctx context.Context, in *AdminGetActiveUsers_Input, opts ...grpc.CallOption) (*AdminGetActiveUsers_Output, error)
+	AdminGetRevenue(ctx context.Context, in *AdminGetRevenue_Input, opts ...grpc.CallOption) (*AdminGetRevenue_Output, error)
+	AdminGetTaxReport(ctx context.Context, in *AdminGetTaxReport_Input, opts ...grpc.CallOption) (*AdminGetTaxReport_Output, error)
+	AdminGrantRole(ctx context.Context, in *AdminGrantRole_Input, opts ...grpc.CallOption) (*AdminGrantRole_Output, error)
+	AdminImpersonateUser(ctx context.Context, in *AdminImpersonateUser_Input, opts ...grpc.CallOption) (*AdminImpersonateUser_Output, error)
+	AdminListCatalog(ctx context.Context, in *AdminListCatalog_Input, opts ...grpc.CallOption) (*AdminListCatalog_Output, error)
+	AdminListExchangeRates(ctx context.Context, in *AdminListExchangeRates_Input, opts ...grpc.CallOption) (*AdminListExchangeRates_Output, error)
+	AdminListImpersonationAudits(ctx context.Context, in *AdminListImpersonationAudits_Input, opts ...grpc.CallOption) (*AdminListImpersonationAudits_Output, error)
+	AdminListPayableCommissions(ctx context.Context, in *AdminListPayableCommissions_Input, opts ...grpc.CallOption) (*AdminListPayableCommissions_Output, error)
+	AdminListRefundRequests(ctx context.Context, in *AdminListRefundRequests_Input, opts ...grpc.CallOption) (*AdminListRefundRequests_Output, error)
+	AdminListRoles(ctx context.Context, in *AdminListRoles_Input, opts ...grpc.CallOption) (*AdminListRoles_Output, error)
+	AdminListStuckOrders(ctx context.Context, in *AdminListStuckOrders_Input, opts ...grpc.CallOption) (*AdminListStuckOrders_Output, error)
+	AdminListTaxRates(ctx context.Context, in *AdminListTaxRates_Input, opts ...grpc.CallOption) (*AdminListTaxRates_Output, error)
+	AdminListWebhookEvents(ctx context.Context, in *AdminListWebhookEvents_Input, opts ...grpc.CallOption) (*AdminListWebhookEvents_Output, error)
+	AdminPayCommissions(ctx context.Context, in *AdminPayCommissions_Input, opts ...grpc.CallOption) (*AdminPayCommissions_Output, error)
+	AdminProvisionPayPalPlans(ctx context.Context, in *AdminProvisionPayPalPlans_Input, opts ...grpc.CallOption) (*AdminProvisionPayPalPlans_Output, error)
+	AdminRegenerateInvoices(ctx context.Context, in *AdminRegenerateInvoices_Input, opts ...grpc.CallOption) (*AdminRegenerateInvoices_Output, error)
+	AdminReplayWebhookEvent(ctx context.Context, in *AdminReplayWebhookEvent_Input, opts ...grpc.CallOption) (*AdminReplayWebhookEvent_Output, error)
 	AdminRevokeLicense(ctx context.Context, in *AdminRevokeLicense_Input, opts ...grpc.CallOption) (*AdminRevokeLicense_Output, error)
+	AdminRevokeRole(ctx context.Context, in *AdminRevokeRole_Input, opts ...grpc.CallOption) (*AdminRevokeRole_Output, error)
 	AdminSearchDatabase(ctx context.Context, in *AdminSearchDatabase_Input, opts ...grpc.CallOption) (*AdminSearchDatabase_Output, error)
+	AdminSetExchangeRate(ctx context.Context, in *AdminSetExchangeRate_Input, opts ...grpc.CallOption) (*AdminSetExchangeRate_Output, error)
+	AdminSetTaxRate(ctx context.Context, in *AdminSetTaxRate_Input, opts ...grpc.CallOption) (*AdminSetTaxRate_Output, error)
+	AdminSyncDiscourseGroup(ctx context.Context, in *AdminSyncDiscourseGroup_Input, opts ...grpc.CallOption) (*AdminSyncDiscourseGroup_Output, error)
+	AdminUpdatePrice(ctx context.Context, in *AdminUpdatePrice_Input, opts ...grpc.CallOption) (*AdminUpdatePrice_Output, error)
+	AdminUpdateProduct(ctx context.Context, in *AdminUpdateProduct_Input, opts ...grpc.CallOption) (*AdminUpdateProduct_Output, error)
+	AuthExchangeSSO(ctx context.Context, in *AuthExchangeSSO_Input, opts ...grpc.CallOption) (*AuthExchangeSSO_Output, error)
+	AuthRefreshSession(ctx context.Context, in *AuthRefreshSession_Input, opts ...grpc.CallOption) (*AuthRefreshSession_Output, error)
+	AuthStartSSO(ctx context.Context, in *AuthStartSSO_Input, opts ...grpc.CallOption) (*AuthStartSSO_Output, error)
 	PaymentCreatePayPalCheckout(ctx context.Context, in *PaymentCreatePayPalCheckout_Input, opts ...grpc.CallOption) (*PaymentCreatePayPalCheckout_Output, error)
+	PaymentCreatePayPalSubscription(ctx context.Context, in *PaymentCreatePayPalSubscription_Input, opts ...grpc.CallOption) (*PaymentCreatePayPalSubscription_Output, error)
+	PaymentCreateStripeCheckout(ctx context.Context, in *PaymentCreateStripeCheckout_Input, opts ...grpc.CallOption) (*PaymentCreateStripeCheckout_Output, error)
+	PaymentGetCheckoutStatus(ctx context.Context, in *PaymentGetCheckoutStatus_Input, opts ...grpc.CallOption) (*PaymentGetCheckoutStatus_Output, error)
+	PaymentWatchCheckoutStatus(ctx context.Context, in *PaymentWatchCheckoutStatus_Input, opts ...grpc.CallOption) (Service_PaymentWatchCheckoutStatusClient, error)
+	PublicListPrices(ctx context.Context, in *PublicListPrices_Input, opts ...grpc.CallOption) (*PublicListPrices_Output, error)
 	ToolStatus(ctx context.Context, in *ToolStatus_Input, opts ...grpc.CallOption) (*ToolStatus_Output, error)
+	UserCancelSubscription(ctx context.Context, in *UserCancelSubscription_Input, opts ...grpc.CallOption) (*UserCancelSubscription_Output, error)
+	UserCreateApiKey(ctx context.Context, in *UserCreateApiKey_Input, opts ...grpc.CallOption) (*UserCreateApiKey_Output, error)
+	UserGetInvoice(ctx context.Context, in *UserGetInvoice_Input, opts ...grpc.CallOption) (*UserGetInvoice_Output, error)
 	UserGetLicenses(ctx context.Context, in *UserGetLicenses_Input, opts ...grpc.CallOption) (*UserGetLicenses_Output, error)
+	UserGetPayment(ctx context.Context, in *UserGetPayment_Input, opts ...grpc.CallOption) (*UserGetPayment_Output, error)
+	UserGetReferralCode(ctx context.Context, in *UserGetReferralCode_Input, opts ...grpc.CallOption) (*UserGetReferralCode_Output, error)
 	UserGetSession(ctx context.Context, in *UserGetSession_Input, opts ...grpc.CallOption) (*UserGetSession_Output, error)
+	UserGetSubscriptions(ctx context.Context, in *UserGetSubscriptions_Input, opts ...grpc.CallOption) (*UserGetSubscriptions_Output, error)
+	UserListApiKeys(ctx context.Context, in *UserListApiKeys_Input, opts ...grpc.CallOption) (*UserListApiKeys_Output, error)
+	UserListCommissions(ctx context.Context, in *UserListCommissions_Input, opts ...grpc.CallOption) (*UserListCommissions_Output, error)
+	UserListPayments(ctx context.Context, in *UserListPayments_Input, opts ...grpc.CallOption) (*UserListPayments_Output, error)
 	UserLogout(ctx context.Context, in *UserLogout_Input, opts ...grpc.CallOption) (*UserLogout_Output, error)
+	UserLogoutEverywhere(ctx context.Context, in *UserLogoutEverywhere_Input, opts ...grpc.CallOption) (*UserLogoutEverywhere_Output, error)
+	UserRequestRefund(ctx context.Context, in *UserRequestRefund_Input, opts ...grpc.CallOption) (*UserRequestRefund_Output, error)
+	UserRevokeApiKey(ctx context.Context, in *UserRevokeApiKey_Input, opts ...grpc.CallOption) (*UserRevokeApiKey_Output, error)
 	UserSyncDiscordRole(ctx context.Context, in *UserSyncDiscordRole_Input, opts ...grpc.CallOption) (*UserSyncDiscordRole_Output, error)
 }
 
@@ -64,6 +162,60 @@ func (c *serviceClient) AdminAddLicenseKey(ctx context.Context, in *AdminAddLice
 	return out, nil
 }
 
+func (c *serviceClient) AdminApproveRefundRequest(ctx context.Context, in *AdminApproveRefundRequest_Input, opts ...grpc.CallOption) (*AdminApproveRefundRequest_Output, error) {
+	out := new(AdminApproveRefundRequest_Output)
+	err := c.cc.Invoke(ctx, Service_AdminApproveRefundRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminCreatePrice(ctx context.Context, in *AdminCreatePrice_Input, opts ...grpc.CallOption) (*AdminCreatePrice_Output, error) {
+	out := new(AdminCreatePrice_Output)
+	err := c.cc.Invoke(ctx, Service_AdminCreatePrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminCreateProduct(ctx context.Context, in *AdminCreateProduct_Input, opts ...grpc.CallOption) (*AdminCreateProduct_Output, error) {
+	out := new(AdminCreateProduct_Output)
+	err := c.cc.Invoke(ctx, Service_AdminCreateProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminDeletePrice(ctx context.Context, in *AdminDeletePrice_Input, opts ...grpc.CallOption) (*AdminDeletePrice_Output, error) {
+	out := new(AdminDeletePrice_Output)
+	err := c.cc.Invoke(ctx, Service_AdminDeletePrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminDeleteProduct(ctx context.Context, in *AdminDeleteProduct_Input, opts ...grpc.CallOption) (*AdminDeleteProduct_Output, error) {
+	out := new(AdminDeleteProduct_Output)
+	err := c.cc.Invoke(ctx, Service_AdminDeleteProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminDenyRefundRequest(ctx context.Context, in *AdminDenyRefundRequest_Input, opts ...grpc.CallOption) (*AdminDenyRefundRequest_Output, error) {
+	out := new(AdminDenyRefundRequest_Output)
+	err := c.cc.Invoke(ctx, Service_AdminDenyRefundRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) AdminGetActiveUsers(ctx context.Context, in *AdminGetActiveUsers_Input, opts ...grpc.CallOption) (*AdminGetActiveUsers_Output, error) {
 	out := new(AdminGetActiveUsers_Output)
 	err := c.cc.Invoke(ctx, Service_AdminGetActiveUsers_FullMethodName, in, out, opts...)
@@ -73,6 +225,159 @@ func (c *serviceClient) AdminGetActiveUsers(ctx context.Context, in *AdminGetAct
 	return out, nil
 }
 
+func (c *serviceClient) AdminGetRevenue(ctx context.Context, in *AdminGetRevenue_Input, opts ...grpc.CallOption) (*AdminGetRevenue_Output, error) {
+	out := new(AdminGetRevenue_Output)
+	err := c.cc.Invoke(ctx, Service_AdminGetRevenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminGetTaxReport(ctx context.Context, in *AdminGetTaxReport_Input, opts ...grpc.CallOption) (*AdminGetTaxReport_Output, error) {
+	out := new(AdminGetTaxReport_Output)
+	err := c.cc.Invoke(ctx, Service_AdminGetTaxReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminGrantRole(ctx context.Context, in *AdminGrantRole_Input, opts ...grpc.CallOption) (*AdminGrantRole_Output, error) {
+	out := new(AdminGrantRole_Output)
+	err := c.cc.Invoke(ctx, Service_AdminGrantRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminImpersonateUser(ctx context.Context, in *AdminImpersonateUser_Input, opts ...grpc.CallOption) (*AdminImpersonateUser_Output, error) {
+	out := new(AdminImpersonateUser_Output)
+	err := c.cc.Invoke(ctx, Service_AdminImpersonateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListCatalog(ctx context.Context, in *AdminListCatalog_Input, opts ...grpc.CallOption) (*AdminListCatalog_Output, error) {
+	out := new(AdminListCatalog_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListCatalog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListExchangeRates(ctx context.Context, in *AdminListExchangeRates_Input, opts ...grpc.CallOption) (*AdminListExchangeRates_Output, error) {
+	out := new(AdminListExchangeRates_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListImpersonationAudits(ctx context.Context, in *AdminListImpersonationAudits_Input, opts ...grpc.CallOption) (*AdminListImpersonationAudits_Output, error) {
+	out := new(AdminListImpersonationAudits_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListImpersonationAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListPayableCommissions(ctx context.Context, in *AdminListPayableCommissions_Input, opts ...grpc.CallOption) (*AdminListPayableCommissions_Output, error) {
+	out := new(AdminListPayableCommissions_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListPayableCommissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListRefundRequests(ctx context.Context, in *AdminListRefundRequests_Input, opts ...grpc.CallOption) (*AdminListRefundRequests_Output, error) {
+	out := new(AdminListRefundRequests_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListRefundRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListRoles(ctx context.Context, in *AdminListRoles_Input, opts ...grpc.CallOption) (*AdminListRoles_Output, error) {
+	out := new(AdminListRoles_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListStuckOrders(ctx context.Context, in *AdminListStuckOrders_Input, opts ...grpc.CallOption) (*AdminListStuckOrders_Output, error) {
+	out := new(AdminListStuckOrders_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListStuckOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListTaxRates(ctx context.Context, in *AdminListTaxRates_Input, opts ...grpc.CallOption) (*AdminListTaxRates_Output, error) {
+	out := new(AdminListTaxRates_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListTaxRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminListWebhookEvents(ctx context.Context, in *AdminListWebhookEvents_Input, opts ...grpc.CallOption) (*AdminListWebhookEvents_Output, error) {
+	out := new(AdminListWebhookEvents_Output)
+	err := c.cc.Invoke(ctx, Service_AdminListWebhookEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminPayCommissions(ctx context.Context, in *AdminPayCommissions_Input, opts ...grpc.CallOption) (*AdminPayCommissions_Output, error) {
+	out := new(AdminPayCommissions_Output)
+	err := c.cc.Invoke(ctx, Service_AdminPayCommissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminProvisionPayPalPlans(ctx context.Context, in *AdminProvisionPayPalPlans_Input, opts ...grpc.CallOption) (*AdminProvisionPayPalPlans_Output, error) {
+	out := new(AdminProvisionPayPalPlans_Output)
+	err := c.cc.Invoke(ctx, Service_AdminProvisionPayPalPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminRegenerateInvoices(ctx context.Context, in *AdminRegenerateInvoices_Input, opts ...grpc.CallOption) (*AdminRegenerateInvoices_Output, error) {
+	out := new(AdminRegenerateInvoices_Output)
+	err := c.cc.Invoke(ctx, Service_AdminRegenerateInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdminReplayWebhookEvent(ctx context.Context, in *AdminReplayWebhookEvent_Input, opts ...grpc.CallOption) (*AdminReplayWebhookEvent_Output, error) {
+	out := new(AdminReplayWebhookEvent_Output)
+	err := c.cc.Invoke(ctx, Service_AdminReplayWebhookEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) AdminRevokeLicense(ctx context.Context, in *AdminRevokeLicense_Input, opts ...grpc.CallOption) (*AdminRevokeLicense_Output, error) {
 	out := new(AdminRevokeLicense_Output)
 	err := c.cc.Invoke(ctx, Service_AdminRevokeLicense_FullMethodName, in, out, opts...)
@@ -82,6 +387,15 @@ func (c *serviceClient) AdminRevokeLicense(ctx context.Context, in *AdminRevokeL
 	return out, nil
 }
 
+func (c *serviceClient) AdminRevokeRole(ctx context.Context, in *AdminRevokeRole_Input, opts ...grpc.CallOption) (*AdminRevokeRole_Output, error) {
+	out := new(AdminRevokeRole_Output)
+	err := c.cc.Invoke(ctx, Service_AdminRevokeRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) AdminSearchDatabase(ctx context.Context, in *AdminSearchDatabase_Input, opts ...grpc.CallOption) (*AdminSearchDatabase_Output, error) {
 	out := new(AdminSearchDatabase_Output)
 	err := c.cc.Invoke(ctx, Service_AdminSearchDatabase_FullMethodName, in, out, opts...)
@@ -91,246 +405,1449 @@ func (c *serviceClient) AdminSearchDatabase(ctx context.Context, in *AdminSearch
 	return out, nil
 }
 
-func (c *serviceClient) PaymentCreatePayPalCheckout(ctx context.Context, in *PaymentCreatePayPalCheckout_Input, opts ...grpc.CallOption) (*PaymentCreatePayPalCheckout_Output, error) {
-	out := new(PaymentCreatePayPalCheckout_Output)
-	err := c.cc.Invoke(ctx, Service_PaymentCreatePayPalCheckout_FullMethodName, in, out, opts...)
+func (c *serviceClient) AdminSetExchangeRate(ctx context.Context, in *AdminSetExchangeRate_Input, opts ...grpc.CallOption) (*AdminSetExchangeRate_Output, error) {
+	out := new(AdminSetExchangeRate_Output)
+	err := c.cc.Invoke(ctx, Service_AdminSetExchangeRate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *serviceClient) ToolStatus(ctx context.Context, in *ToolStatus_Input, opts ...grpc.CallOption) (*ToolStatus_Output, error) {
-	out := new(ToolStatus_Output)
-	err := c.cc.Invoke(ctx, Service_ToolStatus_FullMethodName, in, out, opts...)
+func (c *serviceClient) AdminSetTaxRate(ctx context.Context, in *AdminSetTaxRate_Input, opts ...grpc.CallOption) (*AdminSetTaxRate_Output, error) {
+	out := new(AdminSetTaxRate_Output)
+	err := c.cc.Invoke(ctx, Service_AdminSetTaxRate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *serviceClient) UserGetLicenses(ctx context.Context, in *UserGetLicenses_Input, opts ...grpc.CallOption) (*UserGetLicenses_Output, error) {
-	out := new(UserGetLicenses_Output)
-	err := c.cc.Invoke(ctx, Service_UserGetLicenses_FullMethodName, in, out, opts...)
+func (c *serviceClient) AdminSyncDiscourseGroup(ctx context.Context, in *AdminSyncDiscourseGroup_Input, opts ...grpc.CallOption) (*AdminSyncDiscourseGroup_Output, error) {
+	out := new(AdminSyncDiscourseGroup_Output)
+	err := c.cc.Invoke(ctx, Service_AdminSyncDiscourseGroup_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *serviceClient) UserGetSession(ctx context.Context, in *UserGetSession_Input, opts ...grpc.CallOption) (*UserGetSession_Output, error) {
-	out := new(UserGetSession_Output)
-	err := c.cc.Invoke(ctx, Service_UserGetSession_FullMethodName, in, out, opts...)
+func (c *serviceClient) AdminUpdatePrice(ctx context.Context, in *AdminUpdatePrice_Input, opts ...grpc.CallOption) (*AdminUpdatePrice_Output, error) {
+	out := new(AdminUpdatePrice_Output)
+	err := c.cc.Invoke(ctx, Service_AdminUpdatePrice_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *serviceClient) UserLogout(ctx context.Context, in *UserLogout_Input, opts ...grpc.CallOption) (*UserLogout_Output, error) {
-	out := new(UserLogout_Output)
-	err := c.cc.Invoke(ctx, Service_UserLogout_FullMethodName, in, out, opts...)
+func (c *serviceClient) AdminUpdateProduct(ctx context.Context, in *AdminUpdateProduct_Input, opts ...grpc.CallOption) (*AdminUpdateProduct_Output, error) {
+	out := new(AdminUpdateProduct_Output)
+	err := c.cc.Invoke(ctx, Service_AdminUpdateProduct_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *serviceClient) UserSyncDiscordRole(ctx context.Context, in *UserSyncDiscordRole_Input, opts ...grpc.CallOption) (*UserSyncDiscordRole_Output, error) {
-	out := new(UserSyncDiscordRole_Output)
-	err := c.cc.Invoke(ctx, Service_UserSyncDiscordRole_FullMethodName, in, out, opts...)
+func (c *serviceClient) AuthExchangeSSO(ctx context.Context, in *AuthExchangeSSO_Input, opts ...grpc.CallOption) (*AuthExchangeSSO_Output, error) {
+	out := new(AuthExchangeSSO_Output)
+	err := c.cc.Invoke(ctx, Service_AuthExchangeSSO_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ServiceServer is the server API for Service service.
-// All implementations must embed UnimplementedServiceServer
-// for forward compatibility
-type ServiceServer interface {
-	AdminAddLicenseKey(context.Context, *AdminAddLicenseKey_Input) (*AdminAddLicenseKey_Output, error)
-	AdminGetActiveUsers(context.Context, *AdminGetActiveUsers_Input) (*AdminGetActiveUsers_Output, error)
-	AdminRevokeLicense(context.Context, *AdminRevokeLicense_Input) (*AdminRevokeLicense_Output, error)
-	AdminSearchDatabase(context.Context, *AdminSearchDatabase_Input) (*AdminSearchDatabase_Output, error)
-	PaymentCreatePayPalCheckout(context.Context, *PaymentCreatePayPalCheckout_Input) (*PaymentCreatePayPalCheckout_Output, error)
-	ToolStatus(context.Context, *ToolStatus_Input) (*ToolStatus_Output, error)
-	UserGetLicenses(context.Context, *UserGetLicenses_Input) (*UserGetLicenses_Output, error)
-	UserGetSession(context.Context, *UserGetSession_Input) (*UserGetSession_Output, error)
-	UserLogout(context.Context, *UserLogout_Input) (*UserLogout_Output, error)
-	UserSyncDiscordRole(context.Context, *UserSyncDiscordRole_Input) (*UserSyncDiscordRole_Output, error)
-	mustEmbedUnimplementedServiceServer()
+func (c *serviceClient) AuthRefreshSession(ctx context.Context, in *AuthRefreshSession_Input, opts ...grpc.CallOption) (*AuthRefreshSession_Output, error) {
+	out := new(AuthRefreshSession_Output)
+	err := c.cc.Invoke(ctx, Service_AuthRefreshSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
+func (c *serviceClient) AuthStartSSO(ctx context.Context, in *AuthStartSSO_Input, opts ...grpc.CallOption) (*AuthStartSSO_Output, error) {
+	out := new(AuthStartSSO_Output)
+	err := c.cc.Invoke(ctx, Service_AuthStartSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedServiceServer) AdminAddLicenseKey(context.Context, *AdminAddLicenseKey_Input) (*AdminAddLicenseKey_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminAddLicenseKey not implemented")
+func (c *serviceClient) PaymentCreatePayPalCheckout(ctx context.Context, in *PaymentCreatePayPalCheckout_Input, opts ...grpc.CallOption) (*PaymentCreatePayPalCheckout_Output, error) {
+	out := new(PaymentCreatePayPalCheckout_Output)
+	err := c.cc.Invoke(ctx, Service_PaymentCreatePayPalCheckout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedServiceServer) AdminGetActiveUsers(context.Context, *AdminGetActiveUsers_Input) (*AdminGetActiveUsers_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminGetActiveUsers not implemented")
+
+func (c *serviceClient) PaymentCreatePayPalSubscription(ctx context.Context, in *PaymentCreatePayPalSubscription_Input, opts ...grpc.CallOption) (*PaymentCreatePayPalSubscription_Output, error) {
+	out := new(PaymentCreatePayPalSubscription_Output)
+	err := c.cc.Invoke(ctx, Service_PaymentCreatePayPalSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedServiceServer) AdminRevokeLicense(context.Context, *AdminRevokeLicense_Input) (*AdminRevokeLicense_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminRevokeLicense not implemented")
+
+func (c *serviceClient) PaymentCreateStripeCheckout(ctx context.Context, in *PaymentCreateStripeCheckout_Input, opts ...grpc.CallOption) (*PaymentCreateStripeCheckout_Output, error) {
+	out := new(PaymentCreateStripeCheckout_Output)
+	err := c.cc.Invoke(ctx, Service_PaymentCreateStripeCheckout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedServiceServer) AdminSearchDatabase(context.Context, *AdminSearchDatabase_Input) (*AdminSearchDatabase_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminSearchDatabase not implemented")
+
+func (c *serviceClient) PaymentGetCheckoutStatus(ctx context.Context, in *PaymentGetCheckoutStatus_Input, opts ...grpc.CallOption) (*PaymentGetCheckoutStatus_Output, error) {
+	out := new(PaymentGetCheckoutStatus_Output)
+	err := c.cc.Invoke(ctx, Service_PaymentGetCheckoutStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedServiceServer) PaymentCreatePayPalCheckout(context.Context, *PaymentCreatePayPalCheckout_Input) (*PaymentCreatePayPalCheckout_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentCreatePayPalCheckout not implemented")
+
+func (c *serviceClient) PaymentWatchCheckoutStatus(ctx context.Context, in *PaymentWatchCheckoutStatus_Input, opts ...grpc.CallOption) (Service_PaymentWatchCheckoutStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_PaymentWatchCheckoutStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &servicePaymentWatchCheckoutStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedServiceServer) ToolStatus(context.Context, *ToolStatus_Input) (*ToolStatus_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToolStatus not implemented")
+
+type Service_PaymentWatchCheckoutStatusClient interface {
+	Recv() (*PaymentGetCheckoutStatus_Output, error)
+	grpc.ClientStream
 }
-func (UnimplementedServiceServer) UserGetLicenses(context.Context, *UserGetLicenses_Input) (*UserGetLicenses_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserGetLicenses not implemented")
+
+type servicePaymentWatchCheckoutStatusClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedServiceServer) UserGetSession(context.Context, *UserGetSession_Input) (*UserGetSession_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserGetSession not implemented")
+
+func (x *servicePaymentWatchCheckoutStatusClient) Recv() (*PaymentGetCheckoutStatus_Output, error) {
+	m := new(PaymentGetCheckoutStatus_Output)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedServiceServer) UserLogout(context.Context, *UserLogout_Input) (*UserLogout_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserLogout not implemented")
+
+func (c *serviceClient) PublicListPrices(ctx context.Context, in *PublicListPrices_Input, opts ...grpc.CallOption) (*PublicListPrices_Output, error) {
+	out := new(PublicListPrices_Output)
+	err := c.cc.Invoke(ctx, Service_PublicListPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedServiceServer) UserSyncDiscordRole(context.Context, *UserSyncDiscordRole_Input) (*UserSyncDiscordRole_Output, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserSyncDiscordRole not implemented")
+
+func (c *serviceClient) ToolStatus(ctx context.Context, in *ToolStatus_Input, opts ...grpc.CallOption) (*ToolStatus_Output, error) {
+	out := new(ToolStatus_Output)
+	err := c.cc.Invoke(ctx, Service_ToolStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserCancelSubscription(ctx context.Context, in *UserCancelSubscription_Input, opts ...grpc.CallOption) (*UserCancelSubscription_Output, error) {
+	out := new(UserCancelSubscription_Output)
+	err := c.cc.Invoke(ctx, Service_UserCancelSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserCreateApiKey(ctx context.Context, in *UserCreateApiKey_Input, opts ...grpc.CallOption) (*UserCreateApiKey_Output, error) {
+	out := new(UserCreateApiKey_Output)
+	err := c.cc.Invoke(ctx, Service_UserCreateApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserGetInvoice(ctx context.Context, in *UserGetInvoice_Input, opts ...grpc.CallOption) (*UserGetInvoice_Output, error) {
+	out := new(UserGetInvoice_Output)
+	err := c.cc.Invoke(ctx, Service_UserGetInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserGetLicenses(ctx context.Context, in *UserGetLicenses_Input, opts ...grpc.CallOption) (*UserGetLicenses_Output, error) {
+	out := new(UserGetLicenses_Output)
+	err := c.cc.Invoke(ctx, Service_UserGetLicenses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserGetPayment(ctx context.Context, in *UserGetPayment_Input, opts ...grpc.CallOption) (*UserGetPayment_Output, error) {
+	out := new(UserGetPayment_Output)
+	err := c.cc.Invoke(ctx, Service_UserGetPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserGetReferralCode(ctx context.Context, in *UserGetReferralCode_Input, opts ...grpc.CallOption) (*UserGetReferralCode_Output, error) {
+	out := new(UserGetReferralCode_Output)
+	err := c.cc.Invoke(ctx, Service_UserGetReferralCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserGetSession(ctx context.Context, in *UserGetSession_Input, opts ...grpc.CallOption) (*UserGetSession_Output, error) {
+	out := new(UserGetSession_Output)
+	err := c.cc.Invoke(ctx, Service_UserGetSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserGetSubscriptions(ctx context.Context, in *UserGetSubscriptions_Input, opts ...grpc.CallOption) (*UserGetSubscriptions_Output, error) {
+	out := new(UserGetSubscriptions_Output)
+	err := c.cc.Invoke(ctx, Service_UserGetSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserListApiKeys(ctx context.Context, in *UserListApiKeys_Input, opts ...grpc.CallOption) (*UserListApiKeys_Output, error) {
+	out := new(UserListApiKeys_Output)
+	err := c.cc.Invoke(ctx, Service_UserListApiKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserListCommissions(ctx context.Context, in *UserListCommissions_Input, opts ...grpc.CallOption) (*UserListCommissions_Output, error) {
+	out := new(UserListCommissions_Output)
+	err := c.cc.Invoke(ctx, Service_UserListCommissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserListPayments(ctx context.Context, in *UserListPayments_Input, opts ...grpc.CallOption) (*UserListPayments_Output, error) {
+	out := new(UserListPayments_Output)
+	err := c.cc.Invoke(ctx, Service_UserListPayments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserLogout(ctx context.Context, in *UserLogout_Input, opts ...grpc.CallOption) (*UserLogout_Output, error) {
+	out := new(UserLogout_Output)
+	err := c.cc.Invoke(ctx, Service_UserLogout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserLogoutEverywhere(ctx context.Context, in *UserLogoutEverywhere_Input, opts ...grpc.CallOption) (*UserLogoutEverywhere_Output, error) {
+	out := new(UserLogoutEverywhere_Output)
+	err := c.cc.Invoke(ctx, Service_UserLogoutEverywhere_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserRequestRefund(ctx context.Context, in *UserRequestRefund_Input, opts ...grpc.CallOption) (*UserRequestRefund_Output, error) {
+	out := new(UserRequestRefund_Output)
+	err := c.cc.Invoke(ctx, Service_UserRequestRefund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserRevokeApiKey(ctx context.Context, in *UserRevokeApiKey_Input, opts ...grpc.CallOption) (*UserRevokeApiKey_Output, error) {
+	out := new(UserRevokeApiKey_Output)
+	err := c.cc.Invoke(ctx, Service_UserRevokeApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserSyncDiscordRole(ctx context.Context, in *UserSyncDiscordRole_Input, opts ...grpc.CallOption) (*UserSyncDiscordRole_Output, error) {
+	out := new(UserSyncDiscordRole_Output)
+	err := c.cc.Invoke(ctx, Service_UserSyncDiscordRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	AdminAddLicenseKey(context.Context, *AdminAddLicenseKey_Input) (*AdminAddLicenseKey_Output, error)
+	AdminApproveRefundRequest(context.Context, *AdminApproveRefundRequest_Input) (*AdminApproveRefundRequest_Output, error)
+	AdminCreatePrice(context.Context, *AdminCreatePrice_Input) (*AdminCreatePrice_Output, error)
+	AdminCreateProduct(context.Context, *AdminCreateProduct_Input) (*AdminCreateProduct_Output, error)
+	AdminDeletePrice(context.Context, *AdminDeletePrice_Input) (*AdminDeletePrice_Output, error)
+	AdminDeleteProduct(context.Context, *AdminDeleteProduct_Input) (*AdminDeleteProduct_Output, error)
+	AdminDenyRefundRequest(context.Context, *AdminDenyRefundRequest_Input) (*AdminDenyRefundRequest_Output, error)
+	AdminGetActiveUsers(context.Context, *AdminGetActiveUsers_Input) (*AdminGetActiveUsers_Output, error)
+	AdminGetRevenue(context.Context, *AdminGetRevenue_Input) (*AdminGetRevenue_Output, error)
+	AdminGetTaxReport(context.Context, *AdminGetTaxReport_Input) (*AdminGetTaxReport_Output, error)
+	AdminGrantRole(context.Context, *AdminGrantRole_Input) (*AdminGrantRole_Output, error)
+	AdminImpersonateUser(context.Context, *AdminImpersonateUser_Input) (*AdminImpersonateUser_Output, error)
+	AdminListCatalog(context.Context, *AdminListCatalog_Input) (*AdminListCatalog_Output, error)
+	AdminListExchangeRates(context.Context, *AdminListExchangeRates_Input) (*AdminListExchangeRates_Output, error)
+	AdminListImpersonationAudits(context.Context, *AdminListImpersonationAudits_Input) (*AdminListImpersonationAudits_Output, error)
+	AdminListPayableCommissions(context.Context, *AdminListPayableCommissions_Input) (*AdminListPayableCommissions_Output, error)
+	AdminListRefundRequests(context.Context, *AdminListRefundRequests_Input) (*AdminListRefundRequests_Output, error)
+	AdminListRoles(context.Context, *AdminListRoles_Input) (*AdminListRoles_Output, error)
+	AdminListStuckOrders(context.Context, *AdminListStuckOrders_Input) (*AdminListStuckOrders_Output, error)
+	AdminListTaxRates(context.Context, *AdminListTaxRates_Input) (*AdminListTaxRates_Output, error)
+	AdminListWebhookEvents(context.Context, *AdminListWebhookEvents_Input) (*AdminListWebhookEvents_Output, error)
+	AdminPayCommissions(context.Context, *AdminPayCommissions_Input) (*AdminPayCommissions_Output, error)
+	AdminProvisionPayPalPlans(context.Context, *AdminProvisionPayPalPlans_Input) (*AdminProvisionPayPalPlans_Output, error)
+	AdminRegenerateInvoices(context.Context, *AdminRegenerateInvoices_Input) (*AdminRegenerateInvoices_Output, error)
+	AdminReplayWebhookEvent(context.Context, *AdminReplayWebhookEvent_Input) (*AdminReplayWebhookEvent_Output, error)
+	AdminRevokeLicense(context.Context, *AdminRevokeLicense_Input) (*AdminRevokeLicense_Output, error)
+	AdminRevokeRole(context.Context, *AdminRevokeRole_Input) (*AdminRevokeRole_Output, error)
+	AdminSearchDatabase(context.Context, *AdminSearchDatabase_Input) (*AdminSearchDatabase_Output, error)
+	AdminSetExchangeRate(context.Context, *AdminSetExchangeRate_Input) (*AdminSetExchangeRate_Output, error)
+	AdminSetTaxRate(context.Context, *AdminSetTaxRate_Input) (*AdminSetTaxRate_Output, error)
+	AdminSyncDiscourseGroup(context.Context, *AdminSyncDiscourseGroup_Input) (*AdminSyncDiscourseGroup_Output, error)
+	AdminUpdatePrice(context.Context, *AdminUpdatePrice_Input) (*AdminUpdatePrice_Output, error)
+	AdminUpdateProduct(context.Context, *AdminUpdateProduct_Input) (*AdminUpdateProduct_Output, error)
+	AuthExchangeSSO(context.Context, *AuthExchangeSSO_Input) (*AuthExchangeSSO_Output, error)
+	AuthRefreshSession(context.Context, *AuthRefreshSession_Input) (*AuthRefreshSession_Output, error)
+	AuthStartSSO(context.Context, *AuthStartSSO_Input) (*AuthStartSSO_Output, error)
+	PaymentCreatePayPalCheckout(context.Context, *PaymentCreatePayPalCheckout_Input) (*PaymentCreatePayPalCheckout_Output, error)
+	PaymentCreatePayPalSubscription(context.Context, *PaymentCreatePayPalSubscription_Input) (*PaymentCreatePayPalSubscription_Output, error)
+	PaymentCreateStripeCheckout(context.Context, *PaymentCreateStripeCheckout_Input) (*PaymentCreateStripeCheckout_Output, error)
+	PaymentGetCheckoutStatus(context.Context, *PaymentGetCheckoutStatus_Input) (*PaymentGetCheckoutStatus_Output, error)
+	PaymentWatchCheckoutStatus(*PaymentWatchCheckoutStatus_Input, Service_PaymentWatchCheckoutStatusServer) error
+	PublicListPrices(context.Context, *PublicListPrices_Input) (*PublicListPrices_Output, error)
+	ToolStatus(context.Context, *ToolStatus_Input) (*ToolStatus_Output, error)
+	UserCancelSubscription(context.Context, *UserCancelSubscription_Input) (*UserCancelSubscription_Output, error)
+	UserCreateApiKey(context.Context, *UserCreateApiKey_Input) (*UserCreateApiKey_Output, error)
+	UserGetInvoice(context.Context, *UserGetInvoice_Input) (*UserGetInvoice_Output, error)
+	UserGetLicenses(context.Context, *UserGetLicenses_Input) (*UserGetLicenses_Output, error)
+	UserGetPayment(context.Context, *UserGetPayment_Input) (*UserGetPayment_Output, error)
+	UserGetReferralCode(context.Context, *UserGetReferralCode_Input) (*UserGetReferralCode_Output, error)
+	UserGetSession(context.Context, *UserGetSession_Input) (*UserGetSession_Output, error)
+	UserGetSubscriptions(context.Context, *UserGetSubscriptions_Input) (*UserGetSubscriptions_Output, error)
+	UserListApiKeys(context.Context, *UserListApiKeys_Input) (*UserListApiKeys_Output, error)
+	UserListCommissions(context.Context, *UserListCommissions_Input) (*UserListCommissions_Output, error)
+	UserListPayments(context.Context, *UserListPayments_Input) (*UserListPayments_Output, error)
+	UserLogout(context.Context, *UserLogout_Input) (*UserLogout_Output, error)
+	UserLogoutEverywhere(context.Context, *UserLogoutEverywhere_Input) (*UserLogoutEverywhere_Output, error)
+	UserRequestRefund(context.Context, *UserRequestRefund_Input) (*UserRequestRefund_Output, error)
+	UserRevokeApiKey(context.Context, *UserRevokeApiKey_Input) (*UserRevokeApiKey_Output, error)
+	UserSyncDiscordRole(context.Context, *UserSyncDiscordRole_Input) (*UserSyncDiscordRole_Output, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) AdminAddLicenseKey(context.Context, *AdminAddLicenseKey_Input) (*AdminAddLicenseKey_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminAddLicenseKey not implemented")
+}
+func (UnimplementedServiceServer) AdminApproveRefundRequest(context.Context, *AdminApproveRefundRequest_Input) (*AdminApproveRefundRequest_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminApproveRefundRequest not implemented")
+}
+func (UnimplementedServiceServer) AdminCreatePrice(context.Context, *AdminCreatePrice_Input) (*AdminCreatePrice_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreatePrice not implemented")
+}
+func (UnimplementedServiceServer) AdminCreateProduct(context.Context, *AdminCreateProduct_Input) (*AdminCreateProduct_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateProduct not implemented")
+}
+func (UnimplementedServiceServer) AdminDeletePrice(context.Context, *AdminDeletePrice_Input) (*AdminDeletePrice_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeletePrice not implemented")
+}
+func (UnimplementedServiceServer) AdminDeleteProduct(context.Context, *AdminDeleteProduct_Input) (*AdminDeleteProduct_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteProduct not implemented")
+}
+func (UnimplementedServiceServer) AdminDenyRefundRequest(context.Context, *AdminDenyRefundRequest_Input) (*AdminDenyRefundRequest_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDenyRefundRequest not implemented")
+}
+func (UnimplementedServiceServer) AdminGetActiveUsers(context.Context, *AdminGetActiveUsers_Input) (*AdminGetActiveUsers_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetActiveUsers not implemented")
+}
+func (UnimplementedServiceServer) AdminGetRevenue(context.Context, *AdminGetRevenue_Input) (*AdminGetRevenue_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetRevenue not implemented")
+}
+func (UnimplementedServiceServer) AdminGetTaxReport(context.Context, *AdminGetTaxReport_Input) (*AdminGetTaxReport_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetTaxReport not implemented")
+}
+func (UnimplementedServiceServer) AdminGrantRole(context.Context, *AdminGrantRole_Input) (*AdminGrantRole_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGrantRole not implemented")
+}
+func (UnimplementedServiceServer) AdminImpersonateUser(context.Context, *AdminImpersonateUser_Input) (*AdminImpersonateUser_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminImpersonateUser not implemented")
+}
+func (UnimplementedServiceServer) AdminListCatalog(context.Context, *AdminListCatalog_Input) (*AdminListCatalog_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListCatalog not implemented")
+}
+func (UnimplementedServiceServer) AdminListExchangeRates(context.Context, *AdminListExchangeRates_Input) (*AdminListExchangeRates_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListExchangeRates not implemented")
+}
+func (UnimplementedServiceServer) AdminListImpersonationAudits(context.Context, *AdminListImpersonationAudits_Input) (*AdminListImpersonationAudits_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListImpersonationAudits not implemented")
+}
+func (UnimplementedServiceServer) AdminListPayableCommissions(context.Context, *AdminListPayableCommissions_Input) (*AdminListPayableCommissions_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListPayableCommissions not implemented")
+}
+func (UnimplementedServiceServer) AdminListRefundRequests(context.Context, *AdminListRefundRequests_Input) (*AdminListRefundRequests_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListRefundRequests not implemented")
+}
+func (UnimplementedServiceServer) AdminListRoles(context.Context, *AdminListRoles_Input) (*AdminListRoles_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListRoles not implemented")
+}
+func (UnimplementedServiceServer) AdminListStuckOrders(context.Context, *AdminListStuckOrders_Input) (*AdminListStuckOrders_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListStuckOrders not implemented")
+}
+func (UnimplementedServiceServer) AdminListTaxRates(context.Context, *AdminListTaxRates_Input) (*AdminListTaxRates_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListTaxRates not implemented")
+}
+func (UnimplementedServiceServer) AdminListWebhookEvents(context.Context, *AdminListWebhookEvents_Input) (*AdminListWebhookEvents_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListWebhookEvents not implemented")
+}
+func (UnimplementedServiceServer) AdminPayCommissions(context.Context, *AdminPayCommissions_Input) (*AdminPayCommissions_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPayCommissions not implemented")
+}
+func (UnimplementedServiceServer) AdminProvisionPayPalPlans(context.Context, *AdminProvisionPayPalPlans_Input) (*AdminProvisionPayPalPlans_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProvisionPayPalPlans not implemented")
+}
+func (UnimplementedServiceServer) AdminRegenerateInvoices(context.Context, *AdminRegenerateInvoices_Input) (*AdminRegenerateInvoices_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRegenerateInvoices not implemented")
+}
+func (UnimplementedServiceServer) AdminReplayWebhookEvent(context.Context, *AdminReplayWebhookEvent_Input) (*AdminReplayWebhookEvent_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReplayWebhookEvent not implemented")
+}
+func (UnimplementedServiceServer) AdminRevokeLicense(context.Context, *AdminRevokeLicense_Input) (*AdminRevokeLicense_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRevokeLicense not implemented")
+}
+func (UnimplementedServiceServer) AdminRevokeRole(context.Context, *AdminRevokeRole_Input) (*AdminRevokeRole_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRevokeRole not implemented")
+}
+func (UnimplementedServiceServer) AdminSearchDatabase(context.Context, *AdminSearchDatabase_Input) (*AdminSearchDatabase_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSearchDatabase not implemented")
+}
+func (UnimplementedServiceServer) AdminSetExchangeRate(context.Context, *AdminSetExchangeRate_Input) (*AdminSetExchangeRate_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetExchangeRate not implemented")
+}
+func (UnimplementedServiceServer) AdminSetTaxRate(context.Context, *AdminSetTaxRate_Input) (*AdminSetTaxRate_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetTaxRate not implemented")
+}
+func (UnimplementedServiceServer) AdminSyncDiscourseGroup(context.Context, *AdminSyncDiscourseGroup_Input) (*AdminSyncDiscourseGroup_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSyncDiscourseGroup not implemented")
+}
+func (UnimplementedServiceServer) AdminUpdatePrice(context.Context, *AdminUpdatePrice_Input) (*AdminUpdatePrice_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdatePrice not implemented")
+}
+func (UnimplementedServiceServer) AdminUpdateProduct(context.Context, *AdminUpdateProduct_Input) (*AdminUpdateProduct_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateProduct not implemented")
+}
+func (UnimplementedServiceServer) AuthExchangeSSO(context.Context, *AuthExchangeSSO_Input) (*AuthExchangeSSO_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthExchangeSSO not implemented")
+}
+func (UnimplementedServiceServer) AuthRefreshSession(context.Context, *AuthRefreshSession_Input) (*AuthRefreshSession_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthRefreshSession not implemented")
+}
+func (UnimplementedServiceServer) AuthStartSSO(context.Context, *AuthStartSSO_Input) (*AuthStartSSO_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthStartSSO not implemented")
+}
+func (UnimplementedServiceServer) PaymentCreatePayPalCheckout(context.Context, *PaymentCreatePayPalCheckout_Input) (*PaymentCreatePayPalCheckout_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentCreatePayPalCheckout not implemented")
+}
+func (UnimplementedServiceServer) PaymentCreatePayPalSubscription(context.Context, *PaymentCreatePayPalSubscription_Input) (*PaymentCreatePayPalSubscription_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentCreatePayPalSubscription not implemented")
+}
+func (UnimplementedServiceServer) PaymentCreateStripeCheckout(context.Context, *PaymentCreateStripeCheckout_Input) (*PaymentCreateStripeCheckout_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentCreateStripeCheckout not implemented")
+}
+func (UnimplementedServiceServer) PaymentGetCheckoutStatus(context.Context, *PaymentGetCheckoutStatus_Input) (*PaymentGetCheckoutStatus_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentGetCheckoutStatus not implemented")
+}
+func (UnimplementedServiceServer) PaymentWatchCheckoutStatus(*PaymentWatchCheckoutStatus_Input, Service_PaymentWatchCheckoutStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method PaymentWatchCheckoutStatus not implemented")
+}
+func (UnimplementedServiceServer) PublicListPrices(context.Context, *PublicListPrices_Input) (*PublicListPrices_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicListPrices not implemented")
+}
+func (UnimplementedServiceServer) ToolStatus(context.Context, *ToolStatus_Input) (*ToolStatus_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToolStatus not implemented")
+}
+func (UnimplementedServiceServer) UserCancelSubscription(context.Context, *UserCancelSubscription_Input) (*UserCancelSubscription_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCancelSubscription not implemented")
+}
+func (UnimplementedServiceServer) UserCreateApiKey(context.Context, *UserCreateApiKey_Input) (*UserCreateApiKey_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCreateApiKey not implemented")
+}
+func (UnimplementedServiceServer) UserGetInvoice(context.Context, *UserGetInvoice_Input) (*UserGetInvoice_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetInvoice not implemented")
+}
+func (UnimplementedServiceServer) UserGetLicenses(context.Context, *UserGetLicenses_Input) (*UserGetLicenses_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetLicenses not implemented")
+}
+func (UnimplementedServiceServer) UserGetPayment(context.Context, *UserGetPayment_Input) (*UserGetPayment_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetPayment not implemented")
+}
+func (UnimplementedServiceServer) UserGetReferralCode(context.Context, *UserGetReferralCode_Input) (*UserGetReferralCode_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetReferralCode not implemented")
+}
+func (UnimplementedServiceServer) UserGetSession(context.Context, *UserGetSession_Input) (*UserGetSession_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetSession not implemented")
+}
+func (UnimplementedServiceServer) UserGetSubscriptions(context.Context, *UserGetSubscriptions_Input) (*UserGetSubscriptions_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserGetSubscriptions not implemented")
+}
+func (UnimplementedServiceServer) UserListApiKeys(context.Context, *UserListApiKeys_Input) (*UserListApiKeys_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListApiKeys not implemented")
+}
+func (UnimplementedServiceServer) UserListCommissions(context.Context, *UserListCommissions_Input) (*UserListCommissions_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListCommissions not implemented")
+}
+func (UnimplementedServiceServer) UserListPayments(context.Context, *UserListPayments_Input) (*UserListPayments_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListPayments not implemented")
+}
+func (UnimplementedServiceServer) UserLogout(context.Context, *UserLogout_Input) (*UserLogout_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLogout not implemented")
+}
+func (UnimplementedServiceServer) UserLogoutEverywhere(context.Context, *UserLogoutEverywhere_Input) (*UserLogoutEverywhere_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLogoutEverywhere not implemented")
+}
+func (UnimplementedServiceServer) UserRequestRefund(context.Context, *UserRequestRefund_Input) (*UserRequestRefund_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRequestRefund not implemented")
+}
+func (UnimplementedServiceServer) UserRevokeApiKey(context.Context, *UserRevokeApiKey_Input) (*UserRevokeApiKey_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRevokeApiKey not implemented")
+}
+func (UnimplementedServiceServer) UserSyncDiscordRole(context.Context, *UserSyncDiscordRole_Input) (*UserSyncDiscordRole_Output, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserSyncDiscordRole not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_AdminAddLicenseKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAddLicenseKey_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminAddLicenseKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminAddLicenseKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminAddLicenseKey(ctx, req.(*AdminAddLicenseKey_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminApproveRefundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminApproveRefundRequest_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminApproveRefundRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminApproveRefundRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminApproveRefundRequest(ctx, req.(*AdminApproveRefundRequest_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminCreatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreatePrice_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminCreatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminCreatePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminCreatePrice(ctx, req.(*AdminCreatePrice_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminCreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateProduct_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminCreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminCreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminCreateProduct(ctx, req.(*AdminCreateProduct_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminDeletePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeletePrice_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminDeletePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminDeletePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminDeletePrice(ctx, req.(*AdminDeletePrice_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminDeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteProduct_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminDeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminDeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminDeleteProduct(ctx, req.(*AdminDeleteProduct_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminDenyRefundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDenyRefundRequest_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminDenyRefundRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminDenyRefundRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminDenyRefundRequest(ctx, req.(*AdminDenyRefundRequest_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminGetActiveUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetActiveUsers_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminGetActiveUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminGetActiveUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminGetActiveUsers(ctx, req.(*AdminGetActiveUsers_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminGetRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetRevenue_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminGetRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminGetRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminGetRevenue(ctx, req.(*AdminGetRevenue_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminGetTaxReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetTaxReport_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminGetTaxReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminGetTaxReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminGetTaxReport(ctx, req.(*AdminGetTaxReport_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminGrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGrantRole_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminGrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminGrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminGrantRole(ctx, req.(*AdminGrantRole_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminImpersonateUser_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminImpersonateUser(ctx, req.(*AdminImpersonateUser_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListCatalog_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListCatalog(ctx, req.(*AdminListCatalog_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListExchangeRates_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListExchangeRates(ctx, req.(*AdminListExchangeRates_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListImpersonationAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListImpersonationAudits_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListImpersonationAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListImpersonationAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListImpersonationAudits(ctx, req.(*AdminListImpersonationAudits_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListPayableCommissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListPayableCommissions_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListPayableCommissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListPayableCommissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListPayableCommissions(ctx, req.(*AdminListPayableCommissions_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListRefundRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListRefundRequests_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListRefundRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListRefundRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListRefundRequests(ctx, req.(*AdminListRefundRequests_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListRoles_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListRoles(ctx, req.(*AdminListRoles_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListStuckOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListStuckOrders_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListStuckOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListStuckOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListStuckOrders(ctx, req.(*AdminListStuckOrders_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListTaxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListTaxRates_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListTaxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListTaxRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListTaxRates(ctx, req.(*AdminListTaxRates_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminListWebhookEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListWebhookEvents_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminListWebhookEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminListWebhookEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminListWebhookEvents(ctx, req.(*AdminListWebhookEvents_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminPayCommissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPayCommissions_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminPayCommissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminPayCommissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminPayCommissions(ctx, req.(*AdminPayCommissions_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminProvisionPayPalPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProvisionPayPalPlans_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminProvisionPayPalPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminProvisionPayPalPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminProvisionPayPalPlans(ctx, req.(*AdminProvisionPayPalPlans_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminRegenerateInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRegenerateInvoices_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminRegenerateInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminRegenerateInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminRegenerateInvoices(ctx, req.(*AdminRegenerateInvoices_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminReplayWebhookEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReplayWebhookEvent_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminReplayWebhookEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminReplayWebhookEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminReplayWebhookEvent(ctx, req.(*AdminReplayWebhookEvent_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminRevokeLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRevokeLicense_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminRevokeLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminRevokeLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminRevokeLicense(ctx, req.(*AdminRevokeLicense_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminRevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRevokeRole_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminRevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminRevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminRevokeRole(ctx, req.(*AdminRevokeRole_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminSearchDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSearchDatabase_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminSearchDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminSearchDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminSearchDatabase(ctx, req.(*AdminSearchDatabase_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminSetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetExchangeRate_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminSetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminSetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminSetExchangeRate(ctx, req.(*AdminSetExchangeRate_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminSetTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetTaxRate_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminSetTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminSetTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminSetTaxRate(ctx, req.(*AdminSetTaxRate_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminSyncDiscourseGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSyncDiscourseGroup_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminSyncDiscourseGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminSyncDiscourseGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminSyncDiscourseGroup(ctx, req.(*AdminSyncDiscourseGroup_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminUpdatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdatePrice_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminUpdatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminUpdatePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminUpdatePrice(ctx, req.(*AdminUpdatePrice_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdminUpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateProduct_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdminUpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AdminUpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdminUpdateProduct(ctx, req.(*AdminUpdateProduct_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AuthExchangeSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthExchangeSSO_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AuthExchangeSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AuthExchangeSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AuthExchangeSSO(ctx, req.(*AuthExchangeSSO_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AuthRefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRefreshSession_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AuthRefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AuthRefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AuthRefreshSession(ctx, req.(*AuthRefreshSession_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AuthStartSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthStartSSO_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AuthStartSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AuthStartSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AuthStartSSO(ctx, req.(*AuthStartSSO_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PaymentCreatePayPalCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentCreatePayPalCheckout_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PaymentCreatePayPalCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PaymentCreatePayPalCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PaymentCreatePayPalCheckout(ctx, req.(*PaymentCreatePayPalCheckout_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PaymentCreatePayPalSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentCreatePayPalSubscription_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PaymentCreatePayPalSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PaymentCreatePayPalSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PaymentCreatePayPalSubscription(ctx, req.(*PaymentCreatePayPalSubscription_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PaymentCreateStripeCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentCreateStripeCheckout_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PaymentCreateStripeCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PaymentCreateStripeCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PaymentCreateStripeCheckout(ctx, req.(*PaymentCreateStripeCheckout_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PaymentGetCheckoutStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentGetCheckoutStatus_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PaymentGetCheckoutStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PaymentGetCheckoutStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PaymentGetCheckoutStatus(ctx, req.(*PaymentGetCheckoutStatus_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PaymentWatchCheckoutStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaymentWatchCheckoutStatus_Input)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).PaymentWatchCheckoutStatus(m, &servicePaymentWatchCheckoutStatusServer{stream})
+}
+
+type Service_PaymentWatchCheckoutStatusServer interface {
+	Send(*PaymentGetCheckoutStatus_Output) error
+	grpc.ServerStream
 }
-func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
-// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ServiceServer will
-// result in compilation errors.
-type UnsafeServiceServer interface {
-	mustEmbedUnimplementedServiceServer()
+type servicePaymentWatchCheckoutStatusServer struct {
+	grpc.ServerStream
 }
 
-func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
-	s.RegisterService(&Service_ServiceDesc, srv)
+func (x *servicePaymentWatchCheckoutStatusServer) Send(m *PaymentGetCheckoutStatus_Output) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _Service_AdminAddLicenseKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminAddLicenseKey_Input)
+func _Service_PublicListPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicListPrices_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AdminAddLicenseKey(ctx, in)
+		return srv.(ServiceServer).PublicListPrices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_AdminAddLicenseKey_FullMethodName,
+		FullMethod: Service_PublicListPrices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AdminAddLicenseKey(ctx, req.(*AdminAddLicenseKey_Input))
+		return srv.(ServiceServer).PublicListPrices(ctx, req.(*PublicListPrices_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AdminGetActiveUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminGetActiveUsers_Input)
+func _Service_ToolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToolStatus_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AdminGetActiveUsers(ctx, in)
+		return srv.(ServiceServer).ToolStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_AdminGetActiveUsers_FullMethodName,
+		FullMethod: Service_ToolStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AdminGetActiveUsers(ctx, req.(*AdminGetActiveUsers_Input))
+		return srv.(ServiceServer).ToolStatus(ctx, req.(*ToolStatus_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AdminRevokeLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminRevokeLicense_Input)
+func _Service_UserCancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCancelSubscription_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AdminRevokeLicense(ctx, in)
+		return srv.(ServiceServer).UserCancelSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_AdminRevokeLicense_FullMethodName,
+		FullMethod: Service_UserCancelSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AdminRevokeLicense(ctx, req.(*AdminRevokeLicense_Input))
+		return srv.(ServiceServer).UserCancelSubscription(ctx, req.(*UserCancelSubscription_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AdminSearchDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminSearchDatabase_Input)
+func _Service_UserCreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCreateApiKey_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AdminSearchDatabase(ctx, in)
+		return srv.(ServiceServer).UserCreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_AdminSearchDatabase_FullMethodName,
+		FullMethod: Service_UserCreateApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AdminSearchDatabase(ctx, req.(*AdminSearchDatabase_Input))
+		return srv.(ServiceServer).UserCreateApiKey(ctx, req.(*UserCreateApiKey_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_PaymentCreatePayPalCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentCreatePayPalCheckout_Input)
+func _Service_UserGetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetInvoice_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).PaymentCreatePayPalCheckout(ctx, in)
+		return srv.(ServiceServer).UserGetInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_PaymentCreatePayPalCheckout_FullMethodName,
+		FullMethod: Service_UserGetInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).PaymentCreatePayPalCheckout(ctx, req.(*PaymentCreatePayPalCheckout_Input))
+		return srv.(ServiceServer).UserGetInvoice(ctx, req.(*UserGetInvoice_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ToolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ToolStatus_Input)
+func _Service_UserGetLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetLicenses_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ToolStatus(ctx, in)
+		return srv.(ServiceServer).UserGetLicenses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_ToolStatus_FullMethodName,
+		FullMethod: Service_UserGetLicenses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ToolStatus(ctx, req.(*ToolStatus_Input))
+		return srv.(ServiceServer).UserGetLicenses(ctx, req.(*UserGetLicenses_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_UserGetLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserGetLicenses_Input)
+func _Service_UserGetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetPayment_Input)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).UserGetLicenses(ctx, in)
+		return srv.(ServiceServer).UserGetPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Service_UserGetLicenses_FullMethodName,
+		FullMethod: Service_UserGetPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).UserGetLicenses(ctx, req.(*UserGetLicenses_Input))
+		return srv.(ServiceServer).UserGetPayment(ctx, req.(*UserGetPayment_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UserGetReferralCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetReferralCode_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserGetReferralCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserGetReferralCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserGetReferralCode(ctx, req.(*UserGetReferralCode_Input))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -353,6 +1870,78 @@ func _Service_UserGetSession_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_UserGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetSubscriptions_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserGetSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserGetSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserGetSubscriptions(ctx, req.(*UserGetSubscriptions_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UserListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListApiKeys_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserListApiKeys(ctx, req.(*UserListApiKeys_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UserListCommissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListCommissions_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserListCommissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserListCommissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserListCommissions(ctx, req.(*UserListCommissions_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UserListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListPayments_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserListPayments(ctx, req.(*UserListPayments_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_UserLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserLogout_Input)
 	if err := dec(in); err != nil {
@@ -371,6 +1960,60 @@ func _Service_UserLogout_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_UserLogoutEverywhere_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLogoutEverywhere_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserLogoutEverywhere(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserLogoutEverywhere_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserLogoutEverywhere(ctx, req.(*UserLogoutEverywhere_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UserRequestRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequestRefund_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserRequestRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserRequestRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserRequestRefund(ctx, req.(*UserRequestRefund_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UserRevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRevokeApiKey_Input)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UserRevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UserRevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UserRevokeApiKey(ctx, req.(*UserRevokeApiKey_Input))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_UserSyncDiscordRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserSyncDiscordRole_Input)
 	if err := dec(in); err != nil {
@@ -400,43 +2043,241 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminAddLicenseKey",
 			Handler:    _Service_AdminAddLicenseKey_Handler,
 		},
+		{
+			MethodName: "AdminApproveRefundRequest",
+			Handler:    _Service_AdminApproveRefundRequest_Handler,
+		},
+		{
+			MethodName: "AdminCreatePrice",
+			Handler:    _Service_AdminCreatePrice_Handler,
+		},
+		{
+			MethodName: "AdminCreateProduct",
+			Handler:    _Service_AdminCreateProduct_Handler,
+		},
+		{
+			MethodName: "AdminDeletePrice",
+			Handler:    _Service_AdminDeletePrice_Handler,
+		},
+		{
+			MethodName: "AdminDeleteProduct",
+			Handler:    _Service_AdminDeleteProduct_Handler,
+		},
+		{
+			MethodName: "AdminDenyRefundRequest",
+			Handler:    _Service_AdminDenyRefundRequest_Handler,
+		},
 		{
 			MethodName: "AdminGetActiveUsers",
 			Handler:    _Service_AdminGetActiveUsers_Handler,
 		},
+		{
+			MethodName: "AdminGetRevenue",
+			Handler:    _Service_AdminGetRevenue_Handler,
+		},
+		{
+			MethodName: "AdminGetTaxReport",
+			Handler:    _Service_AdminGetTaxReport_Handler,
+		},
+		{
+			MethodName: "AdminGrantRole",
+			Handler:    _Service_AdminGrantRole_Handler,
+		},
+		{
+			MethodName: "AdminImpersonateUser",
+			Handler:    _Service_AdminImpersonateUser_Handler,
+		},
+		{
+			MethodName: "AdminListCatalog",
+			Handler:    _Service_AdminListCatalog_Handler,
+		},
+		{
+			MethodName: "AdminListExchangeRates",
+			Handler:    _Service_AdminListExchangeRates_Handler,
+		},
+		{
+			MethodName: "AdminListImpersonationAudits",
+			Handler:    _Service_AdminListImpersonationAudits_Handler,
+		},
+		{
+			MethodName: "AdminListPayableCommissions",
+			Handler:    _Service_AdminListPayableCommissions_Handler,
+		},
+		{
+			MethodName: "AdminListRefundRequests",
+			Handler:    _Service_AdminListRefundRequests_Handler,
+		},
+		{
+			MethodName: "AdminListRoles",
+			Handler:    _Service_AdminListRoles_Handler,
+		},
+		{
+			MethodName: "AdminListStuckOrders",
+			Handler:    _Service_AdminListStuckOrders_Handler,
+		},
+		{
+			MethodName: "AdminListTaxRates",
+			Handler:    _Service_AdminListTaxRates_Handler,
+		},
+		{
+			MethodName: "AdminListWebhookEvents",
+			Handler:    _Service_AdminListWebhookEvents_Handler,
+		},
+		{
+			MethodName: "AdminPayCommissions",
+			Handler:    _Service_AdminPayCommissions_Handler,
+		},
+		{
+			MethodName: "AdminProvisionPayPalPlans",
+			Handler:    _Service_AdminProvisionPayPalPlans_Handler,
+		},
+		{
+			MethodName: "AdminRegenerateInvoices",
+			Handler:    _Service_AdminRegenerateInvoices_Handler,
+		},
+		{
+			MethodName: "AdminReplayWebhookEvent",
+			Handler:    _Service_AdminReplayWebhookEvent_Handler,
+		},
 		{
 			MethodName: "AdminRevokeLicense",
 			Handler:    _Service_AdminRevokeLicense_Handler,
 		},
+		{
+			MethodName: "AdminRevokeRole",
+			Handler:    _Service_AdminRevokeRole_Handler,
+		},
 		{
 			MethodName: "AdminSearchDatabase",
 			Handler:    _Service_AdminSearchDatabase_Handler,
 		},
+		{
+			MethodName: "AdminSetExchangeRate",
+			Handler:    _Service_AdminSetExchangeRate_Handler,
+		},
+		{
+			MethodName: "AdminSetTaxRate",
+			Handler:    _Service_AdminSetTaxRate_Handler,
+		},
+		{
+			MethodName: "AdminSyncDiscourseGroup",
+			Handler:    _Service_AdminSyncDiscourseGroup_Handler,
+		},
+		{
+			MethodName: "AdminUpdatePrice",
+			Handler:    _Service_AdminUpdatePrice_Handler,
+		},
+		{
+			MethodName: "AdminUpdateProduct",
+			Handler:    _Service_AdminUpdateProduct_Handler,
+		},
+		{
+			MethodName: "AuthExchangeSSO",
+			Handler:    _Service_AuthExchangeSSO_Handler,
+		},
+		{
+			MethodName: "AuthRefreshSession",
+			Handler:    _Service_AuthRefreshSession_Handler,
+		},
+		{
+			MethodName: "AuthStartSSO",
+			Handler:    _Service_AuthStartSSO_Handler,
+		},
 		{
 			MethodName: "PaymentCreatePayPalCheckout",
 			Handler:    _Service_PaymentCreatePayPalCheckout_Handler,
 		},
+		{
+			MethodName: "PaymentCreatePayPalSubscription",
+			Handler:    _Service_PaymentCreatePayPalSubscription_Handler,
+		},
+		{
+			MethodName: "PaymentCreateStripeCheckout",
+			Handler:    _Service_PaymentCreateStripeCheckout_Handler,
+		},
+		{
+			MethodName: "PaymentGetCheckoutStatus",
+			Handler:    _Service_PaymentGetCheckoutStatus_Handler,
+		},
+		{
+			MethodName: "PublicListPrices",
+			Handler:    _Service_PublicListPrices_Handler,
+		},
 		{
 			MethodName: "ToolStatus",
 			Handler:    _Service_ToolStatus_Handler,
 		},
+		{
+			MethodName: "UserCancelSubscription",
+			Handler:    _Service_UserCancelSubscription_Handler,
+		},
+		{
+			MethodName: "UserCreateApiKey",
+			Handler:    _Service_UserCreateApiKey_Handler,
+		},
+		{
+			MethodName: "UserGetInvoice",
+			Handler:    _Service_UserGetInvoice_Handler,
+		},
 		{
 			MethodName: "UserGetLicenses",
 			Handler:    _Service_UserGetLicenses_Handler,
 		},
+		{
+			MethodName: "UserGetPayment",
+			Handler:    _Service_UserGetPayment_Handler,
+		},
+		{
+			MethodName: "UserGetReferralCode",
+			Handler:    _Service_UserGetReferralCode_Handler,
+		},
 		{
 			MethodName: "UserGetSession",
 			Handler:    _Service_UserGetSession_Handler,
 		},
+		{
+			MethodName: "UserGetSubscriptions",
+			Handler:    _Service_UserGetSubscriptions_Handler,
+		},
+		{
+			MethodName: "UserListApiKeys",
+			Handler:    _Service_UserListApiKeys_Handler,
+		},
+		{
+			MethodName: "UserListCommissions",
+			Handler:    _Service_UserListCommissions_Handler,
+		},
+		{
+			MethodName: "UserListPayments",
+			Handler:    _Service_UserListPayments_Handler,
+		},
 		{
 			MethodName: "UserLogout",
 			Handler:    _Service_UserLogout_Handler,
 		},
+		{
+			MethodName: "UserLogoutEverywhere",
+			Handler:    _Service_UserLogoutEverywhere_Handler,
+		},
+		{
+			MethodName: "UserRequestRefund",
+			Handler:    _Service_UserRequestRefund_Handler,
+		},
+		{
+			MethodName: "UserRevokeApiKey",
+			Handler:    _Service_UserRevokeApiKey_Handler,
+		},
 		{
 			MethodName: "UserSyncDiscordRole",
 			Handler:    _Service_UserSyncDiscordRole_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PaymentWatchCheckoutStatus",
+			Handler:       _Service_PaymentWatchCheckoutStatus_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/rslbot/rbapi.proto",
 }
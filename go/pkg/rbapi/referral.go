@@ -0,0 +1,54 @@
+package rbapi
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// referralMetadataKey holds the referral code in the checkout metadata, kept short for the PayPal custom_id limit
+const referralMetadataKey = "ref"
+
+// applyReferralCode attributes a checkout to the owner of a referral code
+// Unknown codes and the buyer's own code are ignored, a typo must not block a purchase
+func (svc *service) applyReferralCode(checkout *licenseCheckout, code string) error {
+	if rbdb.NormalizeReferralCode(code) == "" {
+		return nil
+	}
+	referrerId, err := rbdb.FindReferrer(svc.db, code)
+	if err != nil {
+		return err
+	}
+	if referrerId == 0 || referrerId == checkout.User.Id {
+		return nil
+	}
+	checkout.ReferralCode = rbdb.NormalizeReferralCode(code)
+	return nil
+}
+
+// attributeReferralTx sets the referrer of a payment from the referral code of its checkout
+func attributeReferralTx(tx *gorm.DB, payment *rbdb.Payment, metadata map[string]string) error {
+	code := metadata[referralMetadataKey]
+	if code == "" {
+		return nil
+	}
+	referrerId, err := rbdb.FindReferrer(tx, code)
+	if err != nil {
+		return err
+	}
+	if referrerId == 0 || referrerId == payment.UserId {
+		return nil
+	}
+	payment.ReferralCode = rbdb.NormalizeReferralCode(code)
+	payment.ReferrerId = referrerId
+	return nil
+}
+
+// recordReferralCommissionTx credits the referrer of a payment, if any
+func recordReferralCommissionTx(tx *gorm.DB, cfg config.Referral, paymentId int64) error {
+	payableAt := time.Now().UTC().Add(time.Duration(cfg.HoldingPeriod))
+	_, err := rbdb.CreateCommissionTx(tx, paymentId, cfg.CommissionRate, payableAt)
+	return err
+}
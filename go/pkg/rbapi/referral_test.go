@@ -0,0 +1,201 @@
+package rbapi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestReferrals(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	referrer, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: 7001, Username: "streamer"}, db)
+	require.NoError(t, err)
+	referrerCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: 7001, Username: "streamer"})
+	userCtx := TestingSetContextToken(ctx, t)
+
+	deliver := func(ctx context.Context, event paypal.AnyEvent) error {
+		return processPayPalWebhookEvent(ctx, event, db, logger, svc.Config(), fake)
+	}
+	checkout := func(t *testing.T, duration rbdb.LicenseKey_Duration, referralCode string) (string, map[string]string) {
+		t.Helper()
+		out, err := svc.PaymentCreatePayPalCheckout(userCtx, &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: duration,
+			ReferralCode:    referralCode,
+		})
+		require.NoError(t, err)
+		order, err := fake.GetOrder(ctx, out.OrderId)
+		require.NoError(t, err)
+		return out.OrderId, order.Metadata
+	}
+	capture := func(t *testing.T, orderID string) *rbdb.PaymentORM {
+		t.Helper()
+		_, err := fake.Approve(orderID)
+		require.NoError(t, err)
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+		order, err := fake.GetOrder(ctx, orderID)
+		require.NoError(t, err)
+		require.Len(t, order.Captures, 1)
+		paymentOrm, err := rbdb.GetPaymentByReference(db, order.Captures[0].ID)
+		require.NoError(t, err)
+		return paymentOrm
+	}
+	listCommissions := func(t *testing.T) *UserListCommissions_Output {
+		t.Helper()
+		out, err := svc.UserListCommissions(referrerCtx, &UserListCommissions_Input{})
+		require.NoError(t, err)
+		return out
+	}
+
+	var code string
+	t.Run("codes are created on first use", func(t *testing.T) {
+		out, err := svc.UserGetReferralCode(referrerCtx, &UserGetReferralCode_Input{})
+		require.NoError(t, err)
+		assert.Len(t, out.Code, 8)
+		assert.Equal(t, svc.Config().Referral.CommissionRate, out.CommissionRate)
+		code = out.Code
+
+		again, err := svc.UserGetReferralCode(referrerCtx, &UserGetReferralCode_Input{})
+		require.NoError(t, err)
+		assert.Equal(t, code, again.Code)
+	})
+
+	t.Run("unknown codes and self-referrals are ignored", func(t *testing.T) {
+		own, err := svc.UserGetReferralCode(userCtx, &UserGetReferralCode_Input{})
+		require.NoError(t, err)
+		assert.NotEqual(t, code, own.Code)
+
+		_, metadata := checkout(t, rbdb.LicenseKey_ONE_WEEK, own.Code)
+		assert.NotContains(t, metadata, referralMetadataKey)
+		_, metadata = checkout(t, rbdb.LicenseKey_ONE_WEEK, "UNKNOWN1")
+		assert.NotContains(t, metadata, referralMetadataKey)
+	})
+
+	var paymentOrm *rbdb.PaymentORM
+	t.Run("checkouts are attributed to the referrer", func(t *testing.T) {
+		orderID, metadata := checkout(t, rbdb.LicenseKey_ONE_MONTH, " "+strings.ToLower(code))
+		assert.Equal(t, code, metadata[referralMetadataKey])
+
+		paymentOrm = capture(t, orderID)
+		assert.Equal(t, code, paymentOrm.ReferralCode)
+		assert.Equal(t, referrer.Id, paymentOrm.ReferrerId)
+
+		out := listCommissions(t)
+		require.Len(t, out.Commissions, 1)
+		earning := out.Commissions[0]
+		assert.Equal(t, rbdb.Commission_KIND_EARNING, earning.Kind)
+		assert.Equal(t, rbdb.Commission_STATUS_PENDING, earning.Status)
+		assert.Equal(t, int64(380), earning.AmountInCents)
+		assert.Equal(t, paymentOrm.Id, earning.PaymentId)
+		assert.True(t, earning.PayableAt.AsTime().After(time.Now().Add(time.Duration(svc.Config().Referral.HoldingPeriod)-time.Minute)))
+		require.Len(t, out.Balances, 1)
+		assert.Equal(t, int64(380), out.Balances[0].PendingInCents)
+	})
+
+	t.Run("refunds reverse the commission", func(t *testing.T) {
+		_, applied, err := rbdb.RefundPayment(db, paymentOrm.Id, "REF-half", 950, time.Now().UTC())
+		require.NoError(t, err)
+		require.True(t, applied)
+
+		out := listCommissions(t)
+		require.Len(t, out.Commissions, 2)
+		reversal := out.Commissions[0]
+		assert.Equal(t, rbdb.Commission_KIND_REVERSAL, reversal.Kind)
+		assert.Equal(t, rbdb.Commission_STATUS_PENDING, reversal.Status)
+		assert.Equal(t, int64(-190), reversal.AmountInCents)
+		assert.Equal(t, "REF-half", reversal.RefundId)
+		assert.Equal(t, int64(190), out.Balances[0].PendingInCents)
+	})
+
+	t.Run("admins pay out the released commissions", func(t *testing.T) {
+		_, err := svc.AdminListPayableCommissions(ctx, &AdminListPayableCommissions_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminPayCommissions(ctx, &AdminPayCommissions_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		payables, err := svc.AdminListPayableCommissions(adminCtx, &AdminListPayableCommissions_Input{})
+		require.NoError(t, err)
+		assert.Empty(t, payables.Payables)
+
+		// The holding period ends
+		require.NoError(t, db.Model(&rbdb.CommissionORM{}).Where("user_id = ?", referrer.Id).
+			Update("payable_at", time.Now().UTC().Add(-time.Minute)).Error)
+		payables, err = svc.AdminListPayableCommissions(adminCtx, &AdminListPayableCommissions_Input{})
+		require.NoError(t, err)
+		require.Len(t, payables.Payables, 1)
+		assert.Equal(t, "streamer", payables.Payables[0].Referrer.Username)
+		assert.Equal(t, "eur", payables.Payables[0].Currency)
+		assert.Equal(t, int64(190), payables.Payables[0].AmountInCents)
+		assert.Equal(t, int32(2), payables.Payables[0].Commissions)
+
+		_, err = svc.AdminPayCommissions(adminCtx, &AdminPayCommissions_Input{UserId: referrer.Id, Currency: "usd", PayoutReference: "PAYOUT-1"})
+		assert.Equal(t, int32(errcode.ERR_REFERRAL_NOTHING_PAYABLE), errcode.Code(err))
+		paid, err := svc.AdminPayCommissions(adminCtx, &AdminPayCommissions_Input{UserId: referrer.Id, Currency: "EUR", PayoutReference: "PAYOUT-1"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(190), paid.PaidInCents)
+		require.Len(t, paid.Commissions, 2)
+		for _, commission := range paid.Commissions {
+			assert.Equal(t, rbdb.Commission_STATUS_PAID, commission.Status)
+			assert.Equal(t, "PAYOUT-1", commission.PayoutReference)
+		}
+		_, err = svc.AdminPayCommissions(adminCtx, &AdminPayCommissions_Input{UserId: referrer.Id, Currency: "eur", PayoutReference: "PAYOUT-2"})
+		assert.Equal(t, int32(errcode.ERR_REFERRAL_NOTHING_PAYABLE), errcode.Code(err))
+	})
+
+	t.Run("refunds after the payout are clawed back", func(t *testing.T) {
+		_, applied, err := rbdb.RefundPayment(db, paymentOrm.Id, "REF-rest", 0, time.Now().UTC())
+		require.NoError(t, err)
+		require.True(t, applied)
+
+		out := listCommissions(t)
+		require.Len(t, out.Commissions, 3)
+		assert.Equal(t, rbdb.Commission_STATUS_PAYABLE, out.Commissions[0].Status)
+		assert.Equal(t, int64(-190), out.Commissions[0].AmountInCents)
+		require.Len(t, out.Balances, 1)
+		assert.Equal(t, int64(190), out.Balances[0].PaidInCents)
+		assert.Equal(t, int64(-190), out.Balances[0].PayableInCents)
+
+		// The debt is settled by the next earnings, not paid out
+		payables, err := svc.AdminListPayableCommissions(adminCtx, &AdminListPayableCommissions_Input{})
+		require.NoError(t, err)
+		assert.Empty(t, payables.Payables)
+	})
+
+	t.Run("subscription renewals pay the referrer of the subscription", func(t *testing.T) {
+		metadata := map[string]string{
+			"user_id":           strconv.FormatInt(paymentOrm.UserId, 10),
+			"duration":          rbdb.LicenseKey_ONE_MONTH.String(),
+			referralMetadataKey: code,
+		}
+		first := &rbdb.Payment{Provider: rbdb.Payment_PROVIDER_PAYPAL, ReferenceId: "SALE-REF-1", AmountInCents: 1000, Currency: "eur"}
+		_, err := startSubscriptionPayment(ctx, db, svc.Config(), first, metadata, &rbdb.SubscriptionORM{ProviderSubscriptionId: "I-REFERRED"})
+		require.NoError(t, err)
+		subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_PAYPAL, "I-REFERRED")
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		renewal := &rbdb.Payment{Provider: rbdb.Payment_PROVIDER_PAYPAL, ReferenceId: "SALE-REF-2", AmountInCents: 1000, Currency: "eur"}
+		require.NoError(t, renewSubscriptionPayment(ctx, db, svc.Config(), renewal, subscriptionOrm, now, now.AddDate(0, 1, 0)))
+		renewalOrm, err := rbdb.GetPaymentByReference(db, "SALE-REF-2")
+		require.NoError(t, err)
+		assert.Equal(t, code, renewalOrm.ReferralCode)
+		assert.Equal(t, referrer.Id, renewalOrm.ReferrerId)
+
+		out := listCommissions(t)
+		require.Len(t, out.Commissions, 5)
+		assert.Equal(t, renewalOrm.Id, out.Commissions[0].PaymentId)
+		assert.Equal(t, int64(200), out.Commissions[0].AmountInCents)
+		assert.Equal(t, int64(400), out.Balances[0].PendingInCents)
+	})
+}
@@ -0,0 +1,34 @@
+package rbapi
+
+import (
+	"fmt"
+	"time"
+
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// checkRefundPolicy tells if a payment can be refunded on request at the given time
+// Only one-time PayPal purchases are refunded by the backend, the PayPal refund needs the capture ID of the payment.
+// Payments from another environment than the sandbox mode of the provider can't be refunded
+func checkRefundPolicy(cfg config.RefundRequests, paymentOrm *rbdb.PaymentORM, sandboxMode bool, requestedAt time.Time) error {
+	if rbdb.Payment_Provider(paymentOrm.Provider) != rbdb.Payment_PROVIDER_PAYPAL || paymentOrm.SubscriptionId != nil {
+		return errcode.ERR_REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE.Wrap(fmt.Errorf("payment %d is not a PayPal purchase", paymentOrm.Id))
+	}
+	if paymentOrm.SandboxMode != sandboxMode {
+		return errcode.ERR_REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE.Wrap(fmt.Errorf("payment %d is from another PayPal environment", paymentOrm.Id))
+	}
+	if rbdb.Payment_Status(paymentOrm.Status) != rbdb.Payment_STATUS_COMPLETED {
+		return errcode.ERR_REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE.Wrap(fmt.Errorf("payment %d is %s", paymentOrm.Id, rbdb.Payment_Status(paymentOrm.Status)))
+	}
+
+	if paymentOrm.CreatedAt != nil && requestedAt.Sub(*paymentOrm.CreatedAt) > time.Duration(cfg.MaxAge) {
+		return errcode.ERR_REFUND_REQUEST_PERIOD_EXPIRED.Wrap(fmt.Errorf("payment %d of %s", paymentOrm.Id, paymentOrm.CreatedAt.Format(time.RFC3339)))
+	}
+
+	if cfg.UnusedOnly && paymentOrm.LicenseKey != nil && paymentOrm.LicenseKey.Uses > 0 {
+		return errcode.ERR_REFUND_REQUEST_LICENSE_ACTIVATED.Wrap(fmt.Errorf("license %d of payment %d", paymentOrm.LicenseKey.Id, paymentOrm.Id))
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestRefundRequests(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	// purchase fulfills a capture of the fake provider for a new buyer and returns the context of the buyer
+	purchase := func(t *testing.T, discourseID int64) (context.Context, *rbdb.PaymentORM) {
+		t.Helper()
+
+		order, err := fake.CreateOrder(ctx, &PaymentOrderRequest{Currency: "eur", AmountInCents: 1900})
+		require.NoError(t, err)
+		_, err = fake.Approve(order.ID)
+		require.NoError(t, err)
+		order, err = fake.CaptureOrder(ctx, order.ID)
+		require.NoError(t, err)
+		captureID := order.Captures[0].ID
+
+		username := fmt.Sprintf("buyer_%d", discourseID)
+		user, err := rbdb.DefaultCreateUser(ctx, &rbdb.User{DiscourseId: discourseID, Username: username}, db)
+		require.NoError(t, err)
+		payment := &rbdb.Payment{
+			Provider:      rbdb.Payment_PROVIDER_PAYPAL,
+			ReferenceId:   captureID,
+			AmountInCents: 1900,
+			Currency:      "eur",
+			SandboxMode:   true,
+		}
+		require.NoError(t, fulfillLicensePayment(ctx, db, logger, svc.Config(), payment, map[string]string{
+			"user_id":  fmt.Sprintf("%d", user.Id),
+			"duration": rbdb.LicenseKey_ONE_MONTH.String(),
+		}))
+
+		paymentOrm, err := rbdb.GetPaymentByReference(db, captureID)
+		require.NoError(t, err)
+		userCtx := context.WithValue(ctx, userInfoCtx, &rbdb.DiscourseUser{ExternalId: discourseID, Username: username})
+		return userCtx, paymentOrm
+	}
+	countActivities := func(t *testing.T, paymentOrm *rbdb.PaymentORM, kind rbdb.Activity_Kind) int64 {
+		t.Helper()
+		var count int64
+		require.NoError(t, db.Model(&rbdb.ActivityORM{}).
+			Where("payment_id = ? AND kind = ?", paymentOrm.Id, int32(kind)).
+			Count(&count).Error)
+		return count
+	}
+
+	t.Run("policy rules", func(t *testing.T) {
+		userCtx, paymentOrm := purchase(t, 9201)
+		_, err := svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+		_, err = svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id + 1000, Reason: "Not needed"})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_NOT_FOUND), errcode.Code(err))
+
+		// Purchases past the refund period
+		createdAt := time.Now().UTC().Add(-time.Duration(svc.Config().RefundRequests.MaxAge) - time.Hour)
+		require.NoError(t, db.Model(&rbdb.PaymentORM{}).Where("id = ?", paymentOrm.Id).Update("created_at", createdAt).Error)
+		_, err = svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id, Reason: "Not needed"})
+		assert.Equal(t, int32(errcode.ERR_REFUND_REQUEST_PERIOD_EXPIRED), errcode.Code(err))
+
+		// Activated keys
+		userCtx, paymentOrm = purchase(t, 9202)
+		var licenseOrm rbdb.LicenseKeyORM
+		require.NoError(t, db.Where(&rbdb.LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error)
+		_, err = rbdb.ActivateLicense(db, licenseOrm.Key)
+		require.NoError(t, err)
+		_, err = svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id, Reason: "Not needed"})
+		assert.Equal(t, int32(errcode.ERR_REFUND_REQUEST_LICENSE_ACTIVATED), errcode.Code(err))
+	})
+
+	userCtx, paymentOrm := purchase(t, 9203)
+	var deniedId int64
+	t.Run("admins deny requests", func(t *testing.T) {
+		out, err := svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id, Reason: "Bought by mistake"})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.RefundRequest_STATUS_PENDING, out.RefundRequest.Status)
+		assert.Equal(t, "Bought by mistake", out.RefundRequest.Reason)
+		deniedId = out.RefundRequest.Id
+		_, err = svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id, Reason: "Again"})
+		assert.Equal(t, int32(errcode.ERR_REFUND_REQUEST_ALREADY_PENDING), errcode.Code(err))
+
+		_, err = svc.AdminListRefundRequests(userCtx, &AdminListRefundRequests_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		list, err := svc.AdminListRefundRequests(adminCtx, &AdminListRefundRequests_Input{})
+		require.NoError(t, err)
+		require.Len(t, list.RefundRequests, 1)
+		assert.Equal(t, "buyer_9203", list.RefundRequests[0].User.Username)
+		assert.Equal(t, paymentOrm.ReferenceId, list.RefundRequests[0].Payment.ReferenceId)
+
+		_, err = svc.AdminDenyRefundRequest(userCtx, &AdminDenyRefundRequest_Input{RefundRequestId: deniedId})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		denied, err := svc.AdminDenyRefundRequest(adminCtx, &AdminDenyRefundRequest_Input{RefundRequestId: deniedId, Note: "Please contact support"})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.RefundRequest_STATUS_DENIED, denied.RefundRequest.Status)
+		assert.Equal(t, "admin", denied.RefundRequest.DecidedBy)
+		assert.Equal(t, "Please contact support", denied.RefundRequest.DecisionNote)
+		_, err = svc.AdminApproveRefundRequest(adminCtx, &AdminApproveRefundRequest_Input{RefundRequestId: deniedId})
+		assert.Equal(t, int32(errcode.ERR_REFUND_REQUEST_ALREADY_DECIDED), errcode.Code(err))
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_REFUND_REQUEST_DENIED))
+
+		list, err = svc.AdminListRefundRequests(adminCtx, &AdminListRefundRequests_Input{})
+		require.NoError(t, err)
+		assert.Empty(t, list.RefundRequests)
+		assert.Empty(t, fake.refunds)
+	})
+
+	t.Run("approval refunds on PayPal and revokes the license", func(t *testing.T) {
+		out, err := svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id, Reason: "Bought by mistake, twice"})
+		require.NoError(t, err)
+		assert.NotEqual(t, deniedId, out.RefundRequest.Id)
+
+		approved, err := svc.AdminApproveRefundRequest(adminCtx, &AdminApproveRefundRequest_Input{RefundRequestId: out.RefundRequest.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.RefundRequest_STATUS_APPROVED, approved.RefundRequest.Status)
+		assert.Equal(t, rbdb.Payment_STATUS_REFUNDED, approved.Payment.Status)
+		assert.Equal(t, int64(1900), approved.Payment.RefundedInCents)
+
+		fake.mu.Lock()
+		require.Len(t, fake.refunds, 1)
+		refund := fake.refunds[fmt.Sprintf("refund-request-%d", out.RefundRequest.Id)]
+		fake.mu.Unlock()
+		require.NotNil(t, refund)
+		assert.Equal(t, paymentOrm.ReferenceId, refund.captureID)
+		assert.Equal(t, refund.refund.ID, approved.RefundRequest.RefundId)
+
+		var licenseOrm rbdb.LicenseKeyORM
+		require.NoError(t, db.Where(&rbdb.LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error)
+		assert.True(t, licenseOrm.Revoked)
+		assert.Equal(t, int64(2), countActivities(t, paymentOrm, rbdb.Activity_KIND_REFUND_REQUESTED))
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_REFUND_REQUEST_APPROVED))
+
+		// The refund webhook sent by PayPal afterwards is already recorded
+		raw, err := json.Marshal(map[string]interface{}{
+			"id":     refund.refund.ID,
+			"status": "COMPLETED",
+			"amount": map[string]interface{}{"value": "19.00", "currency_code": "EUR"},
+			"links": []interface{}{
+				map[string]interface{}{"rel": "up", "href": "http://localhost/v2/payments/captures/" + paymentOrm.ReferenceId},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, processPayPalWebhookEvent(ctx, paypal.AnyEvent{
+			Event:    paypal.Event{ID: "WH-REFUND", EventType: paypal.EventPaymentCaptureRefunded},
+			Resource: raw,
+		}, db, logger, svc.Config(), fake))
+		assert.Equal(t, int64(1), countActivities(t, paymentOrm, rbdb.Activity_KIND_PAYMENT_REFUNDED))
+
+		// Refunded payments can't be requested again
+		_, err = svc.UserRequestRefund(userCtx, &UserRequestRefund_Input{PaymentId: paymentOrm.Id, Reason: "Again"})
+		assert.Equal(t, int32(errcode.ERR_REFUND_REQUEST_PAYMENT_NOT_REFUNDABLE), errcode.Code(err))
+	})
+}
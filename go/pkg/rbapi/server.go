@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/errcode"
 )
 
@@ -51,6 +52,15 @@ type ServerOpts struct {
 	RequestTimeout     time.Duration
 	ShutdownTimeout    time.Duration
 	WithPprof          bool
+
+	// DiscourseGroupSyncInterval is the period of the license holders group sweep (0 disables it)
+	DiscourseGroupSyncInterval time.Duration
+
+	// WebhookRetryInterval is the period of the webhook inbox retries (0 disables them)
+	WebhookRetryInterval time.Duration
+
+	// PayPalReconcileInterval is the period of the stale PayPal checkout orders reconciliation (0 disables it)
+	PayPalReconcileInterval time.Duration
 }
 
 func NewServer(ctx context.Context, svc Service, db *gorm.DB, redisStore *RedisStore, opts ServerOpts) (*Server, error) {
@@ -95,7 +105,7 @@ func NewServer(ctx context.Context, svc Service, db *gorm.DB, redisStore *RedisS
 	})
 
 	// HTTP server
-	httpServer, err := httpServer(ctx, db, redisStore, s.ListenerAddr(), opts)
+	httpServer, err := httpServer(ctx, db, redisStore, svc.Config(), svc.Payments(), s.ListenerAddr(), opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP server: %w", err)
 	}
@@ -112,6 +122,36 @@ func NewServer(ctx context.Context, svc Service, db *gorm.DB, redisStore *RedisS
 		}
 	})
 
+	// Discourse group sweep
+	if opts.DiscourseGroupSyncInterval > 0 && svc.Config().Discourse.LicenseGroup != "" {
+		syncCtx, syncCancel := context.WithCancel(ctx)
+		s.workers.Add(func() error {
+			return runDiscourseGroupSyncLoop(syncCtx, db, opts.Logger.Named("discourse-sync"), svc.Config().Discourse, opts.DiscourseGroupSyncInterval)
+		}, func(error) {
+			syncCancel()
+		})
+	}
+
+	// Webhook inbox retries
+	if opts.WebhookRetryInterval > 0 {
+		retryCtx, retryCancel := context.WithCancel(ctx)
+		s.workers.Add(func() error {
+			return runWebhookInboxLoop(retryCtx, db, opts.Logger.Named("webhook-inbox"), svc.Config(), svc.Payments(), opts.WebhookRetryInterval)
+		}, func(error) {
+			retryCancel()
+		})
+	}
+
+	// PayPal checkout orders reconciliation
+	if opts.PayPalReconcileInterval > 0 {
+		reconcileCtx, reconcileCancel := context.WithCancel(ctx)
+		s.workers.Add(func() error {
+			return runPayPalReconcileLoop(reconcileCtx, db, opts.Logger.Named("paypal-reconcile"), svc.Config(), svc.Payments(), opts.PayPalReconcileInterval)
+		}, func(error) {
+			reconcileCancel()
+		})
+	}
+
 	// cmux
 	s.workers.Add(func() error {
 		return s.cmux.Serve()
@@ -181,7 +221,7 @@ func grpcServer(svc Service, opts ServerOpts) *grpc.Server {
 	return grpcServer
 }
 
-func httpServer(ctx context.Context, db *gorm.DB, redisStore *RedisStore, serverListenerAddr string, opts ServerOpts) (*http.Server, error) {
+func httpServer(ctx context.Context, db *gorm.DB, redisStore *RedisStore, cfg *config.Config, payments PaymentProvider, serverListenerAddr string, opts ServerOpts) (*http.Server, error) {
 	logger := opts.Logger.Named("http")
 
 	r := chi.NewRouter()
@@ -230,10 +270,15 @@ func httpServer(ctx context.Context, db *gorm.DB, redisStore *RedisStore, server
 	}
 
 	r.Mount("/", gwmux)
-	r.HandleFunc("/license/activate", activateLicense(db, redisStore))
-	r.HandleFunc("/license/check", checkLicense(db, redisStore))
-	r.HandleFunc("/offsets/update", updateOffsets(db))
-	r.HandleFunc("/webhooks/paypal", paypalWebhookHandler(db, logger))
+	r.HandleFunc("/license/activate", activateLicense(db, redisStore, cfg))
+	r.HandleFunc("/license/check", checkLicense(db, redisStore, cfg))
+	r.HandleFunc("/offsets/update", updateOffsets(db, redisStore, cfg))
+	r.HandleFunc("/webhooks/paypal", paypalWebhookHandler(db, logger, cfg, payments))
+	if fakeProvider, ok := payments.(*FakePaymentProvider); ok {
+		r.HandleFunc(fakePaymentApprovePath, fakePaymentApproveHandler(fakeProvider, db, logger, cfg))
+	}
+	r.HandleFunc("/webhooks/stripe", stripeWebhookHandler(db, logger, cfg, payments))
+	r.HandleFunc("/webhooks/discourse", discourseWebhookHandler(db, logger, cfg, payments))
 	if opts.WithPprof {
 		r.HandleFunc("/debug/pprof/*", pprof.Index)
 		r.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
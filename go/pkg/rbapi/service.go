@@ -8,6 +8,7 @@ import (
 	"github.com/bwmarrin/snowflake"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/rbdb"
 )
 
@@ -16,13 +17,17 @@ type Service interface {
 	Close() error
 	DB() *gorm.DB
 	Redis() *RedisStore
+	Config() *config.Config
+	Payments() PaymentProvider
 }
 
 type ServiceOpts struct {
 	Logger             *zap.Logger
+	Config             *config.Config // Validated secrets and integration settings, required
 	DBUrn              string
 	CORSAllowedOrigins string
 	RedisConfig        RedisConfig
+	PaymentProvider    PaymentProvider // Provider of the one-time checkouts, PayPal or the fake provider from the config when nil
 }
 
 type service struct {
@@ -30,14 +35,38 @@ type service struct {
 	startedAt time.Time
 	db        *gorm.DB
 	logger    *zap.Logger
+	cfg       *config.Config
 	sfn       *snowflake.Node
 	redis     *RedisStore
+	payments  PaymentProvider
+
+	// discourseBreaker guards the User-Api-Key verifications against an unreachable Discourse
+	discourseBreaker *circuitBreaker
 }
 
 func NewService(ctx context.Context, opts ServiceOpts) (Service, error) {
 	if opts.Logger == nil {
 		opts.Logger = zap.NewNop()
 	}
+	if opts.Config == nil {
+		return nil, fmt.Errorf("missing configuration")
+	}
+
+	if _, err := loadInvoiceTemplate(opts.Config.Invoice); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	payments := opts.PaymentProvider
+	if payments == nil && opts.Config.FakePayments.Enabled {
+		payments = NewFakePaymentProvider(opts.Config.FakePayments.BaseURL)
+	}
+	if payments == nil {
+		paypalProvider, err := NewPayPalProvider(opts.Config.PayPal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+		payments = paypalProvider
+	}
 
 	// Initialize database
 	db, sfn, err := rbdb.InitDB(ctx, rbdb.DBConfig{
@@ -57,9 +86,13 @@ func NewService(ctx context.Context, opts ServiceOpts) (Service, error) {
 	svc := &service{
 		startedAt: time.Now(),
 		logger:    opts.Logger,
+		cfg:       opts.Config,
 		db:        db,
 		sfn:       sfn,
 		redis:     redis,
+		payments:  payments,
+
+		discourseBreaker: newCircuitBreaker(opts.Config.Discourse.BreakerThreshold, time.Duration(opts.Config.Discourse.BreakerCooldown)),
 	}
 
 	return svc, nil
@@ -73,6 +106,14 @@ func (s *service) Redis() *RedisStore {
 	return s.redis
 }
 
+func (s *service) Config() *config.Config {
+	return s.cfg
+}
+
+func (s *service) Payments() PaymentProvider {
+	return s.payments
+}
+
 func (s *service) Close() error {
 	sqlDB, err := s.db.DB()
 	if err != nil {
@@ -0,0 +1,282 @@
+package rbapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// Session tokens are opaque random strings, only their SHA-256 is stored in Redis
+const (
+	accessTokenPrefix  = "rba_"
+	refreshTokenPrefix = "rbr_"
+	sessionTokenBytes  = 32
+
+	sessionKeyPrefix        = "session:"
+	sessionAccessKeyPrefix  = "session-access:"
+	sessionRefreshKeyPrefix = "session-refresh:"
+	userSessionsKeyPrefix   = "user-sessions:"
+)
+
+// AuthSession is a login of a user, created from a verified SSO payload
+// The session lives as long as its refresh token, each refresh extends it and reloads the user from Discourse
+type AuthSession struct {
+	ID          string              `json:"id"`
+	User        *rbdb.DiscourseUser `json:"user"`
+	CreatedAt   time.Time           `json:"created_at"`
+	RefreshedAt time.Time           `json:"refreshed_at"`
+	AccessHash  string              `json:"access_hash"`
+	RefreshHash string              `json:"refresh_hash"`
+}
+
+// SessionTokens are the tokens handed to the client, they are never stored
+type SessionTokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// isAccessToken tells if a bearer token is a session access token
+func isAccessToken(token string) bool {
+	return strings.HasPrefix(token, accessTokenPrefix)
+}
+
+// CreateSession opens a session for a user and issues its first tokens, with the lifetimes of cfg
+func (rs *RedisStore) CreateSession(ctx context.Context, cfg config.Auth, user *rbdb.DiscourseUser, now time.Time) (*AuthSession, *SessionTokens, error) {
+	sessionID, err := randomSessionToken("")
+	if err != nil {
+		return nil, nil, err
+	}
+	session := &AuthSession{
+		ID:        sessionID,
+		User:      user,
+		CreatedAt: now,
+	}
+
+	tokens, err := rs.issueSessionTokens(ctx, cfg, session, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userKey := userSessionsKey(user.ExternalId)
+	pipe := rs.client.TxPipeline()
+	pipe.SAdd(ctx, userKey, session.ID)
+	pipe.Expire(ctx, userKey, time.Duration(cfg.RefreshTokenTTL))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return session, tokens, nil
+}
+
+// GetSessionByAccessToken returns the session of a valid access token
+func (rs *RedisStore) GetSessionByAccessToken(ctx context.Context, accessToken string) (*AuthSession, error) {
+	accessHash := hashSessionToken(accessToken)
+	sessionID, err := rs.client.Get(ctx, sessionAccessKeyPrefix+accessHash).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(fmt.Errorf("unknown or expired access token"))
+	}
+	if err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	session, err := rs.loadSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || session.AccessHash != accessHash {
+		return nil, errcode.ERR_AUTH_INVALID_TOKEN.Wrap(fmt.Errorf("revoked access token"))
+	}
+	return session, nil
+}
+
+// RefreshSession swaps a refresh token for new tokens, the previous tokens of the session stop working
+// A refresh token is consumed on first use, so a stolen one cannot be used after its owner refreshed
+// reloadUser returns the current admin flag and groups of the user, they are only known from Discourse
+// The session is revoked when the user no longer exists, other reload errors leave the refresh token valid
+func (rs *RedisStore) RefreshSession(ctx context.Context, cfg config.Auth, refreshToken string, now time.Time, reloadUser func(context.Context, *rbdb.DiscourseUser) (*rbdb.DiscourseUser, error)) (*AuthSession, *SessionTokens, error) {
+	refreshHash := hashSessionToken(refreshToken)
+	sessionID, err := rs.client.Get(ctx, sessionRefreshKeyPrefix+refreshHash).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil, errcode.ERR_AUTH_INVALID_REFRESH_TOKEN.Wrap(fmt.Errorf("unknown or expired refresh token"))
+	}
+	if err != nil {
+		return nil, nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	session, err := rs.loadSession(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session == nil || session.RefreshHash != refreshHash {
+		return nil, nil, errcode.ERR_AUTH_INVALID_REFRESH_TOKEN.Wrap(fmt.Errorf("revoked refresh token"))
+	}
+
+	user, err := reloadUser(ctx, session.User)
+	if errcode.Code(err) == int32(errcode.ERR_DISCOURSE_NOT_FOUND) {
+		if err := rs.RevokeSession(ctx, session.ID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errcode.ERR_AUTH_INVALID_REFRESH_TOKEN.Wrap(err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Consumed after the reload, a concurrent refresh of the same token only succeeds once
+	if err := rs.client.GetDel(ctx, sessionRefreshKeyPrefix+refreshHash).Err(); errors.Is(err, redis.Nil) {
+		return nil, nil, errcode.ERR_AUTH_INVALID_REFRESH_TOKEN.Wrap(fmt.Errorf("refresh token already used"))
+	} else if err != nil {
+		return nil, nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	if err := rs.client.Del(ctx, sessionAccessKeyPrefix+session.AccessHash).Err(); err != nil {
+		return nil, nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	session.User = user
+	session.RefreshedAt = now
+	tokens, err := rs.issueSessionTokens(ctx, cfg, session, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := rs.client.Expire(ctx, userSessionsKey(session.User.ExternalId), time.Duration(cfg.RefreshTokenTTL)).Err(); err != nil {
+		return nil, nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return session, tokens, nil
+}
+
+// RevokeSession ends a session, its tokens stop working immediately
+func (rs *RedisStore) RevokeSession(ctx context.Context, sessionID string) error {
+	session, err := rs.loadSession(ctx, sessionID)
+	if err != nil || session == nil {
+		return err
+	}
+
+	pipe := rs.client.TxPipeline()
+	pipe.Del(ctx, sessionKeyPrefix+session.ID, sessionAccessKeyPrefix+session.AccessHash, sessionRefreshKeyPrefix+session.RefreshHash)
+	pipe.SRem(ctx, userSessionsKey(session.User.ExternalId), session.ID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return nil
+}
+
+// RevokeUserSessions ends all the sessions of a user and returns how many were still open
+func (rs *RedisStore) RevokeUserSessions(ctx context.Context, discourseID int64) (int, error) {
+	userKey := userSessionsKey(discourseID)
+	sessionIDs, err := rs.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return 0, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	revoked := 0
+	for _, sessionID := range sessionIDs {
+		session, err := rs.loadSession(ctx, sessionID)
+		if err != nil {
+			return revoked, err
+		}
+		// Expired sessions are only left in the set
+		if session == nil {
+			continue
+		}
+		if err := rs.RevokeSession(ctx, sessionID); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+
+	if err := rs.client.Del(ctx, userKey).Err(); err != nil {
+		return revoked, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return revoked, nil
+}
+
+// issueSessionTokens generates new tokens for a session and saves it
+func (rs *RedisStore) issueSessionTokens(ctx context.Context, cfg config.Auth, session *AuthSession, now time.Time) (*SessionTokens, error) {
+	accessToken, err := randomSessionToken(accessTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := randomSessionToken(refreshTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	session.AccessHash = hashSessionToken(accessToken)
+	session.RefreshHash = hashSessionToken(refreshToken)
+
+	sessionData, err := json.Marshal(session)
+	if err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	accessTTL, refreshTTL := time.Duration(cfg.AccessTokenTTL), time.Duration(cfg.RefreshTokenTTL)
+	pipe := rs.client.TxPipeline()
+	pipe.Set(ctx, sessionKeyPrefix+session.ID, sessionData, refreshTTL)
+	pipe.Set(ctx, sessionAccessKeyPrefix+session.AccessHash, session.ID, accessTTL)
+	pipe.Set(ctx, sessionRefreshKeyPrefix+session.RefreshHash, session.ID, refreshTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	return &SessionTokens{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  now.Add(accessTTL),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: now.Add(refreshTTL),
+	}, nil
+}
+
+// loadSession returns a session, nil when it expired or was revoked
+func (rs *RedisStore) loadSession(ctx context.Context, sessionID string) (*AuthSession, error) {
+	sessionData, err := rs.client.Get(ctx, sessionKeyPrefix+sessionID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	var session AuthSession
+	if err := json.Unmarshal(sessionData, &session); err != nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	if session.User == nil {
+		return nil, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(fmt.Errorf("session %s has no user", sessionID))
+	}
+	return &session, nil
+}
+
+func userSessionsKey(discourseID int64) string {
+	return fmt.Sprintf("%s%d", userSessionsKeyPrefix, discourseID)
+}
+
+func randomSessionToken(prefix string) (string, error) {
+	b := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// sessionFromContext returns the session of the access token that authenticated the request, nil for other tokens
+func sessionFromContext(ctx context.Context) *AuthSession {
+	session, _ := ctx.Value(sessionCtx).(*AuthSession)
+	return session
+}
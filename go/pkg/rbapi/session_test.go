@@ -0,0 +1,177 @@
+package rbapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+)
+
+func TestSessions(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+	typed := svc.(*service)
+
+	// Discourse sees the logouts, and the user reloads of the refreshes
+	var discourseLogouts []string
+	discourseUser := `{"id": 7, "username": "test", "admin": false, "groups": [{"name": "trust_level_0"}]}`
+	discourseStatus := http.StatusOK
+	discourse := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/admin/users/7.json" {
+			w.WriteHeader(discourseStatus)
+			_, _ = w.Write([]byte(discourseUser))
+			return
+		}
+		discourseLogouts = append(discourseLogouts, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer discourse.Close()
+	svc.Config().Discourse.URL = discourse.URL
+
+	login := func(t *testing.T) *AuthExchangeSSO_Output {
+		t.Helper()
+		start, err := svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: "http://localhost:8085/callback"})
+		require.NoError(t, err)
+		nonce, returnURL := TestingSSORequest(t, start.SsoUrl)
+		sso, sig := GenerateTestSSOResponse(7, "test", "test@example.com", nonce, returnURL)
+		out, err := svc.AuthExchangeSSO(ctx, &AuthExchangeSSO_Input{Sso: sso, Sig: sig})
+		require.NoError(t, err)
+		return out
+	}
+
+	t.Run("raw SSO tokens are rejected", func(t *testing.T) {
+		_, err := TestingAuthenticate(ctx, t, svc, "UserGetSession", "SSO_"+TestToken+"."+TestSignature)
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_TOKEN_NOT_ACCEPTED), errcode.Code(err))
+	})
+
+	t.Run("exchange", func(t *testing.T) {
+		_, err := svc.AuthExchangeSSO(ctx, &AuthExchangeSSO_Input{Sso: TestToken})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+		_, err = svc.AuthExchangeSSO(ctx, &AuthExchangeSSO_Input{Sso: TestToken, Sig: strings.Repeat("0", 64)})
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+
+		out := login(t)
+		assert.Equal(t, int64(7), out.User.DiscourseId)
+		assert.True(t, out.AccessTokenExpiresAt.AsTime().Before(out.RefreshTokenExpiresAt.AsTime()))
+
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "UserGetSession", out.AccessToken)
+		require.NoError(t, err)
+		session, err := svc.UserGetSession(authCtx, &UserGetSession_Input{})
+		require.NoError(t, err)
+		assert.Equal(t, out.User.Id, session.User.Id)
+
+		// Refresh tokens are not access tokens
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", out.RefreshToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("refresh rotates the tokens", func(t *testing.T) {
+		out := login(t)
+		refreshed, err := svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: out.RefreshToken})
+		require.NoError(t, err)
+		assert.NotEqual(t, out.AccessToken, refreshed.AccessToken)
+		assert.NotEqual(t, out.RefreshToken, refreshed.RefreshToken)
+
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", refreshed.AccessToken)
+		require.NoError(t, err)
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", out.AccessToken)
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+
+		// A refresh token works once
+		_, err = svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: out.RefreshToken})
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_REFRESH_TOKEN), errcode.Code(err))
+		_, err = svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+	})
+
+	t.Run("refresh reloads the user from Discourse", func(t *testing.T) {
+		out := login(t)
+		discourseUser = `{"id": 7, "username": "renamed", "admin": true, "groups": [{"name": "trust_level_0"}, {"name": "support"}]}`
+		defer func() {
+			discourseUser = `{"id": 7, "username": "test", "admin": false, "groups": [{"name": "trust_level_0"}]}`
+		}()
+
+		refreshed, err := svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: out.RefreshToken})
+		require.NoError(t, err)
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "UserGetSession", refreshed.AccessToken)
+		require.NoError(t, err)
+		user, err := discourseUserFromContext(authCtx)
+		require.NoError(t, err)
+		assert.True(t, user.Admin)
+		assert.Equal(t, "renamed", user.Username)
+		assert.Equal(t, "test@example.com", user.Email)
+		assert.Equal(t, []string{"trust_level_0", "support"}, user.Groups)
+	})
+
+	t.Run("refresh fails once the user is deleted from Discourse", func(t *testing.T) {
+		out := login(t)
+
+		// An unavailable Discourse doesn't consume the refresh token
+		discourseStatus = http.StatusBadGateway
+		_, err := svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: out.RefreshToken})
+		assert.Equal(t, int32(errcode.ERR_DISCOURSE_API_RESPONSE), errcode.Code(err))
+		discourseStatus = http.StatusOK
+		refreshed, err := svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: out.RefreshToken})
+		require.NoError(t, err)
+
+		discourseStatus = http.StatusNotFound
+		defer func() { discourseStatus = http.StatusOK }()
+		_, err = svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: refreshed.RefreshToken})
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_REFRESH_TOKEN), errcode.Code(err))
+
+		// The whole session ends with it
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", refreshed.AccessToken)
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+		discourseStatus = http.StatusOK
+		_, err = svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: refreshed.RefreshToken})
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_REFRESH_TOKEN), errcode.Code(err))
+	})
+
+	t.Run("logout revokes the current session", func(t *testing.T) {
+		current, other := login(t), login(t)
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "UserGetSession", current.AccessToken)
+		require.NoError(t, err)
+
+		_, err = svc.UserLogout(authCtx, &UserLogout_Input{})
+		require.NoError(t, err)
+		assert.Equal(t, "/admin/users/7/log_out", discourseLogouts[len(discourseLogouts)-1])
+
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", current.AccessToken)
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+		_, err = svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: current.RefreshToken})
+		assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_REFRESH_TOKEN), errcode.Code(err))
+		_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", other.AccessToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("logout everywhere", func(t *testing.T) {
+		// Revoke what the previous tests left open
+		_, err := typed.redis.RevokeUserSessions(ctx, 7)
+		require.NoError(t, err)
+
+		first, second := login(t), login(t)
+		authCtx, err := TestingAuthenticate(ctx, t, svc, "UserGetSession", first.AccessToken)
+		require.NoError(t, err)
+
+		out, err := svc.UserLogoutEverywhere(authCtx, &UserLogoutEverywhere_Input{})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), out.RevokedSessions)
+
+		for _, tokens := range []*AuthExchangeSSO_Output{first, second} {
+			_, err = TestingAuthenticate(ctx, t, svc, "UserGetSession", tokens.AccessToken)
+			assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_TOKEN), errcode.Code(err))
+			_, err = svc.AuthRefreshSession(ctx, &AuthRefreshSession_Input{RefreshToken: tokens.RefreshToken})
+			assert.Equal(t, int32(errcode.ERR_AUTH_INVALID_REFRESH_TOKEN), errcode.Code(err))
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package rbapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// Consumed nonces are remembered for a while to tell replays apart from unknown nonces
+const (
+	ssoNonceKeyPrefix     = "sso-nonce:"
+	ssoNonceUsedKeyPrefix = "sso-nonce-used:"
+	ssoNonceUsedTTL       = 24 * time.Hour
+)
+
+// CreateSSONonce issues a nonce for an SSO request, the response must come back to returnURL within the nonce TTL of cfg
+func (rs *RedisStore) CreateSSONonce(ctx context.Context, cfg config.Auth, returnURL string, now time.Time) (string, time.Time, error) {
+	nonce, err := randomSessionToken("")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if err := rs.client.Set(ctx, ssoNonceKeyPrefix+nonce, returnURL, time.Duration(cfg.SSONonceTTL)).Err(); err != nil {
+		return "", time.Time{}, errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return nonce, now.Add(time.Duration(cfg.SSONonceTTL)), nil
+}
+
+// ConsumeSSONonce accepts a nonce once and returns the return URL it was issued for
+func (rs *RedisStore) ConsumeSSONonce(ctx context.Context, nonce string) (string, error) {
+	if nonce == "" {
+		return "", errcode.ERR_AUTH_SSO_NONCE_UNKNOWN.Wrap(fmt.Errorf("the SSO payload has no nonce"))
+	}
+
+	returnURL, err := rs.client.GetDel(ctx, ssoNonceKeyPrefix+nonce).Result()
+	if errors.Is(err, redis.Nil) {
+		used, err := rs.client.Exists(ctx, ssoNonceUsedKeyPrefix+nonce).Result()
+		if err != nil {
+			return "", errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+		}
+		if used > 0 {
+			return "", errcode.ERR_AUTH_SSO_NONCE_REUSED.Wrap(fmt.Errorf("nonce %s was already used", nonce))
+		}
+		return "", errcode.ERR_AUTH_SSO_NONCE_UNKNOWN.Wrap(fmt.Errorf("unknown or expired nonce %s", nonce))
+	}
+	if err != nil {
+		return "", errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+
+	if err := rs.client.Set(ctx, ssoNonceUsedKeyPrefix+nonce, 1, ssoNonceUsedTTL).Err(); err != nil {
+		return "", errcode.ERR_AUTH_SESSION_STORE_ERROR.Wrap(err)
+	}
+	return returnURL, nil
+}
@@ -0,0 +1,91 @@
+package rbapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+)
+
+func TestSSONonces(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, cleanup := TestingService(t, ServiceOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+
+	const returnURL = CLILoopbackURL
+	start := func(t *testing.T) (nonce string) {
+		t.Helper()
+		out, err := svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: returnURL})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out.SsoUrl, svc.Config().Discourse.URL+"/session/sso_provider?"))
+		assert.WithinDuration(t, time.Now().Add(time.Duration(svc.Config().Auth.SSONonceTTL)), out.ExpiresAt.AsTime(), time.Minute)
+
+		nonce, signedURL := TestingSSORequest(t, out.SsoUrl)
+		assert.Equal(t, returnURL, signedURL)
+		return nonce
+	}
+	exchange := func(nonce, returnURL string) error {
+		sso, sig := GenerateTestSSOResponse(7, "test", "test@example.com", nonce, returnURL)
+		_, err := svc.AuthExchangeSSO(ctx, &AuthExchangeSSO_Input{Sso: sso, Sig: sig})
+		return err
+	}
+
+	t.Run("return URL is validated", func(t *testing.T) {
+		_, err := svc.AuthStartSSO(ctx, &AuthStartSSO_Input{})
+		assert.Equal(t, int32(errcode.ERR_MISSING_INPUT), errcode.Code(err))
+		_, err = svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: "/auth/callback"})
+		assert.Equal(t, int32(errcode.ERR_INVALID_INPUT), errcode.Code(err))
+		_, err = svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: "javascript://alert(1)"})
+		assert.Equal(t, int32(errcode.ERR_INVALID_INPUT), errcode.Code(err))
+
+		// Only the frontend and the CLI loopback origins receive the signed SSO responses
+		for _, foreign := range []string{
+			"https://attacker.example.com/auth/callback",
+			"http://localhost:5174/auth/callback",
+			"https://localhost:5173/auth/callback",
+			"http://localhost:5173.attacker.example.com/auth/callback",
+			"http://localhost/callback",
+		} {
+			_, err = svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: foreign})
+			assert.Equal(t, int32(errcode.ERR_INVALID_INPUT), errcode.Code(err), foreign)
+		}
+		_, err = svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: "http://LOCALHOST:5173/auth/callback"})
+		assert.NoError(t, err)
+		_, err = svc.AuthStartSSO(ctx, &AuthStartSSO_Input{ReturnSsoUrl: CLILoopbackURL})
+		assert.NoError(t, err)
+	})
+
+	t.Run("nonces are consumed once", func(t *testing.T) {
+		nonce := start(t)
+		require.NoError(t, exchange(nonce, returnURL))
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_NONCE_REUSED), errcode.Code(exchange(nonce, returnURL)))
+	})
+
+	t.Run("unknown nonces are rejected", func(t *testing.T) {
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_NONCE_UNKNOWN), errcode.Code(exchange("nonce-chosen-by-the-client", returnURL)))
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_NONCE_UNKNOWN), errcode.Code(exchange("", returnURL)))
+		_, err := svc.AuthExchangeSSO(ctx, &AuthExchangeSSO_Input{Sso: TestToken, Sig: TestSignature})
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_NONCE_UNKNOWN), errcode.Code(err))
+	})
+
+	t.Run("return URL must match the request", func(t *testing.T) {
+		nonce := start(t)
+		err := exchange(nonce, "https://attacker.example.com/callback")
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_RETURN_URL_MISMATCH), errcode.Code(err))
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_NONCE_REUSED), errcode.Code(exchange(nonce, returnURL)))
+	})
+
+	t.Run("nonces expire", func(t *testing.T) {
+		nonce := start(t)
+		require.NoError(t, TestingSvcRedis(t, svc).client.Del(ctx, ssoNonceKeyPrefix+nonce).Err())
+		assert.Equal(t, int32(errcode.ERR_AUTH_SSO_NONCE_UNKNOWN), errcode.Code(exchange(nonce, returnURL)))
+	})
+}
@@ -0,0 +1,327 @@
+package rbapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// stripeAPIVersion pins the shape of Stripe objects, the webhook endpoint must use the same version
+const stripeAPIVersion = "2024-06-20"
+
+const (
+	// stripeWebhookTolerance is the maximum age of a signed webhook, to prevent replays
+	stripeWebhookTolerance   = 5 * time.Minute
+	maxStripeWebhookBodySize = 1 << 20
+)
+
+// stripeSandboxMode tells whether the payments are recorded as sandbox payments
+// Test mode keys ("sk_test_...") record sandbox payments
+func stripeSandboxMode(cfg config.Stripe) bool {
+	return cfg.APIKey == "" || strings.HasPrefix(cfg.APIKey, "sk_test_")
+}
+
+// StripeCheckoutSession is the subset of the Stripe Checkout Session object we use
+type StripeCheckoutSession struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	Mode              string            `json:"mode"`
+	Subscription      string            `json:"subscription"`
+	PaymentStatus     string            `json:"payment_status"`
+	PaymentIntent     string            `json:"payment_intent"`
+	AmountTotal       int64             `json:"amount_total"`
+	Currency          string            `json:"currency"`
+	ClientReferenceID string            `json:"client_reference_id"`
+	Metadata          map[string]string `json:"metadata"`
+	Livemode          bool              `json:"livemode"`
+	CustomerDetails   *struct {
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	} `json:"customer_details"`
+}
+
+// StripeEvent is a Stripe webhook event, the object is decoded according to the event type
+type StripeEvent struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Livemode bool   `json:"livemode"`
+	Data     struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// stripeRequest sends an authenticated form-encoded request to the Stripe API and decodes the JSON response
+func stripeRequest(ctx context.Context, cfg config.Stripe, method string, path string, form url.Values, out interface{}) error {
+	if cfg.APIKey == "" {
+		return errcode.ERR_PAYMENT_STRIPE_CONFIG_MISSING
+	}
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, cfg.APIBase+path, body)
+	if err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_API_REQUEST.Wrap(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+	req.Header.Set("Stripe-Version", stripeAPIVersion)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_API_REQUEST.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_API_REQUEST.Wrap(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error struct {
+				Type    string `json:"type"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(respBody, &errResp)
+		return errcode.ERR_PAYMENT_STRIPE_API_REQUEST.Wrap(
+			fmt.Errorf("status %d: %s: %s", resp.StatusCode, errResp.Error.Type, errResp.Error.Message))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_API_REQUEST.Wrap(err)
+	}
+	return nil
+}
+
+// CreateStripeCheckoutSession creates a Checkout Session for a license
+// Recurring checkouts start a subscription billed every license duration
+func CreateStripeCheckoutSession(ctx context.Context, cfg *config.Config, checkout *licenseCheckout, recurring bool) (*StripeCheckoutSession, error) {
+	form := url.Values{}
+	form.Set("mode", "payment")
+	if recurring {
+		interval, intervalCount, ok := stripeRecurringInterval(checkout.Duration)
+		if !ok {
+			return nil, errcode.ERR_PAYMENT_RECURRING_DURATION_UNSUPPORTED.Wrap(fmt.Errorf("duration: %s", checkout.Duration.String()))
+		}
+		form.Set("mode", "subscription")
+		form.Set("line_items[0][price_data][recurring][interval]", interval)
+		form.Set("line_items[0][price_data][recurring][interval_count]", strconv.Itoa(intervalCount))
+		// Invoices only reference the subscription, so it carries the metadata too
+		for key, value := range checkout.metadata(stripeSandboxMode(cfg.Stripe)) {
+			form.Set(fmt.Sprintf("subscription_data[metadata][%s]", key), value)
+		}
+	}
+	form.Set("success_url", cfg.Checkout.SuccessURL+"?session_id={CHECKOUT_SESSION_ID}")
+	form.Set("cancel_url", cfg.Checkout.CancelURL)
+	form.Set("client_reference_id", strconv.FormatInt(checkout.User.Id, 10))
+	if checkout.User.Email != "" {
+		form.Set("customer_email", checkout.User.Email)
+	}
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", checkout.Currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(checkout.AmountInCents, 10))
+	form.Set("line_items[0][price_data][product_data][name]", checkout.DisplayName)
+	for key, value := range checkout.metadata(stripeSandboxMode(cfg.Stripe)) {
+		form.Set(fmt.Sprintf("metadata[%s]", key), value)
+	}
+
+	var session StripeCheckoutSession
+	if err := stripeRequest(ctx, cfg.Stripe, "POST", "/v1/checkout/sessions", form, &session); err != nil {
+		return nil, errcode.ERR_PAYMENT_CREATE_STRIPE_CHECKOUT_SESSION.Wrap(err)
+	}
+	return &session, nil
+}
+
+// VerifyStripeWebhookSignature checks the Stripe-Signature header ("t=<timestamp>,v1=<hex hmac>,...")
+func VerifyStripeWebhookSignature(payload []byte, header string, secret string, now time.Time) error {
+	var timestamp string
+	signatures := []string{}
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return errcode.ERR_PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID.Wrap(fmt.Errorf("malformed header"))
+	}
+
+	unixTime, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID.Wrap(err)
+	}
+	if age := now.Sub(time.Unix(unixTime, 0)); age > stripeWebhookTolerance || age < -stripeWebhookTolerance {
+		return errcode.ERR_PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID.Wrap(fmt.Errorf("timestamp outside tolerance: %s", age))
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expectedSig := hex.EncodeToString(mac.Sum(nil))
+
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expectedSig)) {
+			return nil
+		}
+	}
+	return errcode.ERR_PAYMENT_STRIPE_WEBHOOK_SIGNATURE_INVALID
+}
+
+// stripeWebhookHandler handles incoming webhooks from Stripe
+func stripeWebhookHandler(db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger.Info("Received Stripe webhook request", zap.String("path", r.URL.Path))
+
+		if cfg.Stripe.WebhookSecret == "" {
+			logger.Error("Stripe webhook received but not configured", zap.Error(errcode.ERR_PAYMENT_STRIPE_CONFIG_MISSING))
+			http.Error(w, "Webhook not configured", http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxStripeWebhookBodySize))
+		if err != nil {
+			logger.Error("Failed to read Stripe webhook body", zap.Error(err))
+			http.Error(w, "Failed to read request body", http.StatusBadRequest)
+			return
+		}
+
+		if err := VerifyStripeWebhookSignature(body, r.Header.Get("Stripe-Signature"), cfg.Stripe.WebhookSecret, time.Now()); err != nil {
+			logger.Error("Stripe webhook signature verification failed", zap.Error(err))
+			http.Error(w, "Invalid signature", http.StatusBadRequest)
+			return
+		}
+
+		var event StripeEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			logger.Error("Failed to parse Stripe webhook event", zap.Error(errcode.ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR.Wrap(err)))
+			http.Error(w, "Failed to parse event", http.StatusBadRequest)
+			return
+		}
+
+		// Store the event before processing it, failed events are retried by the inbox worker.
+		// Only a failure to store it is reported to Stripe, which then retries the delivery
+		if err := receiveWebhookEvent(r.Context(), db, logger, cfg, payments, rbdb.WebhookEvent_PROVIDER_STRIPE, event.ID, event.Type, body); err != nil {
+			logger.Error("Failed to store Stripe webhook", zap.Error(err), zap.String("event_type", event.Type))
+			http.Error(w, "Failed to store event", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte("OK")); err != nil {
+			logger.Error("Failed to write response", zap.Error(err))
+		}
+	}
+}
+
+// processStripeWebhookEvent processes different Stripe webhook events
+func processStripeWebhookEvent(ctx context.Context, event StripeEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	logger.Info("Processing Stripe webhook event", zap.String("event_type", event.Type), zap.String("event_id", event.ID))
+
+	switch event.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+		return handleStripeCheckoutSessionCompleted(ctx, event, db, logger, cfg)
+	case "checkout.session.async_payment_failed":
+		logger.Info("Stripe asynchronous payment failed", zap.String("event_id", event.ID))
+	case "invoice.paid":
+		return handleStripeInvoicePaid(ctx, event, db, logger, cfg)
+	case "invoice.payment_failed":
+		return handleStripeInvoicePaymentFailed(event, db, logger)
+	case "customer.subscription.deleted":
+		return handleStripeSubscriptionDeleted(event, db, logger)
+	default:
+		logger.Debug("Received unhandled Stripe event", zap.String("event_type", event.Type))
+	}
+
+	return nil
+}
+
+// handleStripeCheckoutSessionCompleted creates or renews the license paid by a Checkout Session
+func handleStripeCheckoutSessionCompleted(ctx context.Context, event StripeEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	var session StripeCheckoutSession
+	if err := json.Unmarshal(event.Data.Object, &session); err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR.Wrap(err)
+	}
+
+	// Subscription payments are handled from their invoices
+	if session.Mode == "subscription" {
+		logger.Info("Stripe subscription checkout completed",
+			zap.String("session_id", session.ID),
+			zap.String("subscription_id", session.Subscription))
+		return nil
+	}
+
+	// Delayed payment methods complete the session before the funds are received,
+	// the license is then delivered on checkout.session.async_payment_succeeded
+	if session.PaymentStatus != "paid" {
+		logger.Info("Stripe checkout session completed without payment yet",
+			zap.String("session_id", session.ID),
+			zap.String("payment_status", session.PaymentStatus))
+		return nil
+	}
+
+	referenceID := session.PaymentIntent
+	if referenceID == "" {
+		referenceID = session.ID
+	}
+
+	// Check if this payment has already been processed
+	var existingPayment rbdb.PaymentORM
+	err := db.Where(&rbdb.PaymentORM{ReferenceId: referenceID}).First(&existingPayment).Error
+	if err == nil {
+		logger.Info("Payment already processed", zap.String("reference_id", referenceID), zap.Int64("payment_id", existingPayment.Id))
+		return nil
+	} else if !rbdb.IsRecordNotFoundError(err) {
+		return rbdb.GormToErrcode(err)
+	}
+
+	payment := &rbdb.Payment{
+		Provider:      rbdb.Payment_PROVIDER_STRIPE,
+		ReferenceId:   referenceID,
+		AmountInCents: session.AmountTotal,
+		Currency:      rbdb.NormalizeCurrency(session.Currency),
+		SandboxMode:   !event.Livemode,
+	}
+	if session.CustomerDetails != nil {
+		payment.BillingEmail = session.CustomerDetails.Email
+		payment.BillingName = strings.TrimSpace(session.CustomerDetails.Name)
+	}
+
+	if err := fulfillLicensePayment(ctx, db, logger, cfg, payment, session.Metadata); err != nil {
+		return err
+	}
+
+	logger.Info("Stripe payment processed successfully", zap.String("session_id", session.ID), zap.String("reference_id", referenceID))
+	return nil
+}
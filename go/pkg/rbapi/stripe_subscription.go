@@ -0,0 +1,236 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// StripeSubscription is the subset of the Stripe Subscription object we use
+type StripeSubscription struct {
+	ID                 string            `json:"id"`
+	Customer           string            `json:"customer"`
+	Status             string            `json:"status"`
+	CurrentPeriodStart int64             `json:"current_period_start"`
+	CurrentPeriodEnd   int64             `json:"current_period_end"`
+	CanceledAt         int64             `json:"canceled_at"`
+	Metadata           map[string]string `json:"metadata"`
+	Livemode           bool              `json:"livemode"`
+}
+
+// StripeInvoice is the subset of the Stripe Invoice object we use
+type StripeInvoice struct {
+	ID            string `json:"id"`
+	Subscription  string `json:"subscription"`
+	Customer      string `json:"customer"`
+	BillingReason string `json:"billing_reason"`
+	AmountPaid    int64  `json:"amount_paid"`
+	Currency      string `json:"currency"`
+	CustomerEmail string `json:"customer_email"`
+	CustomerName  string `json:"customer_name"`
+	Livemode      bool   `json:"livemode"`
+	Lines         struct {
+		Data []struct {
+			Period struct {
+				Start int64 `json:"start"`
+				End   int64 `json:"end"`
+			} `json:"period"`
+		} `json:"data"`
+	} `json:"lines"`
+}
+
+// period returns the subscription period paid by the invoice
+func (inv *StripeInvoice) period() (time.Time, time.Time) {
+	if len(inv.Lines.Data) == 0 {
+		return time.Time{}, time.Time{}
+	}
+	period := inv.Lines.Data[0].Period
+	return time.Unix(period.Start, 0).UTC(), time.Unix(period.End, 0).UTC()
+}
+
+// stripeRecurringInterval maps a license duration to a Stripe billing interval
+func stripeRecurringInterval(duration rbdb.LicenseKey_Duration) (string, int, bool) {
+	switch duration {
+	case rbdb.LicenseKey_ONE_WEEK:
+		return "week", 1, true
+	case rbdb.LicenseKey_ONE_MONTH:
+		return "month", 1, true
+	case rbdb.LicenseKey_SIX_MONTHS:
+		return "month", 6, true
+	case rbdb.LicenseKey_ONE_YEAR:
+		return "year", 1, true
+	default:
+		return "", 0, false
+	}
+}
+
+// GetStripeSubscription retrieves a subscription from the Stripe API
+func GetStripeSubscription(ctx context.Context, cfg config.Stripe, subscriptionID string) (*StripeSubscription, error) {
+	var subscription StripeSubscription
+	path := "/v1/subscriptions/" + url.PathEscape(subscriptionID)
+	if err := stripeRequest(ctx, cfg, "GET", path, nil, &subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// CancelStripeSubscription cancels a subscription immediately on Stripe, no further invoice is created
+func CancelStripeSubscription(ctx context.Context, cfg config.Stripe, subscriptionID string) error {
+	path := "/v1/subscriptions/" + url.PathEscape(subscriptionID)
+	if err := stripeRequest(ctx, cfg, "DELETE", path, nil, nil); err != nil {
+		return errcode.ERR_SUBSCRIPTION_CANCEL.Wrap(err)
+	}
+	return nil
+}
+
+// handleStripeInvoicePaid creates the subscription on its first invoice and renews the license on the next ones
+func handleStripeInvoicePaid(ctx context.Context, event StripeEvent, db *gorm.DB, logger *zap.Logger, cfg *config.Config) error {
+	var invoice StripeInvoice
+	if err := json.Unmarshal(event.Data.Object, &invoice); err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR.Wrap(err)
+	}
+
+	// One-time payments are handled from their checkout session
+	if invoice.Subscription == "" {
+		return nil
+	}
+
+	// Check if this invoice has already been processed
+	var existingPayment rbdb.PaymentORM
+	err := db.Where(&rbdb.PaymentORM{ReferenceId: invoice.ID}).First(&existingPayment).Error
+	if err == nil {
+		logger.Info("Payment already processed", zap.String("invoice_id", invoice.ID), zap.Int64("payment_id", existingPayment.Id))
+		return nil
+	} else if !rbdb.IsRecordNotFoundError(err) {
+		return rbdb.GormToErrcode(err)
+	}
+
+	payment := &rbdb.Payment{
+		Provider:      rbdb.Payment_PROVIDER_STRIPE,
+		ReferenceId:   invoice.ID,
+		AmountInCents: invoice.AmountPaid,
+		Currency:      rbdb.NormalizeCurrency(invoice.Currency),
+		SandboxMode:   !event.Livemode,
+		BillingEmail:  invoice.CustomerEmail,
+		BillingName:   strings.TrimSpace(invoice.CustomerName),
+	}
+	periodStart, periodEnd := invoice.period()
+
+	subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_STRIPE, invoice.Subscription)
+	if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_NOT_FOUND) {
+		return startStripeSubscription(ctx, db, logger, cfg, payment, invoice.Subscription, periodStart, periodEnd)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := renewSubscriptionPayment(ctx, db, cfg, payment, subscriptionOrm, periodStart, periodEnd); err != nil {
+		return err
+	}
+
+	logger.Info("Stripe subscription renewed",
+		zap.String("subscription_id", invoice.Subscription),
+		zap.String("invoice_id", invoice.ID),
+		zap.Time("period_end", periodEnd))
+
+	syncUserDiscourseGroup(ctx, db, logger, cfg.Discourse, subscriptionOrm.UserId)
+	return nil
+}
+
+// startStripeSubscription fulfills the first invoice of a subscription and records the subscription
+func startStripeSubscription(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payment *rbdb.Payment, subscriptionID string, periodStart time.Time, periodEnd time.Time) error {
+	// Invoices don't carry the checkout metadata, it is stored on the subscription
+	stripeSubscription, err := GetStripeSubscription(ctx, cfg.Stripe, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	licenseKey, err := startSubscriptionPayment(ctx, db, cfg, payment, stripeSubscription.Metadata, &rbdb.SubscriptionORM{
+		ProviderSubscriptionId: subscriptionID,
+		ProviderCustomerId:     stripeSubscription.Customer,
+		CurrentPeriodStart:     &periodStart,
+		CurrentPeriodEnd:       &periodEnd,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Stripe subscription started",
+		zap.String("subscription_id", subscriptionID),
+		zap.String("license_key", licenseKey.Key))
+
+	syncUserDiscourseGroup(ctx, db, logger, cfg.Discourse, licenseKey.UserId)
+	return nil
+}
+
+// handleStripeInvoicePaymentFailed flags the subscription, Stripe retries the payment on its own schedule
+func handleStripeInvoicePaymentFailed(event StripeEvent, db *gorm.DB, logger *zap.Logger) error {
+	var invoice StripeInvoice
+	if err := json.Unmarshal(event.Data.Object, &invoice); err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR.Wrap(err)
+	}
+	if invoice.Subscription == "" {
+		return nil
+	}
+
+	subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_STRIPE, invoice.Subscription)
+	if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_NOT_FOUND) {
+		// The first payment of the subscription failed, nothing was delivered yet
+		logger.Info("Payment failed for unknown Stripe subscription", zap.String("subscription_id", invoice.Subscription))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := rbdb.MarkSubscriptionPaymentFailed(db, subscriptionOrm.Id); err != nil {
+		return err
+	}
+
+	logger.Info("Stripe subscription payment failed",
+		zap.String("subscription_id", invoice.Subscription),
+		zap.String("invoice_id", invoice.ID))
+	return nil
+}
+
+// handleStripeSubscriptionDeleted marks the subscription as canceled once Stripe stops billing it
+func handleStripeSubscriptionDeleted(event StripeEvent, db *gorm.DB, logger *zap.Logger) error {
+	var stripeSubscription StripeSubscription
+	if err := json.Unmarshal(event.Data.Object, &stripeSubscription); err != nil {
+		return errcode.ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR.Wrap(err)
+	}
+
+	subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_STRIPE, stripeSubscription.ID)
+	if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_NOT_FOUND) {
+		logger.Info("Unknown Stripe subscription deleted", zap.String("subscription_id", stripeSubscription.ID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	canceledAt := time.Now().UTC()
+	if stripeSubscription.CanceledAt > 0 {
+		canceledAt = time.Unix(stripeSubscription.CanceledAt, 0).UTC()
+	}
+
+	// Subscriptions canceled from our API are already marked
+	_, err = rbdb.CancelSubscription(db, subscriptionOrm.Id, canceledAt)
+	if errcode.Code(err) == int32(errcode.ERR_SUBSCRIPTION_ALREADY_CANCELED) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Stripe subscription canceled", zap.String("subscription_id", stripeSubscription.ID))
+	return nil
+}
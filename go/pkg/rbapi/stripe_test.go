@@ -0,0 +1,373 @@
+package rbapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// fakeStripe emulates the Checkout Sessions and Subscriptions endpoints of the Stripe API
+type fakeStripe struct {
+	mu       sync.Mutex
+	sessions []url.Values
+	canceled []string
+}
+
+func (f *fakeStripe) handler(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/checkout/sessions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sk_test_fake" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"Invalid API Key"}}`))
+			return
+		}
+		require.NoError(t, r.ParseForm())
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.sessions = append(f.sessions, r.PostForm)
+		id := fmt.Sprintf("cs_test_%d", len(f.sessions))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":  id,
+			"url": "https://checkout.stripe.com/c/pay/" + id,
+		})
+	})
+	mux.HandleFunc("GET /v1/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		// Subscriptions are created from the last checkout session
+		metadata := map[string]string{}
+		if len(f.sessions) > 0 {
+			for key, values := range f.sessions[len(f.sessions)-1] {
+				if name, ok := strings.CutPrefix(key, "subscription_data[metadata]["); ok {
+					metadata[strings.TrimSuffix(name, "]")] = values[0]
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":       r.PathValue("id"),
+			"customer": "cus_test",
+			"status":   "active",
+			"metadata": metadata,
+		})
+	})
+	mux.HandleFunc("DELETE /v1/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.canceled = append(f.canceled, r.PathValue("id"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     r.PathValue("id"),
+			"status": "canceled",
+		})
+	})
+	return mux
+}
+
+func signStripePayload(t *testing.T, payload []byte, secret string, now time.Time) string {
+	t.Helper()
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestStripeCheckout(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	server, svc, cleanup := TestingServer(t, ctx, ServerOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+	db := TestingSvcDB(t, svc)
+
+	fake := &fakeStripe{}
+	stripe := httptest.NewServer(fake.handler(t))
+	defer stripe.Close()
+
+	svc.Config().Stripe = config.Stripe{APIKey: "sk_test_fake", WebhookSecret: "whsec_test", APIBase: stripe.URL}
+
+	ctx = TestingSetContextToken(ctx, t)
+	session, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+
+	webhookURL := fmt.Sprintf("http://%s/webhooks/stripe", server.ListenerAddr())
+	sendEvent := func(t *testing.T, payload []byte, signature string) int {
+		t.Helper()
+
+		req, err := http.NewRequest("POST", webhookURL, bytes.NewReader(payload))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Stripe-Signature", signature)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	completedEvent := func(t *testing.T, eventID string, metadata url.Values) []byte {
+		t.Helper()
+
+		sessionMetadata := map[string]string{}
+		for key := range metadata {
+			if name, ok := stripeMetadataKey(key); ok {
+				sessionMetadata[name] = metadata.Get(key)
+			}
+		}
+		payload, err := json.Marshal(map[string]interface{}{
+			"id":       eventID,
+			"type":     "checkout.session.completed",
+			"livemode": false,
+			"data": map[string]interface{}{
+				"object": map[string]interface{}{
+					"id":             "cs_test_1",
+					"mode":           "payment",
+					"payment_status": "paid",
+					"payment_intent": "pi_" + eventID,
+					"amount_total":   1900,
+					"currency":       "eur",
+					"metadata":       sessionMetadata,
+					"customer_details": map[string]interface{}{
+						"email": "buyer@example.com",
+						"name":  "Test Buyer",
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		return payload
+	}
+
+	var checkoutForm url.Values
+	t.Run("create checkout session", func(t *testing.T) {
+		out, err := svc.PaymentCreateStripeCheckout(ctx, &PaymentCreateStripeCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "cs_test_1", out.SessionId)
+		assert.Equal(t, "https://checkout.stripe.com/c/pay/cs_test_1", out.CheckoutUrl)
+
+		require.Len(t, fake.sessions, 1)
+		checkoutForm = fake.sessions[0]
+		assert.Equal(t, "payment", checkoutForm.Get("mode"))
+		assert.Equal(t, "1900", checkoutForm.Get("line_items[0][price_data][unit_amount]"))
+		assert.Equal(t, fmt.Sprintf("%d", session.User.Id), checkoutForm.Get("metadata[user_id]"))
+		assert.Equal(t, "ONE_MONTH", checkoutForm.Get("metadata[duration]"))
+	})
+
+	t.Run("invalid signature is rejected", func(t *testing.T) {
+		payload := completedEvent(t, "evt_bad", checkoutForm)
+		status := sendEvent(t, payload, signStripePayload(t, payload, "whsec_wrong", time.Now()))
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status = sendEvent(t, payload, signStripePayload(t, payload, "whsec_test", time.Now().Add(-time.Hour)))
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("completed session generates a license", func(t *testing.T) {
+		payload := completedEvent(t, "evt_1", checkoutForm)
+		status := sendEvent(t, payload, signStripePayload(t, payload, "whsec_test", time.Now()))
+		require.Equal(t, http.StatusOK, status)
+
+		var paymentOrm rbdb.PaymentORM
+		require.NoError(t, db.Where(&rbdb.PaymentORM{ReferenceId: "pi_evt_1"}).First(&paymentOrm).Error)
+		assert.Equal(t, int32(rbdb.Payment_PROVIDER_STRIPE), paymentOrm.Provider)
+		assert.Equal(t, int64(1900), paymentOrm.AmountInCents)
+		assert.Equal(t, "buyer@example.com", paymentOrm.BillingEmail)
+		assert.True(t, paymentOrm.SandboxMode)
+		require.NotNil(t, paymentOrm.LicenseKeyId)
+
+		var licenseOrm rbdb.LicenseKeyORM
+		require.NoError(t, db.Where(&rbdb.LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error)
+		assert.Equal(t, session.User.Id, licenseOrm.UserId)
+		assert.Equal(t, int32(rbdb.LicenseKey_ONE_MONTH), licenseOrm.Duration)
+	})
+
+	t.Run("duplicate delivery is ignored", func(t *testing.T) {
+		payload := completedEvent(t, "evt_1", checkoutForm)
+		status := sendEvent(t, payload, signStripePayload(t, payload, "whsec_test", time.Now()))
+		require.Equal(t, http.StatusOK, status)
+
+		var count int64
+		require.NoError(t, db.Model(&rbdb.PaymentORM{}).Where("reference_id = ?", "pi_evt_1").Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+}
+
+// stripeMetadataKey extracts "name" from a "metadata[name]" form key
+func stripeMetadataKey(key string) (string, bool) {
+	name, ok := strings.CutPrefix(key, "metadata[")
+	if !ok {
+		return "", false
+	}
+	return strings.CutSuffix(name, "]")
+}
+
+func TestStripeSubscription(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	server, svc, cleanup := TestingServer(t, ctx, ServerOpts{
+		Logger: logger,
+	})
+	defer cleanup()
+	db := TestingSvcDB(t, svc)
+
+	fake := &fakeStripe{}
+	stripe := httptest.NewServer(fake.handler(t))
+	defer stripe.Close()
+
+	svc.Config().Stripe = config.Stripe{APIKey: "sk_test_fake", WebhookSecret: "whsec_test", APIBase: stripe.URL}
+
+	ctx = TestingSetContextToken(ctx, t)
+	session, err := svc.UserGetSession(ctx, nil)
+	require.NoError(t, err)
+
+	webhookURL := fmt.Sprintf("http://%s/webhooks/stripe", server.ListenerAddr())
+	sendEvent := func(t *testing.T, eventType string, object map[string]interface{}) {
+		t.Helper()
+
+		payload, err := json.Marshal(map[string]interface{}{
+			"id":       fmt.Sprintf("evt_%s_%v", eventType, object["id"]),
+			"type":     eventType,
+			"livemode": false,
+			"data":     map[string]interface{}{"object": object},
+		})
+		require.NoError(t, err)
+		req, err := http.NewRequest("POST", webhookURL, bytes.NewReader(payload))
+		require.NoError(t, err)
+		req.Header.Set("Stripe-Signature", signStripePayload(t, payload, "whsec_test", time.Now()))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	invoice := func(id string, periodStart time.Time) map[string]interface{} {
+		return map[string]interface{}{
+			"id":           id,
+			"subscription": "sub_1",
+			"customer":     "cus_test",
+			"amount_paid":  1900,
+			"currency":     "eur",
+			"lines": map[string]interface{}{
+				"data": []interface{}{
+					map[string]interface{}{
+						"period": map[string]interface{}{
+							"start": periodStart.Unix(),
+							"end":   periodStart.AddDate(0, 1, 0).Unix(),
+						},
+					},
+				},
+			},
+		}
+	}
+	loadSubscription := func(t *testing.T) *rbdb.SubscriptionORM {
+		t.Helper()
+		subscriptionOrm, err := rbdb.GetSubscriptionByProviderID(db, rbdb.Payment_PROVIDER_STRIPE, "sub_1")
+		require.NoError(t, err)
+		return subscriptionOrm
+	}
+
+	t.Run("recurring checkout", func(t *testing.T) {
+		_, err := svc.PaymentCreateStripeCheckout(ctx, &PaymentCreateStripeCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+			Recurring:       true,
+		})
+		require.NoError(t, err)
+
+		form := fake.sessions[len(fake.sessions)-1]
+		assert.Equal(t, "subscription", form.Get("mode"))
+		assert.Equal(t, "month", form.Get("line_items[0][price_data][recurring][interval]"))
+		assert.Equal(t, "ONE_MONTH", form.Get("subscription_data[metadata][duration]"))
+
+		_, err = svc.PaymentCreateStripeCheckout(ctx, &PaymentCreateStripeCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_LIFETIME,
+			Recurring:       true,
+		})
+		require.Error(t, err)
+	})
+
+	var firstExpiration time.Time
+	t.Run("first invoice starts the subscription", func(t *testing.T) {
+		sendEvent(t, "invoice.paid", invoice("in_1", time.Now()))
+
+		subscriptionOrm := loadSubscription(t)
+		assert.Equal(t, int32(rbdb.Subscription_STATUS_ACTIVE), subscriptionOrm.Status)
+		assert.Equal(t, session.User.Id, subscriptionOrm.UserId)
+		assert.Equal(t, "cus_test", subscriptionOrm.ProviderCustomerId)
+
+		var paymentOrm rbdb.PaymentORM
+		require.NoError(t, db.Where(&rbdb.PaymentORM{ReferenceId: "in_1"}).First(&paymentOrm).Error)
+		require.NotNil(t, paymentOrm.SubscriptionId)
+		assert.Equal(t, subscriptionOrm.Id, *paymentOrm.SubscriptionId)
+
+		license, err := rbdb.DefaultReadLicenseKey(context.Background(), &rbdb.LicenseKey{Id: subscriptionOrm.LicenseKeyId}, db)
+		require.NoError(t, err)
+		var ok bool
+		firstExpiration, ok = rbdb.LicenseExpiresAt(license)
+		require.True(t, ok)
+
+		_, err = svc.PaymentCreateStripeCheckout(ctx, &PaymentCreateStripeCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+			Recurring:       true,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("renewal invoice extends the license", func(t *testing.T) {
+		sendEvent(t, "invoice.paid", invoice("in_2", firstExpiration))
+
+		subscriptionOrm := loadSubscription(t)
+		license, err := rbdb.DefaultReadLicenseKey(context.Background(), &rbdb.LicenseKey{Id: subscriptionOrm.LicenseKeyId}, db)
+		require.NoError(t, err)
+		expiration, ok := rbdb.LicenseExpiresAt(license)
+		require.True(t, ok)
+		assert.WithinDuration(t, firstExpiration.AddDate(0, 1, 0), expiration, time.Second)
+	})
+
+	t.Run("failed payment marks the subscription past due", func(t *testing.T) {
+		sendEvent(t, "invoice.payment_failed", invoice("in_3", time.Now()))
+		assert.Equal(t, int32(rbdb.Subscription_STATUS_PAST_DUE), loadSubscription(t).Status)
+	})
+
+	t.Run("user lists and cancels the subscription", func(t *testing.T) {
+		out, err := svc.UserGetSubscriptions(ctx, &UserGetSubscriptions_Input{})
+		require.NoError(t, err)
+		require.Len(t, out.Subscriptions, 1)
+		subscriptionId := out.Subscriptions[0].Id
+
+		canceled, err := svc.UserCancelSubscription(ctx, &UserCancelSubscription_Input{SubscriptionId: subscriptionId})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.Subscription_STATUS_CANCELED, canceled.Subscription.Status)
+		assert.NotNil(t, canceled.Subscription.CanceledAt)
+		assert.Equal(t, []string{"sub_1"}, fake.canceled)
+
+		// Stripe confirms the cancellation
+		sendEvent(t, "customer.subscription.deleted", map[string]interface{}{"id": "sub_1", "status": "canceled"})
+
+		_, err = svc.UserCancelSubscription(ctx, &UserCancelSubscription_Input{SubscriptionId: subscriptionId})
+		require.Error(t, err)
+		assert.Equal(t, int32(errcode.ERR_SUBSCRIPTION_ALREADY_CANCELED), errcode.Code(err))
+	})
+}
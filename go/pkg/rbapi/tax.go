@@ -0,0 +1,106 @@
+package rbapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// taxAssessment is the VAT included in a checkout price, with the evidence of the country it is due to
+type taxAssessment struct {
+	CountryCode        string // Empty when no evidence was found, the checkout is then not taxed
+	Rate               float64
+	TaxInCents         int64
+	BillingCountryCode string
+	IPAddress          string
+	IPCountryCode      string
+}
+
+// assessCheckoutTax determines the country of the buyer and the VAT included in the price of a checkout
+// The billing country declared by the buyer wins over the country of the IP, both are kept as evidence
+func assessCheckoutTax(ctx context.Context, db *gorm.DB, cfg config.Tax, checkout *licenseCheckout, billingCountryCode string) (*taxAssessment, error) {
+	tax := &taxAssessment{BillingCountryCode: normalizeCountryCode(billingCountryCode)}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// The gateway appends the proxies to the client address
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			tax.IPAddress = strings.TrimSpace(strings.Split(forwarded[0], ",")[0])
+		}
+		// The gateway prefixes the headers it doesn't know, gRPC clients send them as is
+		for _, key := range []string{"grpcgateway-" + cfg.IPCountryHeader, cfg.IPCountryHeader} {
+			if values := md.Get(key); len(values) > 0 {
+				tax.IPCountryCode = normalizeCountryCode(values[0])
+				break
+			}
+		}
+	}
+
+	tax.CountryCode = tax.BillingCountryCode
+	if tax.CountryCode == "" {
+		tax.CountryCode = tax.IPCountryCode
+	}
+	if tax.CountryCode == "" {
+		return tax, nil
+	}
+
+	rate, err := rbdb.GetTaxRate(db, tax.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+	tax.Rate = rate
+	tax.TaxInCents = rbdb.TaxIncluded(checkout.AmountInCents, rate)
+	return tax, nil
+}
+
+// normalizeCountryCode returns an uppercase ISO 3166-1 alpha-2 code, or an empty string for unknown countries
+func normalizeCountryCode(countryCode string) string {
+	countryCode = strings.ToUpper(strings.TrimSpace(countryCode))
+	if len(countryCode) != 2 || countryCode == "XX" {
+		return ""
+	}
+	for _, r := range countryCode {
+		if r < 'A' || r > 'Z' {
+			return ""
+		}
+	}
+	return countryCode
+}
+
+// conflicting reports if the billing country and the country of the IP disagree
+func (t *taxAssessment) conflicting() bool {
+	return t.BillingCountryCode != "" && t.IPCountryCode != "" && t.BillingCountryCode != t.IPCountryCode
+}
+
+// applyToCheckoutOrder records the tax on the checkout order, it is copied to the payment once captured
+func (t *taxAssessment) applyToCheckoutOrder(orderOrm *rbdb.CheckoutOrderORM) {
+	orderOrm.TaxCountryCode = t.CountryCode
+	orderOrm.TaxRate = t.Rate
+	orderOrm.TaxInCents = t.TaxInCents
+	orderOrm.BillingCountryCode = t.BillingCountryCode
+	orderOrm.IpAddress = t.IPAddress
+	orderOrm.IpCountryCode = t.IPCountryCode
+}
+
+// applyCheckoutOrderTax copies the tax assessed at checkout to the payment of a provider order
+// Orders created before they were recorded have no tax
+func applyCheckoutOrderTax(db *gorm.DB, payment *rbdb.Payment, provider rbdb.Payment_Provider, providerOrderId string) error {
+	orderOrm, err := rbdb.GetCheckoutOrderByProviderID(db, provider, providerOrderId)
+	if errcode.Code(err) == int32(errcode.ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	payment.TaxCountryCode = orderOrm.TaxCountryCode
+	payment.TaxRate = orderOrm.TaxRate
+	payment.TaxInCents = orderOrm.TaxInCents
+	payment.BillingCountryCode = orderOrm.BillingCountryCode
+	payment.IpAddress = orderOrm.IpAddress
+	payment.IpCountryCode = orderOrm.IpCountryCode
+	return nil
+}
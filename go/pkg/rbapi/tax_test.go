@@ -0,0 +1,163 @@
+package rbapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestVAT(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, fake := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	t.Run("admins maintain the rates", func(t *testing.T) {
+		_, err := svc.AdminSetTaxRate(ctx, &AdminSetTaxRate_Input{CountryCode: "DE", Rate: 0.19})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminSetTaxRate(adminCtx, &AdminSetTaxRate_Input{CountryCode: "DEU", Rate: 0.19})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_TAX_RATE_INVALID), errcode.Code(err))
+		_, err = svc.AdminSetTaxRate(adminCtx, &AdminSetTaxRate_Input{CountryCode: "DE", Rate: 19})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_TAX_RATE_INVALID), errcode.Code(err))
+
+		rate, err := svc.AdminSetTaxRate(adminCtx, &AdminSetTaxRate_Input{CountryCode: "de", Rate: 0.16})
+		require.NoError(t, err)
+		assert.Equal(t, "DE", rate.Rate.CountryCode)
+		_, err = svc.AdminSetTaxRate(adminCtx, &AdminSetTaxRate_Input{CountryCode: "DE", Rate: 0.19})
+		require.NoError(t, err)
+		_, err = svc.AdminSetTaxRate(adminCtx, &AdminSetTaxRate_Input{CountryCode: "FR", Rate: 0.2})
+		require.NoError(t, err)
+
+		rates, err := svc.AdminListTaxRates(adminCtx, &AdminListTaxRates_Input{})
+		require.NoError(t, err)
+		require.Len(t, rates.Rates, 2)
+		assert.Equal(t, "DE", rates.Rates[0].CountryCode)
+		assert.Equal(t, 0.19, rates.Rates[0].Rate)
+	})
+
+	userCtx := TestingSetContextToken(ctx, t)
+	requestCtx := func(ipCountry string) context.Context {
+		return metadata.NewIncomingContext(userCtx, metadata.Pairs(
+			"x-forwarded-for", "203.0.113.7, 10.0.0.1",
+			"grpcgateway-cf-ipcountry", ipCountry,
+		))
+	}
+	deliver := func(ctx context.Context, event paypal.AnyEvent) error {
+		return processPayPalWebhookEvent(ctx, event, db, logger, svc.Config(), fake)
+	}
+	capture := func(t *testing.T, orderID string) *rbdb.PaymentORM {
+		t.Helper()
+		_, err := fake.Approve(orderID)
+		require.NoError(t, err)
+		require.NoError(t, fake.DeliverEvents(ctx, deliver))
+		order, err := fake.GetOrder(ctx, orderID)
+		require.NoError(t, err)
+		require.Len(t, order.Captures, 1)
+		paymentOrm, err := rbdb.GetPaymentByReference(db, order.Captures[0].ID)
+		require.NoError(t, err)
+		return paymentOrm
+	}
+
+	var germanPayment *rbdb.PaymentORM
+	t.Run("billing country sets the rate", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(requestCtx("FR"), &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
+			CountryCode:     "DE",
+		})
+		require.NoError(t, err)
+
+		// The gross price is split in net and tax
+		fake.mu.Lock()
+		request := fake.orders[out.OrderId].request
+		fake.mu.Unlock()
+		assert.Equal(t, int64(1900), request.AmountInCents)
+		assert.Equal(t, int64(303), request.TaxInCents)
+
+		germanPayment = capture(t, out.OrderId)
+		assert.Equal(t, int64(1900), germanPayment.AmountInCents)
+		assert.Equal(t, "DE", germanPayment.TaxCountryCode)
+		assert.Equal(t, 0.19, germanPayment.TaxRate)
+		assert.Equal(t, int64(303), germanPayment.TaxInCents)
+		assert.Equal(t, "DE", germanPayment.BillingCountryCode)
+		assert.Equal(t, "203.0.113.7", germanPayment.IpAddress)
+		assert.Equal(t, "FR", germanPayment.IpCountryCode)
+	})
+
+	t.Run("IP country is used without billing country", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(requestCtx("fr"), &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_YEAR,
+		})
+		require.NoError(t, err)
+		paymentOrm := capture(t, out.OrderId)
+		assert.Equal(t, "FR", paymentOrm.TaxCountryCode)
+		assert.Equal(t, int64(2150), paymentOrm.TaxInCents)
+		assert.Empty(t, paymentOrm.BillingCountryCode)
+	})
+
+	t.Run("countries without rate are not taxed", func(t *testing.T) {
+		out, err := svc.PaymentCreatePayPalCheckout(requestCtx("XX"), &PaymentCreatePayPalCheckout_Input{
+			LicenseDuration: rbdb.LicenseKey_ONE_WEEK,
+			CountryCode:     "CH",
+		})
+		require.NoError(t, err)
+		fake.mu.Lock()
+		request := fake.orders[out.OrderId].request
+		fake.mu.Unlock()
+		assert.Equal(t, int64(950), request.AmountInCents)
+		assert.Zero(t, request.TaxInCents)
+
+		paymentOrm := capture(t, out.OrderId)
+		assert.Equal(t, "CH", paymentOrm.TaxCountryCode)
+		assert.Zero(t, paymentOrm.TaxInCents)
+		assert.Empty(t, paymentOrm.IpCountryCode)
+	})
+
+	t.Run("quarterly report by country", func(t *testing.T) {
+		_, err := svc.AdminGetTaxReport(ctx, &AdminGetTaxReport_Input{Year: 2026, Quarter: 1})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+		_, err = svc.AdminGetTaxReport(adminCtx, &AdminGetTaxReport_Input{Year: 2026, Quarter: 5})
+		assert.Equal(t, int32(errcode.ERR_PAYMENT_TAX_REPORT_PERIOD_INVALID), errcode.Code(err))
+
+		// Half of the German payment is refunded
+		_, applied, err := rbdb.RefundPayment(db, germanPayment.Id, "REF-vat", 950, time.Now().UTC())
+		require.NoError(t, err)
+		require.True(t, applied)
+
+		now := time.Now().UTC()
+		report, err := svc.AdminGetTaxReport(adminCtx, &AdminGetTaxReport_Input{
+			Year:           int32(now.Year()),
+			Quarter:        int32(now.Month()-1)/3 + 1,
+			IncludeSandbox: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, report.From.AsTime().Before(now))
+		assert.True(t, report.To.AsTime().After(now))
+
+		byCountry := map[string]*AdminGetTaxReport_Output_Total{}
+		for _, total := range report.Totals {
+			byCountry[total.CountryCode] = total
+		}
+		require.Contains(t, byCountry, "DE")
+		assert.Equal(t, int32(1), byCountry["DE"].Payments)
+		assert.Equal(t, int64(303), byCountry["DE"].TaxInCents)
+		assert.Equal(t, int64(950), byCountry["DE"].RefundedInCents)
+		assert.Equal(t, int64(152), byCountry["DE"].RefundedTaxInCents)
+		assert.Equal(t, int64(151), byCountry["DE"].NetTaxInCents)
+		require.Contains(t, byCountry, "FR")
+		assert.Equal(t, int64(2150), byCountry["FR"].NetTaxInCents)
+		require.Contains(t, byCountry, "CH")
+		assert.Zero(t, byCountry["CH"].NetTaxInCents)
+
+		// Other quarters have none of the payments
+		report, err = svc.AdminGetTaxReport(adminCtx, &AdminGetTaxReport_Input{Year: int32(now.Year()) - 1, Quarter: 1, IncludeSandbox: true})
+		require.NoError(t, err)
+		assert.Empty(t, report.Totals)
+	})
+}
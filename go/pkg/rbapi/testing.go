@@ -13,20 +13,55 @@ import (
 	"google.golang.org/grpc/metadata"
 	"gorm.io/gorm"
 
+	"rslbot.com/go/pkg/config"
 	"rslbot.com/go/pkg/rbdb"
 )
 
+// TestingDiscourseSecret signs the SSO payloads of the tests, TestingConfig uses it
+const TestingDiscourseSecret = "test-discourse-sso-secret"
+
 const (
-	TestToken     = "YWRtaW49ZmFsc2UmZW1haWw9ZXhpbGVkYm90cG9lJTQwZ21haWwuY29tJmV4dGVybmFsX2lkPTcmZ3JvdXBzPXRydXN0X2xldmVsXzAlMkN0cnVzdF9sZXZlbF8xJTJDdGVzdF91c2VycyZtb2RlcmF0b3I9ZmFsc2UmbmFtZT1UZXN0K1VzZXImbm9uY2U9dGVzdC1ub25jZS0xMjMmcmV0dXJuX3Nzb191cmw9aHR0cCUzQSUyRiUyRmxvY2FsaG9zdCUzQTgwODUlMkZjYWxsYmFjayZ1c2VybmFtZT10ZXN0"
-	TestSignature = "1b5d7dee203122461577227156ba95795c508b44df1a8a969ebdc113f6643362"
+	TestToken = "YWRtaW49ZmFsc2UmZW1haWw9ZXhpbGVkYm90cG9lJTQwZ21haWwuY29tJmV4dGVybmFsX2lkPTcmZ3JvdXBzPXRydXN0X2xldmVsXzAlMkN0cnVzdF9sZXZlbF8xJTJDdGVzdF91c2VycyZtb2RlcmF0b3I9ZmFsc2UmbmFtZT1UZXN0K1VzZXImbm9uY2U9dGVzdC1ub25jZS0xMjMmcmV0dXJuX3Nzb191cmw9aHR0cCUzQSUyRiUyRmxvY2FsaG9zdCUzQTgwODUlMkZjYWxsYmFjayZ1c2VybmFtZT10ZXN0"
 )
 
+var TestSignature = signSSOPayload(TestToken, TestingDiscourseSecret)
+
+// TestingConfig returns a valid configuration with test secrets and no integration enabled
+func TestingConfig() *config.Config {
+	cfg := config.Default()
+	cfg.Discourse.SSOSecret = TestingDiscourseSecret
+	cfg.Discourse.APIKey = "test-discourse-api-key"
+	cfg.License.ActivateSecret = "test-activate-secret"
+	cfg.License.CheckSecret = "test-check-secret"
+	cfg.Checkout.SuccessURL = "http://localhost:5173/payment/success"
+	cfg.Checkout.CancelURL = "http://localhost:5173/purchase"
+	cfg.Frontend.URL = "http://localhost:5173"
+	return cfg
+}
+
+// TestingRolesConfig returns a testing configuration with the Discourse groups giving the roles
+func TestingRolesConfig() *config.Config {
+	cfg := TestingConfig()
+	cfg.Roles = config.Roles{
+		SupportGroup:           "support",
+		BillingGroup:           "billing",
+		OffsetsMaintainerGroup: "offsets-maintainers",
+	}
+	return cfg
+}
+
 func TestingService(t *testing.T, opts ServiceOpts) (Service, func()) {
 	t.Helper()
 
 	if opts.Logger == nil {
 		opts.Logger = zap.NewNop()
 	}
+	if opts.Config == nil {
+		opts.Config = TestingConfig()
+	}
+	if opts.PaymentProvider == nil {
+		opts.PaymentProvider = NewFakePaymentProvider("http://localhost")
+	}
 
 	// Use TestingSqliteDB directly for test database
 	db, sfn := rbdb.TestingSqliteDB(t, opts.Logger)
@@ -36,10 +71,14 @@ func TestingService(t *testing.T, opts ServiceOpts) (Service, func()) {
 
 	svc := &service{
 		logger:    opts.Logger,
+		cfg:       opts.Config,
 		db:        db,
 		sfn:       sfn,
 		redis:     redisStore,
+		payments:  opts.PaymentProvider,
 		startedAt: time.Now(),
+
+		discourseBreaker: newCircuitBreaker(opts.Config.Discourse.BreakerThreshold, time.Duration(opts.Config.Discourse.BreakerCooldown)),
 	}
 
 	// Give the default test user (discourse ID 7) a lifetime license
@@ -53,6 +92,7 @@ func TestingService(t *testing.T, opts ServiceOpts) (Service, func()) {
 			ReferenceId:     "test-payment-default",
 			AmountInCents:   900,
 			Currency:        "eur",
+			Status:          rbdb.Payment_STATUS_COMPLETED,
 			LicenseDuration: rbdb.LicenseKey_LIFETIME,
 			UserId:          userOrm.Id,
 		}
@@ -74,6 +114,19 @@ func TestingService(t *testing.T, opts ServiceOpts) (Service, func()) {
 	return svc, cleanup
 }
 
+// TestingPaymentService creates a testing service closed at the end of the test, with its database,
+// an admin context and the fake payment provider (nil when opts injects another provider)
+func TestingPaymentService(t *testing.T, opts ServiceOpts) (Service, *gorm.DB, context.Context, *FakePaymentProvider) {
+	t.Helper()
+
+	svc, cleanup := TestingService(t, opts)
+	t.Cleanup(cleanup)
+
+	fake, _ := svc.Payments().(*FakePaymentProvider)
+	adminCtx := context.WithValue(context.Background(), userInfoCtx, &rbdb.DiscourseUser{ExternalId: 1, Username: "admin", Admin: true})
+	return svc, TestingSvcDB(t, svc), adminCtx, fake
+}
+
 // TestingServer creates a new server instance for testing
 func TestingServer(t *testing.T, ctx context.Context, opts ServerOpts) (*Server, Service, func()) {
 	t.Helper()
@@ -142,13 +195,20 @@ func TestingSetContextToken(ctx context.Context, t *testing.T) context.Context {
 	t.Helper()
 
 	// Create a mock userInfo based on the test token
-	userInfo, err := VerifySSO(TestToken, TestSignature, DiscourseSecret)
+	userInfo, err := VerifySSO(TestToken, TestSignature, TestingDiscourseSecret)
 	require.NoError(t, err)
 
 	// Set it directly in context
 	return context.WithValue(ctx, userInfoCtx, userInfo)
 }
 
+// TestingAuthenticate runs the auth interceptor of an RPC with a bearer token, method is the RPC name
+func TestingAuthenticate(ctx context.Context, t *testing.T, svc Service, method string, token string) (context.Context, error) {
+	t.Helper()
+	md := metadata.New(map[string]string{"authorization": "Bearer " + token})
+	return svc.(*service).AuthFuncOverride(metadata.NewIncomingContext(ctx, md), "/rslbot.api.Service/"+method)
+}
+
 // Helper to test if a context has valid auth
 func TestingHasValidAuth(ctx context.Context, t *testing.T) {
 	t.Helper()
@@ -185,7 +245,7 @@ func TestingRedisStore(t *testing.T) (*RedisStore, func()) {
 // Helper function to test SSO verification
 func TestingVerifySSO(t *testing.T) {
 	t.Helper()
-	discourseUser, err := VerifySSO(TestToken, TestSignature, DiscourseSecret)
+	discourseUser, err := VerifySSO(TestToken, TestSignature, TestingDiscourseSecret)
 	require.NoError(t, err, "should verify SSO token")
 	require.Equal(t, 7, discourseUser.ExternalId, "should have correct discourse id")
 }
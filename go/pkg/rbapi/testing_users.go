@@ -2,10 +2,7 @@ package rbapi
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"encoding/base64"
-	"encoding/hex"
 	"fmt"
 	"net/url"
 	"testing"
@@ -31,6 +28,11 @@ type TestUser struct {
 
 // GenerateTestSSOToken generates a valid SSO token and signature for a test user
 func GenerateTestSSOToken(discourseID int64, username, email string) (token string, signature string) {
+	return GenerateTestSSOResponse(discourseID, username, email, fmt.Sprintf("test-nonce-%d", time.Now().UnixNano()), "http://localhost:8085/callback")
+}
+
+// GenerateTestSSOResponse generates the SSO response Discourse sends back for a request with the nonce
+func GenerateTestSSOResponse(discourseID int64, username, email, nonce, returnURL string) (token string, signature string) {
 	// Build the SSO payload
 	params := url.Values{}
 	params.Set("admin", "false")
@@ -39,8 +41,8 @@ func GenerateTestSSOToken(discourseID int64, username, email string) (token stri
 	params.Set("groups", "trust_level_0,trust_level_1,test_users")
 	params.Set("moderator", "false")
 	params.Set("name", username)
-	params.Set("nonce", fmt.Sprintf("test-nonce-%d", time.Now().UnixNano()))
-	params.Set("return_sso_url", "http://localhost:8085/callback")
+	params.Set("nonce", nonce)
+	params.Set("return_sso_url", returnURL)
 	params.Set("username", username)
 
 	// Encode the payload
@@ -48,13 +50,24 @@ func GenerateTestSSOToken(discourseID int64, username, email string) (token stri
 	token = base64.StdEncoding.EncodeToString([]byte(payload))
 
 	// Generate the signature
-	mac := hmac.New(sha256.New, []byte(DiscourseSecret))
-	mac.Write([]byte(token))
-	signature = hex.EncodeToString(mac.Sum(nil))
+	signature = signSSOPayload(token, TestingDiscourseSecret)
 
 	return token, signature
 }
 
+// TestingSSORequest returns the nonce and return URL signed in the Discourse login URL of AuthStartSSO
+func TestingSSORequest(t *testing.T, ssoURL string) (nonce string, returnURL string) {
+	t.Helper()
+
+	loginURL, err := url.Parse(ssoURL)
+	require.NoError(t, err)
+	payload, err := base64.StdEncoding.DecodeString(loginURL.Query().Get("sso"))
+	require.NoError(t, err)
+	params, err := url.ParseQuery(string(payload))
+	require.NoError(t, err)
+	return params.Get("nonce"), params.Get("return_sso_url")
+}
+
 // createTestUserWithLicense is a helper to create a test user with a specific license configuration
 func createTestUserWithLicense(t *testing.T, svc Service, discourseID int64, userType string, duration rbdb.LicenseKey_Duration, amountInCents int64, description string) *TestUser {
 	t.Helper()
@@ -81,6 +94,7 @@ func createTestUserWithLicense(t *testing.T, svc Service, discourseID int64, use
 		ReferenceId:     fmt.Sprintf("test-payment-%s-%d", userType, discourseID),
 		AmountInCents:   amountInCents,
 		Currency:        "eur",
+		Status:          rbdb.Payment_STATUS_COMPLETED,
 		LicenseDuration: duration,
 		UserId:          createdUser.Id,
 	}
@@ -165,6 +179,7 @@ func CreateTestUserWithExpiredLicense(t *testing.T, svc Service, discourseID int
 		ReferenceId:     fmt.Sprintf("test-payment-expired-%d", discourseID),
 		AmountInCents:   900,
 		Currency:        "eur",
+		Status:          rbdb.Payment_STATUS_COMPLETED,
 		LicenseDuration: rbdb.LicenseKey_ONE_WEEK,
 		UserId:          createdUser.Id,
 	}
@@ -218,6 +233,7 @@ func CreateTestUserWithRevokedLicense(t *testing.T, svc Service, discourseID int
 		ReferenceId:     fmt.Sprintf("test-payment-revoked-%d", discourseID),
 		AmountInCents:   900,
 		Currency:        "eur",
+		Status:          rbdb.Payment_STATUS_COMPLETED,
 		LicenseDuration: rbdb.LicenseKey_ONE_MONTH,
 		UserId:          createdUser.Id,
 	}
@@ -254,7 +270,7 @@ func SetContextForTestUser(ctx context.Context, t *testing.T, testUser *TestUser
 	t.Helper()
 
 	// Verify the token works
-	userInfo, err := VerifySSO(testUser.Token, testUser.Signature, DiscourseSecret)
+	userInfo, err := VerifySSO(testUser.Token, testUser.Signature, TestingDiscourseSecret)
 	require.NoError(t, err)
 	require.Equal(t, testUser.DiscourseID, userInfo.ExternalId)
 
@@ -295,6 +311,7 @@ func EnsureDefaultTestUserHasLicense(t *testing.T, svc Service) {
 			ReferenceId:     "test-payment-default",
 			AmountInCents:   900,
 			Currency:        "eur",
+			Status:          rbdb.Payment_STATUS_COMPLETED,
 			LicenseDuration: rbdb.LicenseKey_LIFETIME,
 			UserId:          userOrm.Id,
 		}
@@ -0,0 +1,179 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/plutov/paypal/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/config"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+// Webhook inbox retry policy
+const (
+	webhookLease          = 5 * time.Minute // Time given to an attempt before the event is due again
+	webhookRetryBaseDelay = time.Minute
+	webhookRetryMaxDelay  = 6 * time.Hour
+	webhookMaxAttempts    = 10
+	webhookRetryBatchSize = 50
+)
+
+// webhookRetryDelay returns the exponential backoff after a number of failed attempts
+func webhookRetryDelay(attempts int32) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := webhookRetryBaseDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookRetryMaxDelay {
+			return webhookRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// receiveWebhookEvent stores a verified webhook in the inbox, then processes it
+// It only fails when the event could not be stored: processing errors are retried by the inbox worker
+// The payment provider is needed by the handlers of every provider, so each webhook endpoint passes it here
+func receiveWebhookEvent(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, provider rbdb.WebhookEvent_Provider, eventID string, eventType string, payload []byte) error {
+	eventOrm, received, err := rbdb.ReceiveWebhookEvent(db, provider, eventID, eventType, payload, time.Now().UTC().Add(webhookLease))
+	if err != nil {
+		return err
+	}
+	if !received {
+		logger.Info("Webhook event already received",
+			zap.String("provider", provider.String()),
+			zap.String("event_id", eventID),
+			zap.String("status", rbdb.WebhookEvent_Status(eventOrm.Status).String()))
+		return nil
+	}
+
+	attemptWebhookEvent(ctx, db, logger, cfg, payments, eventOrm)
+	return nil
+}
+
+// attemptWebhookEvent processes an inbox event and records the outcome of the attempt
+// The event must be leased by the caller
+func attemptWebhookEvent(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, eventOrm *rbdb.WebhookEventORM) {
+	logger = logger.With(
+		zap.String("provider", rbdb.WebhookEvent_Provider(eventOrm.Provider).String()),
+		zap.String("event_id", eventOrm.EventId),
+		zap.String("event_type", eventOrm.EventType))
+
+	processErr := processWebhookInboxEvent(ctx, db, logger, cfg, payments, eventOrm)
+	now := time.Now().UTC()
+	if processErr == nil {
+		if err := rbdb.MarkWebhookEventProcessed(db, eventOrm, now); err != nil {
+			logger.Error("Failed to mark webhook event as processed", zap.Error(err))
+		}
+		return
+	}
+
+	retryAt := time.Time{}
+	if eventOrm.Attempts+1 < webhookMaxAttempts {
+		retryAt = now.Add(webhookRetryDelay(eventOrm.Attempts + 1))
+	}
+	if err := rbdb.MarkWebhookEventAttemptFailed(db, eventOrm, processErr.Error(), retryAt); err != nil {
+		logger.Error("Failed to record webhook event attempt", zap.Error(err), zap.NamedError("process_error", processErr))
+		return
+	}
+
+	if retryAt.IsZero() {
+		logger.Error("Webhook event failed, giving up", zap.Error(processErr), zap.Int32("attempts", eventOrm.Attempts))
+	} else {
+		logger.Warn("Webhook event failed, will retry", zap.Error(processErr),
+			zap.Int32("attempts", eventOrm.Attempts),
+			zap.Time("retry_at", retryAt))
+	}
+}
+
+// processWebhookInboxEvent dispatches a stored event to the handler of its provider
+func processWebhookInboxEvent(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, eventOrm *rbdb.WebhookEventORM) error {
+	switch rbdb.WebhookEvent_Provider(eventOrm.Provider) {
+	case rbdb.WebhookEvent_PROVIDER_DISCOURSE:
+		return processDiscourseWebhookEvent(ctx, db, logger, eventOrm.EventType, []byte(eventOrm.Payload))
+	case rbdb.WebhookEvent_PROVIDER_PAYPAL:
+		var event paypal.AnyEvent
+		if err := json.Unmarshal([]byte(eventOrm.Payload), &event); err != nil {
+			return errcode.ERR_PAYMENT_PAYPAL_EVENT_PARSING_ERROR.Wrap(err)
+		}
+		return processPayPalWebhookEvent(ctx, event, db, logger, cfg, payments)
+	case rbdb.WebhookEvent_PROVIDER_STRIPE:
+		var event StripeEvent
+		if err := json.Unmarshal([]byte(eventOrm.Payload), &event); err != nil {
+			return errcode.ERR_PAYMENT_STRIPE_EVENT_PARSING_ERROR.Wrap(err)
+		}
+		return processStripeWebhookEvent(ctx, event, db, logger, cfg)
+	default:
+		return errcode.ERR_WEBHOOK_PROVIDER_UNSUPPORTED.Wrap(fmt.Errorf("provider %d", eventOrm.Provider))
+	}
+}
+
+// replayWebhookEvent processes an inbox event again, whatever its status
+// Events recorded before the inbox stored payloads can't be replayed, they are left untouched
+func replayWebhookEvent(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, id int64) (*rbdb.WebhookEventORM, error) {
+	eventOrm, err := rbdb.GetWebhookEvent(db, id)
+	if err != nil {
+		return nil, err
+	}
+	if eventOrm.Payload == "" {
+		return nil, errcode.ERR_WEBHOOK_EVENT_PAYLOAD_MISSING.Wrap(fmt.Errorf("id %d", id))
+	}
+	if err := rbdb.ResetWebhookEvent(db, eventOrm, time.Now().UTC().Add(webhookLease)); err != nil {
+		return nil, err
+	}
+
+	attemptWebhookEvent(ctx, db, logger, cfg, payments, eventOrm)
+	return eventOrm, nil
+}
+
+// retryDueWebhookEvents attempts the pending events whose retry is due, it returns the number of attempts
+func retryDueWebhookEvents(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider) (int, error) {
+	now := time.Now().UTC()
+	eventsOrm, err := rbdb.ListDueWebhookEvents(db, now, webhookRetryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, eventOrm := range eventsOrm {
+		claimed, err := rbdb.ClaimWebhookEvent(db, eventOrm, now.Add(webhookLease))
+		if err != nil {
+			return attempted, err
+		}
+		if !claimed {
+			continue
+		}
+		attemptWebhookEvent(ctx, db, logger, cfg, payments, eventOrm)
+		attempted++
+	}
+	return attempted, nil
+}
+
+// runWebhookInboxLoop periodically retries the failed webhook events until the context is done
+func runWebhookInboxLoop(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config, payments PaymentProvider, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			attempted, err := retryDueWebhookEvents(ctx, db, logger, cfg, payments)
+			if err != nil {
+				logger.Error("Webhook inbox retry failed", zap.Error(err))
+				continue
+			}
+			if attempted > 0 {
+				logger.Info("Webhook inbox retry done", zap.Int("attempted", attempted))
+			}
+		}
+	}
+}
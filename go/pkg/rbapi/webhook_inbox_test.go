@@ -0,0 +1,144 @@
+package rbapi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"rslbot.com/go/internal/testutil"
+	"rslbot.com/go/pkg/errcode"
+	"rslbot.com/go/pkg/rbdb"
+)
+
+func TestWebhookRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, webhookRetryDelay(0))
+	assert.Equal(t, time.Minute, webhookRetryDelay(1))
+	assert.Equal(t, 2*time.Minute, webhookRetryDelay(2))
+	assert.Equal(t, 8*time.Minute, webhookRetryDelay(4))
+	assert.Equal(t, webhookRetryMaxDelay, webhookRetryDelay(webhookMaxAttempts))
+	assert.Equal(t, webhookRetryMaxDelay, webhookRetryDelay(100))
+}
+
+func TestWebhookInbox(t *testing.T) {
+	logger := testutil.Logger(t)
+	ctx := context.Background()
+	svc, db, adminCtx, _ := TestingPaymentService(t, ServiceOpts{Logger: logger})
+
+	stripeEvent := func(t *testing.T, eventID string, eventType string, object interface{}) []byte {
+		t.Helper()
+		payload, err := json.Marshal(map[string]interface{}{
+			"id":   eventID,
+			"type": eventType,
+			"data": map[string]interface{}{"object": object},
+		})
+		require.NoError(t, err)
+		return payload
+	}
+	loadEvent := func(t *testing.T, eventID string) *rbdb.WebhookEventORM {
+		t.Helper()
+		var eventOrm rbdb.WebhookEventORM
+		require.NoError(t, db.Where(&rbdb.WebhookEventORM{EventId: eventID}).First(&eventOrm).Error)
+		return &eventOrm
+	}
+	makeDue := func(t *testing.T, eventOrm *rbdb.WebhookEventORM, attempts int32) {
+		t.Helper()
+		past := time.Now().UTC().Add(-time.Second)
+		require.NoError(t, db.Model(eventOrm).Updates(map[string]interface{}{
+			"next_attempt_at": past,
+			"attempts":        attempts,
+		}).Error)
+	}
+
+	t.Run("processed event", func(t *testing.T) {
+		payload := stripeEvent(t, "evt_ok", "customer.created", map[string]interface{}{"id": "cus_1"})
+		require.NoError(t, receiveWebhookEvent(ctx, db, logger, svc.Config(), svc.Payments(), rbdb.WebhookEvent_PROVIDER_STRIPE, "evt_ok", "customer.created", payload))
+
+		eventOrm := loadEvent(t, "evt_ok")
+		assert.Equal(t, int32(rbdb.WebhookEvent_STATUS_PROCESSED), eventOrm.Status)
+		assert.Equal(t, int32(1), eventOrm.Attempts)
+		assert.Equal(t, string(payload), eventOrm.Payload)
+		assert.NotNil(t, eventOrm.ProcessedAt)
+		assert.Nil(t, eventOrm.NextAttemptAt)
+
+		// Duplicate deliveries are acknowledged without processing
+		require.NoError(t, receiveWebhookEvent(ctx, db, logger, svc.Config(), svc.Payments(), rbdb.WebhookEvent_PROVIDER_STRIPE, "evt_ok", "customer.created", payload))
+		assert.Equal(t, int32(1), loadEvent(t, "evt_ok").Attempts)
+	})
+
+	t.Run("failed event is retried with backoff", func(t *testing.T) {
+		// An invoice that cannot be parsed fails every attempt
+		payload := stripeEvent(t, "evt_bad", "invoice.paid", "not an invoice")
+		before := time.Now().UTC()
+		require.NoError(t, receiveWebhookEvent(ctx, db, logger, svc.Config(), svc.Payments(), rbdb.WebhookEvent_PROVIDER_STRIPE, "evt_bad", "invoice.paid", payload))
+
+		eventOrm := loadEvent(t, "evt_bad")
+		assert.Equal(t, int32(rbdb.WebhookEvent_STATUS_PENDING), eventOrm.Status)
+		assert.Equal(t, int32(1), eventOrm.Attempts)
+		assert.NotEmpty(t, eventOrm.LastError)
+		require.NotNil(t, eventOrm.NextAttemptAt)
+		assert.WithinDuration(t, before.Add(webhookRetryDelay(1)), *eventOrm.NextAttemptAt, 5*time.Second)
+
+		// Not due yet
+		attempted, err := retryDueWebhookEvents(ctx, db, logger, svc.Config(), svc.Payments())
+		require.NoError(t, err)
+		assert.Equal(t, 0, attempted)
+
+		makeDue(t, eventOrm, 1)
+		attempted, err = retryDueWebhookEvents(ctx, db, logger, svc.Config(), svc.Payments())
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempted)
+
+		eventOrm = loadEvent(t, "evt_bad")
+		assert.Equal(t, int32(rbdb.WebhookEvent_STATUS_PENDING), eventOrm.Status)
+		assert.Equal(t, int32(2), eventOrm.Attempts)
+		require.NotNil(t, eventOrm.NextAttemptAt)
+		assert.WithinDuration(t, time.Now().UTC().Add(webhookRetryDelay(2)), *eventOrm.NextAttemptAt, 5*time.Second)
+	})
+
+	t.Run("inbox gives up after too many attempts", func(t *testing.T) {
+		makeDue(t, loadEvent(t, "evt_bad"), webhookMaxAttempts-1)
+		_, err := retryDueWebhookEvents(ctx, db, logger, svc.Config(), svc.Payments())
+		require.NoError(t, err)
+
+		eventOrm := loadEvent(t, "evt_bad")
+		assert.Equal(t, int32(rbdb.WebhookEvent_STATUS_FAILED), eventOrm.Status)
+		assert.Equal(t, int32(webhookMaxAttempts), eventOrm.Attempts)
+		assert.Nil(t, eventOrm.NextAttemptAt)
+	})
+
+	t.Run("admin lists failed events", func(t *testing.T) {
+		_, err := svc.AdminListWebhookEvents(ctx, &AdminListWebhookEvents_Input{})
+		assert.Equal(t, errcode.ERR_RESTRICTED_AREA, err)
+
+		out, err := svc.AdminListWebhookEvents(adminCtx, &AdminListWebhookEvents_Input{
+			Status: rbdb.WebhookEvent_STATUS_FAILED,
+		})
+		require.NoError(t, err)
+		require.Len(t, out.Events, 1)
+		assert.Equal(t, "evt_bad", out.Events[0].EventId)
+		assert.Equal(t, rbdb.WebhookEvent_PROVIDER_STRIPE, out.Events[0].Provider)
+	})
+
+	t.Run("admin replays an event", func(t *testing.T) {
+		eventOrm := loadEvent(t, "evt_bad")
+
+		// Still failing: the replay starts a new series of attempts
+		out, err := svc.AdminReplayWebhookEvent(adminCtx, &AdminReplayWebhookEvent_Input{Id: eventOrm.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.WebhookEvent_STATUS_PENDING, out.Event.Status)
+		assert.Equal(t, int32(1), out.Event.Attempts)
+
+		// Once the cause is fixed, the replay processes the event
+		require.NoError(t, db.Model(eventOrm).Update("payload", string(stripeEvent(t, "evt_bad", "invoice.created", map[string]interface{}{}))).Error)
+		out, err = svc.AdminReplayWebhookEvent(adminCtx, &AdminReplayWebhookEvent_Input{Id: eventOrm.Id})
+		require.NoError(t, err)
+		assert.Equal(t, rbdb.WebhookEvent_STATUS_PROCESSED, out.Event.Status)
+		assert.Empty(t, out.Event.LastError)
+
+		_, err = svc.AdminReplayWebhookEvent(adminCtx, &AdminReplayWebhookEvent_Input{Id: 42})
+		assert.Equal(t, int32(errcode.ERR_WEBHOOK_EVENT_NOT_FOUND), errcode.Code(err))
+	})
+}
@@ -0,0 +1,70 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// CreateApiKey records a new personal API key
+func CreateApiKey(db *gorm.DB, keyOrm *ApiKeyORM) error {
+	if err := db.Create(keyOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	return nil
+}
+
+// GetApiKeyByHash finds an API key with its user from the hash of the key
+func GetApiKeyByHash(db *gorm.DB, keyHash string) (*ApiKeyORM, error) {
+	var keyOrm ApiKeyORM
+	err := db.Preload("User").Where(&ApiKeyORM{KeyHash: keyHash}).First(&keyOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_API_KEY_INVALID.Wrap(fmt.Errorf("unknown key"))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &keyOrm, nil
+}
+
+// ListUserApiKeys returns the API keys of a user, newest first
+func ListUserApiKeys(db *gorm.DB, userId int64) ([]*ApiKeyORM, error) {
+	var keysOrm []*ApiKeyORM
+	err := db.Where(&ApiKeyORM{UserId: userId}).Order("created_at DESC, id DESC").Find(&keysOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return keysOrm, nil
+}
+
+// RevokeUserApiKey revokes an API key of a user, revoking a revoked key keeps its first revocation
+func RevokeUserApiKey(db *gorm.DB, userId int64, keyId int64, revokedAt time.Time) (*ApiKeyORM, error) {
+	var keyOrm ApiKeyORM
+	err := db.Where(&ApiKeyORM{Id: keyId, UserId: userId}).First(&keyOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_API_KEY_NOT_FOUND.Wrap(fmt.Errorf("%d", keyId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	if keyOrm.RevokedAt != nil {
+		return &keyOrm, nil
+	}
+
+	keyOrm.RevokedAt = &revokedAt
+	if err := db.Model(&keyOrm).Update("revoked_at", revokedAt).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return &keyOrm, nil
+}
+
+// TouchApiKey records the use of an API key
+func TouchApiKey(db *gorm.DB, keyId int64, usedAt time.Time) error {
+	err := db.Model(&ApiKeyORM{}).Where("id = ?", keyId).Update("last_used_at", usedAt).Error
+	if err != nil {
+		return GormToErrcode(err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package rbdb
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// GetActiveBillingPlan finds the plan new subscriptions of a duration are created with
+func GetActiveBillingPlan(db *gorm.DB, provider Payment_Provider, duration LicenseKey_Duration, sandboxMode bool) (*BillingPlanORM, error) {
+	var planOrm BillingPlanORM
+	// Conditions are explicit since a struct query would skip the false booleans
+	err := db.Where("provider = ? AND duration = ? AND sandbox_mode = ? AND active = ?",
+		int32(provider), int32(duration), sandboxMode, true).
+		Order("id DESC").
+		First(&planOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_PAYMENT_BILLING_PLAN_NOT_FOUND.Wrap(fmt.Errorf("%s plan for %s", provider, duration))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &planOrm, nil
+}
+
+// GetBillingPlanProductID returns the provider product that existing plans are attached to, if any
+func GetBillingPlanProductID(db *gorm.DB, provider Payment_Provider, sandboxMode bool) (string, error) {
+	var planOrm BillingPlanORM
+	err := db.Where("provider = ? AND sandbox_mode = ? AND provider_product_id <> ?", int32(provider), sandboxMode, "").
+		Order("id DESC").
+		First(&planOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return "", nil
+		}
+		return "", GormToErrcode(err)
+	}
+	return planOrm.ProviderProductId, nil
+}
+
+// ActivateBillingPlan records a new plan and deactivates the previous plans of the same duration
+func ActivateBillingPlan(db *gorm.DB, planOrm *BillingPlanORM) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&BillingPlanORM{}).
+			Where("provider = ? AND duration = ? AND sandbox_mode = ? AND active = ?",
+				planOrm.Provider, planOrm.Duration, planOrm.SandboxMode, true).
+			Update("active", false).Error
+		if err != nil {
+			return GormToErrcode(err)
+		}
+
+		planOrm.Active = true
+		return GormToErrcode(tx.Create(planOrm).Error)
+	})
+}
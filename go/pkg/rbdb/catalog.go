@@ -0,0 +1,237 @@
+package rbdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// defaultCatalog is the catalog created on an empty database, with the launch prices
+var defaultCatalog = struct {
+	product ProductORM
+	prices  []PriceORM
+}{
+	product: ProductORM{
+		Name:     "EB2",
+		Tier:     int32(LicenseKey_TIER_PREMIUM),
+		ImageUrl: "https://rslbot.com/images/eb2avatar.png",
+		Active:   true,
+	},
+	prices: []PriceORM{
+		{Duration: int32(LicenseKey_ONE_WEEK), Currency: "eur", AmountInCents: 950, DisplayName: "EB2 - 1-Week License", Active: true},
+		{Duration: int32(LicenseKey_ONE_MONTH), Currency: "eur", AmountInCents: 1900, DisplayName: "EB2 - 1-Month License", Active: true},
+		{Duration: int32(LicenseKey_SIX_MONTHS), Currency: "eur", AmountInCents: 7900, DisplayName: "EB2 - 6-Month License", Active: true},
+		{Duration: int32(LicenseKey_ONE_YEAR), Currency: "eur", AmountInCents: 12900, DisplayName: "EB2 - 1-Year License", Active: true},
+		{Duration: int32(LicenseKey_LIFETIME), Currency: "eur", AmountInCents: 19900, DisplayName: "EB2 - Lifetime License", Active: true},
+	},
+}
+
+// seedCatalog creates the default catalog when there is no product yet
+func seedCatalog(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&ProductORM{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		product := defaultCatalog.product
+		if err := tx.Create(&product).Error; err != nil {
+			return err
+		}
+		for _, price := range defaultCatalog.prices {
+			price.ProductId = product.Id
+			if err := tx.Create(&price).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetProduct finds a product from its ID
+func GetProduct(db *gorm.DB, id int64) (*ProductORM, error) {
+	var productOrm ProductORM
+	err := db.Where(&ProductORM{Id: id}).First(&productOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_CATALOG_PRODUCT_NOT_FOUND.Wrap(fmt.Errorf("product %d", id))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &productOrm, nil
+}
+
+// ListProducts returns all the products, inactive ones included
+func ListProducts(db *gorm.DB) ([]*ProductORM, error) {
+	var productsOrm []*ProductORM
+	if err := db.Order("id").Find(&productsOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return productsOrm, nil
+}
+
+// SaveProduct validates then creates or updates a product, all the fields of an updated product are replaced
+func SaveProduct(db *gorm.DB, productOrm *ProductORM) error {
+	if strings.TrimSpace(productOrm.Name) == "" {
+		return errcode.ERR_CATALOG_PRODUCT_INVALID.Wrap(fmt.Errorf("missing name"))
+	}
+	if productOrm.Tier == int32(LicenseKey_TIER_UNSPECIFIED) {
+		return errcode.ERR_CATALOG_PRODUCT_INVALID.Wrap(fmt.Errorf("missing tier"))
+	}
+	if productOrm.Id != 0 {
+		existing, err := GetProduct(db, productOrm.Id)
+		if err != nil {
+			return err
+		}
+		productOrm.CreatedAt = existing.CreatedAt
+	}
+	return GormToErrcode(db.Save(productOrm).Error)
+}
+
+// DeleteProduct deletes a product without prices, products with prices can be deactivated instead
+func DeleteProduct(db *gorm.DB, id int64) error {
+	productOrm, err := GetProduct(db, id)
+	if err != nil {
+		return err
+	}
+
+	var prices int64
+	if err := db.Model(&PriceORM{}).Where("product_id = ?", id).Count(&prices).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	if prices > 0 {
+		return errcode.ERR_CATALOG_PRODUCT_HAS_PRICES.Wrap(fmt.Errorf("product %d has %d prices", id, prices))
+	}
+	return GormToErrcode(db.Delete(productOrm).Error)
+}
+
+// GetPrice finds a price from its ID, with its product
+func GetPrice(db *gorm.DB, id int64) (*PriceORM, error) {
+	var priceOrm PriceORM
+	err := db.Preload("Product").Where(&PriceORM{Id: id}).First(&priceOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_CATALOG_PRICE_NOT_FOUND.Wrap(fmt.Errorf("price %d", id))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &priceOrm, nil
+}
+
+// ListPrices returns all the prices with their product, inactive and expired ones included
+func ListPrices(db *gorm.DB) ([]*PriceORM, error) {
+	var pricesOrm []*PriceORM
+	if err := db.Preload("Product").Order("product_id, duration, currency, id").Find(&pricesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return pricesOrm, nil
+}
+
+// SavePrice validates then creates or updates a price, all the fields of an updated price are replaced
+// The currency is stored lowercase
+func SavePrice(db *gorm.DB, priceOrm *PriceORM) error {
+	priceOrm.Currency = NormalizeCurrency(priceOrm.Currency)
+	switch {
+	case priceOrm.Duration == int32(LicenseKey_UNSPECIFIED):
+		return errcode.ERR_CATALOG_PRICE_INVALID.Wrap(fmt.Errorf("missing duration"))
+	case len(priceOrm.Currency) != 3:
+		return errcode.ERR_CATALOG_PRICE_INVALID.Wrap(fmt.Errorf("invalid currency %q", priceOrm.Currency))
+	case priceOrm.AmountInCents <= 0:
+		return errcode.ERR_CATALOG_PRICE_INVALID.Wrap(fmt.Errorf("invalid amount %d", priceOrm.AmountInCents))
+	case strings.TrimSpace(priceOrm.DisplayName) == "":
+		return errcode.ERR_CATALOG_PRICE_INVALID.Wrap(fmt.Errorf("missing display name"))
+	case priceOrm.ValidFrom != nil && priceOrm.ValidUntil != nil && !priceOrm.ValidUntil.After(*priceOrm.ValidFrom):
+		return errcode.ERR_CATALOG_PRICE_INVALID.Wrap(fmt.Errorf("empty validity window"))
+	}
+
+	productOrm, err := GetProduct(db, priceOrm.ProductId)
+	if err != nil {
+		return err
+	}
+	if priceOrm.Id != 0 {
+		existing, err := GetPrice(db, priceOrm.Id)
+		if err != nil {
+			return err
+		}
+		priceOrm.CreatedAt = existing.CreatedAt
+	}
+
+	// The product is only set to be returned, it is not saved with the price
+	priceOrm.Product = nil
+	if err := db.Save(priceOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	priceOrm.Product = productOrm
+	return nil
+}
+
+// DeletePrice deletes a price
+func DeletePrice(db *gorm.DB, id int64) error {
+	priceOrm, err := GetPrice(db, id)
+	if err != nil {
+		return err
+	}
+	return GormToErrcode(db.Delete(&PriceORM{Id: priceOrm.Id}).Error)
+}
+
+// activePrices filters the prices of active products that are active and valid at a time
+func activePrices(db *gorm.DB, at time.Time) *gorm.DB {
+	return db.
+		Joins("Product").
+		Where("prices.active = ? AND Product.active = ?", true, true).
+		Where("(prices.valid_from IS NULL OR prices.valid_from <= ?) AND (prices.valid_until IS NULL OR prices.valid_until > ?)", at, at)
+}
+
+// FindActivePrice returns the price to pay for a license tier and duration in a currency
+// When several prices are valid, the one whose validity started last wins, so limited offers override the regular price
+func FindActivePrice(db *gorm.DB, tier LicenseKey_Tier, duration LicenseKey_Duration, currency string, at time.Time) (*PriceORM, error) {
+	var priceOrm PriceORM
+	err := activePrices(db, at).
+		Where("Product.tier = ? AND prices.duration = ? AND prices.currency = ?", int32(tier), int32(duration), strings.ToLower(currency)).
+		Order("prices.valid_from DESC, prices.id DESC").
+		First(&priceOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_CATALOG_PRICE_NOT_FOUND.Wrap(fmt.Errorf("no %s price for %s %s", currency, tier, duration))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &priceOrm, nil
+}
+
+// ListActivePrices returns the prices that can be bought at a time, an empty currency returns all of them
+func ListActivePrices(db *gorm.DB, currency string, at time.Time) ([]*PriceORM, error) {
+	query := activePrices(db, at)
+	if currency != "" {
+		query = query.Where("prices.currency = ?", strings.ToLower(currency))
+	}
+
+	var pricesOrm []*PriceORM
+	if err := query.Order("Product.id, prices.duration, prices.currency, prices.valid_from DESC, prices.id DESC").Find(&pricesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+
+	// Only keep the price that wins for each product, duration and currency, see FindActivePrice
+	type priceKey struct {
+		productId int64
+		duration  int32
+		currency  string
+	}
+	seen := map[priceKey]bool{}
+	filtered := []*PriceORM{}
+	for _, priceOrm := range pricesOrm {
+		key := priceKey{priceOrm.ProductId, priceOrm.Duration, priceOrm.Currency}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		filtered = append(filtered, priceOrm)
+	}
+	return filtered, nil
+}
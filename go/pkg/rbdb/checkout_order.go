@@ -0,0 +1,138 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// CreateCheckoutOrder records an order created on the provider side, waiting for the buyer approval
+func CreateCheckoutOrder(db *gorm.DB, orderOrm *CheckoutOrderORM) error {
+	orderOrm.Status = int32(CheckoutOrder_STATUS_CREATED)
+	return GormToErrcode(db.Create(orderOrm).Error)
+}
+
+// GetCheckoutOrderByProviderID finds an order from its ID on the provider side
+func GetCheckoutOrderByProviderID(db *gorm.DB, provider Payment_Provider, providerOrderId string) (*CheckoutOrderORM, error) {
+	var orderOrm CheckoutOrderORM
+	err := db.Where(&CheckoutOrderORM{Provider: int32(provider), ProviderOrderId: providerOrderId}).First(&orderOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND.Wrap(fmt.Errorf("provider order %s", providerOrderId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &orderOrm, nil
+}
+
+// IsCheckoutOrderFinal reports if nothing is left to do for an order
+func IsCheckoutOrderFinal(status CheckoutOrder_Status) bool {
+	switch status {
+	case CheckoutOrder_STATUS_COMPLETED, CheckoutOrder_STATUS_VOIDED, CheckoutOrder_STATUS_FAILED:
+		return true
+	default:
+		return false
+	}
+}
+
+// AdvanceCheckoutOrder moves an order to a later status, a non-empty capture ID is recorded with it
+// Final orders and moves backwards are ignored, as webhooks and the reconciliation can race.
+// The boolean is false when the order was not changed, including orders created before they were recorded
+func AdvanceCheckoutOrder(db *gorm.DB, provider Payment_Provider, providerOrderId string, status CheckoutOrder_Status, captureId string, at time.Time) (bool, error) {
+	orderOrm, err := GetCheckoutOrderByProviderID(db, provider, providerOrderId)
+	if errcode.Code(err) == int32(errcode.ERR_PAYMENT_CHECKOUT_ORDER_NOT_FOUND) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	current := CheckoutOrder_Status(orderOrm.Status)
+	if IsCheckoutOrderFinal(current) || (!IsCheckoutOrderFinal(status) && status <= current) {
+		return false, nil
+	}
+
+	orderOrm.Status = int32(status)
+	if captureId != "" {
+		orderOrm.CaptureId = captureId
+	}
+	if status == CheckoutOrder_STATUS_COMPLETED {
+		orderOrm.CompletedAt = &at
+	}
+	orderOrm.LastError = ""
+	if err := db.Save(orderOrm).Error; err != nil {
+		return false, GormToErrcode(err)
+	}
+	return true, nil
+}
+
+// RecordCheckoutOrderCheck records a reconciliation of an order, a failed one counts as an attempt
+func RecordCheckoutOrderCheck(db *gorm.DB, orderOrm *CheckoutOrderORM, checkErr error, checkedAt time.Time) error {
+	updates := map[string]interface{}{
+		"last_checked_at": checkedAt,
+	}
+	if checkErr != nil {
+		updates["reconcile_attempts"] = gorm.Expr("reconcile_attempts + 1")
+		updates["last_error"] = checkErr.Error()
+	}
+	return GormToErrcode(db.Model(orderOrm).Updates(updates).Error)
+}
+
+// pendingCheckoutOrderStatuses are the statuses of orders that may still need an action
+var pendingCheckoutOrderStatuses = []int32{
+	int32(CheckoutOrder_STATUS_CREATED),
+	int32(CheckoutOrder_STATUS_APPROVED),
+	int32(CheckoutOrder_STATUS_CAPTURED),
+}
+
+// ListStaleCheckoutOrders returns the pending orders of a provider that were not updated nor checked since olderThan
+// Sandbox orders are only visible with sandbox credentials, so they are listed apart from live ones
+func ListStaleCheckoutOrders(db *gorm.DB, provider Payment_Provider, sandboxMode bool, olderThan time.Time, limit int) ([]*CheckoutOrderORM, error) {
+	var ordersOrm []*CheckoutOrderORM
+	err := db.
+		Where("provider = ? AND sandbox_mode = ? AND status IN ? AND updated_at < ?", int32(provider), sandboxMode, pendingCheckoutOrderStatuses, olderThan).
+		Order("updated_at").
+		Limit(limit).
+		Find(&ordersOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return ordersOrm, nil
+}
+
+// CheckoutOrderStatusCount is the number of orders in a status
+type CheckoutOrderStatusCount struct {
+	Status int32
+	Count  int32
+}
+
+// CountStuckCheckoutOrders counts the pending orders created before olderThan, by status
+func CountStuckCheckoutOrders(db *gorm.DB, olderThan time.Time) ([]*CheckoutOrderStatusCount, error) {
+	var counts []*CheckoutOrderStatusCount
+	err := db.Model(&CheckoutOrderORM{}).
+		Select("status, COUNT(*) AS count").
+		Where("status IN ? AND created_at < ?", pendingCheckoutOrderStatuses, olderThan).
+		Group("status").
+		Order("status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return counts, nil
+}
+
+// ListStuckCheckoutOrders returns the pending orders created before olderThan, oldest first
+func ListStuckCheckoutOrders(db *gorm.DB, olderThan time.Time, limit int) ([]*CheckoutOrderORM, error) {
+	var ordersOrm []*CheckoutOrderORM
+	err := db.
+		Where("status IN ? AND created_at < ?", pendingCheckoutOrderStatuses, olderThan).
+		Order("created_at").
+		Limit(limit).
+		Find(&ordersOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return ordersOrm, nil
+}
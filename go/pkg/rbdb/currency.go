@@ -0,0 +1,83 @@
+package rbdb
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// BaseCurrency is the currency revenue is normalized to, exchange rates are relative to it
+const BaseCurrency = "eur"
+
+// zeroDecimalCurrencies are the currencies without minor unit, their amounts in cents are whole units
+var zeroDecimalCurrencies = map[string]bool{
+	"jpy": true,
+	"krw": true,
+}
+
+// NormalizeCurrency returns the form currencies are stored in: payments, prices and exchange rates use lower-case ISO 4217 codes
+// Providers don't agree on the case, Stripe sends "eur" and PayPal "EUR"
+func NormalizeCurrency(currency string) string {
+	return strings.ToLower(strings.TrimSpace(currency))
+}
+
+// CurrencyExponent returns the number of decimals of a currency, amounts in cents are in this minor unit
+func CurrencyExponent(currency string) int {
+	if zeroDecimalCurrencies[strings.ToLower(currency)] {
+		return 0
+	}
+	return 2
+}
+
+// SetExchangeRate creates or updates the exchange rate of a currency
+func SetExchangeRate(db *gorm.DB, currency string, rate float64) (*ExchangeRateORM, error) {
+	currency = NormalizeCurrency(currency)
+	switch {
+	case len(currency) != 3:
+		return nil, errcode.ERR_PAYMENT_EXCHANGE_RATE_INVALID.Wrap(fmt.Errorf("invalid currency %q", currency))
+	case currency == BaseCurrency:
+		return nil, errcode.ERR_PAYMENT_EXCHANGE_RATE_INVALID.Wrap(fmt.Errorf("%s is the base currency", currency))
+	case rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate):
+		return nil, errcode.ERR_PAYMENT_EXCHANGE_RATE_INVALID.Wrap(fmt.Errorf("invalid rate %v", rate))
+	}
+
+	var rateOrm ExchangeRateORM
+	err := db.Where(&ExchangeRateORM{Currency: currency}).First(&rateOrm).Error
+	if err != nil && !IsRecordNotFoundError(err) {
+		return nil, GormToErrcode(err)
+	}
+	rateOrm.Currency = currency
+	rateOrm.Rate = rate
+	if err := db.Save(&rateOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return &rateOrm, nil
+}
+
+// ListExchangeRates returns the exchange rates of all the currencies
+func ListExchangeRates(db *gorm.DB) ([]*ExchangeRateORM, error) {
+	var ratesOrm []*ExchangeRateORM
+	if err := db.Order("currency").Find(&ratesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return ratesOrm, nil
+}
+
+// ConvertToBaseCurrency converts an amount in cents of a currency to cents of the base currency
+// rates maps lowercase currencies to their exchange rate, false is returned when the currency has none
+func ConvertToBaseCurrency(amountInCents int64, currency string, rates map[string]float64) (int64, bool) {
+	currency = strings.ToLower(currency)
+	if currency == BaseCurrency {
+		return amountInCents, true
+	}
+	rate, ok := rates[currency]
+	if !ok || rate <= 0 {
+		return 0, false
+	}
+
+	units := float64(amountInCents) / math.Pow10(CurrencyExponent(currency))
+	return int64(math.Round(units / rate * math.Pow10(CurrencyExponent(BaseCurrency)))), true
+}
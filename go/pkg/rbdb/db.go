@@ -23,6 +23,21 @@ var Models = []interface{}{
 	&PaymentORM{},
 	&SubscriptionORM{},
 	&OffsetORM{},
+	&WebhookEventORM{},
+	&BillingPlanORM{},
+	&CheckoutOrderORM{},
+	&ProductORM{},
+	&PriceORM{},
+	&ExchangeRateORM{},
+	&TaxRateORM{},
+	&InvoiceORM{},
+	&InvoiceSequenceORM{},
+	&ReferralCodeORM{},
+	&CommissionORM{},
+	&RefundRequestORM{},
+	&ApiKeyORM{},
+	&UserRoleORM{},
+	&ImpersonationAuditORM{},
 }
 
 type DBConfig struct {
@@ -81,11 +96,52 @@ func configureDB(ctx context.Context, db *gorm.DB, sfn *snowflake.Node) error {
 		return errcode.ERR_DB_ADD_CALLBACK.Wrap(err)
 	}
 
+	// Rename legacy columns before AutoMigrate creates their replacements
+	if err := renameLegacyColumns(db); err != nil {
+		return errcode.ERR_DB_AUTO_MIGRATE.Wrap(err)
+	}
+
 	// Run migrations
 	if err := db.AutoMigrate(Models...); err != nil {
 		return errcode.ERR_DB_AUTO_MIGRATE.Wrap(err)
 	}
 
+	// Fill the columns added to existing tables
+	if err := backfillPaymentStatus(db); err != nil {
+		return errcode.ERR_DB_AUTO_MIGRATE.Wrap(err)
+	}
+	if err := backfillWebhookEventStatus(db); err != nil {
+		return errcode.ERR_DB_AUTO_MIGRATE.Wrap(err)
+	}
+
+	// Start with the launch prices, they are managed by admins afterwards
+	if err := seedCatalog(db); err != nil {
+		return errcode.ERR_DB_AUTO_MIGRATE.Wrap(err)
+	}
+
+	return nil
+}
+
+// renameLegacyColumns renames columns whose field was renamed in rbdb.proto, so existing data is kept
+func renameLegacyColumns(db *gorm.DB) error {
+	renames := []struct {
+		model   interface{}
+		oldName string
+		newName string
+	}{
+		{&SubscriptionORM{}, "stripe_subscription_id", "provider_subscription_id"},
+		{&SubscriptionORM{}, "stripe_customer_id", "provider_customer_id"},
+	}
+
+	migrator := db.Migrator()
+	for _, rename := range renames {
+		if !migrator.HasColumn(rename.model, rename.oldName) || migrator.HasColumn(rename.model, rename.newName) {
+			continue
+		}
+		if err := migrator.RenameColumn(rename.model, rename.oldName, rename.newName); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,29 @@
+package rbdb
+
+import (
+	"gorm.io/gorm"
+)
+
+// CreateImpersonationAudit records a call made by an admin as another user
+func CreateImpersonationAudit(db *gorm.DB, audit *ImpersonationAuditORM) error {
+	if err := db.Create(audit).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	return nil
+}
+
+// ListImpersonationAudits returns the newest audit entries with their user, filtered by user and admin when set
+func ListImpersonationAudits(db *gorm.DB, userId int64, adminUsername string, limit int) ([]*ImpersonationAuditORM, error) {
+	var auditsOrm []*ImpersonationAuditORM
+	query := db.Preload("User").Order("id DESC").Limit(limit)
+	if userId != 0 {
+		query = query.Where(&ImpersonationAuditORM{UserId: userId})
+	}
+	if adminUsername != "" {
+		query = query.Where(&ImpersonationAuditORM{AdminUsername: adminUsername})
+	}
+	if err := query.Find(&auditsOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return auditsOrm, nil
+}
@@ -0,0 +1,208 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// Invoice number prefixes, each year starts a new series
+const (
+	invoiceSeriesPrefix    = "INV"
+	creditNoteSeriesPrefix = "CN"
+)
+
+// invoiceDurationLabels name the license durations on the invoice lines
+var invoiceDurationLabels = map[LicenseKey_Duration]string{
+	LicenseKey_LIFETIME:   "Lifetime",
+	LicenseKey_ONE_WEEK:   "1-Week",
+	LicenseKey_ONE_MONTH:  "1-Month",
+	LicenseKey_SIX_MONTHS: "6-Month",
+	LicenseKey_ONE_YEAR:   "1-Year",
+}
+
+// allocateInvoiceNumber takes the next number of a series, the caller's transaction keeps the series gap-free:
+// the number is only consumed if the invoice using it is committed with it
+// Invoices are issued in the transactions delivering licenses, so allocating never fails on a concurrent allocation:
+// the series row is created if missing without conflict error, then the increment locks it until the transaction ends
+func allocateInvoiceNumber(tx *gorm.DB, prefix string, at time.Time) (string, error) {
+	series := fmt.Sprintf("%s-%d", prefix, at.Year())
+
+	// A concurrent creation of the first number waits for the other transaction, then does nothing
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&InvoiceSequenceORM{Series: series}).Error
+	if err != nil {
+		return "", errcode.ERR_INVOICE_NUMBER_ALLOCATION.Wrap(err)
+	}
+
+	result := tx.Model(&InvoiceSequenceORM{}).
+		Where("series = ?", series).
+		Update("last_number", gorm.Expr("last_number + 1"))
+	if result.Error != nil {
+		return "", errcode.ERR_INVOICE_NUMBER_ALLOCATION.Wrap(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return "", errcode.ERR_INVOICE_NUMBER_ALLOCATION.Wrap(fmt.Errorf("series %s is missing", series))
+	}
+
+	var sequenceOrm InvoiceSequenceORM
+	if err := tx.Where(&InvoiceSequenceORM{Series: series}).First(&sequenceOrm).Error; err != nil {
+		return "", errcode.ERR_INVOICE_NUMBER_ALLOCATION.Wrap(err)
+	}
+	return fmt.Sprintf("%s-%06d", series, sequenceOrm.LastNumber), nil
+}
+
+// invoiceDescription describes what a payment paid for
+func invoiceDescription(paymentOrm *PaymentORM) string {
+	label, ok := invoiceDurationLabels[LicenseKey_Duration(paymentOrm.LicenseDuration)]
+	if !ok {
+		label = "RSL Bot"
+	}
+	if paymentOrm.IsRenewal {
+		return label + " License Renewal"
+	}
+	return label + " License"
+}
+
+// IssueInvoiceTx issues the invoice of a completed payment, in the transaction completing it
+// Manual and free payments are not invoiced, nil is then returned, as when the invoice was already issued
+func IssueInvoiceTx(tx *gorm.DB, paymentId int64, issuedAt time.Time) (*InvoiceORM, error) {
+	var paymentOrm PaymentORM
+	if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return issueInvoiceTx(tx, &paymentOrm, issuedAt)
+}
+
+func issueInvoiceTx(tx *gorm.DB, paymentOrm *PaymentORM, issuedAt time.Time) (*InvoiceORM, error) {
+	if Payment_Provider(paymentOrm.Provider) == Payment_PROVIDER_MANUAL || paymentOrm.AmountInCents <= 0 {
+		return nil, nil
+	}
+	switch Payment_Status(paymentOrm.Status) {
+	case Payment_STATUS_COMPLETED, Payment_STATUS_REFUNDED, Payment_STATUS_PARTIALLY_REFUNDED:
+	default:
+		return nil, nil
+	}
+
+	// A payment is only invoiced once
+	_, err := GetPaymentInvoice(tx, paymentOrm.Id)
+	if err == nil {
+		return nil, nil
+	}
+	if errcode.Code(err) != int32(errcode.ERR_INVOICE_NOT_FOUND) {
+		return nil, err
+	}
+
+	number, err := allocateInvoiceNumber(tx, invoiceSeriesPrefix, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+	invoiceOrm := &InvoiceORM{
+		Kind:          int32(Invoice_KIND_INVOICE),
+		Number:        number,
+		IssuedAt:      &issuedAt,
+		BillingName:   paymentOrm.BillingName,
+		BillingEmail:  paymentOrm.BillingEmail,
+		Description:   invoiceDescription(paymentOrm),
+		AmountInCents: paymentOrm.AmountInCents,
+		Currency:      paymentOrm.Currency,
+		PaymentId:     paymentOrm.Id,
+		UserId:        paymentOrm.UserId,
+	}
+	if err := tx.Create(invoiceOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return invoiceOrm, nil
+}
+
+// issueCreditNoteTx issues the credit note of a refund, in the transaction recording the refund
+// Payments without invoice, recorded before invoicing, get no credit note
+func issueCreditNoteTx(tx *gorm.DB, paymentOrm *PaymentORM, refundId string, amountInCents int64, issuedAt time.Time) (*InvoiceORM, error) {
+	invoiceOrm, err := GetPaymentInvoice(tx, paymentOrm.Id)
+	if errcode.Code(err) == int32(errcode.ERR_INVOICE_NOT_FOUND) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := allocateInvoiceNumber(tx, creditNoteSeriesPrefix, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+	creditNoteOrm := &InvoiceORM{
+		Kind:              int32(Invoice_KIND_CREDIT_NOTE),
+		Number:            number,
+		IssuedAt:          &issuedAt,
+		BillingName:       invoiceOrm.BillingName,
+		BillingEmail:      invoiceOrm.BillingEmail,
+		Description:       "Refund of " + invoiceOrm.Description,
+		AmountInCents:     amountInCents,
+		Currency:          invoiceOrm.Currency,
+		RefundId:          refundId,
+		PaymentId:         paymentOrm.Id,
+		UserId:            paymentOrm.UserId,
+		CreditedInvoiceId: invoiceOrm.Id,
+	}
+	if err := tx.Create(creditNoteOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return creditNoteOrm, nil
+}
+
+// GetInvoice finds an invoice or a credit note from its ID
+func GetInvoice(db *gorm.DB, id int64) (*InvoiceORM, error) {
+	var invoiceOrm InvoiceORM
+	if err := db.Where(&InvoiceORM{Id: id}).First(&invoiceOrm).Error; err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_INVOICE_NOT_FOUND.Wrap(fmt.Errorf("invoice %d", id))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &invoiceOrm, nil
+}
+
+// GetPaymentInvoice finds the invoice of a payment
+func GetPaymentInvoice(db *gorm.DB, paymentId int64) (*InvoiceORM, error) {
+	var invoiceOrm InvoiceORM
+	err := db.Where(&InvoiceORM{PaymentId: paymentId, Kind: int32(Invoice_KIND_INVOICE)}).First(&invoiceOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_INVOICE_NOT_FOUND.Wrap(fmt.Errorf("payment %d", paymentId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &invoiceOrm, nil
+}
+
+// ListPaymentInvoices returns the invoice and the credit notes of a payment, in issuing order
+func ListPaymentInvoices(db *gorm.DB, paymentId int64) ([]*InvoiceORM, error) {
+	var invoicesOrm []*InvoiceORM
+	if err := db.Where(&InvoiceORM{PaymentId: paymentId}).Order("issued_at, id").Find(&invoicesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return invoicesOrm, nil
+}
+
+// ListCreditNotes returns the credit notes correcting an invoice, in issuing order
+func ListCreditNotes(db *gorm.DB, invoiceId int64) ([]*InvoiceORM, error) {
+	var creditNotesOrm []*InvoiceORM
+	if err := db.Where(&InvoiceORM{CreditedInvoiceId: invoiceId}).Order("issued_at, id").Find(&creditNotesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return creditNotesOrm, nil
+}
+
+// SaveInvoicePDF stores the rendered document of an invoice
+func SaveInvoicePDF(db *gorm.DB, invoiceOrm *InvoiceORM, pdf []byte, renderedAt time.Time) error {
+	invoiceOrm.Pdf = pdf
+	invoiceOrm.RenderedAt = &renderedAt
+	err := db.Model(invoiceOrm).Updates(map[string]interface{}{
+		"pdf":         pdf,
+		"rendered_at": renderedAt,
+	}).Error
+	return GormToErrcode(err)
+}
@@ -56,28 +56,46 @@ func MapToClientLicenseType(duration LicenseKey_Duration, tier LicenseKey_Tier)
 	}
 }
 
-// IsLicenseExpired checks if a license is expired based on its duration and effective date
-func IsLicenseExpired(license *LicenseKey) bool {
-	// If EffectiveFrom is not set, license hasn't been activated yet, so not expired
+// LicenseExpiresAt returns the end of a license period, without grace period
+// The boolean is false for licenses that never expire or have not been activated yet
+func LicenseExpiresAt(license *LicenseKey) (time.Time, bool) {
+	// If EffectiveFrom is not set, license hasn't been activated yet
 	if license.EffectiveFrom == nil {
-		return false
+		return time.Time{}, false
 	}
 
-	// Calculate expiration based on duration
-	var expiration time.Time
-	switch license.Duration {
-	case LicenseKey_LIFETIME:
-		return false
+	return LicensePeriodEnd(license.EffectiveFrom.AsTime(), license.Duration)
+}
+
+// LicensePeriodEnd returns the end of a license period starting at the given time
+// The boolean is false for durations that never expire
+func LicensePeriodEnd(from time.Time, duration LicenseKey_Duration) (time.Time, bool) {
+	switch duration {
 	case LicenseKey_ONE_WEEK:
-		expiration = license.EffectiveFrom.AsTime().AddDate(0, 0, 7)
+		return from.AddDate(0, 0, 7), true
 	case LicenseKey_ONE_MONTH:
-		expiration = license.EffectiveFrom.AsTime().AddDate(0, 1, 0)
+		return from.AddDate(0, 1, 0), true
 	case LicenseKey_SIX_MONTHS:
-		expiration = license.EffectiveFrom.AsTime().AddDate(0, 6, 0)
+		return from.AddDate(0, 6, 0), true
 	case LicenseKey_ONE_YEAR:
-		expiration = license.EffectiveFrom.AsTime().AddDate(1, 0, 0)
+		return from.AddDate(1, 0, 0), true
 	default:
-		return true
+		return time.Time{}, false
+	}
+}
+
+// IsLicenseExpired checks if a license is expired based on its duration and effective date
+func IsLicenseExpired(license *LicenseKey) bool {
+	// If EffectiveFrom is not set, license hasn't been activated yet, so not expired
+	if license.EffectiveFrom == nil {
+		return false
+	}
+
+	// Calculate expiration based on duration
+	expiration, ok := LicenseExpiresAt(license)
+	if !ok {
+		// Lifetime licenses never expire, unknown durations are considered expired
+		return license.Duration != LicenseKey_LIFETIME
 	}
 
 	// Check if current time is after expiration + grace period
@@ -342,8 +360,12 @@ func RenewLicense(db *gorm.DB, licenseKeyId int64, userId int64, paymentId int64
 			return err
 		}
 
-		// Update effective_from date to now
+		// Update effective_from date to now, or to the current expiration when a
+		// subscription renews a license that is still running so no paid time is lost
 		licenseKey.EffectiveFrom = timestamppb.Now()
+		if expiration, ok := LicenseExpiresAt(&licenseKey); ok && expiration.After(time.Now()) {
+			licenseKey.EffectiveFrom = timestamppb.New(expiration)
+		}
 		_, err = DefaultStrictUpdateLicenseKey(context.Background(), &licenseKey, tx)
 		if err != nil {
 			return err
@@ -0,0 +1,45 @@
+package rbdb
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// GetUserPayment finds a payment of a user with its license, payments of other users are not found
+func GetUserPayment(db *gorm.DB, userId int64, paymentId int64) (*PaymentORM, error) {
+	var paymentOrm PaymentORM
+	err := db.Preload("LicenseKey").Where(&PaymentORM{Id: paymentId, UserId: userId}).First(&paymentOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_PAYMENT_NOT_FOUND.Wrap(fmt.Errorf("payment %d of user %d", paymentId, userId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &paymentOrm, nil
+}
+
+// ListUserPayments returns a page of the payments of a user with their license, latest first
+// beforeId continues after the last payment of the previous page, 0 starts from the latest
+func ListUserPayments(db *gorm.DB, userId int64, beforeId int64, limit int) ([]*PaymentORM, error) {
+	query := db.Preload("LicenseKey").Where(&PaymentORM{UserId: userId})
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	var paymentsOrm []*PaymentORM
+	if err := query.Order("id DESC").Limit(limit).Find(&paymentsOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return paymentsOrm, nil
+}
+
+// CountUserPayments counts all the payments of a user
+func CountUserPayments(db *gorm.DB, userId int64) (int64, error) {
+	var count int64
+	if err := db.Model(&PaymentORM{}).Where(&PaymentORM{UserId: userId}).Count(&count).Error; err != nil {
+		return 0, GormToErrcode(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,127 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// SetPaymentStatus sets the status of a payment that is not created yet, with the timestamp of the transition
+func SetPaymentStatus(payment *Payment, status Payment_Status, at time.Time) {
+	payment.Status = status
+	switch status {
+	case Payment_STATUS_COMPLETED:
+		payment.CompletedAt = timestamppb.New(at)
+	case Payment_STATUS_FAILED:
+		payment.FailedAt = timestamppb.New(at)
+	case Payment_STATUS_REFUNDED, Payment_STATUS_PARTIALLY_REFUNDED:
+		payment.RefundedAt = timestamppb.New(at)
+	}
+}
+
+// setPaymentStatus is the ORM counterpart of SetPaymentStatus, the caller saves the payment
+func setPaymentStatus(paymentOrm *PaymentORM, status Payment_Status, at time.Time) {
+	paymentOrm.Status = int32(status)
+	switch status {
+	case Payment_STATUS_COMPLETED:
+		paymentOrm.CompletedAt = &at
+	case Payment_STATUS_FAILED:
+		paymentOrm.FailedAt = &at
+	case Payment_STATUS_REFUNDED, Payment_STATUS_PARTIALLY_REFUNDED:
+		paymentOrm.RefundedAt = &at
+	}
+}
+
+// CompletePayment marks a pending payment as completed, once the provider released the funds
+// The boolean is false when the payment was not pending
+func CompletePayment(db *gorm.DB, paymentId int64, completedAt time.Time) (*PaymentORM, bool, error) {
+	var paymentOrm PaymentORM
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		if Payment_Status(paymentOrm.Status) != Payment_STATUS_PENDING {
+			return nil
+		}
+
+		setPaymentStatus(&paymentOrm, Payment_STATUS_COMPLETED, completedAt)
+		if err := tx.Save(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		applied = true
+
+		// Pending payments are invoiced once the funds are released
+		_, err := issueInvoiceTx(tx, &paymentOrm, completedAt)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &paymentOrm, applied, nil
+}
+
+// FailPayment marks a payment as failed and takes back the whole license time it paid for
+// It is used when a provider finally declines a payment that was delivered while pending.
+// The boolean is false when the payment already failed or was refunded
+func FailPayment(db *gorm.DB, paymentId int64, reason string, failedAt time.Time) (*PaymentORM, bool, error) {
+	var paymentOrm PaymentORM
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		switch Payment_Status(paymentOrm.Status) {
+		case Payment_STATUS_FAILED, Payment_STATUS_REFUNDED:
+			return nil
+		}
+
+		setPaymentStatus(&paymentOrm, Payment_STATUS_FAILED, failedAt)
+		if err := tx.Save(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		details := fmt.Sprintf("reason=%s", reason)
+		if err := createPaymentActivity(tx, &paymentOrm, Activity_KIND_PAYMENT_FAILED, details); err != nil {
+			return err
+		}
+		applied = true
+
+		return takeBackLicenseTime(tx, &paymentOrm, paymentOrm.AmountInCents-paymentOrm.RefundedInCents, true, details)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &paymentOrm, applied, nil
+}
+
+// backfillPaymentStatus sets the status of payments recorded before the status column existed
+// Those payments were all delivered, so they are completed unless refunds were recorded for them
+// Only completed payments get a completion time, the refund times of the others are unknown
+func backfillPaymentStatus(db *gorm.DB) error {
+	backfills := []struct {
+		status Payment_Status
+		where  string
+	}{
+		{Payment_STATUS_REFUNDED, "amount_in_cents > 0 AND refunded_in_cents >= amount_in_cents"},
+		{Payment_STATUS_PARTIALLY_REFUNDED, "refunded_in_cents > 0"},
+		{Payment_STATUS_COMPLETED, "1 = 1"},
+	}
+
+	for _, backfill := range backfills {
+		updates := map[string]interface{}{"status": int32(backfill.status)}
+		if backfill.status == Payment_STATUS_COMPLETED {
+			updates["completed_at"] = gorm.Expr("created_at")
+		}
+		err := db.Model(&PaymentORM{}).
+			Where("status = ?", int32(Payment_STATUS_UNSPECIFIED)).
+			Where(backfill.where).
+			Updates(updates).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -37,6 +37,21 @@ const (
 	Activity_KIND_SUBSCRIPTION_PAYMENT_FAILED Activity_Kind = 9
 	Activity_KIND_ADMIN_LICENSE_CREATION      Activity_Kind = 10
 	Activity_KIND_ADMIN_LICENSE_REVOCATION    Activity_Kind = 11
+	Activity_KIND_USER_UPDATED                Activity_Kind = 12
+	Activity_KIND_USER_ANONYMIZED             Activity_Kind = 13
+	Activity_KIND_USER_ADDED_TO_GROUP         Activity_Kind = 14
+	Activity_KIND_USER_REMOVED_FROM_GROUP     Activity_Kind = 15
+	Activity_KIND_PAYMENT_REFUNDED            Activity_Kind = 16
+	Activity_KIND_PAYMENT_DISPUTED            Activity_Kind = 17
+	Activity_KIND_PAYMENT_DISPUTE_RESOLVED    Activity_Kind = 18
+	Activity_KIND_LICENSE_REVOKED             Activity_Kind = 19 // Revoked after a refund or dispute, see KIND_ADMIN_LICENSE_REVOCATION for manual ones
+	Activity_KIND_LICENSE_SHORTENED           Activity_Kind = 20
+	Activity_KIND_LICENSE_RESTORED            Activity_Kind = 21
+	Activity_KIND_DISCORD_ROLE_REMOVED        Activity_Kind = 22
+	Activity_KIND_PAYMENT_FAILED              Activity_Kind = 23
+	Activity_KIND_REFUND_REQUESTED            Activity_Kind = 24
+	Activity_KIND_REFUND_REQUEST_APPROVED     Activity_Kind = 25
+	Activity_KIND_REFUND_REQUEST_DENIED       Activity_Kind = 26
 )
 
 // Enum value maps for Activity_Kind.
@@ -54,6 +69,21 @@ var (
 		9:  "KIND_SUBSCRIPTION_PAYMENT_FAILED",
 		10: "KIND_ADMIN_LICENSE_CREATION",
 		11: "KIND_ADMIN_LICENSE_REVOCATION",
+		12: "KIND_USER_UPDATED",
+		13: "KIND_USER_ANONYMIZED",
+		14: "KIND_USER_ADDED_TO_GROUP",
+		15: "KIND_USER_REMOVED_FROM_GROUP",
+		16: "KIND_PAYMENT_REFUNDED",
+		17: "KIND_PAYMENT_DISPUTED",
+		18: "KIND_PAYMENT_DISPUTE_RESOLVED",
+		19: "KIND_LICENSE_REVOKED",
+		20: "KIND_LICENSE_SHORTENED",
+		21: "KIND_LICENSE_RESTORED",
+		22: "KIND_DISCORD_ROLE_REMOVED",
+		23: "KIND_PAYMENT_FAILED",
+		24: "KIND_REFUND_REQUESTED",
+		25: "KIND_REFUND_REQUEST_APPROVED",
+		26: "KIND_REFUND_REQUEST_DENIED",
 	}
 	Activity_Kind_value = map[string]int32{
 		"KIND_UNSPECIFIED":                 0,
@@ -68,6 +98,21 @@ var (
 		"KIND_SUBSCRIPTION_PAYMENT_FAILED": 9,
 		"KIND_ADMIN_LICENSE_CREATION":      10,
 		"KIND_ADMIN_LICENSE_REVOCATION":    11,
+		"KIND_USER_UPDATED":                12,
+		"KIND_USER_ANONYMIZED":             13,
+		"KIND_USER_ADDED_TO_GROUP":         14,
+		"KIND_USER_REMOVED_FROM_GROUP":     15,
+		"KIND_PAYMENT_REFUNDED":            16,
+		"KIND_PAYMENT_DISPUTED":            17,
+		"KIND_PAYMENT_DISPUTE_RESOLVED":    18,
+		"KIND_LICENSE_REVOKED":             19,
+		"KIND_LICENSE_SHORTENED":           20,
+		"KIND_LICENSE_RESTORED":            21,
+		"KIND_DISCORD_ROLE_REMOVED":        22,
+		"KIND_PAYMENT_FAILED":              23,
+		"KIND_REFUND_REQUESTED":            24,
+		"KIND_REFUND_REQUEST_APPROVED":     25,
+		"KIND_REFUND_REQUEST_DENIED":       26,
 	}
 )
 
@@ -211,10 +256,12 @@ func (LicenseKey_Tier) EnumDescriptor() ([]byte, []int) {
 type Payment_Status int32
 
 const (
-	Payment_STATUS_UNSPECIFIED Payment_Status = 0
-	Payment_STATUS_COMPLETED   Payment_Status = 1
-	Payment_STATUS_FAILED      Payment_Status = 2
-	Payment_STATUS_REFUNDED    Payment_Status = 3
+	Payment_STATUS_UNSPECIFIED        Payment_Status = 0
+	Payment_STATUS_COMPLETED          Payment_Status = 1
+	Payment_STATUS_FAILED             Payment_Status = 2
+	Payment_STATUS_REFUNDED           Payment_Status = 3
+	Payment_STATUS_PENDING            Payment_Status = 4 // Funds held by the provider, the license is delivered but may be taken back
+	Payment_STATUS_PARTIALLY_REFUNDED Payment_Status = 5
 )
 
 // Enum value maps for Payment_Status.
@@ -224,12 +271,16 @@ var (
 		1: "STATUS_COMPLETED",
 		2: "STATUS_FAILED",
 		3: "STATUS_REFUNDED",
+		4: "STATUS_PENDING",
+		5: "STATUS_PARTIALLY_REFUNDED",
 	}
 	Payment_Status_value = map[string]int32{
-		"STATUS_UNSPECIFIED": 0,
-		"STATUS_COMPLETED":   1,
-		"STATUS_FAILED":      2,
-		"STATUS_REFUNDED":    3,
+		"STATUS_UNSPECIFIED":        0,
+		"STATUS_COMPLETED":          1,
+		"STATUS_FAILED":             2,
+		"STATUS_REFUNDED":           3,
+		"STATUS_PENDING":            4,
+		"STATUS_PARTIALLY_REFUNDED": 5,
 	}
 )
 
@@ -318,6 +369,7 @@ const (
 	Subscription_STATUS_UNSPECIFIED Subscription_Status = 0
 	Subscription_STATUS_ACTIVE      Subscription_Status = 1
 	Subscription_STATUS_CANCELED    Subscription_Status = 2
+	Subscription_STATUS_PAST_DUE    Subscription_Status = 3 // Last renewal payment failed, the provider may retry
 )
 
 // Enum value maps for Subscription_Status.
@@ -326,11 +378,13 @@ var (
 		0: "STATUS_UNSPECIFIED",
 		1: "STATUS_ACTIVE",
 		2: "STATUS_CANCELED",
+		3: "STATUS_PAST_DUE",
 	}
 	Subscription_Status_value = map[string]int32{
 		"STATUS_UNSPECIFIED": 0,
 		"STATUS_ACTIVE":      1,
 		"STATUS_CANCELED":    2,
+		"STATUS_PAST_DUE":    3,
 	}
 )
 
@@ -361,6 +415,429 @@ func (Subscription_Status) EnumDescriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{3, 0}
 }
 
+type CheckoutOrder_Status int32
+
+const (
+	CheckoutOrder_STATUS_UNSPECIFIED CheckoutOrder_Status = 0
+	CheckoutOrder_STATUS_CREATED     CheckoutOrder_Status = 1 // Waiting for the buyer approval
+	CheckoutOrder_STATUS_APPROVED    CheckoutOrder_Status = 2 // Approved by the buyer, not captured yet
+	CheckoutOrder_STATUS_CAPTURED    CheckoutOrder_Status = 3 // Captured, the license is not delivered yet
+	CheckoutOrder_STATUS_COMPLETED   CheckoutOrder_Status = 4 // License delivered
+	CheckoutOrder_STATUS_VOIDED      CheckoutOrder_Status = 5 // Abandoned by the buyer or voided by the provider
+	CheckoutOrder_STATUS_FAILED      CheckoutOrder_Status = 6 // Capture declined
+)
+
+// Enum value maps for CheckoutOrder_Status.
+var (
+	CheckoutOrder_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_CREATED",
+		2: "STATUS_APPROVED",
+		3: "STATUS_CAPTURED",
+		4: "STATUS_COMPLETED",
+		5: "STATUS_VOIDED",
+		6: "STATUS_FAILED",
+	}
+	CheckoutOrder_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_CREATED":     1,
+		"STATUS_APPROVED":    2,
+		"STATUS_CAPTURED":    3,
+		"STATUS_COMPLETED":   4,
+		"STATUS_VOIDED":      5,
+		"STATUS_FAILED":      6,
+	}
+)
+
+func (x CheckoutOrder_Status) Enum() *CheckoutOrder_Status {
+	p := new(CheckoutOrder_Status)
+	*p = x
+	return p
+}
+
+func (x CheckoutOrder_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckoutOrder_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[6].Descriptor()
+}
+
+func (CheckoutOrder_Status) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[6]
+}
+
+func (x CheckoutOrder_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckoutOrder_Status.Descriptor instead.
+func (CheckoutOrder_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type Invoice_Kind int32
+
+const (
+	Invoice_KIND_UNSPECIFIED Invoice_Kind = 0
+	Invoice_KIND_INVOICE     Invoice_Kind = 1
+	Invoice_KIND_CREDIT_NOTE Invoice_Kind = 2
+)
+
+// Enum value maps for Invoice_Kind.
+var (
+	Invoice_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_INVOICE",
+		2: "KIND_CREDIT_NOTE",
+	}
+	Invoice_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_INVOICE":     1,
+		"KIND_CREDIT_NOTE": 2,
+	}
+)
+
+func (x Invoice_Kind) Enum() *Invoice_Kind {
+	p := new(Invoice_Kind)
+	*p = x
+	return p
+}
+
+func (x Invoice_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Invoice_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[7].Descriptor()
+}
+
+func (Invoice_Kind) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[7]
+}
+
+func (x Invoice_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Invoice_Kind.Descriptor instead.
+func (Invoice_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type Commission_Kind int32
+
+const (
+	Commission_KIND_UNSPECIFIED Commission_Kind = 0
+	Commission_KIND_EARNING     Commission_Kind = 1
+	Commission_KIND_REVERSAL    Commission_Kind = 2 // Takes back the part of an earning refunded to the buyer
+)
+
+// Enum value maps for Commission_Kind.
+var (
+	Commission_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_EARNING",
+		2: "KIND_REVERSAL",
+	}
+	Commission_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_EARNING":     1,
+		"KIND_REVERSAL":    2,
+	}
+)
+
+func (x Commission_Kind) Enum() *Commission_Kind {
+	p := new(Commission_Kind)
+	*p = x
+	return p
+}
+
+func (x Commission_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Commission_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[8].Descriptor()
+}
+
+func (Commission_Kind) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[8]
+}
+
+func (x Commission_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Commission_Kind.Descriptor instead.
+func (Commission_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Commission_Status int32
+
+const (
+	Commission_STATUS_UNSPECIFIED Commission_Status = 0
+	Commission_STATUS_PENDING     Commission_Status = 1 // Held until payable_at, refunds are likely within this period
+	Commission_STATUS_PAYABLE     Commission_Status = 2
+	Commission_STATUS_PAID        Commission_Status = 3
+)
+
+// Enum value maps for Commission_Status.
+var (
+	Commission_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_PAYABLE",
+		3: "STATUS_PAID",
+	}
+	Commission_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_PAYABLE":     2,
+		"STATUS_PAID":        3,
+	}
+)
+
+func (x Commission_Status) Enum() *Commission_Status {
+	p := new(Commission_Status)
+	*p = x
+	return p
+}
+
+func (x Commission_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Commission_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[9].Descriptor()
+}
+
+func (Commission_Status) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[9]
+}
+
+func (x Commission_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Commission_Status.Descriptor instead.
+func (Commission_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{12, 1}
+}
+
+type RefundRequest_Status int32
+
+const (
+	RefundRequest_STATUS_UNSPECIFIED RefundRequest_Status = 0
+	RefundRequest_STATUS_PENDING     RefundRequest_Status = 1 // Waiting for an admin
+	RefundRequest_STATUS_APPROVED    RefundRequest_Status = 2 // Refunded on the provider and recorded on the payment
+	RefundRequest_STATUS_DENIED      RefundRequest_Status = 3
+)
+
+// Enum value maps for RefundRequest_Status.
+var (
+	RefundRequest_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_APPROVED",
+		3: "STATUS_DENIED",
+	}
+	RefundRequest_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_APPROVED":    2,
+		"STATUS_DENIED":      3,
+	}
+)
+
+func (x RefundRequest_Status) Enum() *RefundRequest_Status {
+	p := new(RefundRequest_Status)
+	*p = x
+	return p
+}
+
+func (x RefundRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[10].Descriptor()
+}
+
+func (RefundRequest_Status) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[10]
+}
+
+func (x RefundRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundRequest_Status.Descriptor instead.
+func (RefundRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{13, 0}
+}
+
+// Roles are also given by the Discourse admin flag and by Discourse groups, see rbapi/rbac.go
+type UserRole_Role int32
+
+const (
+	UserRole_ROLE_UNSPECIFIED        UserRole_Role = 0
+	UserRole_ROLE_ADMIN              UserRole_Role = 1 // Every permission
+	UserRole_ROLE_SUPPORT            UserRole_Role = 2 // Read-only search and history
+	UserRole_ROLE_BILLING            UserRole_Role = 3 // Refunds, payments and commissions
+	UserRole_ROLE_OFFSETS_MAINTAINER UserRole_Role = 4 // Offset uploads
+)
+
+// Enum value maps for UserRole_Role.
+var (
+	UserRole_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_ADMIN",
+		2: "ROLE_SUPPORT",
+		3: "ROLE_BILLING",
+		4: "ROLE_OFFSETS_MAINTAINER",
+	}
+	UserRole_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED":        0,
+		"ROLE_ADMIN":              1,
+		"ROLE_SUPPORT":            2,
+		"ROLE_BILLING":            3,
+		"ROLE_OFFSETS_MAINTAINER": 4,
+	}
+)
+
+func (x UserRole_Role) Enum() *UserRole_Role {
+	p := new(UserRole_Role)
+	*p = x
+	return p
+}
+
+func (x UserRole_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[11].Descriptor()
+}
+
+func (UserRole_Role) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[11]
+}
+
+func (x UserRole_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole_Role.Descriptor instead.
+func (UserRole_Role) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type WebhookEvent_Provider int32
+
+const (
+	WebhookEvent_PROVIDER_UNSPECIFIED WebhookEvent_Provider = 0
+	WebhookEvent_PROVIDER_DISCOURSE   WebhookEvent_Provider = 1
+	WebhookEvent_PROVIDER_PAYPAL      WebhookEvent_Provider = 2
+	WebhookEvent_PROVIDER_STRIPE      WebhookEvent_Provider = 3
+)
+
+// Enum value maps for WebhookEvent_Provider.
+var (
+	WebhookEvent_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "PROVIDER_DISCOURSE",
+		2: "PROVIDER_PAYPAL",
+		3: "PROVIDER_STRIPE",
+	}
+	WebhookEvent_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"PROVIDER_DISCOURSE":   1,
+		"PROVIDER_PAYPAL":      2,
+		"PROVIDER_STRIPE":      3,
+	}
+)
+
+func (x WebhookEvent_Provider) Enum() *WebhookEvent_Provider {
+	p := new(WebhookEvent_Provider)
+	*p = x
+	return p
+}
+
+func (x WebhookEvent_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEvent_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[12].Descriptor()
+}
+
+func (WebhookEvent_Provider) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[12]
+}
+
+func (x WebhookEvent_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEvent_Provider.Descriptor instead.
+func (WebhookEvent_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type WebhookEvent_Status int32
+
+const (
+	WebhookEvent_STATUS_UNSPECIFIED WebhookEvent_Status = 0
+	WebhookEvent_STATUS_PENDING     WebhookEvent_Status = 1 // Waiting for its first or next attempt
+	WebhookEvent_STATUS_PROCESSED   WebhookEvent_Status = 2
+	WebhookEvent_STATUS_FAILED      WebhookEvent_Status = 3 // Gave up after too many attempts, can be replayed by an admin
+)
+
+// Enum value maps for WebhookEvent_Status.
+var (
+	WebhookEvent_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_PROCESSED",
+		3: "STATUS_FAILED",
+	}
+	WebhookEvent_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_PROCESSED":   2,
+		"STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookEvent_Status) Enum() *WebhookEvent_Status {
+	p := new(WebhookEvent_Status)
+	*p = x
+	return p
+}
+
+func (x WebhookEvent_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEvent_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_rslbot_rbdb_proto_enumTypes[13].Descriptor()
+}
+
+func (WebhookEvent_Status) Type() protoreflect.EnumType {
+	return &file_proto_rslbot_rbdb_proto_enumTypes[13]
+}
+
+func (x WebhookEvent_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEvent_Status.Descriptor instead.
+func (WebhookEvent_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{21, 1}
+}
+
 type Activity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -370,6 +847,7 @@ type Activity struct {
 	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Kind         Activity_Kind          `protobuf:"varint,100,opt,name=kind,proto3,enum=rslbot.db.Activity_Kind" json:"kind,omitempty"`
+	Details      string                 `protobuf:"bytes,101,opt,name=details,proto3" json:"details,omitempty"` // Free-form context (e.g., Discourse group ID)
 	User         *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
 	LicenseKey   *LicenseKey            `protobuf:"bytes,201,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
 	Payment      *Payment               `protobuf:"bytes,202,opt,name=payment,proto3" json:"payment,omitempty"`
@@ -434,6 +912,13 @@ func (x *Activity) GetKind() Activity_Kind {
 	return Activity_KIND_UNSPECIFIED
 }
 
+func (x *Activity) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
 func (x *Activity) GetUser() *User {
 	if x != nil {
 		return x.User
@@ -608,22 +1093,37 @@ type Payment struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Provider        Payment_Provider       `protobuf:"varint,100,opt,name=provider,proto3,enum=rslbot.db.Payment_Provider" json:"provider,omitempty"`
-	ReferenceId     string                 `protobuf:"bytes,101,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	AmountInCents   int64                  `protobuf:"varint,102,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"`
-	Currency        string                 `protobuf:"bytes,103,opt,name=currency,proto3" json:"currency,omitempty"`
-	LicenseDuration LicenseKey_Duration    `protobuf:"varint,104,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
-	IsRenewal       bool                   `protobuf:"varint,105,opt,name=is_renewal,json=isRenewal,proto3" json:"is_renewal,omitempty"`
-	SandboxMode     bool                   `protobuf:"varint,106,opt,name=sandbox_mode,json=sandboxMode,proto3" json:"sandbox_mode,omitempty"`
-	BillingEmail    string                 `protobuf:"bytes,107,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
-	BillingName     string                 `protobuf:"bytes,108,opt,name=billing_name,json=billingName,proto3" json:"billing_name,omitempty"`
-	User            *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
-	UserId          int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LicenseKey      *LicenseKey            `protobuf:"bytes,202,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
-	Subscription    *Subscription          `protobuf:"bytes,203,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Provider           Payment_Provider       `protobuf:"varint,100,opt,name=provider,proto3,enum=rslbot.db.Payment_Provider" json:"provider,omitempty"`
+	ReferenceId        string                 `protobuf:"bytes,101,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	AmountInCents      int64                  `protobuf:"varint,102,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"`
+	Currency           string                 `protobuf:"bytes,103,opt,name=currency,proto3" json:"currency,omitempty"`
+	LicenseDuration    LicenseKey_Duration    `protobuf:"varint,104,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
+	IsRenewal          bool                   `protobuf:"varint,105,opt,name=is_renewal,json=isRenewal,proto3" json:"is_renewal,omitempty"`
+	SandboxMode        bool                   `protobuf:"varint,106,opt,name=sandbox_mode,json=sandboxMode,proto3" json:"sandbox_mode,omitempty"`
+	BillingEmail       string                 `protobuf:"bytes,107,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
+	BillingName        string                 `protobuf:"bytes,108,opt,name=billing_name,json=billingName,proto3" json:"billing_name,omitempty"`
+	RefundedInCents    int64                  `protobuf:"varint,109,opt,name=refunded_in_cents,json=refundedInCents,proto3" json:"refunded_in_cents,omitempty"` // Total refunded so far, partial refunds add up
+	RefundedAt         *timestamppb.Timestamp `protobuf:"bytes,110,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	DisputeId          string                 `protobuf:"bytes,111,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"` // Provider-side ID of the last dispute (chargeback) opened on the payment
+	DisputedAt         *timestamppb.Timestamp `protobuf:"bytes,112,opt,name=disputed_at,json=disputedAt,proto3" json:"disputed_at,omitempty"`
+	Status             Payment_Status         `protobuf:"varint,113,opt,name=status,proto3,enum=rslbot.db.Payment_Status" json:"status,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,114,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	FailedAt           *timestamppb.Timestamp `protobuf:"bytes,115,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	TaxCountryCode     string                 `protobuf:"bytes,116,opt,name=tax_country_code,json=taxCountryCode,proto3" json:"tax_country_code,omitempty"` // Country the VAT is due to, empty when no tax was assessed
+	TaxRate            float64                `protobuf:"fixed64,117,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxInCents         int64                  `protobuf:"varint,118,opt,name=tax_in_cents,json=taxInCents,proto3" json:"tax_in_cents,omitempty"`                        // Part of amount_in_cents
+	BillingCountryCode string                 `protobuf:"bytes,119,opt,name=billing_country_code,json=billingCountryCode,proto3" json:"billing_country_code,omitempty"` // Country declared by the buyer, evidence of the tax country
+	IpAddress          string                 `protobuf:"bytes,120,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`                              // Evidence of the tax country
+	IpCountryCode      string                 `protobuf:"bytes,121,opt,name=ip_country_code,json=ipCountryCode,proto3" json:"ip_country_code,omitempty"`                // Country of ip_address, evidence of the tax country
+	ReferralCode       string                 `protobuf:"bytes,122,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`                     // Referral code used at checkout
+	ReferrerId         int64                  `protobuf:"varint,123,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"`                          // User earning a commission on the payment
+	User               *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId             int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LicenseKey         *LicenseKey            `protobuf:"bytes,202,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	Subscription       *Subscription          `protobuf:"bytes,203,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
 
 func (x *Payment) Reset() {
@@ -740,55 +1240,163 @@ func (x *Payment) GetBillingName() string {
 	return ""
 }
 
-func (x *Payment) GetUser() *User {
+func (x *Payment) GetRefundedInCents() int64 {
 	if x != nil {
-		return x.User
+		return x.RefundedInCents
+	}
+	return 0
+}
+
+func (x *Payment) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
 	}
 	return nil
 }
 
-func (x *Payment) GetUserId() int64 {
+func (x *Payment) GetDisputeId() string {
 	if x != nil {
-		return x.UserId
+		return x.DisputeId
 	}
-	return 0
+	return ""
 }
 
-func (x *Payment) GetLicenseKey() *LicenseKey {
+func (x *Payment) GetDisputedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LicenseKey
+		return x.DisputedAt
 	}
 	return nil
 }
 
-func (x *Payment) GetSubscription() *Subscription {
+func (x *Payment) GetStatus() Payment_Status {
 	if x != nil {
-		return x.Subscription
+		return x.Status
+	}
+	return Payment_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
 	}
 	return nil
 }
 
-type Subscription struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Payment) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
 
-	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	StripeSubscriptionId string                 `protobuf:"bytes,100,opt,name=stripe_subscription_id,json=stripeSubscriptionId,proto3" json:"stripe_subscription_id,omitempty"`
-	StripeCustomerId     string                 `protobuf:"bytes,101,opt,name=stripe_customer_id,json=stripeCustomerId,proto3" json:"stripe_customer_id,omitempty"`
-	Status               Subscription_Status    `protobuf:"varint,102,opt,name=status,proto3,enum=rslbot.db.Subscription_Status" json:"status,omitempty"`
-	Duration             LicenseKey_Duration    `protobuf:"varint,103,opt,name=duration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"duration,omitempty"` // Duration type for recurring billing
-	CurrentPeriodStart   *timestamppb.Timestamp `protobuf:"bytes,104,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
-	SandboxMode          bool                   `protobuf:"varint,106,opt,name=sandbox_mode,json=sandboxMode,proto3" json:"sandbox_mode,omitempty"`
-	User                 *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
-	UserId               int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LicenseKey           *LicenseKey            `protobuf:"bytes,202,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
-	LicenseKeyId         int64                  `protobuf:"varint,203,opt,name=license_key_id,json=licenseKeyId,proto3" json:"license_key_id,omitempty"`
+func (x *Payment) GetTaxCountryCode() string {
+	if x != nil {
+		return x.TaxCountryCode
+	}
+	return ""
 }
 
-func (x *Subscription) Reset() {
+func (x *Payment) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *Payment) GetTaxInCents() int64 {
+	if x != nil {
+		return x.TaxInCents
+	}
+	return 0
+}
+
+func (x *Payment) GetBillingCountryCode() string {
+	if x != nil {
+		return x.BillingCountryCode
+	}
+	return ""
+}
+
+func (x *Payment) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Payment) GetIpCountryCode() string {
+	if x != nil {
+		return x.IpCountryCode
+	}
+	return ""
+}
+
+func (x *Payment) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *Payment) GetReferrerId() int64 {
+	if x != nil {
+		return x.ReferrerId
+	}
+	return 0
+}
+
+func (x *Payment) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Payment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Payment) GetLicenseKey() *LicenseKey {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return nil
+}
+
+func (x *Payment) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ProviderSubscriptionId string                 `protobuf:"bytes,100,opt,name=provider_subscription_id,json=providerSubscriptionId,proto3" json:"provider_subscription_id,omitempty"` // Subscription ID on the provider side
+	ProviderCustomerId     string                 `protobuf:"bytes,101,opt,name=provider_customer_id,json=providerCustomerId,proto3" json:"provider_customer_id,omitempty"`
+	Status                 Subscription_Status    `protobuf:"varint,102,opt,name=status,proto3,enum=rslbot.db.Subscription_Status" json:"status,omitempty"`
+	Duration               LicenseKey_Duration    `protobuf:"varint,103,opt,name=duration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"duration,omitempty"` // Duration type for recurring billing
+	CurrentPeriodStart     *timestamppb.Timestamp `protobuf:"bytes,104,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
+	CurrentPeriodEnd       *timestamppb.Timestamp `protobuf:"bytes,105,opt,name=current_period_end,json=currentPeriodEnd,proto3" json:"current_period_end,omitempty"`
+	SandboxMode            bool                   `protobuf:"varint,106,opt,name=sandbox_mode,json=sandboxMode,proto3" json:"sandbox_mode,omitempty"`
+	Provider               Payment_Provider       `protobuf:"varint,107,opt,name=provider,proto3,enum=rslbot.db.Payment_Provider" json:"provider,omitempty"`
+	CanceledAt             *timestamppb.Timestamp `protobuf:"bytes,108,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	User                   *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId                 int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LicenseKey             *LicenseKey            `protobuf:"bytes,202,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	LicenseKeyId           int64                  `protobuf:"varint,203,opt,name=license_key_id,json=licenseKeyId,proto3" json:"license_key_id,omitempty"`
+}
+
+func (x *Subscription) Reset() {
 	*x = Subscription{}
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,16 +1447,16 @@ func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *Subscription) GetStripeSubscriptionId() string {
+func (x *Subscription) GetProviderSubscriptionId() string {
 	if x != nil {
-		return x.StripeSubscriptionId
+		return x.ProviderSubscriptionId
 	}
 	return ""
 }
 
-func (x *Subscription) GetStripeCustomerId() string {
+func (x *Subscription) GetProviderCustomerId() string {
 	if x != nil {
-		return x.StripeCustomerId
+		return x.ProviderCustomerId
 	}
 	return ""
 }
@@ -874,6 +1482,13 @@ func (x *Subscription) GetCurrentPeriodStart() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Subscription) GetCurrentPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodEnd
+	}
+	return nil
+}
+
 func (x *Subscription) GetSandboxMode() bool {
 	if x != nil {
 		return x.SandboxMode
@@ -881,6 +1496,20 @@ func (x *Subscription) GetSandboxMode() bool {
 	return false
 }
 
+func (x *Subscription) GetProvider() Payment_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Payment_PROVIDER_UNSPECIFIED
+}
+
+func (x *Subscription) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
 func (x *Subscription) GetUser() *User {
 	if x != nil {
 		return x.User
@@ -909,33 +1538,38 @@ func (x *Subscription) GetLicenseKeyId() int64 {
 	return 0
 }
 
-type User struct {
+type BillingPlan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DiscourseId int64                  `protobuf:"varint,100,opt,name=discourse_id,json=discourseId,proto3" json:"discourse_id,omitempty"`
-	Email       string                 `protobuf:"bytes,101,opt,name=email,proto3" json:"email,omitempty"`
-	Username    string                 `protobuf:"bytes,102,opt,name=username,proto3" json:"username,omitempty"`
-}
-
-func (x *User) Reset() {
-	*x = User{}
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Provider          Payment_Provider       `protobuf:"varint,100,opt,name=provider,proto3,enum=rslbot.db.Payment_Provider" json:"provider,omitempty"`
+	Duration          LicenseKey_Duration    `protobuf:"varint,101,opt,name=duration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"duration,omitempty"` // Billing interval of the plan
+	SandboxMode       bool                   `protobuf:"varint,102,opt,name=sandbox_mode,json=sandboxMode,proto3" json:"sandbox_mode,omitempty"`
+	ProviderProductId string                 `protobuf:"bytes,103,opt,name=provider_product_id,json=providerProductId,proto3" json:"provider_product_id,omitempty"`
+	ProviderPlanId    string                 `protobuf:"bytes,104,opt,name=provider_plan_id,json=providerPlanId,proto3" json:"provider_plan_id,omitempty"` // Plan ID on the provider side
+	AmountInCents     int64                  `protobuf:"varint,105,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"`
+	Currency          string                 `protobuf:"bytes,106,opt,name=currency,proto3" json:"currency,omitempty"`
+	Active            bool                   `protobuf:"varint,107,opt,name=active,proto3" json:"active,omitempty"` // New subscriptions only use the active plan, older plans keep billing their subscribers
+}
+
+func (x *BillingPlan) Reset() {
+	*x = BillingPlan{}
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *BillingPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*BillingPlan) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *BillingPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -947,79 +1581,117 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use BillingPlan.ProtoReflect.Descriptor instead.
+func (*BillingPlan) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *User) GetId() int64 {
+func (x *BillingPlan) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+func (x *BillingPlan) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *BillingPlan) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *User) GetDiscourseId() int64 {
+func (x *BillingPlan) GetProvider() Payment_Provider {
 	if x != nil {
-		return x.DiscourseId
+		return x.Provider
 	}
-	return 0
+	return Payment_PROVIDER_UNSPECIFIED
 }
 
-func (x *User) GetEmail() string {
+func (x *BillingPlan) GetDuration() LicenseKey_Duration {
 	if x != nil {
-		return x.Email
+		return x.Duration
+	}
+	return LicenseKey_UNSPECIFIED
+}
+
+func (x *BillingPlan) GetSandboxMode() bool {
+	if x != nil {
+		return x.SandboxMode
+	}
+	return false
+}
+
+func (x *BillingPlan) GetProviderProductId() string {
+	if x != nil {
+		return x.ProviderProductId
 	}
 	return ""
 }
 
-func (x *User) GetUsername() string {
+func (x *BillingPlan) GetProviderPlanId() string {
 	if x != nil {
-		return x.Username
+		return x.ProviderPlanId
 	}
 	return ""
 }
 
-type DiscourseUser struct {
+func (x *BillingPlan) GetAmountInCents() int64 {
+	if x != nil {
+		return x.AmountInCents
+	}
+	return 0
+}
+
+func (x *BillingPlan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BillingPlan) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Product struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExternalId int64    `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	Username   string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email      string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Groups     []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
-	Admin      bool     `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name        string                 `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,101,opt,name=description,proto3" json:"description,omitempty"`
+	Tier        LicenseKey_Tier        `protobuf:"varint,102,opt,name=tier,proto3,enum=rslbot.db.LicenseKey_Tier" json:"tier,omitempty"` // Tier of the licenses sold with the product prices
+	ImageUrl    string                 `protobuf:"bytes,103,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Active      bool                   `protobuf:"varint,104,opt,name=active,proto3" json:"active,omitempty"` // Prices of inactive products can't be bought
 }
 
-func (x *DiscourseUser) Reset() {
-	*x = DiscourseUser{}
+func (x *Product) Reset() {
+	*x = Product{}
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiscourseUser) String() string {
+func (x *Product) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscourseUser) ProtoMessage() {}
+func (*Product) ProtoMessage() {}
 
-func (x *DiscourseUser) ProtoReflect() protoreflect.Message {
+func (x *Product) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,72 +1703,100 @@ func (x *DiscourseUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscourseUser.ProtoReflect.Descriptor instead.
-func (*DiscourseUser) Descriptor() ([]byte, []int) {
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DiscourseUser) GetExternalId() int64 {
+func (x *Product) GetId() int64 {
 	if x != nil {
-		return x.ExternalId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *DiscourseUser) GetUsername() string {
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Username
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DiscourseUser) GetEmail() string {
+func (x *Product) GetDescription() string {
 	if x != nil {
-		return x.Email
+		return x.Description
 	}
 	return ""
 }
 
-func (x *DiscourseUser) GetGroups() []string {
+func (x *Product) GetTier() LicenseKey_Tier {
 	if x != nil {
-		return x.Groups
+		return x.Tier
 	}
-	return nil
+	return LicenseKey_TIER_UNSPECIFIED
 }
 
-func (x *DiscourseUser) GetAdmin() bool {
+func (x *Product) GetImageUrl() string {
 	if x != nil {
-		return x.Admin
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Product) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-type Offset struct {
+type Price struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Version   string                 `protobuf:"bytes,100,opt,name=version,proto3" json:"version,omitempty"` // Client version (e.g., "1.2.3")
-	Data      []byte                 `protobuf:"bytes,101,opt,name=data,proto3" json:"data,omitempty"`       // Serialized PixelDefinitions data (JSON)
-}
-
-func (x *Offset) Reset() {
-	*x = Offset{}
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Duration      LicenseKey_Duration    `protobuf:"varint,100,opt,name=duration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"duration,omitempty"`
+	Currency      string                 `protobuf:"bytes,101,opt,name=currency,proto3" json:"currency,omitempty"` // Lowercase ISO 4217 code
+	AmountInCents int64                  `protobuf:"varint,102,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,103,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Line item name shown by the payment providers
+	Active        bool                   `protobuf:"varint,104,opt,name=active,proto3" json:"active,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,105,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`    // Optional start of the validity window
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,106,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // Optional end of the validity window, excluded
+	Product       *Product               `protobuf:"bytes,200,opt,name=product,proto3" json:"product,omitempty"`
+	ProductId     int64                  `protobuf:"varint,201,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Offset) String() string {
+func (x *Price) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Offset) ProtoMessage() {}
+func (*Price) ProtoMessage() {}
 
-func (x *Offset) ProtoReflect() protoreflect.Message {
+func (x *Price) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_rslbot_rbdb_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1108,61 +1808,1881 @@ func (x *Offset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Offset.ProtoReflect.Descriptor instead.
-func (*Offset) Descriptor() ([]byte, []int) {
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
 	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Offset) GetId() int64 {
+func (x *Price) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Offset) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Price) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Offset) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Price) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Offset) GetVersion() string {
+func (x *Price) GetDuration() LicenseKey_Duration {
 	if x != nil {
-		return x.Version
+		return x.Duration
+	}
+	return LicenseKey_UNSPECIFIED
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *Offset) GetData() []byte {
+func (x *Price) GetAmountInCents() int64 {
 	if x != nil {
-		return x.Data
+		return x.AmountInCents
+	}
+	return 0
+}
+
+func (x *Price) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Price) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Price) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
 	}
 	return nil
 }
 
-var File_proto_rslbot_rbdb_proto protoreflect.FileDescriptor
+func (x *Price) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
 
-var file_proto_rslbot_rbdb_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2f, 0x72,
-	0x62, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x72, 0x73, 0x6c, 0x62, 0x6f,
-	0x74, 0x2e, 0x64, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xac, 0x06, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02,
-	0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+func (x *Price) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *Price) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency  string                 `protobuf:"bytes,100,opt,name=currency,proto3" json:"currency,omitempty"` // Lowercase ISO 4217 code
+	Rate      float64                `protobuf:"fixed64,101,opt,name=rate,proto3" json:"rate,omitempty"`       // Units of the currency worth one unit of the base currency
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExchangeRate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type TaxRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CountryCode string                 `protobuf:"bytes,100,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // Uppercase ISO 3166-1 alpha-2 code
+	Rate        float64                `protobuf:"fixed64,101,opt,name=rate,proto3" json:"rate,omitempty"`                                // VAT rate of digital goods, 0.2 for 20%
+}
+
+func (x *TaxRate) Reset() {
+	*x = TaxRate{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRate) ProtoMessage() {}
+
+func (x *TaxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRate.ProtoReflect.Descriptor instead.
+func (*TaxRate) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TaxRate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaxRate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaxRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TaxRate) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *TaxRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type CheckoutOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Provider           Payment_Provider       `protobuf:"varint,100,opt,name=provider,proto3,enum=rslbot.db.Payment_Provider" json:"provider,omitempty"`
+	ProviderOrderId    string                 `protobuf:"bytes,101,opt,name=provider_order_id,json=providerOrderId,proto3" json:"provider_order_id,omitempty"` // Order ID on the provider side
+	Status             CheckoutOrder_Status   `protobuf:"varint,102,opt,name=status,proto3,enum=rslbot.db.CheckoutOrder_Status" json:"status,omitempty"`
+	LicenseDuration    LicenseKey_Duration    `protobuf:"varint,103,opt,name=license_duration,json=licenseDuration,proto3,enum=rslbot.db.LicenseKey_Duration" json:"license_duration,omitempty"`
+	RenewalKeyId       int64                  `protobuf:"varint,104,opt,name=renewal_key_id,json=renewalKeyId,proto3" json:"renewal_key_id,omitempty"` // License renewed by the order, 0 for new licenses
+	AmountInCents      int64                  `protobuf:"varint,105,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"`
+	Currency           string                 `protobuf:"bytes,106,opt,name=currency,proto3" json:"currency,omitempty"`
+	SandboxMode        bool                   `protobuf:"varint,107,opt,name=sandbox_mode,json=sandboxMode,proto3" json:"sandbox_mode,omitempty"`
+	CaptureId          string                 `protobuf:"bytes,108,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`                          // Provider-side capture, the reference of the payment once delivered
+	ReconcileAttempts  int32                  `protobuf:"varint,109,opt,name=reconcile_attempts,json=reconcileAttempts,proto3" json:"reconcile_attempts,omitempty"` // Failed reconciliation attempts
+	LastError          string                 `protobuf:"bytes,110,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastCheckedAt      *timestamppb.Timestamp `protobuf:"bytes,111,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,112,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	TaxCountryCode     string                 `protobuf:"bytes,113,opt,name=tax_country_code,json=taxCountryCode,proto3" json:"tax_country_code,omitempty"` // Tax assessed at checkout, copied to the payment, see Payment
+	TaxRate            float64                `protobuf:"fixed64,114,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxInCents         int64                  `protobuf:"varint,115,opt,name=tax_in_cents,json=taxInCents,proto3" json:"tax_in_cents,omitempty"`
+	BillingCountryCode string                 `protobuf:"bytes,116,opt,name=billing_country_code,json=billingCountryCode,proto3" json:"billing_country_code,omitempty"`
+	IpAddress          string                 `protobuf:"bytes,117,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	IpCountryCode      string                 `protobuf:"bytes,118,opt,name=ip_country_code,json=ipCountryCode,proto3" json:"ip_country_code,omitempty"`
+	User               *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId             int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CheckoutOrder) Reset() {
+	*x = CheckoutOrder{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutOrder) ProtoMessage() {}
+
+func (x *CheckoutOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutOrder.ProtoReflect.Descriptor instead.
+func (*CheckoutOrder) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckoutOrder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetProvider() Payment_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Payment_PROVIDER_UNSPECIFIED
+}
+
+func (x *CheckoutOrder) GetProviderOrderId() string {
+	if x != nil {
+		return x.ProviderOrderId
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetStatus() CheckoutOrder_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CheckoutOrder_STATUS_UNSPECIFIED
+}
+
+func (x *CheckoutOrder) GetLicenseDuration() LicenseKey_Duration {
+	if x != nil {
+		return x.LicenseDuration
+	}
+	return LicenseKey_UNSPECIFIED
+}
+
+func (x *CheckoutOrder) GetRenewalKeyId() int64 {
+	if x != nil {
+		return x.RenewalKeyId
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetAmountInCents() int64 {
+	if x != nil {
+		return x.AmountInCents
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetSandboxMode() bool {
+	if x != nil {
+		return x.SandboxMode
+	}
+	return false
+}
+
+func (x *CheckoutOrder) GetCaptureId() string {
+	if x != nil {
+		return x.CaptureId
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetReconcileAttempts() int32 {
+	if x != nil {
+		return x.ReconcileAttempts
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetLastCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetTaxCountryCode() string {
+	if x != nil {
+		return x.TaxCountryCode
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetTaxInCents() int64 {
+	if x != nil {
+		return x.TaxInCents
+	}
+	return 0
+}
+
+func (x *CheckoutOrder) GetBillingCountryCode() string {
+	if x != nil {
+		return x.BillingCountryCode
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetIpCountryCode() string {
+	if x != nil {
+		return x.IpCountryCode
+	}
+	return ""
+}
+
+func (x *CheckoutOrder) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CheckoutOrder) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Kind              Invoice_Kind           `protobuf:"varint,100,opt,name=kind,proto3,enum=rslbot.db.Invoice_Kind" json:"kind,omitempty"`
+	Number            string                 `protobuf:"bytes,101,opt,name=number,proto3" json:"number,omitempty"` // Gap-free in each series, e.g. INV-2026-000042
+	IssuedAt          *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	BillingName       string                 `protobuf:"bytes,103,opt,name=billing_name,json=billingName,proto3" json:"billing_name,omitempty"`
+	BillingEmail      string                 `protobuf:"bytes,104,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
+	Description       string                 `protobuf:"bytes,105,opt,name=description,proto3" json:"description,omitempty"`                             // Line item
+	AmountInCents     int64                  `protobuf:"varint,106,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"` // Amount invoiced, or credited for credit notes
+	Currency          string                 `protobuf:"bytes,107,opt,name=currency,proto3" json:"currency,omitempty"`
+	RefundId          string                 `protobuf:"bytes,108,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"` // Provider-side refund of a credit note
+	Pdf               []byte                 `protobuf:"bytes,109,opt,name=pdf,proto3" json:"pdf,omitempty"`                           // Rendered document, empty until first rendered
+	RenderedAt        *timestamppb.Timestamp `protobuf:"bytes,110,opt,name=rendered_at,json=renderedAt,proto3" json:"rendered_at,omitempty"`
+	Payment           *Payment               `protobuf:"bytes,200,opt,name=payment,proto3" json:"payment,omitempty"`
+	PaymentId         int64                  `protobuf:"varint,201,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	User              *User                  `protobuf:"bytes,202,opt,name=user,proto3" json:"user,omitempty"`
+	UserId            int64                  `protobuf:"varint,203,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreditedInvoiceId int64                  `protobuf:"varint,204,opt,name=credited_invoice_id,json=creditedInvoiceId,proto3" json:"credited_invoice_id,omitempty"` // Invoice corrected by a credit note
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Invoice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetKind() Invoice_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Invoice_KIND_UNSPECIFIED
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetBillingName() string {
+	if x != nil {
+		return x.BillingName
+	}
+	return ""
+}
+
+func (x *Invoice) GetBillingEmail() string {
+	if x != nil {
+		return x.BillingEmail
+	}
+	return ""
+}
+
+func (x *Invoice) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Invoice) GetAmountInCents() int64 {
+	if x != nil {
+		return x.AmountInCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *Invoice) GetRenderedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RenderedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Invoice) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *Invoice) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Invoice) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreditedInvoiceId() int64 {
+	if x != nil {
+		return x.CreditedInvoiceId
+	}
+	return 0
+}
+
+type ReferralCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Code      string                 `protobuf:"bytes,100,opt,name=code,proto3" json:"code,omitempty"`
+	User      *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId    int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ReferralCode) Reset() {
+	*x = ReferralCode{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralCode) ProtoMessage() {}
+
+func (x *ReferralCode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralCode.ProtoReflect.Descriptor instead.
+func (*ReferralCode) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReferralCode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReferralCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReferralCode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ReferralCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReferralCode) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ReferralCode) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Commission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Kind            Commission_Kind        `protobuf:"varint,100,opt,name=kind,proto3,enum=rslbot.db.Commission_Kind" json:"kind,omitempty"`
+	Status          Commission_Status      `protobuf:"varint,101,opt,name=status,proto3,enum=rslbot.db.Commission_Status" json:"status,omitempty"`
+	AmountInCents   int64                  `protobuf:"varint,102,opt,name=amount_in_cents,json=amountInCents,proto3" json:"amount_in_cents,omitempty"` // Negative for reversals
+	Currency        string                 `protobuf:"bytes,103,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rate            float64                `protobuf:"fixed64,104,opt,name=rate,proto3" json:"rate,omitempty"`                          // Share of the payment net of tax
+	PayableAt       *timestamppb.Timestamp `protobuf:"bytes,105,opt,name=payable_at,json=payableAt,proto3" json:"payable_at,omitempty"` // End of the holding period of pending commissions
+	PaidAt          *timestamppb.Timestamp `protobuf:"bytes,106,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	PayoutReference string                 `protobuf:"bytes,107,opt,name=payout_reference,json=payoutReference,proto3" json:"payout_reference,omitempty"` // Reference of the payout on the admin side, e.g. a PayPal payout ID
+	RefundId        string                 `protobuf:"bytes,108,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`                      // Refund reversed by a reversal
+	Payment         *Payment               `protobuf:"bytes,200,opt,name=payment,proto3" json:"payment,omitempty"`
+	PaymentId       int64                  `protobuf:"varint,201,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	User            *User                  `protobuf:"bytes,202,opt,name=user,proto3" json:"user,omitempty"` // Referrer
+	UserId          int64                  `protobuf:"varint,203,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *Commission) Reset() {
+	*x = Commission{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Commission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commission) ProtoMessage() {}
+
+func (x *Commission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commission.ProtoReflect.Descriptor instead.
+func (*Commission) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Commission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Commission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Commission) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Commission) GetKind() Commission_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Commission_KIND_UNSPECIFIED
+}
+
+func (x *Commission) GetStatus() Commission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Commission_STATUS_UNSPECIFIED
+}
+
+func (x *Commission) GetAmountInCents() int64 {
+	if x != nil {
+		return x.AmountInCents
+	}
+	return 0
+}
+
+func (x *Commission) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Commission) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Commission) GetPayableAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PayableAt
+	}
+	return nil
+}
+
+func (x *Commission) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *Commission) GetPayoutReference() string {
+	if x != nil {
+		return x.PayoutReference
+	}
+	return ""
+}
+
+func (x *Commission) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *Commission) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Commission) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *Commission) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Commission) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status       RefundRequest_Status   `protobuf:"varint,100,opt,name=status,proto3,enum=rslbot.db.RefundRequest_Status" json:"status,omitempty"`
+	Reason       string                 `protobuf:"bytes,101,opt,name=reason,proto3" json:"reason,omitempty"`                                 // Given by the user
+	DecisionNote string                 `protobuf:"bytes,102,opt,name=decision_note,json=decisionNote,proto3" json:"decision_note,omitempty"` // Given by the admin
+	DecidedBy    string                 `protobuf:"bytes,103,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`          // Username of the admin
+	DecidedAt    *timestamppb.Timestamp `protobuf:"bytes,104,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	RefundId     string                 `protobuf:"bytes,105,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"` // Provider-side refund ID, set on approval
+	Payment      *Payment               `protobuf:"bytes,200,opt,name=payment,proto3" json:"payment,omitempty"`
+	PaymentId    int64                  `protobuf:"varint,201,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	User         *User                  `protobuf:"bytes,202,opt,name=user,proto3" json:"user,omitempty"`
+	UserId       int64                  `protobuf:"varint,203,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RefundRequest) Reset() {
+	*x = RefundRequest{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRequest) ProtoMessage() {}
+
+func (x *RefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRequest.ProtoReflect.Descriptor instead.
+func (*RefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefundRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetStatus() RefundRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RefundRequest_STATUS_UNSPECIFIED
+}
+
+func (x *RefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetDecisionNote() string {
+	if x != nil {
+		return x.DecisionNote
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name       string                 `protobuf:"bytes,100,opt,name=name,proto3" json:"name,omitempty"`                      // Given by the owner, e.g. "offsets CI"
+	Prefix     string                 `protobuf:"bytes,101,opt,name=prefix,proto3" json:"prefix,omitempty"`                  // Start of the key, to recognize it in listings
+	KeyHash    string                 `protobuf:"bytes,102,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"` // SHA-256 of the key, the key itself is only shown once
+	Scopes     string                 `protobuf:"bytes,103,opt,name=scopes,proto3" json:"scopes,omitempty"`                  // Comma separated, e.g. "licenses:read,offsets:write"
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,105,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,106,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt  *timestamppb.Timestamp `protobuf:"bytes,107,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	User       *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId     int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApiKey) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetKeyHash() string {
+	if x != nil {
+		return x.KeyHash
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() string {
+	if x != nil {
+		return x.Scopes
+	}
+	return ""
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ApiKey) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ImpersonationAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ImpersonationId  string                 `protobuf:"bytes,100,opt,name=impersonation_id,json=impersonationId,proto3" json:"impersonation_id,omitempty"` // Shared by the calls of a token
+	AdminDiscourseId int64                  `protobuf:"varint,101,opt,name=admin_discourse_id,json=adminDiscourseId,proto3" json:"admin_discourse_id,omitempty"`
+	AdminUsername    string                 `protobuf:"bytes,102,opt,name=admin_username,json=adminUsername,proto3" json:"admin_username,omitempty"`
+	Method           string                 `protobuf:"bytes,103,opt,name=method,proto3" json:"method,omitempty"` // Full gRPC method, AdminImpersonateUser for the token creation
+	ReadWrite        bool                   `protobuf:"varint,104,opt,name=read_write,json=readWrite,proto3" json:"read_write,omitempty"`
+	Allowed          bool                   `protobuf:"varint,105,opt,name=allowed,proto3" json:"allowed,omitempty"` // False when the call was refused
+	Reason           string                 `protobuf:"bytes,106,opt,name=reason,proto3" json:"reason,omitempty"`    // Given by the admin when creating the token
+	User             *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId           int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ImpersonationAudit) Reset() {
+	*x = ImpersonationAudit{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationAudit) ProtoMessage() {}
+
+func (x *ImpersonationAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationAudit.ProtoReflect.Descriptor instead.
+func (*ImpersonationAudit) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImpersonationAudit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImpersonationAudit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImpersonationAudit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ImpersonationAudit) GetImpersonationId() string {
+	if x != nil {
+		return x.ImpersonationId
+	}
+	return ""
+}
+
+func (x *ImpersonationAudit) GetAdminDiscourseId() int64 {
+	if x != nil {
+		return x.AdminDiscourseId
+	}
+	return 0
+}
+
+func (x *ImpersonationAudit) GetAdminUsername() string {
+	if x != nil {
+		return x.AdminUsername
+	}
+	return ""
+}
+
+func (x *ImpersonationAudit) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ImpersonationAudit) GetReadWrite() bool {
+	if x != nil {
+		return x.ReadWrite
+	}
+	return false
+}
+
+func (x *ImpersonationAudit) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ImpersonationAudit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonationAudit) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ImpersonationAudit) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type InvoiceSequence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Series     string                 `protobuf:"bytes,100,opt,name=series,proto3" json:"series,omitempty"`                            // Number prefix, e.g. INV-2026
+	LastNumber int64                  `protobuf:"varint,101,opt,name=last_number,json=lastNumber,proto3" json:"last_number,omitempty"` // Last number allocated in the series
+}
+
+func (x *InvoiceSequence) Reset() {
+	*x = InvoiceSequence{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceSequence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceSequence) ProtoMessage() {}
+
+func (x *InvoiceSequence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceSequence.ProtoReflect.Descriptor instead.
+func (*InvoiceSequence) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InvoiceSequence) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvoiceSequence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InvoiceSequence) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *InvoiceSequence) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *InvoiceSequence) GetLastNumber() int64 {
+	if x != nil {
+		return x.LastNumber
+	}
+	return 0
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DiscourseId int64                  `protobuf:"varint,100,opt,name=discourse_id,json=discourseId,proto3" json:"discourse_id,omitempty"`
+	Email       string                 `protobuf:"bytes,101,opt,name=email,proto3" json:"email,omitempty"`
+	Username    string                 `protobuf:"bytes,102,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetDiscourseId() int64 {
+	if x != nil {
+		return x.DiscourseId
+	}
+	return 0
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UserRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Role      UserRole_Role          `protobuf:"varint,100,opt,name=role,proto3,enum=rslbot.db.UserRole_Role" json:"role,omitempty"`
+	GrantedBy string                 `protobuf:"bytes,101,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"` // Username of the admin
+	User      *User                  `protobuf:"bytes,200,opt,name=user,proto3" json:"user,omitempty"`
+	UserId    int64                  `protobuf:"varint,201,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UserRole) Reset() {
+	*x = UserRole{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRole) ProtoMessage() {}
+
+func (x *UserRole) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRole.ProtoReflect.Descriptor instead.
+func (*UserRole) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserRole) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserRole) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserRole) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserRole) GetRole() UserRole_Role {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_ROLE_UNSPECIFIED
+}
+
+func (x *UserRole) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *UserRole) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserRole) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DiscourseUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalId int64    `protobuf:"varint,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Username   string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email      string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Groups     []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	Admin      bool     `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (x *DiscourseUser) Reset() {
+	*x = DiscourseUser{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscourseUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscourseUser) ProtoMessage() {}
+
+func (x *DiscourseUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscourseUser.ProtoReflect.Descriptor instead.
+func (*DiscourseUser) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DiscourseUser) GetExternalId() int64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
+}
+
+func (x *DiscourseUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DiscourseUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DiscourseUser) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *DiscourseUser) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type Offset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version   string                 `protobuf:"bytes,100,opt,name=version,proto3" json:"version,omitempty"` // Client version (e.g., "1.2.3")
+	Data      []byte                 `protobuf:"bytes,101,opt,name=data,proto3" json:"data,omitempty"`       // Serialized PixelDefinitions data (JSON)
+}
+
+func (x *Offset) Reset() {
+	*x = Offset{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Offset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offset) ProtoMessage() {}
+
+func (x *Offset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offset.ProtoReflect.Descriptor instead.
+func (*Offset) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Offset) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Offset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Offset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Offset) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Offset) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WebhookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Provider      WebhookEvent_Provider  `protobuf:"varint,100,opt,name=provider,proto3,enum=rslbot.db.WebhookEvent_Provider" json:"provider,omitempty"`
+	EventId       string                 `protobuf:"bytes,101,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // Provider-side event ID
+	EventType     string                 `protobuf:"bytes,102,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload       string                 `protobuf:"bytes,103,opt,name=payload,proto3" json:"payload,omitempty"` // Raw verified body, replayed by the inbox worker
+	Status        WebhookEvent_Status    `protobuf:"varint,104,opt,name=status,proto3,enum=rslbot.db.WebhookEvent_Status" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,105,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,106,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,107,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	ProcessedAt   *timestamppb.Timestamp `protobuf:"bytes,108,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+}
+
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent) ProtoMessage() {}
+
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rslbot_rbdb_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return file_proto_rslbot_rbdb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WebhookEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetProvider() WebhookEvent_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WebhookEvent_PROVIDER_UNSPECIFIED
+}
+
+func (x *WebhookEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetStatus() WebhookEvent_Status {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookEvent_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+var File_proto_rslbot_rbdb_proto protoreflect.FileDescriptor
+
+var file_proto_rslbot_rbdb_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2f, 0x72,
+	0x62, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xf6, 0x09, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02,
+	0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
 	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
@@ -1170,126 +3690,196 @@ var file_proto_rslbot_rbdb_proto_rawDesc = []byte{
 	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x04,
 	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c,
 	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e,
-	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62,
-	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02,
-	0x22, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65,
-	0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x22, 0x00, 0x52, 0x0a, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73,
-	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x22, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x44, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
-	0x2e, 0x64, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x22, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xed, 0x02, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
-	0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53,
-	0x45, 0x52, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a,
-	0x19, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x52, 0x4d, 0x45, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x4e,
-	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x57, 0x41,
-	0x4c, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4d,
-	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d,
-	0x0a, 0x19, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1e, 0x0a,
-	0x1a, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1d, 0x0a,
-	0x19, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x57, 0x45, 0x44, 0x10, 0x08, 0x12, 0x24, 0x0a, 0x20,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x09, 0x12, 0x1f, 0x0a, 0x1b, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e,
-	0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x0a, 0x12, 0x21, 0x0a, 0x1d, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44, 0x4d, 0x49,
-	0x4e, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xeb,
-	0x05, 0x0a, 0x0a, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a,
-	0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x41, 0x0a,
-	0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x22, 0x00, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x42,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x22, 0x00, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xca,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64,
+	0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x22,
+	0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02,
+	0x22, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9d, 0x06, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x47,
+	0x49, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x45,
+	0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c,
+	0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x43, 0x45,
+	0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x57, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x19, 0x0a,
+	0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4e,
+	0x45, 0x57, 0x45, 0x44, 0x10, 0x08, 0x12, 0x24, 0x0a, 0x20, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53,
+	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x1f, 0x0a, 0x1b,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e,
+	0x53, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x21, 0x0a,
+	0x1d, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4c, 0x49, 0x43, 0x45,
+	0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b,
+	0x12, 0x15, 0x0a, 0x11, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x4f, 0x4e, 0x59, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41,
+	0x44, 0x44, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x0e, 0x12,
+	0x20, 0x0a, 0x1c, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x44, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10,
+	0x0f, 0x12, 0x19, 0x0a, 0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53,
+	0x50, 0x55, 0x54, 0x45, 0x44, 0x10, 0x11, 0x12, 0x21, 0x0a, 0x1d, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x13, 0x12, 0x1a, 0x0a, 0x16, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x43,
+	0x45, 0x4e, 0x53, 0x45, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x45, 0x4e, 0x45, 0x44, 0x10, 0x14,
+	0x12, 0x19, 0x0a, 0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45,
+	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x15, 0x12, 0x1d, 0x0a, 0x19, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x16, 0x12, 0x17, 0x0a, 0x13, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x17, 0x12, 0x19, 0x0a, 0x15, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x18, 0x12, 0x20,
+	0x0a, 0x1c, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x19,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x1a,
+	0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xeb, 0x05, 0x0a, 0x0a, 0x4c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
-	0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
-	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
-	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a,
-	0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x73,
-	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b,
-	0x65, 0x79, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x22, 0x64, 0x0a,
-	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49,
-	0x46, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x45, 0x5f,
-	0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x4e, 0x45, 0x5f, 0x4d, 0x4f,
-	0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x58, 0x5f, 0x4d, 0x4f, 0x4e,
-	0x54, 0x48, 0x53, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x45, 0x5f, 0x59, 0x45, 0x41,
-	0x52, 0x10, 0x05, 0x22, 0x4f, 0x0a, 0x04, 0x54, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x54,
-	0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x10, 0x01,
-	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x52,
-	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49,
-	0x55, 0x4d, 0x10, 0x03, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xb8, 0x07, 0x0a,
-	0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x6c,
-	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x2b, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30,
-	0x01, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26,
-	0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72,
-	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
-	0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x18, 0x69, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
-	0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c,
-	0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30,
+	0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x67, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x68, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73, 0x18, 0x69, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6e, 0x64,
+	0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19,
+	0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18,
+	0x6b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64,
+	0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x54, 0x69, 0x65,
+	0x72, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x22, 0x64, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x46, 0x45, 0x54, 0x49, 0x4d, 0x45,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x45, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x4e, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12,
+	0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x58, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x53, 0x10, 0x04, 0x12,
+	0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x45, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x05, 0x22, 0x4f, 0x0a,
+	0x04, 0x54, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54,
+	0x49, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x49,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x54, 0x49, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x10, 0x03, 0x3a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x82, 0x0d, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0c, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x67, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x49, 0x0a, 0x10,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x68, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x61, 0x6c, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f,
+	0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x61,
+	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x6c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a,
+	0x0b, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x6e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x71, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x74, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x78,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x75, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74,
+	0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x76, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61,
+	0x78, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x79, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
 	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
 	0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12,
 	0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
@@ -1302,68 +3892,509 @@ var file_proto_rslbot_rbdb_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x73,
 	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x22, 0x00, 0x52, 0x0c, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x22, 0x63, 0x0a, 0x08, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
-	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x4e,
-	0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45,
-	0x52, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52,
-	0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45, 0x10, 0x03, 0x3a,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xfb, 0x05, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41,
+	0x4c, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x05, 0x22, 0x63,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52,
+	0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52,
+	0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x50, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x13,
+	0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50,
+	0x45, 0x10, 0x03, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xd8, 0x07, 0x0a, 0x0c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02,
+	0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x18,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30, 0x01, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x68,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x6b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12,
+	0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79,
+	0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x0a, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22,
+	0x5d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x55, 0x45, 0x10, 0x03, 0x3a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xfd, 0x03, 0x0a, 0x0b, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02,
+	0x30, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x6b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xbc, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72,
+	0x18, 0x66, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x54, 0x69,
+	0x65, 0x72, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x68, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x06, 0xba,
+	0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xf9, 0x04, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19,
+	0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x5f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x23, 0xba, 0xb9, 0x19, 0x1f, 0x0a, 0x1d, 0x52, 0x1b, 0x69, 0x64, 0x78, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x25, 0xba, 0xb9, 0x19, 0x21, 0x0a, 0x1f, 0x18, 0x03, 0x52, 0x1b, 0x69, 0x64,
+	0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x69,
-	0x70, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02,
-	0x30, 0x01, 0x52, 0x14, 0x73, 0x74, 0x72, 0x69, 0x70, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x69,
-	0x70, 0x65, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x65,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x72, 0x69, 0x70, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x66, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
-	0x64, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a,
-	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x39,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
+	0x01, 0x22, 0xe2, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x0a, 0x04, 0x18, 0x03, 0x30, 0x01,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x07, 0x54, 0x61, 0x78, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x0a, 0x04,
+	0x18, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x91, 0x0a,
+	0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19,
+	0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6e, 0x64,
-	0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30, 0x01, 0x52, 0x0f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x5a,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x21, 0xba, 0xb9, 0x19, 0x1d, 0x0a, 0x1b, 0x52, 0x19, 0x69, 0x64, 0x78, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x67,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62,
+	0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x68, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x69,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x6b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x2b, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x6e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0xb9, 0x19, 0x08, 0x0a, 0x06, 0x12, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x78, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
+	0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x72, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x61,
+	0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x73, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x78,
+	0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x74, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x75, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50,
+	0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f,
+	0x49, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
+	0x01, 0x22, 0x92, 0x07, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a,
+	0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x2e,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06,
+	0x0a, 0x04, 0x18, 0x20, 0x30, 0x01, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x37,
+	0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x68, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x69,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x70, 0x64, 0x66, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x10, 0xba, 0xb9, 0x19, 0x0c, 0x0a, 0x0a, 0x12, 0x08, 0x4c, 0x4f, 0x4e, 0x47, 0x42, 0x4c, 0x4f,
+	0x42, 0x52, 0x03, 0x70, 0x64, 0x66, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xc8,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64,
+	0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22,
+	0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b,
+	0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x1b, 0xba, 0xb9, 0x19, 0x17, 0x0a, 0x15, 0x52, 0x13, 0x69, 0x64, 0x78,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c,
 	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19,
 	0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73,
-	0x65, 0x4b, 0x65, 0x79, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01,
-	0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e,
-	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0xcb,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65,
-	0x79, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0xcc,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49,
+	0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x3a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xb2, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x0a, 0x04, 0x18, 0x10, 0x30,
+	0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
+	0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12,
+	0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1e, 0xba, 0xb9, 0x19,
+	0x1a, 0x0a, 0x18, 0x5a, 0x16, 0x69, 0x64, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xbb, 0x07, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f,
+	0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x1d, 0xba, 0xb9, 0x19, 0x19, 0x0a, 0x17,
+	0x52, 0x15, 0x69, 0x64, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x6a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x6b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x6c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40,
+	0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1e, 0xba, 0xb9, 0x19, 0x1a, 0x0a, 0x18, 0x52, 0x16, 0x69, 0x64, 0x78, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06,
+	0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1b,
+	0xba, 0xb9, 0x19, 0x17, 0x0a, 0x15, 0x52, 0x13, 0x69, 0x64, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x41, 0x52, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x56, 0x45,
+	0x52, 0x53, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x59, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x59, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10,
+	0x03, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x9d, 0x06, 0x0a, 0x0d, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x5a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x21, 0xba, 0xb9,
+	0x19, 0x1d, 0x0a, 0x1b, 0x52, 0x19, 0x69, 0x64, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0xb9, 0x19, 0x08, 0x0a, 0x06, 0x12,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x0d, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x66,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0xb9, 0x19, 0x08, 0x0a, 0x06, 0x12, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x67,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x39, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x68, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x73, 0x6c, 0x62,
+	0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0xba,
+	0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x22, 0xba, 0xb9, 0x19, 0x1e, 0x0a, 0x1c, 0x52,
+	0x1a, 0x69, 0x64, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xca,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02,
+	0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1f, 0xba, 0xb9, 0x19, 0x1b,
+	0x0a, 0x19, 0x52, 0x17, 0x69, 0x64, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
 	0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x3a, 0x06, 0xba,
-	0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x8d, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10,
+	0x03, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xab, 0x04, 0x0a, 0x06, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x25, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x0a, 0x04, 0x18, 0x40, 0x30, 0x01, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x69, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xba, 0xb9, 0x19, 0x14, 0x0a, 0x12, 0x52,
+	0x10, 0x69, 0x64, 0x78, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
+	0x01, 0x4a, 0x04, 0x08, 0x68, 0x10, 0x69, 0x22, 0xdd, 0x04, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04,
 	0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
@@ -1372,47 +4403,192 @@ var file_proto_rslbot_rbdb_proto_rawDesc = []byte{
 	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b,
-	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xba, 0xb9, 0x19, 0x14, 0x0a, 0x12, 0x5a, 0x10, 0x69, 0x64,
-	0x78, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x66, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x06, 0xba,
-	0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0xea, 0x01, 0x0a, 0x06, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x58,
+	0x0a, 0x10, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xba, 0xb9, 0x19, 0x29, 0x0a, 0x27,
+	0x52, 0x25, 0x69, 0x64, 0x78, 0x5f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25,
+	0xba, 0xb9, 0x19, 0x21, 0x0a, 0x1f, 0x52, 0x1d, 0x69, 0x64, 0x78, 0x5f, 0x69, 0x6d, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x67,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72,
+	0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0a, 0xba,
+	0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x3e, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x24, 0xba, 0xb9, 0x19, 0x20, 0x0a, 0x1e, 0x52, 0x1c, 0x69, 0x64, 0x78, 0x5f, 0x69,
+	0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x3a,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xee, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0xb9, 0x19,
+	0x06, 0x0a, 0x04, 0x18, 0x20, 0x30, 0x01, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x8d, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba,
+	0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xba, 0xb9, 0x19, 0x14, 0x0a, 0x12, 0x5a,
+	0x10, 0x69, 0x64, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xec, 0x03, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x1f, 0xba,
+	0xb9, 0x19, 0x1b, 0x0a, 0x19, 0x5a, 0x17, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x22, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1f, 0xba, 0xb9, 0x19, 0x1b, 0x0a, 0x19, 0x5a,
+	0x17, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x6d, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x02,
+	0x12, 0x10, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x42, 0x49, 0x4c, 0x4c, 0x49, 0x4e, 0x47,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x53, 0x45,
+	0x54, 0x53, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x04, 0x3a,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0xea, 0x01, 0x0a, 0x06, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30, 0x01,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x10, 0xba, 0xb9, 0x19, 0x0c, 0x0a, 0x0a, 0x12,
+	0x08, 0x4c, 0x4f, 0x4e, 0x47, 0x42, 0x4c, 0x4f, 0x42, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0xd8, 0x07, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x30, 0x01, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x65, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x10, 0xba, 0xb9, 0x19, 0x0c, 0x0a, 0x0a, 0x12, 0x08, 0x4c,
-	0x4f, 0x4e, 0x47, 0x42, 0x4c, 0x4f, 0x42, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x06, 0xba,
-	0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x7c, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x73, 0x6c,
-	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x42, 0x09, 0x52, 0x62, 0x64, 0x62, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x62, 0x64, 0x62, 0x3b, 0x72, 0x62, 0x64, 0x62,
-	0xa2, 0x02, 0x03, 0x52, 0x44, 0x58, 0xaa, 0x02, 0x09, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e,
-	0x44, 0x62, 0xca, 0x02, 0x09, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x44, 0x62, 0xe2, 0x02,
-	0x15, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x44, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x3a,
-	0x3a, 0x44, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x69, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x72, 0x73, 0x6c,
+	0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x2b, 0xba, 0xb9,
+	0x19, 0x27, 0x0a, 0x25, 0x5a, 0x23, 0x69, 0x64, 0x78, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xba, 0xb9, 0x19, 0x2a, 0x0a, 0x28, 0x18, 0xbf, 0x01,
+	0x5a, 0x23, 0x69, 0x64, 0x78, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
+	0xba, 0xb9, 0x19, 0x0e, 0x0a, 0x0c, 0x12, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x68, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x72, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x64, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x30, 0xba, 0xb9, 0x19,
+	0x2c, 0x0a, 0x2a, 0x52, 0x28, 0x69, 0x64, 0x78, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x6a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0xb9, 0x19, 0x08, 0x0a, 0x06, 0x12, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x74,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x30, 0xba, 0xb9, 0x19, 0x2c, 0x0a, 0x2a, 0x52, 0x28, 0x69, 0x64, 0x78,
+	0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x61, 0x74, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x66, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
+	0x59, 0x50, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x50, 0x45, 0x10, 0x03, 0x22, 0x5d, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43,
+	0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02,
+	0x08, 0x01, 0x42, 0x7c, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74,
+	0x2e, 0x64, 0x62, 0x42, 0x09, 0x52, 0x62, 0x64, 0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x1b, 0x72, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x72, 0x62, 0x64, 0x62, 0x3b, 0x72, 0x62, 0x64, 0x62, 0xa2, 0x02, 0x03,
+	0x52, 0x44, 0x58, 0xaa, 0x02, 0x09, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x2e, 0x44, 0x62, 0xca,
+	0x02, 0x09, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x44, 0x62, 0xe2, 0x02, 0x15, 0x52, 0x73,
+	0x6c, 0x62, 0x6f, 0x74, 0x5c, 0x44, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x52, 0x73, 0x6c, 0x62, 0x6f, 0x74, 0x3a, 0x3a, 0x44, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1427,8 +4603,8 @@ func file_proto_rslbot_rbdb_proto_rawDescGZIP() []byte {
 	return file_proto_rslbot_rbdb_proto_rawDescData
 }
 
-var file_proto_rslbot_rbdb_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_proto_rslbot_rbdb_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_rslbot_rbdb_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_proto_rslbot_rbdb_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_proto_rslbot_rbdb_proto_goTypes = []any{
 	(Activity_Kind)(0),            // 0: rslbot.db.Activity.Kind
 	(LicenseKey_Duration)(0),      // 1: rslbot.db.LicenseKey.Duration
@@ -1436,52 +4612,153 @@ var file_proto_rslbot_rbdb_proto_goTypes = []any{
 	(Payment_Status)(0),           // 3: rslbot.db.Payment.Status
 	(Payment_Provider)(0),         // 4: rslbot.db.Payment.Provider
 	(Subscription_Status)(0),      // 5: rslbot.db.Subscription.Status
-	(*Activity)(nil),              // 6: rslbot.db.Activity
-	(*LicenseKey)(nil),            // 7: rslbot.db.LicenseKey
-	(*Payment)(nil),               // 8: rslbot.db.Payment
-	(*Subscription)(nil),          // 9: rslbot.db.Subscription
-	(*User)(nil),                  // 10: rslbot.db.User
-	(*DiscourseUser)(nil),         // 11: rslbot.db.DiscourseUser
-	(*Offset)(nil),                // 12: rslbot.db.Offset
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(CheckoutOrder_Status)(0),     // 6: rslbot.db.CheckoutOrder.Status
+	(Invoice_Kind)(0),             // 7: rslbot.db.Invoice.Kind
+	(Commission_Kind)(0),          // 8: rslbot.db.Commission.Kind
+	(Commission_Status)(0),        // 9: rslbot.db.Commission.Status
+	(RefundRequest_Status)(0),     // 10: rslbot.db.RefundRequest.Status
+	(UserRole_Role)(0),            // 11: rslbot.db.UserRole.Role
+	(WebhookEvent_Provider)(0),    // 12: rslbot.db.WebhookEvent.Provider
+	(WebhookEvent_Status)(0),      // 13: rslbot.db.WebhookEvent.Status
+	(*Activity)(nil),              // 14: rslbot.db.Activity
+	(*LicenseKey)(nil),            // 15: rslbot.db.LicenseKey
+	(*Payment)(nil),               // 16: rslbot.db.Payment
+	(*Subscription)(nil),          // 17: rslbot.db.Subscription
+	(*BillingPlan)(nil),           // 18: rslbot.db.BillingPlan
+	(*Product)(nil),               // 19: rslbot.db.Product
+	(*Price)(nil),                 // 20: rslbot.db.Price
+	(*ExchangeRate)(nil),          // 21: rslbot.db.ExchangeRate
+	(*TaxRate)(nil),               // 22: rslbot.db.TaxRate
+	(*CheckoutOrder)(nil),         // 23: rslbot.db.CheckoutOrder
+	(*Invoice)(nil),               // 24: rslbot.db.Invoice
+	(*ReferralCode)(nil),          // 25: rslbot.db.ReferralCode
+	(*Commission)(nil),            // 26: rslbot.db.Commission
+	(*RefundRequest)(nil),         // 27: rslbot.db.RefundRequest
+	(*ApiKey)(nil),                // 28: rslbot.db.ApiKey
+	(*ImpersonationAudit)(nil),    // 29: rslbot.db.ImpersonationAudit
+	(*InvoiceSequence)(nil),       // 30: rslbot.db.InvoiceSequence
+	(*User)(nil),                  // 31: rslbot.db.User
+	(*UserRole)(nil),              // 32: rslbot.db.UserRole
+	(*DiscourseUser)(nil),         // 33: rslbot.db.DiscourseUser
+	(*Offset)(nil),                // 34: rslbot.db.Offset
+	(*WebhookEvent)(nil),          // 35: rslbot.db.WebhookEvent
+	(*timestamppb.Timestamp)(nil), // 36: google.protobuf.Timestamp
 }
 var file_proto_rslbot_rbdb_proto_depIdxs = []int32{
-	13, // 0: rslbot.db.Activity.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: rslbot.db.Activity.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: rslbot.db.Activity.kind:type_name -> rslbot.db.Activity.Kind
-	10, // 3: rslbot.db.Activity.user:type_name -> rslbot.db.User
-	7,  // 4: rslbot.db.Activity.license_key:type_name -> rslbot.db.LicenseKey
-	8,  // 5: rslbot.db.Activity.payment:type_name -> rslbot.db.Payment
-	9,  // 6: rslbot.db.Activity.subscription:type_name -> rslbot.db.Subscription
-	13, // 7: rslbot.db.LicenseKey.created_at:type_name -> google.protobuf.Timestamp
-	13, // 8: rslbot.db.LicenseKey.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 9: rslbot.db.LicenseKey.effective_from:type_name -> google.protobuf.Timestamp
-	1,  // 10: rslbot.db.LicenseKey.duration:type_name -> rslbot.db.LicenseKey.Duration
-	10, // 11: rslbot.db.LicenseKey.user:type_name -> rslbot.db.User
-	2,  // 12: rslbot.db.LicenseKey.tier:type_name -> rslbot.db.LicenseKey.Tier
-	13, // 13: rslbot.db.Payment.created_at:type_name -> google.protobuf.Timestamp
-	13, // 14: rslbot.db.Payment.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 15: rslbot.db.Payment.provider:type_name -> rslbot.db.Payment.Provider
-	1,  // 16: rslbot.db.Payment.license_duration:type_name -> rslbot.db.LicenseKey.Duration
-	10, // 17: rslbot.db.Payment.user:type_name -> rslbot.db.User
-	7,  // 18: rslbot.db.Payment.license_key:type_name -> rslbot.db.LicenseKey
-	9,  // 19: rslbot.db.Payment.subscription:type_name -> rslbot.db.Subscription
-	13, // 20: rslbot.db.Subscription.created_at:type_name -> google.protobuf.Timestamp
-	13, // 21: rslbot.db.Subscription.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 22: rslbot.db.Subscription.status:type_name -> rslbot.db.Subscription.Status
-	1,  // 23: rslbot.db.Subscription.duration:type_name -> rslbot.db.LicenseKey.Duration
-	13, // 24: rslbot.db.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
-	10, // 25: rslbot.db.Subscription.user:type_name -> rslbot.db.User
-	7,  // 26: rslbot.db.Subscription.license_key:type_name -> rslbot.db.LicenseKey
-	13, // 27: rslbot.db.User.created_at:type_name -> google.protobuf.Timestamp
-	13, // 28: rslbot.db.User.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 29: rslbot.db.Offset.created_at:type_name -> google.protobuf.Timestamp
-	13, // 30: rslbot.db.Offset.updated_at:type_name -> google.protobuf.Timestamp
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	36,  // 0: rslbot.db.Activity.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 1: rslbot.db.Activity.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: rslbot.db.Activity.kind:type_name -> rslbot.db.Activity.Kind
+	31,  // 3: rslbot.db.Activity.user:type_name -> rslbot.db.User
+	15,  // 4: rslbot.db.Activity.license_key:type_name -> rslbot.db.LicenseKey
+	16,  // 5: rslbot.db.Activity.payment:type_name -> rslbot.db.Payment
+	17,  // 6: rslbot.db.Activity.subscription:type_name -> rslbot.db.Subscription
+	36,  // 7: rslbot.db.LicenseKey.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 8: rslbot.db.LicenseKey.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 9: rslbot.db.LicenseKey.effective_from:type_name -> google.protobuf.Timestamp
+	1,   // 10: rslbot.db.LicenseKey.duration:type_name -> rslbot.db.LicenseKey.Duration
+	31,  // 11: rslbot.db.LicenseKey.user:type_name -> rslbot.db.User
+	2,   // 12: rslbot.db.LicenseKey.tier:type_name -> rslbot.db.LicenseKey.Tier
+	36,  // 13: rslbot.db.Payment.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 14: rslbot.db.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 15: rslbot.db.Payment.provider:type_name -> rslbot.db.Payment.Provider
+	1,   // 16: rslbot.db.Payment.license_duration:type_name -> rslbot.db.LicenseKey.Duration
+	36,  // 17: rslbot.db.Payment.refunded_at:type_name -> google.protobuf.Timestamp
+	36,  // 18: rslbot.db.Payment.disputed_at:type_name -> google.protobuf.Timestamp
+	3,   // 19: rslbot.db.Payment.status:type_name -> rslbot.db.Payment.Status
+	36,  // 20: rslbot.db.Payment.completed_at:type_name -> google.protobuf.Timestamp
+	36,  // 21: rslbot.db.Payment.failed_at:type_name -> google.protobuf.Timestamp
+	31,  // 22: rslbot.db.Payment.user:type_name -> rslbot.db.User
+	15,  // 23: rslbot.db.Payment.license_key:type_name -> rslbot.db.LicenseKey
+	17,  // 24: rslbot.db.Payment.subscription:type_name -> rslbot.db.Subscription
+	36,  // 25: rslbot.db.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 26: rslbot.db.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 27: rslbot.db.Subscription.status:type_name -> rslbot.db.Subscription.Status
+	1,   // 28: rslbot.db.Subscription.duration:type_name -> rslbot.db.LicenseKey.Duration
+	36,  // 29: rslbot.db.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
+	36,  // 30: rslbot.db.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
+	4,   // 31: rslbot.db.Subscription.provider:type_name -> rslbot.db.Payment.Provider
+	36,  // 32: rslbot.db.Subscription.canceled_at:type_name -> google.protobuf.Timestamp
+	31,  // 33: rslbot.db.Subscription.user:type_name -> rslbot.db.User
+	15,  // 34: rslbot.db.Subscription.license_key:type_name -> rslbot.db.LicenseKey
+	36,  // 35: rslbot.db.BillingPlan.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 36: rslbot.db.BillingPlan.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 37: rslbot.db.BillingPlan.provider:type_name -> rslbot.db.Payment.Provider
+	1,   // 38: rslbot.db.BillingPlan.duration:type_name -> rslbot.db.LicenseKey.Duration
+	36,  // 39: rslbot.db.Product.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 40: rslbot.db.Product.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 41: rslbot.db.Product.tier:type_name -> rslbot.db.LicenseKey.Tier
+	36,  // 42: rslbot.db.Price.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 43: rslbot.db.Price.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 44: rslbot.db.Price.duration:type_name -> rslbot.db.LicenseKey.Duration
+	36,  // 45: rslbot.db.Price.valid_from:type_name -> google.protobuf.Timestamp
+	36,  // 46: rslbot.db.Price.valid_until:type_name -> google.protobuf.Timestamp
+	19,  // 47: rslbot.db.Price.product:type_name -> rslbot.db.Product
+	36,  // 48: rslbot.db.ExchangeRate.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 49: rslbot.db.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 50: rslbot.db.TaxRate.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 51: rslbot.db.TaxRate.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 52: rslbot.db.CheckoutOrder.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 53: rslbot.db.CheckoutOrder.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 54: rslbot.db.CheckoutOrder.provider:type_name -> rslbot.db.Payment.Provider
+	6,   // 55: rslbot.db.CheckoutOrder.status:type_name -> rslbot.db.CheckoutOrder.Status
+	1,   // 56: rslbot.db.CheckoutOrder.license_duration:type_name -> rslbot.db.LicenseKey.Duration
+	36,  // 57: rslbot.db.CheckoutOrder.last_checked_at:type_name -> google.protobuf.Timestamp
+	36,  // 58: rslbot.db.CheckoutOrder.completed_at:type_name -> google.protobuf.Timestamp
+	31,  // 59: rslbot.db.CheckoutOrder.user:type_name -> rslbot.db.User
+	36,  // 60: rslbot.db.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 61: rslbot.db.Invoice.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 62: rslbot.db.Invoice.kind:type_name -> rslbot.db.Invoice.Kind
+	36,  // 63: rslbot.db.Invoice.issued_at:type_name -> google.protobuf.Timestamp
+	36,  // 64: rslbot.db.Invoice.rendered_at:type_name -> google.protobuf.Timestamp
+	16,  // 65: rslbot.db.Invoice.payment:type_name -> rslbot.db.Payment
+	31,  // 66: rslbot.db.Invoice.user:type_name -> rslbot.db.User
+	36,  // 67: rslbot.db.ReferralCode.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 68: rslbot.db.ReferralCode.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 69: rslbot.db.ReferralCode.user:type_name -> rslbot.db.User
+	36,  // 70: rslbot.db.Commission.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 71: rslbot.db.Commission.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 72: rslbot.db.Commission.kind:type_name -> rslbot.db.Commission.Kind
+	9,   // 73: rslbot.db.Commission.status:type_name -> rslbot.db.Commission.Status
+	36,  // 74: rslbot.db.Commission.payable_at:type_name -> google.protobuf.Timestamp
+	36,  // 75: rslbot.db.Commission.paid_at:type_name -> google.protobuf.Timestamp
+	16,  // 76: rslbot.db.Commission.payment:type_name -> rslbot.db.Payment
+	31,  // 77: rslbot.db.Commission.user:type_name -> rslbot.db.User
+	36,  // 78: rslbot.db.RefundRequest.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 79: rslbot.db.RefundRequest.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 80: rslbot.db.RefundRequest.status:type_name -> rslbot.db.RefundRequest.Status
+	36,  // 81: rslbot.db.RefundRequest.decided_at:type_name -> google.protobuf.Timestamp
+	16,  // 82: rslbot.db.RefundRequest.payment:type_name -> rslbot.db.Payment
+	31,  // 83: rslbot.db.RefundRequest.user:type_name -> rslbot.db.User
+	36,  // 84: rslbot.db.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 85: rslbot.db.ApiKey.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 86: rslbot.db.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	36,  // 87: rslbot.db.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	36,  // 88: rslbot.db.ApiKey.revoked_at:type_name -> google.protobuf.Timestamp
+	31,  // 89: rslbot.db.ApiKey.user:type_name -> rslbot.db.User
+	36,  // 90: rslbot.db.ImpersonationAudit.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 91: rslbot.db.ImpersonationAudit.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 92: rslbot.db.ImpersonationAudit.user:type_name -> rslbot.db.User
+	36,  // 93: rslbot.db.InvoiceSequence.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 94: rslbot.db.InvoiceSequence.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 95: rslbot.db.User.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 96: rslbot.db.User.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 97: rslbot.db.UserRole.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 98: rslbot.db.UserRole.updated_at:type_name -> google.protobuf.Timestamp
+	11,  // 99: rslbot.db.UserRole.role:type_name -> rslbot.db.UserRole.Role
+	31,  // 100: rslbot.db.UserRole.user:type_name -> rslbot.db.User
+	36,  // 101: rslbot.db.Offset.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 102: rslbot.db.Offset.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 103: rslbot.db.WebhookEvent.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 104: rslbot.db.WebhookEvent.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 105: rslbot.db.WebhookEvent.provider:type_name -> rslbot.db.WebhookEvent.Provider
+	13,  // 106: rslbot.db.WebhookEvent.status:type_name -> rslbot.db.WebhookEvent.Status
+	36,  // 107: rslbot.db.WebhookEvent.next_attempt_at:type_name -> google.protobuf.Timestamp
+	36,  // 108: rslbot.db.WebhookEvent.processed_at:type_name -> google.protobuf.Timestamp
+	109, // [109:109] is the sub-list for method output_type
+	109, // [109:109] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_proto_rslbot_rbdb_proto_init() }
@@ -1494,8 +4771,8 @@ func file_proto_rslbot_rbdb_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_rslbot_rbdb_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   7,
+			NumEnums:      14,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
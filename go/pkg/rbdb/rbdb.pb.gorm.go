@@ -14,6 +14,7 @@ import (
 
 type ActivityORM struct {
 	CreatedAt      *time.Time
+	Details        string
 	Id             int64 `gorm:"primaryKey"`
 	Kind           int32
 	LicenseKey     *LicenseKeyORM `gorm:"foreignKey:LicenseKeyId;references:Id"`
@@ -52,6 +53,7 @@ func (m *Activity) ToORM(ctx context.Context) (ActivityORM, error) {
 		to.UpdatedAt = &t
 	}
 	to.Kind = int32(m.Kind)
+	to.Details = m.Details
 	if m.User != nil {
 		tempUser, err := m.User.ToORM(ctx)
 		if err != nil {
@@ -104,6 +106,7 @@ func (m *ActivityORM) ToPB(ctx context.Context) (Activity, error) {
 		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
 	to.Kind = Activity_Kind(m.Kind)
+	to.Details = m.Details
 	if m.User != nil {
 		tempUser, err := m.User.ToPB(ctx)
 		if err != nil {
@@ -291,24 +294,39 @@ type LicenseKeyWithAfterToPB interface {
 }
 
 type PaymentORM struct {
-	AmountInCents   int64
-	BillingEmail    string
-	BillingName     string
-	CreatedAt       *time.Time
-	Currency        string
-	Id              int64 `gorm:"primaryKey"`
-	IsRenewal       bool
-	LicenseDuration int32
-	LicenseKey      *LicenseKeyORM `gorm:"foreignKey:LicenseKeyId;references:Id"`
-	LicenseKeyId    *int64
-	Provider        int32
-	ReferenceId     string `gorm:"unique"`
-	SandboxMode     bool
-	Subscription    *SubscriptionORM `gorm:"foreignKey:SubscriptionId;references:Id"`
-	SubscriptionId  *int64
-	UpdatedAt       *time.Time
-	User            *UserORM `gorm:"foreignKey:UserId;references:Id"`
-	UserId          int64
+	AmountInCents      int64
+	BillingCountryCode string
+	BillingEmail       string
+	BillingName        string
+	CompletedAt        *time.Time
+	CreatedAt          *time.Time
+	Currency           string
+	DisputeId          string
+	DisputedAt         *time.Time
+	FailedAt           *time.Time
+	Id                 int64 `gorm:"primaryKey"`
+	IpAddress          string
+	IpCountryCode      string
+	IsRenewal          bool
+	LicenseDuration    int32
+	LicenseKey         *LicenseKeyORM `gorm:"foreignKey:LicenseKeyId;references:Id"`
+	LicenseKeyId       *int64
+	Provider           int32
+	ReferenceId        string `gorm:"unique"`
+	ReferralCode       string
+	ReferrerId         int64
+	RefundedAt         *time.Time
+	RefundedInCents    int64
+	SandboxMode        bool
+	Status             int32
+	Subscription       *SubscriptionORM `gorm:"foreignKey:SubscriptionId;references:Id"`
+	SubscriptionId     *int64
+	TaxCountryCode     string
+	TaxInCents         int64
+	TaxRate            float64
+	UpdatedAt          *time.Time
+	User               *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId             int64
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -344,6 +362,33 @@ func (m *Payment) ToORM(ctx context.Context) (PaymentORM, error) {
 	to.SandboxMode = m.SandboxMode
 	to.BillingEmail = m.BillingEmail
 	to.BillingName = m.BillingName
+	to.RefundedInCents = m.RefundedInCents
+	if m.RefundedAt != nil {
+		t := m.RefundedAt.AsTime()
+		to.RefundedAt = &t
+	}
+	to.DisputeId = m.DisputeId
+	if m.DisputedAt != nil {
+		t := m.DisputedAt.AsTime()
+		to.DisputedAt = &t
+	}
+	to.Status = int32(m.Status)
+	if m.CompletedAt != nil {
+		t := m.CompletedAt.AsTime()
+		to.CompletedAt = &t
+	}
+	if m.FailedAt != nil {
+		t := m.FailedAt.AsTime()
+		to.FailedAt = &t
+	}
+	to.TaxCountryCode = m.TaxCountryCode
+	to.TaxRate = m.TaxRate
+	to.TaxInCents = m.TaxInCents
+	to.BillingCountryCode = m.BillingCountryCode
+	to.IpAddress = m.IpAddress
+	to.IpCountryCode = m.IpCountryCode
+	to.ReferralCode = m.ReferralCode
+	to.ReferrerId = m.ReferrerId
 	if m.User != nil {
 		tempUser, err := m.User.ToORM(ctx)
 		if err != nil {
@@ -398,6 +443,29 @@ func (m *PaymentORM) ToPB(ctx context.Context) (Payment, error) {
 	to.SandboxMode = m.SandboxMode
 	to.BillingEmail = m.BillingEmail
 	to.BillingName = m.BillingName
+	to.RefundedInCents = m.RefundedInCents
+	if m.RefundedAt != nil {
+		to.RefundedAt = timestamppb.New(*m.RefundedAt)
+	}
+	to.DisputeId = m.DisputeId
+	if m.DisputedAt != nil {
+		to.DisputedAt = timestamppb.New(*m.DisputedAt)
+	}
+	to.Status = Payment_Status(m.Status)
+	if m.CompletedAt != nil {
+		to.CompletedAt = timestamppb.New(*m.CompletedAt)
+	}
+	if m.FailedAt != nil {
+		to.FailedAt = timestamppb.New(*m.FailedAt)
+	}
+	to.TaxCountryCode = m.TaxCountryCode
+	to.TaxRate = m.TaxRate
+	to.TaxInCents = m.TaxInCents
+	to.BillingCountryCode = m.BillingCountryCode
+	to.IpAddress = m.IpAddress
+	to.IpCountryCode = m.IpCountryCode
+	to.ReferralCode = m.ReferralCode
+	to.ReferrerId = m.ReferrerId
 	if m.User != nil {
 		tempUser, err := m.User.ToPB(ctx)
 		if err != nil {
@@ -450,19 +518,22 @@ type PaymentWithAfterToPB interface {
 }
 
 type SubscriptionORM struct {
-	CreatedAt            *time.Time
-	CurrentPeriodStart   *time.Time
-	Duration             int32
-	Id                   int64          `gorm:"primaryKey"`
-	LicenseKey           *LicenseKeyORM `gorm:"foreignKey:LicenseKeyId;references:Id"`
-	LicenseKeyId         int64
-	SandboxMode          bool
-	Status               int32
-	StripeCustomerId     string
-	StripeSubscriptionId string `gorm:"unique"`
-	UpdatedAt            *time.Time
-	User                 *UserORM `gorm:"foreignKey:UserId;references:Id"`
-	UserId               int64
+	CanceledAt             *time.Time
+	CreatedAt              *time.Time
+	CurrentPeriodEnd       *time.Time
+	CurrentPeriodStart     *time.Time
+	Duration               int32
+	Id                     int64          `gorm:"primaryKey"`
+	LicenseKey             *LicenseKeyORM `gorm:"foreignKey:LicenseKeyId;references:Id"`
+	LicenseKeyId           int64
+	Provider               int32
+	ProviderCustomerId     string
+	ProviderSubscriptionId string `gorm:"unique"`
+	SandboxMode            bool
+	Status                 int32
+	UpdatedAt              *time.Time
+	User                   *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId                 int64
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -489,15 +560,24 @@ func (m *Subscription) ToORM(ctx context.Context) (SubscriptionORM, error) {
 		t := m.UpdatedAt.AsTime()
 		to.UpdatedAt = &t
 	}
-	to.StripeSubscriptionId = m.StripeSubscriptionId
-	to.StripeCustomerId = m.StripeCustomerId
+	to.ProviderSubscriptionId = m.ProviderSubscriptionId
+	to.ProviderCustomerId = m.ProviderCustomerId
 	to.Status = int32(m.Status)
 	to.Duration = int32(m.Duration)
 	if m.CurrentPeriodStart != nil {
 		t := m.CurrentPeriodStart.AsTime()
 		to.CurrentPeriodStart = &t
 	}
+	if m.CurrentPeriodEnd != nil {
+		t := m.CurrentPeriodEnd.AsTime()
+		to.CurrentPeriodEnd = &t
+	}
 	to.SandboxMode = m.SandboxMode
+	to.Provider = int32(m.Provider)
+	if m.CanceledAt != nil {
+		t := m.CanceledAt.AsTime()
+		to.CanceledAt = &t
+	}
 	if m.User != nil {
 		tempUser, err := m.User.ToORM(ctx)
 		if err != nil {
@@ -537,14 +617,21 @@ func (m *SubscriptionORM) ToPB(ctx context.Context) (Subscription, error) {
 	if m.UpdatedAt != nil {
 		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
-	to.StripeSubscriptionId = m.StripeSubscriptionId
-	to.StripeCustomerId = m.StripeCustomerId
+	to.ProviderSubscriptionId = m.ProviderSubscriptionId
+	to.ProviderCustomerId = m.ProviderCustomerId
 	to.Status = Subscription_Status(m.Status)
 	to.Duration = LicenseKey_Duration(m.Duration)
 	if m.CurrentPeriodStart != nil {
 		to.CurrentPeriodStart = timestamppb.New(*m.CurrentPeriodStart)
 	}
+	if m.CurrentPeriodEnd != nil {
+		to.CurrentPeriodEnd = timestamppb.New(*m.CurrentPeriodEnd)
+	}
 	to.SandboxMode = m.SandboxMode
+	to.Provider = Payment_Provider(m.Provider)
+	if m.CanceledAt != nil {
+		to.CanceledAt = timestamppb.New(*m.CanceledAt)
+	}
 	if m.User != nil {
 		tempUser, err := m.User.ToPB(ctx)
 		if err != nil {
@@ -590,26 +677,31 @@ type SubscriptionWithAfterToPB interface {
 	AfterToPB(context.Context, *Subscription) error
 }
 
-type UserORM struct {
-	CreatedAt   *time.Time
-	DiscourseId int64 `gorm:"uniqueIndex:idx_discourse_id"`
-	Email       string
-	Id          int64 `gorm:"primaryKey"`
-	UpdatedAt   *time.Time
-	Username    string
+type BillingPlanORM struct {
+	Active            bool
+	AmountInCents     int64
+	CreatedAt         *time.Time
+	Currency          string
+	Duration          int32
+	Id                int64 `gorm:"primaryKey"`
+	Provider          int32
+	ProviderPlanId    string `gorm:"unique"`
+	ProviderProductId string
+	SandboxMode       bool
+	UpdatedAt         *time.Time
 }
 
 // TableName overrides the default tablename generated by GORM
-func (UserORM) TableName() string {
-	return "users"
+func (BillingPlanORM) TableName() string {
+	return "billing_plans"
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *User) ToORM(ctx context.Context) (UserORM, error) {
-	to := UserORM{}
+func (m *BillingPlan) ToORM(ctx context.Context) (BillingPlanORM, error) {
+	to := BillingPlanORM{}
 	var err error
-	if prehook, ok := interface{}(m).(UserWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(BillingPlanWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
@@ -623,10 +715,15 @@ func (m *User) ToORM(ctx context.Context) (UserORM, error) {
 		t := m.UpdatedAt.AsTime()
 		to.UpdatedAt = &t
 	}
-	to.DiscourseId = m.DiscourseId
-	to.Email = m.Email
-	to.Username = m.Username
-	if posthook, ok := interface{}(m).(UserWithAfterToORM); ok {
+	to.Provider = int32(m.Provider)
+	to.Duration = int32(m.Duration)
+	to.SandboxMode = m.SandboxMode
+	to.ProviderProductId = m.ProviderProductId
+	to.ProviderPlanId = m.ProviderPlanId
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.Active = m.Active
+	if posthook, ok := interface{}(m).(BillingPlanWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -634,10 +731,10 @@ func (m *User) ToORM(ctx context.Context) (UserORM, error) {
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *UserORM) ToPB(ctx context.Context) (User, error) {
-	to := User{}
+func (m *BillingPlanORM) ToPB(ctx context.Context) (BillingPlan, error) {
+	to := BillingPlan{}
 	var err error
-	if prehook, ok := interface{}(m).(UserWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(BillingPlanWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
@@ -649,57 +746,65 @@ func (m *UserORM) ToPB(ctx context.Context) (User, error) {
 	if m.UpdatedAt != nil {
 		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
-	to.DiscourseId = m.DiscourseId
-	to.Email = m.Email
-	to.Username = m.Username
-	if posthook, ok := interface{}(m).(UserWithAfterToPB); ok {
+	to.Provider = Payment_Provider(m.Provider)
+	to.Duration = LicenseKey_Duration(m.Duration)
+	to.SandboxMode = m.SandboxMode
+	to.ProviderProductId = m.ProviderProductId
+	to.ProviderPlanId = m.ProviderPlanId
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.Active = m.Active
+	if posthook, ok := interface{}(m).(BillingPlanWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type User the arg will be the target, the caller the one being converted from
+// of type BillingPlan the arg will be the target, the caller the one being converted from
 
-// UserBeforeToORM called before default ToORM code
-type UserWithBeforeToORM interface {
-	BeforeToORM(context.Context, *UserORM) error
+// BillingPlanBeforeToORM called before default ToORM code
+type BillingPlanWithBeforeToORM interface {
+	BeforeToORM(context.Context, *BillingPlanORM) error
 }
 
-// UserAfterToORM called after default ToORM code
-type UserWithAfterToORM interface {
-	AfterToORM(context.Context, *UserORM) error
+// BillingPlanAfterToORM called after default ToORM code
+type BillingPlanWithAfterToORM interface {
+	AfterToORM(context.Context, *BillingPlanORM) error
 }
 
-// UserBeforeToPB called before default ToPB code
-type UserWithBeforeToPB interface {
-	BeforeToPB(context.Context, *User) error
+// BillingPlanBeforeToPB called before default ToPB code
+type BillingPlanWithBeforeToPB interface {
+	BeforeToPB(context.Context, *BillingPlan) error
 }
 
-// UserAfterToPB called after default ToPB code
-type UserWithAfterToPB interface {
-	AfterToPB(context.Context, *User) error
+// BillingPlanAfterToPB called after default ToPB code
+type BillingPlanWithAfterToPB interface {
+	AfterToPB(context.Context, *BillingPlan) error
 }
 
-type OffsetORM struct {
-	CreatedAt *time.Time
-	Data      []byte `gorm:"type:LONGBLOB"`
-	Id        int64  `gorm:"primaryKey"`
-	UpdatedAt *time.Time
-	Version   string `gorm:"unique"`
+type ProductORM struct {
+	Active      bool
+	CreatedAt   *time.Time
+	Description string
+	Id          int64 `gorm:"primaryKey"`
+	ImageUrl    string
+	Name        string
+	Tier        int32
+	UpdatedAt   *time.Time
 }
 
 // TableName overrides the default tablename generated by GORM
-func (OffsetORM) TableName() string {
-	return "offsets"
+func (ProductORM) TableName() string {
+	return "products"
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *Offset) ToORM(ctx context.Context) (OffsetORM, error) {
-	to := OffsetORM{}
+func (m *Product) ToORM(ctx context.Context) (ProductORM, error) {
+	to := ProductORM{}
 	var err error
-	if prehook, ok := interface{}(m).(OffsetWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(ProductWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
@@ -713,9 +818,12 @@ func (m *Offset) ToORM(ctx context.Context) (OffsetORM, error) {
 		t := m.UpdatedAt.AsTime()
 		to.UpdatedAt = &t
 	}
-	to.Version = m.Version
-	to.Data = m.Data
-	if posthook, ok := interface{}(m).(OffsetWithAfterToORM); ok {
+	to.Name = m.Name
+	to.Description = m.Description
+	to.Tier = int32(m.Tier)
+	to.ImageUrl = m.ImageUrl
+	to.Active = m.Active
+	if posthook, ok := interface{}(m).(ProductWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -723,10 +831,10 @@ func (m *Offset) ToORM(ctx context.Context) (OffsetORM, error) {
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *OffsetORM) ToPB(ctx context.Context) (Offset, error) {
-	to := Offset{}
+func (m *ProductORM) ToPB(ctx context.Context) (Product, error) {
+	to := Product{}
 	var err error
-	if prehook, ok := interface{}(m).(OffsetWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(ProductWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
@@ -738,202 +846,9146 @@ func (m *OffsetORM) ToPB(ctx context.Context) (Offset, error) {
 	if m.UpdatedAt != nil {
 		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
-	to.Version = m.Version
-	to.Data = m.Data
-	if posthook, ok := interface{}(m).(OffsetWithAfterToPB); ok {
+	to.Name = m.Name
+	to.Description = m.Description
+	to.Tier = LicenseKey_Tier(m.Tier)
+	to.ImageUrl = m.ImageUrl
+	to.Active = m.Active
+	if posthook, ok := interface{}(m).(ProductWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type Offset the arg will be the target, the caller the one being converted from
+// of type Product the arg will be the target, the caller the one being converted from
 
-// OffsetBeforeToORM called before default ToORM code
-type OffsetWithBeforeToORM interface {
-	BeforeToORM(context.Context, *OffsetORM) error
+// ProductBeforeToORM called before default ToORM code
+type ProductWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ProductORM) error
 }
 
-// OffsetAfterToORM called after default ToORM code
-type OffsetWithAfterToORM interface {
-	AfterToORM(context.Context, *OffsetORM) error
+// ProductAfterToORM called after default ToORM code
+type ProductWithAfterToORM interface {
+	AfterToORM(context.Context, *ProductORM) error
 }
 
-// OffsetBeforeToPB called before default ToPB code
-type OffsetWithBeforeToPB interface {
-	BeforeToPB(context.Context, *Offset) error
+// ProductBeforeToPB called before default ToPB code
+type ProductWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Product) error
 }
 
-// OffsetAfterToPB called after default ToPB code
-type OffsetWithAfterToPB interface {
-	AfterToPB(context.Context, *Offset) error
+// ProductAfterToPB called after default ToPB code
+type ProductWithAfterToPB interface {
+	AfterToPB(context.Context, *Product) error
 }
 
-// DefaultCreateActivity executes a basic gorm create call
-func DefaultCreateActivity(ctx context.Context, in *Activity, db *gorm.DB) (*Activity, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+type PriceORM struct {
+	Active        bool
+	AmountInCents int64
+	CreatedAt     *time.Time
+	Currency      string `gorm:"size:3;index:idx_price_duration_currency"`
+	DisplayName   string
+	Duration      int32       `gorm:"index:idx_price_duration_currency"`
+	Id            int64       `gorm:"primaryKey"`
+	Product       *ProductORM `gorm:"foreignKey:ProductId;references:Id"`
+	ProductId     int64
+	UpdatedAt     *time.Time
+	ValidFrom     *time.Time
+	ValidUntil    *time.Time
 }
 
-type ActivityORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type ActivityORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// TableName overrides the default tablename generated by GORM
+func (PriceORM) TableName() string {
+	return "prices"
 }
 
-func DefaultReadActivity(ctx context.Context, in *Activity, db *gorm.DB) (*Activity, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Price) ToORM(ctx context.Context) (PriceORM, error) {
+	to := PriceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PriceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
 	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
+	to.Duration = int32(m.Duration)
+	to.Currency = m.Currency
+	to.AmountInCents = m.AmountInCents
+	to.DisplayName = m.DisplayName
+	to.Active = m.Active
+	if m.ValidFrom != nil {
+		t := m.ValidFrom.AsTime()
+		to.ValidFrom = &t
+	}
+	if m.ValidUntil != nil {
+		t := m.ValidUntil.AsTime()
+		to.ValidUntil = &t
+	}
+	if m.Product != nil {
+		tempProduct, err := m.Product.ToORM(ctx)
+		if err != nil {
+			return to, err
 		}
+		to.Product = &tempProduct
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
+	to.ProductId = m.ProductId
+	if posthook, ok := interface{}(m).(PriceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PriceORM) ToPB(ctx context.Context) (Price, error) {
+	to := Price{}
+	var err error
+	if prehook, ok := interface{}(m).(PriceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	ormResponse := ActivityORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
 	}
-	if hook, ok := interface{}(&ormResponse).(ActivityORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Duration = LicenseKey_Duration(m.Duration)
+	to.Currency = m.Currency
+	to.AmountInCents = m.AmountInCents
+	to.DisplayName = m.DisplayName
+	to.Active = m.Active
+	if m.ValidFrom != nil {
+		to.ValidFrom = timestamppb.New(*m.ValidFrom)
+	}
+	if m.ValidUntil != nil {
+		to.ValidUntil = timestamppb.New(*m.ValidUntil)
+	}
+	if m.Product != nil {
+		tempProduct, err := m.Product.ToPB(ctx)
+		if err != nil {
+			return to, err
 		}
+		to.Product = &tempProduct
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	to.ProductId = m.ProductId
+	if posthook, ok := interface{}(m).(PriceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-type ActivityORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Price the arg will be the target, the caller the one being converted from
+
+// PriceBeforeToORM called before default ToORM code
+type PriceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PriceORM) error
 }
-type ActivityORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// PriceAfterToORM called after default ToORM code
+type PriceWithAfterToORM interface {
+	AfterToORM(context.Context, *PriceORM) error
 }
-type ActivityORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// PriceBeforeToPB called before default ToPB code
+type PriceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Price) error
 }
 
-func DefaultDeleteActivity(ctx context.Context, in *Activity, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where(&ormObj).Delete(&ActivityORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
-	}
-	return err
+// PriceAfterToPB called after default ToPB code
+type PriceWithAfterToPB interface {
+	AfterToPB(context.Context, *Price) error
 }
 
-type ActivityORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+type ExchangeRateORM struct {
+	CreatedAt *time.Time
+	Currency  string `gorm:"size:3;unique"`
+	Id        int64  `gorm:"primaryKey"`
+	Rate      float64
+	UpdatedAt *time.Time
 }
-type ActivityORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// TableName overrides the default tablename generated by GORM
+func (ExchangeRateORM) TableName() string {
+	return "exchange_rates"
 }
 
-func DefaultDeleteActivitySet(ctx context.Context, in []*Activity, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ExchangeRate) ToORM(ctx context.Context) (ExchangeRateORM, error) {
+	to := ExchangeRateORM{}
 	var err error
-	keys := []int64{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+	if prehook, ok := interface{}(m).(ExchangeRateWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Currency = m.Currency
+	to.Rate = m.Rate
+	if posthook, ok := interface{}(m).(ExchangeRateWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ExchangeRateORM) ToPB(ctx context.Context) (ExchangeRate, error) {
+	to := ExchangeRate{}
+	var err error
+	if prehook, ok := interface{}(m).(ExchangeRateWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Currency = m.Currency
+	to.Rate = m.Rate
+	if posthook, ok := interface{}(m).(ExchangeRateWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ExchangeRate the arg will be the target, the caller the one being converted from
+
+// ExchangeRateBeforeToORM called before default ToORM code
+type ExchangeRateWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ExchangeRateORM) error
+}
+
+// ExchangeRateAfterToORM called after default ToORM code
+type ExchangeRateWithAfterToORM interface {
+	AfterToORM(context.Context, *ExchangeRateORM) error
+}
+
+// ExchangeRateBeforeToPB called before default ToPB code
+type ExchangeRateWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ExchangeRate) error
+}
+
+// ExchangeRateAfterToPB called after default ToPB code
+type ExchangeRateWithAfterToPB interface {
+	AfterToPB(context.Context, *ExchangeRate) error
+}
+
+type TaxRateORM struct {
+	CountryCode string `gorm:"size:2;unique"`
+	CreatedAt   *time.Time
+	Id          int64 `gorm:"primaryKey"`
+	Rate        float64
+	UpdatedAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TaxRateORM) TableName() string {
+	return "tax_rates"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TaxRate) ToORM(ctx context.Context) (TaxRateORM, error) {
+	to := TaxRateORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TaxRateWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.CountryCode = m.CountryCode
+	to.Rate = m.Rate
+	if posthook, ok := interface{}(m).(TaxRateWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TaxRateORM) ToPB(ctx context.Context) (TaxRate, error) {
+	to := TaxRate{}
+	var err error
+	if prehook, ok := interface{}(m).(TaxRateWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.CountryCode = m.CountryCode
+	to.Rate = m.Rate
+	if posthook, ok := interface{}(m).(TaxRateWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TaxRate the arg will be the target, the caller the one being converted from
+
+// TaxRateBeforeToORM called before default ToORM code
+type TaxRateWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TaxRateORM) error
+}
+
+// TaxRateAfterToORM called after default ToORM code
+type TaxRateWithAfterToORM interface {
+	AfterToORM(context.Context, *TaxRateORM) error
+}
+
+// TaxRateBeforeToPB called before default ToPB code
+type TaxRateWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TaxRate) error
+}
+
+// TaxRateAfterToPB called after default ToPB code
+type TaxRateWithAfterToPB interface {
+	AfterToPB(context.Context, *TaxRate) error
+}
+
+type CheckoutOrderORM struct {
+	AmountInCents      int64
+	BillingCountryCode string
+	CaptureId          string
+	CompletedAt        *time.Time
+	CreatedAt          *time.Time
+	Currency           string
+	Id                 int64 `gorm:"primaryKey"`
+	IpAddress          string
+	IpCountryCode      string
+	LastCheckedAt      *time.Time
+	LastError          string `gorm:"type:text"`
+	LicenseDuration    int32
+	Provider           int32
+	ProviderOrderId    string `gorm:"unique"`
+	ReconcileAttempts  int32
+	RenewalKeyId       int64
+	SandboxMode        bool
+	Status             int32 `gorm:"index:idx_checkout_order_status"`
+	TaxCountryCode     string
+	TaxInCents         int64
+	TaxRate            float64
+	UpdatedAt          *time.Time
+	User               *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId             int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (CheckoutOrderORM) TableName() string {
+	return "checkout_orders"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *CheckoutOrder) ToORM(ctx context.Context) (CheckoutOrderORM, error) {
+	to := CheckoutOrderORM{}
+	var err error
+	if prehook, ok := interface{}(m).(CheckoutOrderWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Provider = int32(m.Provider)
+	to.ProviderOrderId = m.ProviderOrderId
+	to.Status = int32(m.Status)
+	to.LicenseDuration = int32(m.LicenseDuration)
+	to.RenewalKeyId = m.RenewalKeyId
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.SandboxMode = m.SandboxMode
+	to.CaptureId = m.CaptureId
+	to.ReconcileAttempts = m.ReconcileAttempts
+	to.LastError = m.LastError
+	if m.LastCheckedAt != nil {
+		t := m.LastCheckedAt.AsTime()
+		to.LastCheckedAt = &t
+	}
+	if m.CompletedAt != nil {
+		t := m.CompletedAt.AsTime()
+		to.CompletedAt = &t
+	}
+	to.TaxCountryCode = m.TaxCountryCode
+	to.TaxRate = m.TaxRate
+	to.TaxInCents = m.TaxInCents
+	to.BillingCountryCode = m.BillingCountryCode
+	to.IpAddress = m.IpAddress
+	to.IpCountryCode = m.IpCountryCode
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(CheckoutOrderWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *CheckoutOrderORM) ToPB(ctx context.Context) (CheckoutOrder, error) {
+	to := CheckoutOrder{}
+	var err error
+	if prehook, ok := interface{}(m).(CheckoutOrderWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Provider = Payment_Provider(m.Provider)
+	to.ProviderOrderId = m.ProviderOrderId
+	to.Status = CheckoutOrder_Status(m.Status)
+	to.LicenseDuration = LicenseKey_Duration(m.LicenseDuration)
+	to.RenewalKeyId = m.RenewalKeyId
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.SandboxMode = m.SandboxMode
+	to.CaptureId = m.CaptureId
+	to.ReconcileAttempts = m.ReconcileAttempts
+	to.LastError = m.LastError
+	if m.LastCheckedAt != nil {
+		to.LastCheckedAt = timestamppb.New(*m.LastCheckedAt)
+	}
+	if m.CompletedAt != nil {
+		to.CompletedAt = timestamppb.New(*m.CompletedAt)
+	}
+	to.TaxCountryCode = m.TaxCountryCode
+	to.TaxRate = m.TaxRate
+	to.TaxInCents = m.TaxInCents
+	to.BillingCountryCode = m.BillingCountryCode
+	to.IpAddress = m.IpAddress
+	to.IpCountryCode = m.IpCountryCode
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(CheckoutOrderWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type CheckoutOrder the arg will be the target, the caller the one being converted from
+
+// CheckoutOrderBeforeToORM called before default ToORM code
+type CheckoutOrderWithBeforeToORM interface {
+	BeforeToORM(context.Context, *CheckoutOrderORM) error
+}
+
+// CheckoutOrderAfterToORM called after default ToORM code
+type CheckoutOrderWithAfterToORM interface {
+	AfterToORM(context.Context, *CheckoutOrderORM) error
+}
+
+// CheckoutOrderBeforeToPB called before default ToPB code
+type CheckoutOrderWithBeforeToPB interface {
+	BeforeToPB(context.Context, *CheckoutOrder) error
+}
+
+// CheckoutOrderAfterToPB called after default ToPB code
+type CheckoutOrderWithAfterToPB interface {
+	AfterToPB(context.Context, *CheckoutOrder) error
+}
+
+type InvoiceORM struct {
+	AmountInCents     int64
+	BillingEmail      string
+	BillingName       string
+	CreatedAt         *time.Time
+	CreditedInvoiceId int64
+	Currency          string
+	Description       string
+	Id                int64 `gorm:"primaryKey"`
+	IssuedAt          *time.Time
+	Kind              int32
+	Number            string      `gorm:"size:32;unique"`
+	Payment           *PaymentORM `gorm:"foreignKey:PaymentId;references:Id"`
+	PaymentId         int64       `gorm:"index:idx_invoice_payment"`
+	Pdf               []byte      `gorm:"type:LONGBLOB"`
+	RefundId          string
+	RenderedAt        *time.Time
+	UpdatedAt         *time.Time
+	User              *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId            int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (InvoiceORM) TableName() string {
+	return "invoices"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Invoice) ToORM(ctx context.Context) (InvoiceORM, error) {
+	to := InvoiceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(InvoiceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Kind = int32(m.Kind)
+	to.Number = m.Number
+	if m.IssuedAt != nil {
+		t := m.IssuedAt.AsTime()
+		to.IssuedAt = &t
+	}
+	to.BillingName = m.BillingName
+	to.BillingEmail = m.BillingEmail
+	to.Description = m.Description
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.RefundId = m.RefundId
+	to.Pdf = m.Pdf
+	if m.RenderedAt != nil {
+		t := m.RenderedAt.AsTime()
+		to.RenderedAt = &t
+	}
+	if m.Payment != nil {
+		tempPayment, err := m.Payment.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Payment = &tempPayment
+	}
+	to.PaymentId = m.PaymentId
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	to.CreditedInvoiceId = m.CreditedInvoiceId
+	if posthook, ok := interface{}(m).(InvoiceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *InvoiceORM) ToPB(ctx context.Context) (Invoice, error) {
+	to := Invoice{}
+	var err error
+	if prehook, ok := interface{}(m).(InvoiceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Kind = Invoice_Kind(m.Kind)
+	to.Number = m.Number
+	if m.IssuedAt != nil {
+		to.IssuedAt = timestamppb.New(*m.IssuedAt)
+	}
+	to.BillingName = m.BillingName
+	to.BillingEmail = m.BillingEmail
+	to.Description = m.Description
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.RefundId = m.RefundId
+	to.Pdf = m.Pdf
+	if m.RenderedAt != nil {
+		to.RenderedAt = timestamppb.New(*m.RenderedAt)
+	}
+	if m.Payment != nil {
+		tempPayment, err := m.Payment.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Payment = &tempPayment
+	}
+	to.PaymentId = m.PaymentId
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	to.CreditedInvoiceId = m.CreditedInvoiceId
+	if posthook, ok := interface{}(m).(InvoiceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Invoice the arg will be the target, the caller the one being converted from
+
+// InvoiceBeforeToORM called before default ToORM code
+type InvoiceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *InvoiceORM) error
+}
+
+// InvoiceAfterToORM called after default ToORM code
+type InvoiceWithAfterToORM interface {
+	AfterToORM(context.Context, *InvoiceORM) error
+}
+
+// InvoiceBeforeToPB called before default ToPB code
+type InvoiceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Invoice) error
+}
+
+// InvoiceAfterToPB called after default ToPB code
+type InvoiceWithAfterToPB interface {
+	AfterToPB(context.Context, *Invoice) error
+}
+
+type ReferralCodeORM struct {
+	Code      string `gorm:"size:16;unique"`
+	CreatedAt *time.Time
+	Id        int64 `gorm:"primaryKey"`
+	UpdatedAt *time.Time
+	User      *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId    int64    `gorm:"uniqueIndex:idx_referral_code_user"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ReferralCodeORM) TableName() string {
+	return "referral_codes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ReferralCode) ToORM(ctx context.Context) (ReferralCodeORM, error) {
+	to := ReferralCodeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ReferralCodeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Code = m.Code
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(ReferralCodeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ReferralCodeORM) ToPB(ctx context.Context) (ReferralCode, error) {
+	to := ReferralCode{}
+	var err error
+	if prehook, ok := interface{}(m).(ReferralCodeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Code = m.Code
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(ReferralCodeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ReferralCode the arg will be the target, the caller the one being converted from
+
+// ReferralCodeBeforeToORM called before default ToORM code
+type ReferralCodeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ReferralCodeORM) error
+}
+
+// ReferralCodeAfterToORM called after default ToORM code
+type ReferralCodeWithAfterToORM interface {
+	AfterToORM(context.Context, *ReferralCodeORM) error
+}
+
+// ReferralCodeBeforeToPB called before default ToPB code
+type ReferralCodeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ReferralCode) error
+}
+
+// ReferralCodeAfterToPB called after default ToPB code
+type ReferralCodeWithAfterToPB interface {
+	AfterToPB(context.Context, *ReferralCode) error
+}
+
+type CommissionORM struct {
+	AmountInCents   int64
+	CreatedAt       *time.Time
+	Currency        string
+	Id              int64 `gorm:"primaryKey"`
+	Kind            int32
+	PaidAt          *time.Time
+	PayableAt       *time.Time
+	Payment         *PaymentORM `gorm:"foreignKey:PaymentId;references:Id"`
+	PaymentId       int64       `gorm:"index:idx_commission_payment"`
+	PayoutReference string
+	Rate            float64
+	RefundId        string
+	Status          int32 `gorm:"index:idx_commission_status"`
+	UpdatedAt       *time.Time
+	User            *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId          int64    `gorm:"index:idx_commission_user"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (CommissionORM) TableName() string {
+	return "commissions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Commission) ToORM(ctx context.Context) (CommissionORM, error) {
+	to := CommissionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(CommissionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Kind = int32(m.Kind)
+	to.Status = int32(m.Status)
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.Rate = m.Rate
+	if m.PayableAt != nil {
+		t := m.PayableAt.AsTime()
+		to.PayableAt = &t
+	}
+	if m.PaidAt != nil {
+		t := m.PaidAt.AsTime()
+		to.PaidAt = &t
+	}
+	to.PayoutReference = m.PayoutReference
+	to.RefundId = m.RefundId
+	if m.Payment != nil {
+		tempPayment, err := m.Payment.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Payment = &tempPayment
+	}
+	to.PaymentId = m.PaymentId
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(CommissionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *CommissionORM) ToPB(ctx context.Context) (Commission, error) {
+	to := Commission{}
+	var err error
+	if prehook, ok := interface{}(m).(CommissionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Kind = Commission_Kind(m.Kind)
+	to.Status = Commission_Status(m.Status)
+	to.AmountInCents = m.AmountInCents
+	to.Currency = m.Currency
+	to.Rate = m.Rate
+	if m.PayableAt != nil {
+		to.PayableAt = timestamppb.New(*m.PayableAt)
+	}
+	if m.PaidAt != nil {
+		to.PaidAt = timestamppb.New(*m.PaidAt)
+	}
+	to.PayoutReference = m.PayoutReference
+	to.RefundId = m.RefundId
+	if m.Payment != nil {
+		tempPayment, err := m.Payment.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Payment = &tempPayment
+	}
+	to.PaymentId = m.PaymentId
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(CommissionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Commission the arg will be the target, the caller the one being converted from
+
+// CommissionBeforeToORM called before default ToORM code
+type CommissionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *CommissionORM) error
+}
+
+// CommissionAfterToORM called after default ToORM code
+type CommissionWithAfterToORM interface {
+	AfterToORM(context.Context, *CommissionORM) error
+}
+
+// CommissionBeforeToPB called before default ToPB code
+type CommissionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Commission) error
+}
+
+// CommissionAfterToPB called after default ToPB code
+type CommissionWithAfterToPB interface {
+	AfterToPB(context.Context, *Commission) error
+}
+
+type RefundRequestORM struct {
+	CreatedAt    *time.Time
+	DecidedAt    *time.Time
+	DecidedBy    string
+	DecisionNote string      `gorm:"type:text"`
+	Id           int64       `gorm:"primaryKey"`
+	Payment      *PaymentORM `gorm:"foreignKey:PaymentId;references:Id"`
+	PaymentId    int64       `gorm:"index:idx_refund_request_payment"`
+	Reason       string      `gorm:"type:text"`
+	RefundId     string
+	Status       int32 `gorm:"index:idx_refund_request_status"`
+	UpdatedAt    *time.Time
+	User         *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId       int64    `gorm:"index:idx_refund_request_user"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RefundRequestORM) TableName() string {
+	return "refund_requests"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *RefundRequest) ToORM(ctx context.Context) (RefundRequestORM, error) {
+	to := RefundRequestORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RefundRequestWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Status = int32(m.Status)
+	to.Reason = m.Reason
+	to.DecisionNote = m.DecisionNote
+	to.DecidedBy = m.DecidedBy
+	if m.DecidedAt != nil {
+		t := m.DecidedAt.AsTime()
+		to.DecidedAt = &t
+	}
+	to.RefundId = m.RefundId
+	if m.Payment != nil {
+		tempPayment, err := m.Payment.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Payment = &tempPayment
+	}
+	to.PaymentId = m.PaymentId
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(RefundRequestWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RefundRequestORM) ToPB(ctx context.Context) (RefundRequest, error) {
+	to := RefundRequest{}
+	var err error
+	if prehook, ok := interface{}(m).(RefundRequestWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Status = RefundRequest_Status(m.Status)
+	to.Reason = m.Reason
+	to.DecisionNote = m.DecisionNote
+	to.DecidedBy = m.DecidedBy
+	if m.DecidedAt != nil {
+		to.DecidedAt = timestamppb.New(*m.DecidedAt)
+	}
+	to.RefundId = m.RefundId
+	if m.Payment != nil {
+		tempPayment, err := m.Payment.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Payment = &tempPayment
+	}
+	to.PaymentId = m.PaymentId
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(RefundRequestWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type RefundRequest the arg will be the target, the caller the one being converted from
+
+// RefundRequestBeforeToORM called before default ToORM code
+type RefundRequestWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RefundRequestORM) error
+}
+
+// RefundRequestAfterToORM called after default ToORM code
+type RefundRequestWithAfterToORM interface {
+	AfterToORM(context.Context, *RefundRequestORM) error
+}
+
+// RefundRequestBeforeToPB called before default ToPB code
+type RefundRequestWithBeforeToPB interface {
+	BeforeToPB(context.Context, *RefundRequest) error
+}
+
+// RefundRequestAfterToPB called after default ToPB code
+type RefundRequestWithAfterToPB interface {
+	AfterToPB(context.Context, *RefundRequest) error
+}
+
+type ApiKeyORM struct {
+	CreatedAt  *time.Time
+	ExpiresAt  *time.Time
+	Id         int64  `gorm:"primaryKey"`
+	KeyHash    string `gorm:"size:64;unique"`
+	LastUsedAt *time.Time
+	Name       string
+	Prefix     string
+	RevokedAt  *time.Time
+	Scopes     string
+	UpdatedAt  *time.Time
+	User       *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId     int64    `gorm:"index:idx_api_key_user"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ApiKeyORM) TableName() string {
+	return "api_keys"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ApiKey) ToORM(ctx context.Context) (ApiKeyORM, error) {
+	to := ApiKeyORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ApiKeyWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Name = m.Name
+	to.Prefix = m.Prefix
+	to.KeyHash = m.KeyHash
+	to.Scopes = m.Scopes
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.LastUsedAt != nil {
+		t := m.LastUsedAt.AsTime()
+		to.LastUsedAt = &t
+	}
+	if m.RevokedAt != nil {
+		t := m.RevokedAt.AsTime()
+		to.RevokedAt = &t
+	}
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(ApiKeyWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ApiKeyORM) ToPB(ctx context.Context) (ApiKey, error) {
+	to := ApiKey{}
+	var err error
+	if prehook, ok := interface{}(m).(ApiKeyWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Name = m.Name
+	to.Prefix = m.Prefix
+	to.KeyHash = m.KeyHash
+	to.Scopes = m.Scopes
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.LastUsedAt != nil {
+		to.LastUsedAt = timestamppb.New(*m.LastUsedAt)
+	}
+	if m.RevokedAt != nil {
+		to.RevokedAt = timestamppb.New(*m.RevokedAt)
+	}
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(ApiKeyWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ApiKey the arg will be the target, the caller the one being converted from
+
+// ApiKeyBeforeToORM called before default ToORM code
+type ApiKeyWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ApiKeyORM) error
+}
+
+// ApiKeyAfterToORM called after default ToORM code
+type ApiKeyWithAfterToORM interface {
+	AfterToORM(context.Context, *ApiKeyORM) error
+}
+
+// ApiKeyBeforeToPB called before default ToPB code
+type ApiKeyWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ApiKey) error
+}
+
+// ApiKeyAfterToPB called after default ToPB code
+type ApiKeyWithAfterToPB interface {
+	AfterToPB(context.Context, *ApiKey) error
+}
+
+type ImpersonationAuditORM struct {
+	AdminDiscourseId int64
+	AdminUsername    string `gorm:"index:idx_impersonation_audit_admin"`
+	Allowed          bool
+	CreatedAt        *time.Time
+	Id               int64  `gorm:"primaryKey"`
+	ImpersonationId  string `gorm:"index:idx_impersonation_audit_impersonation"`
+	Method           string
+	ReadWrite        bool
+	Reason           string
+	UpdatedAt        *time.Time
+	User             *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId           int64    `gorm:"index:idx_impersonation_audit_user"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ImpersonationAuditORM) TableName() string {
+	return "impersonation_audits"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ImpersonationAudit) ToORM(ctx context.Context) (ImpersonationAuditORM, error) {
+	to := ImpersonationAuditORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ImpersonationAuditWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.ImpersonationId = m.ImpersonationId
+	to.AdminDiscourseId = m.AdminDiscourseId
+	to.AdminUsername = m.AdminUsername
+	to.Method = m.Method
+	to.ReadWrite = m.ReadWrite
+	to.Allowed = m.Allowed
+	to.Reason = m.Reason
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(ImpersonationAuditWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ImpersonationAuditORM) ToPB(ctx context.Context) (ImpersonationAudit, error) {
+	to := ImpersonationAudit{}
+	var err error
+	if prehook, ok := interface{}(m).(ImpersonationAuditWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.ImpersonationId = m.ImpersonationId
+	to.AdminDiscourseId = m.AdminDiscourseId
+	to.AdminUsername = m.AdminUsername
+	to.Method = m.Method
+	to.ReadWrite = m.ReadWrite
+	to.Allowed = m.Allowed
+	to.Reason = m.Reason
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(ImpersonationAuditWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ImpersonationAudit the arg will be the target, the caller the one being converted from
+
+// ImpersonationAuditBeforeToORM called before default ToORM code
+type ImpersonationAuditWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ImpersonationAuditORM) error
+}
+
+// ImpersonationAuditAfterToORM called after default ToORM code
+type ImpersonationAuditWithAfterToORM interface {
+	AfterToORM(context.Context, *ImpersonationAuditORM) error
+}
+
+// ImpersonationAuditBeforeToPB called before default ToPB code
+type ImpersonationAuditWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ImpersonationAudit) error
+}
+
+// ImpersonationAuditAfterToPB called after default ToPB code
+type ImpersonationAuditWithAfterToPB interface {
+	AfterToPB(context.Context, *ImpersonationAudit) error
+}
+
+type InvoiceSequenceORM struct {
+	CreatedAt  *time.Time
+	Id         int64 `gorm:"primaryKey"`
+	LastNumber int64
+	Series     string `gorm:"size:32;unique"`
+	UpdatedAt  *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (InvoiceSequenceORM) TableName() string {
+	return "invoice_sequences"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *InvoiceSequence) ToORM(ctx context.Context) (InvoiceSequenceORM, error) {
+	to := InvoiceSequenceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(InvoiceSequenceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Series = m.Series
+	to.LastNumber = m.LastNumber
+	if posthook, ok := interface{}(m).(InvoiceSequenceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *InvoiceSequenceORM) ToPB(ctx context.Context) (InvoiceSequence, error) {
+	to := InvoiceSequence{}
+	var err error
+	if prehook, ok := interface{}(m).(InvoiceSequenceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Series = m.Series
+	to.LastNumber = m.LastNumber
+	if posthook, ok := interface{}(m).(InvoiceSequenceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type InvoiceSequence the arg will be the target, the caller the one being converted from
+
+// InvoiceSequenceBeforeToORM called before default ToORM code
+type InvoiceSequenceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *InvoiceSequenceORM) error
+}
+
+// InvoiceSequenceAfterToORM called after default ToORM code
+type InvoiceSequenceWithAfterToORM interface {
+	AfterToORM(context.Context, *InvoiceSequenceORM) error
+}
+
+// InvoiceSequenceBeforeToPB called before default ToPB code
+type InvoiceSequenceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *InvoiceSequence) error
+}
+
+// InvoiceSequenceAfterToPB called after default ToPB code
+type InvoiceSequenceWithAfterToPB interface {
+	AfterToPB(context.Context, *InvoiceSequence) error
+}
+
+type UserORM struct {
+	CreatedAt   *time.Time
+	DiscourseId int64 `gorm:"uniqueIndex:idx_discourse_id"`
+	Email       string
+	Id          int64 `gorm:"primaryKey"`
+	UpdatedAt   *time.Time
+	Username    string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (UserORM) TableName() string {
+	return "users"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *User) ToORM(ctx context.Context) (UserORM, error) {
+	to := UserORM{}
+	var err error
+	if prehook, ok := interface{}(m).(UserWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.DiscourseId = m.DiscourseId
+	to.Email = m.Email
+	to.Username = m.Username
+	if posthook, ok := interface{}(m).(UserWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *UserORM) ToPB(ctx context.Context) (User, error) {
+	to := User{}
+	var err error
+	if prehook, ok := interface{}(m).(UserWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.DiscourseId = m.DiscourseId
+	to.Email = m.Email
+	to.Username = m.Username
+	if posthook, ok := interface{}(m).(UserWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type User the arg will be the target, the caller the one being converted from
+
+// UserBeforeToORM called before default ToORM code
+type UserWithBeforeToORM interface {
+	BeforeToORM(context.Context, *UserORM) error
+}
+
+// UserAfterToORM called after default ToORM code
+type UserWithAfterToORM interface {
+	AfterToORM(context.Context, *UserORM) error
+}
+
+// UserBeforeToPB called before default ToPB code
+type UserWithBeforeToPB interface {
+	BeforeToPB(context.Context, *User) error
+}
+
+// UserAfterToPB called after default ToPB code
+type UserWithAfterToPB interface {
+	AfterToPB(context.Context, *User) error
+}
+
+type UserRoleORM struct {
+	CreatedAt *time.Time
+	GrantedBy string
+	Id        int64 `gorm:"primaryKey"`
+	Role      int32 `gorm:"uniqueIndex:idx_user_role_user_role"`
+	UpdatedAt *time.Time
+	User      *UserORM `gorm:"foreignKey:UserId;references:Id"`
+	UserId    int64    `gorm:"uniqueIndex:idx_user_role_user_role"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (UserRoleORM) TableName() string {
+	return "user_roles"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *UserRole) ToORM(ctx context.Context) (UserRoleORM, error) {
+	to := UserRoleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(UserRoleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Role = int32(m.Role)
+	to.GrantedBy = m.GrantedBy
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(UserRoleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *UserRoleORM) ToPB(ctx context.Context) (UserRole, error) {
+	to := UserRole{}
+	var err error
+	if prehook, ok := interface{}(m).(UserRoleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Role = UserRole_Role(m.Role)
+	to.GrantedBy = m.GrantedBy
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.UserId = m.UserId
+	if posthook, ok := interface{}(m).(UserRoleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type UserRole the arg will be the target, the caller the one being converted from
+
+// UserRoleBeforeToORM called before default ToORM code
+type UserRoleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *UserRoleORM) error
+}
+
+// UserRoleAfterToORM called after default ToORM code
+type UserRoleWithAfterToORM interface {
+	AfterToORM(context.Context, *UserRoleORM) error
+}
+
+// UserRoleBeforeToPB called before default ToPB code
+type UserRoleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *UserRole) error
+}
+
+// UserRoleAfterToPB called after default ToPB code
+type UserRoleWithAfterToPB interface {
+	AfterToPB(context.Context, *UserRole) error
+}
+
+type OffsetORM struct {
+	CreatedAt *time.Time
+	Data      []byte `gorm:"type:LONGBLOB"`
+	Id        int64  `gorm:"primaryKey"`
+	UpdatedAt *time.Time
+	Version   string `gorm:"unique"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OffsetORM) TableName() string {
+	return "offsets"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Offset) ToORM(ctx context.Context) (OffsetORM, error) {
+	to := OffsetORM{}
+	var err error
+	if prehook, ok := interface{}(m).(OffsetWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Version = m.Version
+	to.Data = m.Data
+	if posthook, ok := interface{}(m).(OffsetWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *OffsetORM) ToPB(ctx context.Context) (Offset, error) {
+	to := Offset{}
+	var err error
+	if prehook, ok := interface{}(m).(OffsetWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Version = m.Version
+	to.Data = m.Data
+	if posthook, ok := interface{}(m).(OffsetWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Offset the arg will be the target, the caller the one being converted from
+
+// OffsetBeforeToORM called before default ToORM code
+type OffsetWithBeforeToORM interface {
+	BeforeToORM(context.Context, *OffsetORM) error
+}
+
+// OffsetAfterToORM called after default ToORM code
+type OffsetWithAfterToORM interface {
+	AfterToORM(context.Context, *OffsetORM) error
+}
+
+// OffsetBeforeToPB called before default ToPB code
+type OffsetWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Offset) error
+}
+
+// OffsetAfterToPB called after default ToPB code
+type OffsetWithAfterToPB interface {
+	AfterToPB(context.Context, *Offset) error
+}
+
+type WebhookEventORM struct {
+	Attempts      int32
+	CreatedAt     *time.Time
+	EventId       string `gorm:"size:191;uniqueIndex:idx_webhook_event_provider_event_id"`
+	EventType     string
+	Id            int64      `gorm:"primaryKey"`
+	LastError     string     `gorm:"type:text"`
+	NextAttemptAt *time.Time `gorm:"index:idx_webhook_event_status_next_attempt_at"`
+	Payload       string     `gorm:"type:mediumtext"`
+	ProcessedAt   *time.Time
+	Provider      int32 `gorm:"uniqueIndex:idx_webhook_event_provider_event_id"`
+	Status        int32 `gorm:"index:idx_webhook_event_status_next_attempt_at"`
+	UpdatedAt     *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WebhookEventORM) TableName() string {
+	return "webhook_events"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WebhookEvent) ToORM(ctx context.Context) (WebhookEventORM, error) {
+	to := WebhookEventORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookEventWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.Provider = int32(m.Provider)
+	to.EventId = m.EventId
+	to.EventType = m.EventType
+	to.Payload = m.Payload
+	to.Status = int32(m.Status)
+	to.Attempts = m.Attempts
+	to.LastError = m.LastError
+	if m.NextAttemptAt != nil {
+		t := m.NextAttemptAt.AsTime()
+		to.NextAttemptAt = &t
+	}
+	if m.ProcessedAt != nil {
+		t := m.ProcessedAt.AsTime()
+		to.ProcessedAt = &t
+	}
+	if posthook, ok := interface{}(m).(WebhookEventWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WebhookEventORM) ToPB(ctx context.Context) (WebhookEvent, error) {
+	to := WebhookEvent{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookEventWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.Provider = WebhookEvent_Provider(m.Provider)
+	to.EventId = m.EventId
+	to.EventType = m.EventType
+	to.Payload = m.Payload
+	to.Status = WebhookEvent_Status(m.Status)
+	to.Attempts = m.Attempts
+	to.LastError = m.LastError
+	if m.NextAttemptAt != nil {
+		to.NextAttemptAt = timestamppb.New(*m.NextAttemptAt)
+	}
+	if m.ProcessedAt != nil {
+		to.ProcessedAt = timestamppb.New(*m.ProcessedAt)
+	}
+	if posthook, ok := interface{}(m).(WebhookEventWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WebhookEvent the arg will be the target, the caller the one being converted from
+
+// WebhookEventBeforeToORM called before default ToORM code
+type WebhookEventWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WebhookEventORM) error
+}
+
+// WebhookEventAfterToORM called after default ToORM code
+type WebhookEventWithAfterToORM interface {
+	AfterToORM(context.Context, *WebhookEventORM) error
+}
+
+// WebhookEventBeforeToPB called before default ToPB code
+type WebhookEventWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WebhookEvent) error
+}
+
+// WebhookEventAfterToPB called after default ToPB code
+type WebhookEventWithAfterToPB interface {
+	AfterToPB(context.Context, *WebhookEvent) error
+}
+
+// DefaultCreateActivity executes a basic gorm create call
+func DefaultCreateActivity(ctx context.Context, in *Activity, db *gorm.DB) (*Activity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ActivityORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadActivity(ctx context.Context, in *Activity, db *gorm.DB) (*Activity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ActivityORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ActivityORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ActivityORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteActivity(ctx context.Context, in *Activity, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ActivityORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ActivityORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteActivitySet(ctx context.Context, in []*Activity, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ActivityORM{})).(ActivityORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ActivityORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ActivityORM{})).(ActivityORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ActivityORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Activity, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Activity, *gorm.DB) error
+}
+
+// DefaultStrictUpdateActivity clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateActivity(ctx context.Context, in *Activity, db *gorm.DB) (*Activity, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateActivity")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ActivityORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ActivityORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchActivity executes a basic gorm update call with patch behavior
+func DefaultPatchActivity(ctx context.Context, in *Activity, updateMask *field_mask.FieldMask, db *gorm.DB) (*Activity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Activity
+	var err error
+	if hook, ok := interface{}(&pbObj).(ActivityWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadActivity(ctx, &Activity{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ActivityWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskActivity(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ActivityWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateActivity(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ActivityWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ActivityWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetActivity executes a bulk gorm update call with patch behavior
+func DefaultPatchSetActivity(ctx context.Context, objects []*Activity, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Activity, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Activity, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchActivity(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskActivity patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskActivity(ctx context.Context, patchee *Activity, patcher *Activity, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Activity, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedUser bool
+	var updatedLicenseKey bool
+	var updatedPayment bool
+	var updatedSubscription bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Kind" {
+			patchee.Kind = patcher.Kind
+			continue
+		}
+		if f == prefix+"Details" {
+			patchee.Details = patcher.Details
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if !updatedLicenseKey && strings.HasPrefix(f, prefix+"LicenseKey.") {
+			updatedLicenseKey = true
+			if patcher.LicenseKey == nil {
+				patchee.LicenseKey = nil
+				continue
+			}
+			if patchee.LicenseKey == nil {
+				patchee.LicenseKey = &LicenseKey{}
+			}
+			if o, err := DefaultApplyFieldMaskLicenseKey(ctx, patchee.LicenseKey, patcher.LicenseKey, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"LicenseKey.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.LicenseKey = o
+			}
+			continue
+		}
+		if f == prefix+"LicenseKey" {
+			updatedLicenseKey = true
+			patchee.LicenseKey = patcher.LicenseKey
+			continue
+		}
+		if !updatedPayment && strings.HasPrefix(f, prefix+"Payment.") {
+			updatedPayment = true
+			if patcher.Payment == nil {
+				patchee.Payment = nil
+				continue
+			}
+			if patchee.Payment == nil {
+				patchee.Payment = &Payment{}
+			}
+			if o, err := DefaultApplyFieldMaskPayment(ctx, patchee.Payment, patcher.Payment, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Payment.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Payment = o
+			}
+			continue
+		}
+		if f == prefix+"Payment" {
+			updatedPayment = true
+			patchee.Payment = patcher.Payment
+			continue
+		}
+		if !updatedSubscription && strings.HasPrefix(f, prefix+"Subscription.") {
+			updatedSubscription = true
+			if patcher.Subscription == nil {
+				patchee.Subscription = nil
+				continue
+			}
+			if patchee.Subscription == nil {
+				patchee.Subscription = &Subscription{}
+			}
+			if o, err := DefaultApplyFieldMaskSubscription(ctx, patchee.Subscription, patcher.Subscription, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Subscription.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Subscription = o
+			}
+			continue
+		}
+		if f == prefix+"Subscription" {
+			updatedSubscription = true
+			patchee.Subscription = patcher.Subscription
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListActivity executes a gorm list call
+func DefaultListActivity(ctx context.Context, db *gorm.DB) ([]*Activity, error) {
+	in := Activity{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ActivityORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Activity{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ActivityORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ActivityORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ActivityORM) error
+}
+
+// DefaultCreateLicenseKey executes a basic gorm create call
+func DefaultCreateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*LicenseKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LicenseKeyORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*LicenseKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LicenseKeyORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(LicenseKeyORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LicenseKeyORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&LicenseKeyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LicenseKeyORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLicenseKeySet(ctx context.Context, in []*LicenseKey, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&LicenseKeyORM{})).(LicenseKeyORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&LicenseKeyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LicenseKeyORM{})).(LicenseKeyORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LicenseKeyORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*LicenseKey, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*LicenseKey, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLicenseKey clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*LicenseKey, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLicenseKey")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &LicenseKeyORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type LicenseKeyORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLicenseKey executes a basic gorm update call with patch behavior
+func DefaultPatchLicenseKey(ctx context.Context, in *LicenseKey, updateMask *field_mask.FieldMask, db *gorm.DB) (*LicenseKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj LicenseKey
+	var err error
+	if hook, ok := interface{}(&pbObj).(LicenseKeyWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadLicenseKey(ctx, &LicenseKey{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(LicenseKeyWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskLicenseKey(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(LicenseKeyWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateLicenseKey(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LicenseKeyWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type LicenseKeyWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLicenseKey executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLicenseKey(ctx context.Context, objects []*LicenseKey, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*LicenseKey, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*LicenseKey, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchLicenseKey(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskLicenseKey patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLicenseKey(ctx context.Context, patchee *LicenseKey, patcher *LicenseKey, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*LicenseKey, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedEffectiveFrom bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if !updatedEffectiveFrom && strings.HasPrefix(f, prefix+"EffectiveFrom.") {
+			if patcher.EffectiveFrom == nil {
+				patchee.EffectiveFrom = nil
+				continue
+			}
+			if patchee.EffectiveFrom == nil {
+				patchee.EffectiveFrom = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"EffectiveFrom."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.EffectiveFrom, patchee.EffectiveFrom, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"EffectiveFrom" {
+			updatedEffectiveFrom = true
+			patchee.EffectiveFrom = patcher.EffectiveFrom
+			continue
+		}
+		if f == prefix+"Key" {
+			patchee.Key = patcher.Key
+			continue
+		}
+		if f == prefix+"Revoked" {
+			patchee.Revoked = patcher.Revoked
+			continue
+		}
+		if f == prefix+"Duration" {
+			patchee.Duration = patcher.Duration
+			continue
+		}
+		if f == prefix+"ActiveUsageId" {
+			patchee.ActiveUsageId = patcher.ActiveUsageId
+			continue
+		}
+		if f == prefix+"Uses" {
+			patchee.Uses = patcher.Uses
+			continue
+		}
+		if f == prefix+"SandboxMode" {
+			patchee.SandboxMode = patcher.SandboxMode
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"Tier" {
+			patchee.Tier = patcher.Tier
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListLicenseKey executes a gorm list call
+func DefaultListLicenseKey(ctx context.Context, db *gorm.DB) ([]*LicenseKey, error) {
+	in := LicenseKey{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []LicenseKeyORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*LicenseKey{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LicenseKeyORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LicenseKeyORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LicenseKeyORM) error
+}
+
+// DefaultCreatePayment executes a basic gorm create call
+func DefaultCreatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PaymentORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadPayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PaymentORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PaymentORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PaymentORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePayment(ctx context.Context, in *Payment, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PaymentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PaymentORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePaymentSet(ctx context.Context, in []*Payment, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PaymentORM{})).(PaymentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PaymentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PaymentORM{})).(PaymentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PaymentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Payment, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Payment, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePayment clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePayment")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PaymentORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PaymentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPayment executes a basic gorm update call with patch behavior
+func DefaultPatchPayment(ctx context.Context, in *Payment, updateMask *field_mask.FieldMask, db *gorm.DB) (*Payment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Payment
+	var err error
+	if hook, ok := interface{}(&pbObj).(PaymentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPayment(ctx, &Payment{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PaymentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPayment(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PaymentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePayment(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PaymentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PaymentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPayment executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPayment(ctx context.Context, objects []*Payment, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Payment, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Payment, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPayment(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPayment patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPayment(ctx context.Context, patchee *Payment, patcher *Payment, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Payment, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedRefundedAt bool
+	var updatedDisputedAt bool
+	var updatedCompletedAt bool
+	var updatedFailedAt bool
+	var updatedUser bool
+	var updatedLicenseKey bool
+	var updatedSubscription bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
+			continue
+		}
+		if f == prefix+"ReferenceId" {
+			patchee.ReferenceId = patcher.ReferenceId
+			continue
+		}
+		if f == prefix+"AmountInCents" {
+			patchee.AmountInCents = patcher.AmountInCents
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"LicenseDuration" {
+			patchee.LicenseDuration = patcher.LicenseDuration
+			continue
+		}
+		if f == prefix+"IsRenewal" {
+			patchee.IsRenewal = patcher.IsRenewal
+			continue
+		}
+		if f == prefix+"SandboxMode" {
+			patchee.SandboxMode = patcher.SandboxMode
+			continue
+		}
+		if f == prefix+"BillingEmail" {
+			patchee.BillingEmail = patcher.BillingEmail
+			continue
+		}
+		if f == prefix+"BillingName" {
+			patchee.BillingName = patcher.BillingName
+			continue
+		}
+		if f == prefix+"RefundedInCents" {
+			patchee.RefundedInCents = patcher.RefundedInCents
+			continue
+		}
+		if !updatedRefundedAt && strings.HasPrefix(f, prefix+"RefundedAt.") {
+			if patcher.RefundedAt == nil {
+				patchee.RefundedAt = nil
+				continue
+			}
+			if patchee.RefundedAt == nil {
+				patchee.RefundedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RefundedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RefundedAt, patchee.RefundedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RefundedAt" {
+			updatedRefundedAt = true
+			patchee.RefundedAt = patcher.RefundedAt
+			continue
+		}
+		if f == prefix+"DisputeId" {
+			patchee.DisputeId = patcher.DisputeId
+			continue
+		}
+		if !updatedDisputedAt && strings.HasPrefix(f, prefix+"DisputedAt.") {
+			if patcher.DisputedAt == nil {
+				patchee.DisputedAt = nil
+				continue
+			}
+			if patchee.DisputedAt == nil {
+				patchee.DisputedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DisputedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DisputedAt, patchee.DisputedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DisputedAt" {
+			updatedDisputedAt = true
+			patchee.DisputedAt = patcher.DisputedAt
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if !updatedCompletedAt && strings.HasPrefix(f, prefix+"CompletedAt.") {
+			if patcher.CompletedAt == nil {
+				patchee.CompletedAt = nil
+				continue
+			}
+			if patchee.CompletedAt == nil {
+				patchee.CompletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CompletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CompletedAt, patchee.CompletedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CompletedAt" {
+			updatedCompletedAt = true
+			patchee.CompletedAt = patcher.CompletedAt
+			continue
+		}
+		if !updatedFailedAt && strings.HasPrefix(f, prefix+"FailedAt.") {
+			if patcher.FailedAt == nil {
+				patchee.FailedAt = nil
+				continue
+			}
+			if patchee.FailedAt == nil {
+				patchee.FailedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"FailedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.FailedAt, patchee.FailedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"FailedAt" {
+			updatedFailedAt = true
+			patchee.FailedAt = patcher.FailedAt
+			continue
+		}
+		if f == prefix+"TaxCountryCode" {
+			patchee.TaxCountryCode = patcher.TaxCountryCode
+			continue
+		}
+		if f == prefix+"TaxRate" {
+			patchee.TaxRate = patcher.TaxRate
+			continue
+		}
+		if f == prefix+"TaxInCents" {
+			patchee.TaxInCents = patcher.TaxInCents
+			continue
+		}
+		if f == prefix+"BillingCountryCode" {
+			patchee.BillingCountryCode = patcher.BillingCountryCode
+			continue
+		}
+		if f == prefix+"IpAddress" {
+			patchee.IpAddress = patcher.IpAddress
+			continue
+		}
+		if f == prefix+"IpCountryCode" {
+			patchee.IpCountryCode = patcher.IpCountryCode
+			continue
+		}
+		if f == prefix+"ReferralCode" {
+			patchee.ReferralCode = patcher.ReferralCode
+			continue
+		}
+		if f == prefix+"ReferrerId" {
+			patchee.ReferrerId = patcher.ReferrerId
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if !updatedLicenseKey && strings.HasPrefix(f, prefix+"LicenseKey.") {
+			updatedLicenseKey = true
+			if patcher.LicenseKey == nil {
+				patchee.LicenseKey = nil
+				continue
+			}
+			if patchee.LicenseKey == nil {
+				patchee.LicenseKey = &LicenseKey{}
+			}
+			if o, err := DefaultApplyFieldMaskLicenseKey(ctx, patchee.LicenseKey, patcher.LicenseKey, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"LicenseKey.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.LicenseKey = o
+			}
+			continue
+		}
+		if f == prefix+"LicenseKey" {
+			updatedLicenseKey = true
+			patchee.LicenseKey = patcher.LicenseKey
+			continue
+		}
+		if !updatedSubscription && strings.HasPrefix(f, prefix+"Subscription.") {
+			updatedSubscription = true
+			if patcher.Subscription == nil {
+				patchee.Subscription = nil
+				continue
+			}
+			if patchee.Subscription == nil {
+				patchee.Subscription = &Subscription{}
+			}
+			if o, err := DefaultApplyFieldMaskSubscription(ctx, patchee.Subscription, patcher.Subscription, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Subscription.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Subscription = o
+			}
+			continue
+		}
+		if f == prefix+"Subscription" {
+			updatedSubscription = true
+			patchee.Subscription = patcher.Subscription
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPayment executes a gorm list call
+func DefaultListPayment(ctx context.Context, db *gorm.DB) ([]*Payment, error) {
+	in := Payment{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PaymentORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Payment{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PaymentORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PaymentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PaymentORM) error
+}
+
+// DefaultCreateSubscription executes a basic gorm create call
+func DefaultCreateSubscription(ctx context.Context, in *Subscription, db *gorm.DB) (*Subscription, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SubscriptionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadSubscription(ctx context.Context, in *Subscription, db *gorm.DB) (*Subscription, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SubscriptionORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SubscriptionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SubscriptionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSubscription(ctx context.Context, in *Subscription, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&SubscriptionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SubscriptionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSubscriptionSet(ctx context.Context, in []*Subscription, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&SubscriptionORM{})).(SubscriptionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&SubscriptionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SubscriptionORM{})).(SubscriptionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SubscriptionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Subscription, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Subscription, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSubscription clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSubscription(ctx context.Context, in *Subscription, db *gorm.DB) (*Subscription, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateSubscription")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &SubscriptionORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type SubscriptionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSubscription executes a basic gorm update call with patch behavior
+func DefaultPatchSubscription(ctx context.Context, in *Subscription, updateMask *field_mask.FieldMask, db *gorm.DB) (*Subscription, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Subscription
+	var err error
+	if hook, ok := interface{}(&pbObj).(SubscriptionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSubscription(ctx, &Subscription{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(SubscriptionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSubscription(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SubscriptionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSubscription(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SubscriptionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SubscriptionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSubscription executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSubscription(ctx context.Context, objects []*Subscription, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Subscription, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Subscription, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSubscription(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSubscription patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSubscription(ctx context.Context, patchee *Subscription, patcher *Subscription, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Subscription, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedCurrentPeriodStart bool
+	var updatedCurrentPeriodEnd bool
+	var updatedCanceledAt bool
+	var updatedUser bool
+	var updatedLicenseKey bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"ProviderSubscriptionId" {
+			patchee.ProviderSubscriptionId = patcher.ProviderSubscriptionId
+			continue
+		}
+		if f == prefix+"ProviderCustomerId" {
+			patchee.ProviderCustomerId = patcher.ProviderCustomerId
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Duration" {
+			patchee.Duration = patcher.Duration
+			continue
+		}
+		if !updatedCurrentPeriodStart && strings.HasPrefix(f, prefix+"CurrentPeriodStart.") {
+			if patcher.CurrentPeriodStart == nil {
+				patchee.CurrentPeriodStart = nil
+				continue
+			}
+			if patchee.CurrentPeriodStart == nil {
+				patchee.CurrentPeriodStart = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CurrentPeriodStart."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CurrentPeriodStart, patchee.CurrentPeriodStart, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CurrentPeriodStart" {
+			updatedCurrentPeriodStart = true
+			patchee.CurrentPeriodStart = patcher.CurrentPeriodStart
+			continue
+		}
+		if !updatedCurrentPeriodEnd && strings.HasPrefix(f, prefix+"CurrentPeriodEnd.") {
+			if patcher.CurrentPeriodEnd == nil {
+				patchee.CurrentPeriodEnd = nil
+				continue
+			}
+			if patchee.CurrentPeriodEnd == nil {
+				patchee.CurrentPeriodEnd = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CurrentPeriodEnd."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CurrentPeriodEnd, patchee.CurrentPeriodEnd, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CurrentPeriodEnd" {
+			updatedCurrentPeriodEnd = true
+			patchee.CurrentPeriodEnd = patcher.CurrentPeriodEnd
+			continue
+		}
+		if f == prefix+"SandboxMode" {
+			patchee.SandboxMode = patcher.SandboxMode
+			continue
+		}
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
+			continue
+		}
+		if !updatedCanceledAt && strings.HasPrefix(f, prefix+"CanceledAt.") {
+			if patcher.CanceledAt == nil {
+				patchee.CanceledAt = nil
+				continue
+			}
+			if patchee.CanceledAt == nil {
+				patchee.CanceledAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CanceledAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CanceledAt, patchee.CanceledAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CanceledAt" {
+			updatedCanceledAt = true
+			patchee.CanceledAt = patcher.CanceledAt
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if !updatedLicenseKey && strings.HasPrefix(f, prefix+"LicenseKey.") {
+			updatedLicenseKey = true
+			if patcher.LicenseKey == nil {
+				patchee.LicenseKey = nil
+				continue
+			}
+			if patchee.LicenseKey == nil {
+				patchee.LicenseKey = &LicenseKey{}
+			}
+			if o, err := DefaultApplyFieldMaskLicenseKey(ctx, patchee.LicenseKey, patcher.LicenseKey, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"LicenseKey.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.LicenseKey = o
+			}
+			continue
+		}
+		if f == prefix+"LicenseKey" {
+			updatedLicenseKey = true
+			patchee.LicenseKey = patcher.LicenseKey
+			continue
+		}
+		if f == prefix+"LicenseKeyId" {
+			patchee.LicenseKeyId = patcher.LicenseKeyId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSubscription executes a gorm list call
+func DefaultListSubscription(ctx context.Context, db *gorm.DB) ([]*Subscription, error) {
+	in := Subscription{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []SubscriptionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Subscription{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SubscriptionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SubscriptionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SubscriptionORM) error
+}
+
+// DefaultCreateBillingPlan executes a basic gorm create call
+func DefaultCreateBillingPlan(ctx context.Context, in *BillingPlan, db *gorm.DB) (*BillingPlan, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BillingPlanORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadBillingPlan(ctx context.Context, in *BillingPlan, db *gorm.DB) (*BillingPlan, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BillingPlanORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BillingPlanORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BillingPlanORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBillingPlan(ctx context.Context, in *BillingPlan, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&BillingPlanORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type BillingPlanORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBillingPlanSet(ctx context.Context, in []*BillingPlan, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&BillingPlanORM{})).(BillingPlanORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&BillingPlanORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&BillingPlanORM{})).(BillingPlanORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type BillingPlanORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*BillingPlan, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*BillingPlan, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBillingPlan clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBillingPlan(ctx context.Context, in *BillingPlan, db *gorm.DB) (*BillingPlan, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBillingPlan")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &BillingPlanORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type BillingPlanORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBillingPlan executes a basic gorm update call with patch behavior
+func DefaultPatchBillingPlan(ctx context.Context, in *BillingPlan, updateMask *field_mask.FieldMask, db *gorm.DB) (*BillingPlan, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj BillingPlan
+	var err error
+	if hook, ok := interface{}(&pbObj).(BillingPlanWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadBillingPlan(ctx, &BillingPlan{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(BillingPlanWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBillingPlan(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(BillingPlanWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBillingPlan(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BillingPlanWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BillingPlanWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *BillingPlan, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *BillingPlan, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *BillingPlan, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *BillingPlan, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBillingPlan executes a bulk gorm update call with patch behavior
+func DefaultPatchSetBillingPlan(ctx context.Context, objects []*BillingPlan, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BillingPlan, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*BillingPlan, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchBillingPlan(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskBillingPlan patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBillingPlan(ctx context.Context, patchee *BillingPlan, patcher *BillingPlan, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BillingPlan, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
+			continue
+		}
+		if f == prefix+"Duration" {
+			patchee.Duration = patcher.Duration
+			continue
+		}
+		if f == prefix+"SandboxMode" {
+			patchee.SandboxMode = patcher.SandboxMode
+			continue
+		}
+		if f == prefix+"ProviderProductId" {
+			patchee.ProviderProductId = patcher.ProviderProductId
+			continue
+		}
+		if f == prefix+"ProviderPlanId" {
+			patchee.ProviderPlanId = patcher.ProviderPlanId
+			continue
+		}
+		if f == prefix+"AmountInCents" {
+			patchee.AmountInCents = patcher.AmountInCents
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"Active" {
+			patchee.Active = patcher.Active
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListBillingPlan executes a gorm list call
+func DefaultListBillingPlan(ctx context.Context, db *gorm.DB) ([]*BillingPlan, error) {
+	in := BillingPlan{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []BillingPlanORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BillingPlanORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*BillingPlan{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BillingPlanORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BillingPlanORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BillingPlanORM) error
+}
+
+// DefaultCreateProduct executes a basic gorm create call
+func DefaultCreateProduct(ctx context.Context, in *Product, db *gorm.DB) (*Product, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProductORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadProduct(ctx context.Context, in *Product, db *gorm.DB) (*Product, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ProductORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ProductORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProductORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteProduct(ctx context.Context, in *Product, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ProductORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ProductORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteProductSet(ctx context.Context, in []*Product, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ProductORM{})).(ProductORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ProductORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ProductORM{})).(ProductORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ProductORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Product, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Product, *gorm.DB) error
+}
+
+// DefaultStrictUpdateProduct clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateProduct(ctx context.Context, in *Product, db *gorm.DB) (*Product, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateProduct")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ProductORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ProductORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchProduct executes a basic gorm update call with patch behavior
+func DefaultPatchProduct(ctx context.Context, in *Product, updateMask *field_mask.FieldMask, db *gorm.DB) (*Product, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Product
+	var err error
+	if hook, ok := interface{}(&pbObj).(ProductWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadProduct(ctx, &Product{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ProductWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskProduct(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ProductWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateProduct(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ProductWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ProductWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Product, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProductWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Product, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProductWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Product, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProductWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Product, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetProduct executes a bulk gorm update call with patch behavior
+func DefaultPatchSetProduct(ctx context.Context, objects []*Product, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Product, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Product, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchProduct(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskProduct patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskProduct(ctx context.Context, patchee *Product, patcher *Product, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Product, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if f == prefix+"Tier" {
+			patchee.Tier = patcher.Tier
+			continue
+		}
+		if f == prefix+"ImageUrl" {
+			patchee.ImageUrl = patcher.ImageUrl
+			continue
+		}
+		if f == prefix+"Active" {
+			patchee.Active = patcher.Active
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListProduct executes a gorm list call
+func DefaultListProduct(ctx context.Context, db *gorm.DB) ([]*Product, error) {
+	in := Product{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ProductORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProductORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Product{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ProductORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProductORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ProductORM) error
+}
+
+// DefaultCreatePrice executes a basic gorm create call
+func DefaultCreatePrice(ctx context.Context, in *Price, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PriceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadPrice(ctx context.Context, in *Price, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PriceORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PriceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PriceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrice(ctx context.Context, in *Price, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PriceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PriceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePriceSet(ctx context.Context, in []*Price, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PriceORM{})).(PriceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PriceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PriceORM{})).(PriceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PriceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Price, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Price, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrice clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrice(ctx context.Context, in *Price, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrice")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PriceORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PriceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrice executes a basic gorm update call with patch behavior
+func DefaultPatchPrice(ctx context.Context, in *Price, updateMask *field_mask.FieldMask, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Price
+	var err error
+	if hook, ok := interface{}(&pbObj).(PriceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPrice(ctx, &Price{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PriceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrice(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PriceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrice(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PriceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PriceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrice executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrice(ctx context.Context, objects []*Price, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Price, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Price, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPrice(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrice patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrice(ctx context.Context, patchee *Price, patcher *Price, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Price, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedValidFrom bool
+	var updatedValidUntil bool
+	var updatedProduct bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Duration" {
+			patchee.Duration = patcher.Duration
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"AmountInCents" {
+			patchee.AmountInCents = patcher.AmountInCents
+			continue
+		}
+		if f == prefix+"DisplayName" {
+			patchee.DisplayName = patcher.DisplayName
+			continue
+		}
+		if f == prefix+"Active" {
+			patchee.Active = patcher.Active
+			continue
+		}
+		if !updatedValidFrom && strings.HasPrefix(f, prefix+"ValidFrom.") {
+			if patcher.ValidFrom == nil {
+				patchee.ValidFrom = nil
+				continue
+			}
+			if patchee.ValidFrom == nil {
+				patchee.ValidFrom = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ValidFrom."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ValidFrom, patchee.ValidFrom, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ValidFrom" {
+			updatedValidFrom = true
+			patchee.ValidFrom = patcher.ValidFrom
+			continue
+		}
+		if !updatedValidUntil && strings.HasPrefix(f, prefix+"ValidUntil.") {
+			if patcher.ValidUntil == nil {
+				patchee.ValidUntil = nil
+				continue
+			}
+			if patchee.ValidUntil == nil {
+				patchee.ValidUntil = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ValidUntil."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ValidUntil, patchee.ValidUntil, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ValidUntil" {
+			updatedValidUntil = true
+			patchee.ValidUntil = patcher.ValidUntil
+			continue
+		}
+		if !updatedProduct && strings.HasPrefix(f, prefix+"Product.") {
+			updatedProduct = true
+			if patcher.Product == nil {
+				patchee.Product = nil
+				continue
+			}
+			if patchee.Product == nil {
+				patchee.Product = &Product{}
+			}
+			if o, err := DefaultApplyFieldMaskProduct(ctx, patchee.Product, patcher.Product, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Product.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Product = o
+			}
+			continue
+		}
+		if f == prefix+"Product" {
+			updatedProduct = true
+			patchee.Product = patcher.Product
+			continue
+		}
+		if f == prefix+"ProductId" {
+			patchee.ProductId = patcher.ProductId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPrice executes a gorm list call
+func DefaultListPrice(ctx context.Context, db *gorm.DB) ([]*Price, error) {
+	in := Price{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PriceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Price{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PriceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PriceORM) error
+}
+
+// DefaultCreateExchangeRate executes a basic gorm create call
+func DefaultCreateExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm.DB) (*ExchangeRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ExchangeRateORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm.DB) (*ExchangeRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ExchangeRateORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ExchangeRateORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ExchangeRateORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ExchangeRateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ExchangeRateORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteExchangeRateSet(ctx context.Context, in []*ExchangeRate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ExchangeRateORM{})).(ExchangeRateORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ExchangeRateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ExchangeRateORM{})).(ExchangeRateORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ExchangeRateORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ExchangeRate, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ExchangeRate, *gorm.DB) error
+}
+
+// DefaultStrictUpdateExchangeRate clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm.DB) (*ExchangeRate, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExchangeRate")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ExchangeRateORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ExchangeRateORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchExchangeRate executes a basic gorm update call with patch behavior
+func DefaultPatchExchangeRate(ctx context.Context, in *ExchangeRate, updateMask *field_mask.FieldMask, db *gorm.DB) (*ExchangeRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj ExchangeRate
+	var err error
+	if hook, ok := interface{}(&pbObj).(ExchangeRateWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadExchangeRate(ctx, &ExchangeRate{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ExchangeRateWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskExchangeRate(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ExchangeRateWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateExchangeRate(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ExchangeRateWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ExchangeRateWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ExchangeRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ExchangeRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ExchangeRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ExchangeRate, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetExchangeRate executes a bulk gorm update call with patch behavior
+func DefaultPatchSetExchangeRate(ctx context.Context, objects []*ExchangeRate, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ExchangeRate, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*ExchangeRate, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchExchangeRate(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskExchangeRate patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskExchangeRate(ctx context.Context, patchee *ExchangeRate, patcher *ExchangeRate, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ExchangeRate, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"Rate" {
+			patchee.Rate = patcher.Rate
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListExchangeRate executes a gorm list call
+func DefaultListExchangeRate(ctx context.Context, db *gorm.DB) ([]*ExchangeRate, error) {
+	in := ExchangeRate{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ExchangeRateORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ExchangeRate{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ExchangeRateORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExchangeRateORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ExchangeRateORM) error
+}
+
+// DefaultCreateTaxRate executes a basic gorm create call
+func DefaultCreateTaxRate(ctx context.Context, in *TaxRate, db *gorm.DB) (*TaxRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaxRateORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadTaxRate(ctx context.Context, in *TaxRate, db *gorm.DB) (*TaxRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TaxRateORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TaxRateORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TaxRateORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTaxRate(ctx context.Context, in *TaxRate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TaxRateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TaxRateORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTaxRateSet(ctx context.Context, in []*TaxRate, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TaxRateORM{})).(TaxRateORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TaxRateORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TaxRateORM{})).(TaxRateORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TaxRateORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TaxRate, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TaxRate, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTaxRate clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTaxRate(ctx context.Context, in *TaxRate, db *gorm.DB) (*TaxRate, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTaxRate")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &TaxRateORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TaxRateORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTaxRate executes a basic gorm update call with patch behavior
+func DefaultPatchTaxRate(ctx context.Context, in *TaxRate, updateMask *field_mask.FieldMask, db *gorm.DB) (*TaxRate, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TaxRate
+	var err error
+	if hook, ok := interface{}(&pbObj).(TaxRateWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTaxRate(ctx, &TaxRate{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TaxRateWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTaxRate(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TaxRateWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTaxRate(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TaxRateWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TaxRateWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TaxRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TaxRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TaxRate, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TaxRate, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTaxRate executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTaxRate(ctx context.Context, objects []*TaxRate, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TaxRate, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TaxRate, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTaxRate(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTaxRate patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTaxRate(ctx context.Context, patchee *TaxRate, patcher *TaxRate, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TaxRate, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"CountryCode" {
+			patchee.CountryCode = patcher.CountryCode
+			continue
+		}
+		if f == prefix+"Rate" {
+			patchee.Rate = patcher.Rate
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTaxRate executes a gorm list call
+func DefaultListTaxRate(ctx context.Context, db *gorm.DB) ([]*TaxRate, error) {
+	in := TaxRate{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TaxRateORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaxRateORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TaxRate{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TaxRateORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TaxRateORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TaxRateORM) error
+}
+
+// DefaultCreateCheckoutOrder executes a basic gorm create call
+func DefaultCreateCheckoutOrder(ctx context.Context, in *CheckoutOrder, db *gorm.DB) (*CheckoutOrder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CheckoutOrderORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadCheckoutOrder(ctx context.Context, in *CheckoutOrder, db *gorm.DB) (*CheckoutOrder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CheckoutOrderORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(CheckoutOrderORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CheckoutOrderORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCheckoutOrder(ctx context.Context, in *CheckoutOrder, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&CheckoutOrderORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CheckoutOrderORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCheckoutOrderSet(ctx context.Context, in []*CheckoutOrder, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&CheckoutOrderORM{})).(CheckoutOrderORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&CheckoutOrderORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CheckoutOrderORM{})).(CheckoutOrderORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CheckoutOrderORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*CheckoutOrder, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*CheckoutOrder, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCheckoutOrder clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCheckoutOrder(ctx context.Context, in *CheckoutOrder, db *gorm.DB) (*CheckoutOrder, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCheckoutOrder")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &CheckoutOrderORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type CheckoutOrderORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCheckoutOrder executes a basic gorm update call with patch behavior
+func DefaultPatchCheckoutOrder(ctx context.Context, in *CheckoutOrder, updateMask *field_mask.FieldMask, db *gorm.DB) (*CheckoutOrder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj CheckoutOrder
+	var err error
+	if hook, ok := interface{}(&pbObj).(CheckoutOrderWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCheckoutOrder(ctx, &CheckoutOrder{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(CheckoutOrderWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCheckoutOrder(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(CheckoutOrderWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCheckoutOrder(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CheckoutOrderWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CheckoutOrderWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *CheckoutOrder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *CheckoutOrder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *CheckoutOrder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *CheckoutOrder, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCheckoutOrder executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCheckoutOrder(ctx context.Context, objects []*CheckoutOrder, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CheckoutOrder, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*CheckoutOrder, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchCheckoutOrder(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCheckoutOrder patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCheckoutOrder(ctx context.Context, patchee *CheckoutOrder, patcher *CheckoutOrder, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CheckoutOrder, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedLastCheckedAt bool
+	var updatedCompletedAt bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
+			continue
+		}
+		if f == prefix+"ProviderOrderId" {
+			patchee.ProviderOrderId = patcher.ProviderOrderId
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"LicenseDuration" {
+			patchee.LicenseDuration = patcher.LicenseDuration
+			continue
+		}
+		if f == prefix+"RenewalKeyId" {
+			patchee.RenewalKeyId = patcher.RenewalKeyId
+			continue
+		}
+		if f == prefix+"AmountInCents" {
+			patchee.AmountInCents = patcher.AmountInCents
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"SandboxMode" {
+			patchee.SandboxMode = patcher.SandboxMode
+			continue
+		}
+		if f == prefix+"CaptureId" {
+			patchee.CaptureId = patcher.CaptureId
+			continue
+		}
+		if f == prefix+"ReconcileAttempts" {
+			patchee.ReconcileAttempts = patcher.ReconcileAttempts
+			continue
+		}
+		if f == prefix+"LastError" {
+			patchee.LastError = patcher.LastError
+			continue
+		}
+		if !updatedLastCheckedAt && strings.HasPrefix(f, prefix+"LastCheckedAt.") {
+			if patcher.LastCheckedAt == nil {
+				patchee.LastCheckedAt = nil
+				continue
+			}
+			if patchee.LastCheckedAt == nil {
+				patchee.LastCheckedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LastCheckedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LastCheckedAt, patchee.LastCheckedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LastCheckedAt" {
+			updatedLastCheckedAt = true
+			patchee.LastCheckedAt = patcher.LastCheckedAt
+			continue
+		}
+		if !updatedCompletedAt && strings.HasPrefix(f, prefix+"CompletedAt.") {
+			if patcher.CompletedAt == nil {
+				patchee.CompletedAt = nil
+				continue
+			}
+			if patchee.CompletedAt == nil {
+				patchee.CompletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CompletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CompletedAt, patchee.CompletedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CompletedAt" {
+			updatedCompletedAt = true
+			patchee.CompletedAt = patcher.CompletedAt
+			continue
+		}
+		if f == prefix+"TaxCountryCode" {
+			patchee.TaxCountryCode = patcher.TaxCountryCode
+			continue
+		}
+		if f == prefix+"TaxRate" {
+			patchee.TaxRate = patcher.TaxRate
+			continue
+		}
+		if f == prefix+"TaxInCents" {
+			patchee.TaxInCents = patcher.TaxInCents
+			continue
+		}
+		if f == prefix+"BillingCountryCode" {
+			patchee.BillingCountryCode = patcher.BillingCountryCode
+			continue
+		}
+		if f == prefix+"IpAddress" {
+			patchee.IpAddress = patcher.IpAddress
+			continue
+		}
+		if f == prefix+"IpCountryCode" {
+			patchee.IpCountryCode = patcher.IpCountryCode
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCheckoutOrder executes a gorm list call
+func DefaultListCheckoutOrder(ctx context.Context, db *gorm.DB) ([]*CheckoutOrder, error) {
+	in := CheckoutOrder{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []CheckoutOrderORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CheckoutOrderORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*CheckoutOrder{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CheckoutOrderORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CheckoutOrderORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CheckoutOrderORM) error
+}
+
+// DefaultCreateInvoice executes a basic gorm create call
+func DefaultCreateInvoice(ctx context.Context, in *Invoice, db *gorm.DB) (*Invoice, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type InvoiceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadInvoice(ctx context.Context, in *Invoice, db *gorm.DB) (*Invoice, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := InvoiceORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(InvoiceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type InvoiceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteInvoice(ctx context.Context, in *Invoice, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&InvoiceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type InvoiceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteInvoiceSet(ctx context.Context, in []*Invoice, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&InvoiceORM{})).(InvoiceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&InvoiceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&InvoiceORM{})).(InvoiceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type InvoiceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Invoice, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Invoice, *gorm.DB) error
+}
+
+// DefaultStrictUpdateInvoice clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateInvoice(ctx context.Context, in *Invoice, db *gorm.DB) (*Invoice, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateInvoice")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &InvoiceORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type InvoiceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchInvoice executes a basic gorm update call with patch behavior
+func DefaultPatchInvoice(ctx context.Context, in *Invoice, updateMask *field_mask.FieldMask, db *gorm.DB) (*Invoice, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Invoice
+	var err error
+	if hook, ok := interface{}(&pbObj).(InvoiceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadInvoice(ctx, &Invoice{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(InvoiceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskInvoice(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(InvoiceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateInvoice(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(InvoiceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type InvoiceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Invoice, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Invoice, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Invoice, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Invoice, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetInvoice executes a bulk gorm update call with patch behavior
+func DefaultPatchSetInvoice(ctx context.Context, objects []*Invoice, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Invoice, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Invoice, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchInvoice(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskInvoice patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskInvoice(ctx context.Context, patchee *Invoice, patcher *Invoice, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Invoice, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedIssuedAt bool
+	var updatedRenderedAt bool
+	var updatedPayment bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Kind" {
+			patchee.Kind = patcher.Kind
+			continue
+		}
+		if f == prefix+"Number" {
+			patchee.Number = patcher.Number
+			continue
+		}
+		if !updatedIssuedAt && strings.HasPrefix(f, prefix+"IssuedAt.") {
+			if patcher.IssuedAt == nil {
+				patchee.IssuedAt = nil
+				continue
+			}
+			if patchee.IssuedAt == nil {
+				patchee.IssuedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"IssuedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.IssuedAt, patchee.IssuedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"IssuedAt" {
+			updatedIssuedAt = true
+			patchee.IssuedAt = patcher.IssuedAt
+			continue
+		}
+		if f == prefix+"BillingName" {
+			patchee.BillingName = patcher.BillingName
+			continue
+		}
+		if f == prefix+"BillingEmail" {
+			patchee.BillingEmail = patcher.BillingEmail
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if f == prefix+"AmountInCents" {
+			patchee.AmountInCents = patcher.AmountInCents
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"RefundId" {
+			patchee.RefundId = patcher.RefundId
+			continue
+		}
+		if f == prefix+"Pdf" {
+			patchee.Pdf = patcher.Pdf
+			continue
+		}
+		if !updatedRenderedAt && strings.HasPrefix(f, prefix+"RenderedAt.") {
+			if patcher.RenderedAt == nil {
+				patchee.RenderedAt = nil
+				continue
+			}
+			if patchee.RenderedAt == nil {
+				patchee.RenderedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RenderedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RenderedAt, patchee.RenderedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RenderedAt" {
+			updatedRenderedAt = true
+			patchee.RenderedAt = patcher.RenderedAt
+			continue
+		}
+		if !updatedPayment && strings.HasPrefix(f, prefix+"Payment.") {
+			updatedPayment = true
+			if patcher.Payment == nil {
+				patchee.Payment = nil
+				continue
+			}
+			if patchee.Payment == nil {
+				patchee.Payment = &Payment{}
+			}
+			if o, err := DefaultApplyFieldMaskPayment(ctx, patchee.Payment, patcher.Payment, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Payment.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Payment = o
+			}
+			continue
+		}
+		if f == prefix+"Payment" {
+			updatedPayment = true
+			patchee.Payment = patcher.Payment
+			continue
+		}
+		if f == prefix+"PaymentId" {
+			patchee.PaymentId = patcher.PaymentId
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"CreditedInvoiceId" {
+			patchee.CreditedInvoiceId = patcher.CreditedInvoiceId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListInvoice executes a gorm list call
+func DefaultListInvoice(ctx context.Context, db *gorm.DB) ([]*Invoice, error) {
+	in := Invoice{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []InvoiceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InvoiceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Invoice{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type InvoiceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type InvoiceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]InvoiceORM) error
+}
+
+// DefaultCreateReferralCode executes a basic gorm create call
+func DefaultCreateReferralCode(ctx context.Context, in *ReferralCode, db *gorm.DB) (*ReferralCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ReferralCodeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadReferralCode(ctx context.Context, in *ReferralCode, db *gorm.DB) (*ReferralCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ReferralCodeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ReferralCodeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ReferralCodeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteReferralCode(ctx context.Context, in *ReferralCode, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ReferralCodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ReferralCodeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteReferralCodeSet(ctx context.Context, in []*ReferralCode, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ReferralCodeORM{})).(ReferralCodeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ReferralCodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ReferralCodeORM{})).(ReferralCodeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ReferralCodeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ReferralCode, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ReferralCode, *gorm.DB) error
+}
+
+// DefaultStrictUpdateReferralCode clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateReferralCode(ctx context.Context, in *ReferralCode, db *gorm.DB) (*ReferralCode, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateReferralCode")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ReferralCodeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ReferralCodeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchReferralCode executes a basic gorm update call with patch behavior
+func DefaultPatchReferralCode(ctx context.Context, in *ReferralCode, updateMask *field_mask.FieldMask, db *gorm.DB) (*ReferralCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj ReferralCode
+	var err error
+	if hook, ok := interface{}(&pbObj).(ReferralCodeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadReferralCode(ctx, &ReferralCode{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ReferralCodeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskReferralCode(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ReferralCodeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateReferralCode(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ReferralCodeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ReferralCodeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ReferralCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ReferralCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ReferralCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ReferralCode, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetReferralCode executes a bulk gorm update call with patch behavior
+func DefaultPatchSetReferralCode(ctx context.Context, objects []*ReferralCode, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ReferralCode, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*ReferralCode, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchReferralCode(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskReferralCode patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskReferralCode(ctx context.Context, patchee *ReferralCode, patcher *ReferralCode, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ReferralCode, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListReferralCode executes a gorm list call
+func DefaultListReferralCode(ctx context.Context, db *gorm.DB) ([]*ReferralCode, error) {
+	in := ReferralCode{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ReferralCodeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReferralCodeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ReferralCode{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ReferralCodeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReferralCodeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ReferralCodeORM) error
+}
+
+// DefaultCreateCommission executes a basic gorm create call
+func DefaultCreateCommission(ctx context.Context, in *Commission, db *gorm.DB) (*Commission, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CommissionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadCommission(ctx context.Context, in *Commission, db *gorm.DB) (*Commission, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CommissionORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(CommissionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CommissionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCommission(ctx context.Context, in *Commission, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&CommissionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CommissionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCommissionSet(ctx context.Context, in []*Commission, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&CommissionORM{})).(CommissionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&CommissionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CommissionORM{})).(CommissionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CommissionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Commission, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Commission, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCommission clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCommission(ctx context.Context, in *Commission, db *gorm.DB) (*Commission, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCommission")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &CommissionORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type CommissionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCommission executes a basic gorm update call with patch behavior
+func DefaultPatchCommission(ctx context.Context, in *Commission, updateMask *field_mask.FieldMask, db *gorm.DB) (*Commission, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Commission
+	var err error
+	if hook, ok := interface{}(&pbObj).(CommissionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCommission(ctx, &Commission{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(CommissionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCommission(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(CommissionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCommission(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CommissionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CommissionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Commission, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Commission, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Commission, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Commission, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCommission executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCommission(ctx context.Context, objects []*Commission, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Commission, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Commission, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchCommission(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCommission patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCommission(ctx context.Context, patchee *Commission, patcher *Commission, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Commission, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedPayableAt bool
+	var updatedPaidAt bool
+	var updatedPayment bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Kind" {
+			patchee.Kind = patcher.Kind
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"AmountInCents" {
+			patchee.AmountInCents = patcher.AmountInCents
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"Rate" {
+			patchee.Rate = patcher.Rate
+			continue
+		}
+		if !updatedPayableAt && strings.HasPrefix(f, prefix+"PayableAt.") {
+			if patcher.PayableAt == nil {
+				patchee.PayableAt = nil
+				continue
+			}
+			if patchee.PayableAt == nil {
+				patchee.PayableAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"PayableAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.PayableAt, patchee.PayableAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"PayableAt" {
+			updatedPayableAt = true
+			patchee.PayableAt = patcher.PayableAt
+			continue
+		}
+		if !updatedPaidAt && strings.HasPrefix(f, prefix+"PaidAt.") {
+			if patcher.PaidAt == nil {
+				patchee.PaidAt = nil
+				continue
+			}
+			if patchee.PaidAt == nil {
+				patchee.PaidAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"PaidAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.PaidAt, patchee.PaidAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"PaidAt" {
+			updatedPaidAt = true
+			patchee.PaidAt = patcher.PaidAt
+			continue
+		}
+		if f == prefix+"PayoutReference" {
+			patchee.PayoutReference = patcher.PayoutReference
+			continue
+		}
+		if f == prefix+"RefundId" {
+			patchee.RefundId = patcher.RefundId
+			continue
+		}
+		if !updatedPayment && strings.HasPrefix(f, prefix+"Payment.") {
+			updatedPayment = true
+			if patcher.Payment == nil {
+				patchee.Payment = nil
+				continue
+			}
+			if patchee.Payment == nil {
+				patchee.Payment = &Payment{}
+			}
+			if o, err := DefaultApplyFieldMaskPayment(ctx, patchee.Payment, patcher.Payment, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Payment.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Payment = o
+			}
+			continue
+		}
+		if f == prefix+"Payment" {
+			updatedPayment = true
+			patchee.Payment = patcher.Payment
+			continue
+		}
+		if f == prefix+"PaymentId" {
+			patchee.PaymentId = patcher.PaymentId
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCommission executes a gorm list call
+func DefaultListCommission(ctx context.Context, db *gorm.DB) ([]*Commission, error) {
+	in := Commission{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []CommissionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommissionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Commission{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CommissionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommissionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CommissionORM) error
+}
+
+// DefaultCreateRefundRequest executes a basic gorm create call
+func DefaultCreateRefundRequest(ctx context.Context, in *RefundRequest, db *gorm.DB) (*RefundRequest, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RefundRequestORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadRefundRequest(ctx context.Context, in *RefundRequest, db *gorm.DB) (*RefundRequest, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RefundRequestORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RefundRequestORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RefundRequestORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRefundRequest(ctx context.Context, in *RefundRequest, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&RefundRequestORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RefundRequestORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRefundRequestSet(ctx context.Context, in []*RefundRequest, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RefundRequestORM{})).(RefundRequestORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RefundRequestORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RefundRequestORM{})).(RefundRequestORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RefundRequestORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*RefundRequest, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*RefundRequest, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRefundRequest clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRefundRequest(ctx context.Context, in *RefundRequest, db *gorm.DB) (*RefundRequest, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRefundRequest")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RefundRequestORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RefundRequestORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRefundRequest executes a basic gorm update call with patch behavior
+func DefaultPatchRefundRequest(ctx context.Context, in *RefundRequest, updateMask *field_mask.FieldMask, db *gorm.DB) (*RefundRequest, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj RefundRequest
+	var err error
+	if hook, ok := interface{}(&pbObj).(RefundRequestWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRefundRequest(ctx, &RefundRequest{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RefundRequestWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRefundRequest(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RefundRequestWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRefundRequest(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RefundRequestWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RefundRequestWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *RefundRequest, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *RefundRequest, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *RefundRequest, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *RefundRequest, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRefundRequest executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRefundRequest(ctx context.Context, objects []*RefundRequest, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*RefundRequest, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*RefundRequest, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRefundRequest(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRefundRequest patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRefundRequest(ctx context.Context, patchee *RefundRequest, patcher *RefundRequest, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*RefundRequest, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedDecidedAt bool
+	var updatedPayment bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Reason" {
+			patchee.Reason = patcher.Reason
+			continue
+		}
+		if f == prefix+"DecisionNote" {
+			patchee.DecisionNote = patcher.DecisionNote
+			continue
+		}
+		if f == prefix+"DecidedBy" {
+			patchee.DecidedBy = patcher.DecidedBy
+			continue
+		}
+		if !updatedDecidedAt && strings.HasPrefix(f, prefix+"DecidedAt.") {
+			if patcher.DecidedAt == nil {
+				patchee.DecidedAt = nil
+				continue
+			}
+			if patchee.DecidedAt == nil {
+				patchee.DecidedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DecidedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DecidedAt, patchee.DecidedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DecidedAt" {
+			updatedDecidedAt = true
+			patchee.DecidedAt = patcher.DecidedAt
+			continue
+		}
+		if f == prefix+"RefundId" {
+			patchee.RefundId = patcher.RefundId
+			continue
+		}
+		if !updatedPayment && strings.HasPrefix(f, prefix+"Payment.") {
+			updatedPayment = true
+			if patcher.Payment == nil {
+				patchee.Payment = nil
+				continue
+			}
+			if patchee.Payment == nil {
+				patchee.Payment = &Payment{}
+			}
+			if o, err := DefaultApplyFieldMaskPayment(ctx, patchee.Payment, patcher.Payment, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Payment.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Payment = o
+			}
+			continue
+		}
+		if f == prefix+"Payment" {
+			updatedPayment = true
+			patchee.Payment = patcher.Payment
+			continue
+		}
+		if f == prefix+"PaymentId" {
+			patchee.PaymentId = patcher.PaymentId
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRefundRequest executes a gorm list call
+func DefaultListRefundRequest(ctx context.Context, db *gorm.DB) ([]*RefundRequest, error) {
+	in := RefundRequest{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []RefundRequestORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefundRequestORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*RefundRequest{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RefundRequestORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefundRequestORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RefundRequestORM) error
+}
+
+// DefaultCreateApiKey executes a basic gorm create call
+func DefaultCreateApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ApiKeyORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ApiKeyORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ApiKeyORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ApiKeyORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ApiKeyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ApiKeyORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteApiKeySet(ctx context.Context, in []*ApiKey, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ApiKeyORM{})).(ApiKeyORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ApiKeyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ApiKeyORM{})).(ApiKeyORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ApiKeyORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ApiKey, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ApiKey, *gorm.DB) error
+}
+
+// DefaultStrictUpdateApiKey clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateApiKey")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ApiKeyORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ApiKeyORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchApiKey executes a basic gorm update call with patch behavior
+func DefaultPatchApiKey(ctx context.Context, in *ApiKey, updateMask *field_mask.FieldMask, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj ApiKey
+	var err error
+	if hook, ok := interface{}(&pbObj).(ApiKeyWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadApiKey(ctx, &ApiKey{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ApiKeyWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskApiKey(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ApiKeyWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateApiKey(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ApiKeyWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ApiKeyWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetApiKey executes a bulk gorm update call with patch behavior
+func DefaultPatchSetApiKey(ctx context.Context, objects []*ApiKey, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ApiKey, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*ApiKey, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchApiKey(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskApiKey patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskApiKey(ctx context.Context, patchee *ApiKey, patcher *ApiKey, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ApiKey, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedExpiresAt bool
+	var updatedLastUsedAt bool
+	var updatedRevokedAt bool
+	var updatedUser bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Prefix" {
+			patchee.Prefix = patcher.Prefix
+			continue
+		}
+		if f == prefix+"KeyHash" {
+			patchee.KeyHash = patcher.KeyHash
+			continue
+		}
+		if f == prefix+"Scopes" {
+			patchee.Scopes = patcher.Scopes
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedLastUsedAt && strings.HasPrefix(f, prefix+"LastUsedAt.") {
+			if patcher.LastUsedAt == nil {
+				patchee.LastUsedAt = nil
+				continue
+			}
+			if patchee.LastUsedAt == nil {
+				patchee.LastUsedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LastUsedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LastUsedAt, patchee.LastUsedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LastUsedAt" {
+			updatedLastUsedAt = true
+			patchee.LastUsedAt = patcher.LastUsedAt
+			continue
+		}
+		if !updatedRevokedAt && strings.HasPrefix(f, prefix+"RevokedAt.") {
+			if patcher.RevokedAt == nil {
+				patchee.RevokedAt = nil
+				continue
+			}
+			if patchee.RevokedAt == nil {
+				patchee.RevokedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RevokedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RevokedAt, patchee.RevokedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RevokedAt" {
+			updatedRevokedAt = true
+			patchee.RevokedAt = patcher.RevokedAt
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListApiKey executes a gorm list call
+func DefaultListApiKey(ctx context.Context, db *gorm.DB) ([]*ApiKey, error) {
+	in := ApiKey{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ApiKeyORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ApiKey{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ApiKeyORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ApiKeyORM) error
+}
+
+// DefaultCreateImpersonationAudit executes a basic gorm create call
+func DefaultCreateImpersonationAudit(ctx context.Context, in *ImpersonationAudit, db *gorm.DB) (*ImpersonationAudit, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ImpersonationAuditORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ImpersonationAuditORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadImpersonationAudit(ctx context.Context, in *ImpersonationAudit, db *gorm.DB) (*ImpersonationAudit, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ImpersonationAuditORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ImpersonationAuditORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ImpersonationAuditORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ImpersonationAuditORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ImpersonationAuditORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteImpersonationAudit(ctx context.Context, in *ImpersonationAudit, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ImpersonationAuditORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ImpersonationAuditORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ImpersonationAuditORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteImpersonationAuditSet(ctx context.Context, in []*ImpersonationAudit, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&ActivityORM{})).(ActivityORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ImpersonationAuditORM{})).(ImpersonationAuditORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&ActivityORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ImpersonationAuditORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&ActivityORM{})).(ActivityORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ImpersonationAuditORM{})).(ImpersonationAuditORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type ActivityORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Activity, *gorm.DB) (*gorm.DB, error)
+type ImpersonationAuditORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ImpersonationAudit, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Activity, *gorm.DB) error
+type ImpersonationAuditORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ImpersonationAudit, *gorm.DB) error
 }
 
-// DefaultStrictUpdateActivity clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateActivity(ctx context.Context, in *Activity, db *gorm.DB) (*Activity, error) {
+// DefaultStrictUpdateImpersonationAudit clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateImpersonationAudit(ctx context.Context, in *ImpersonationAudit, db *gorm.DB) (*ImpersonationAudit, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateActivity")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateImpersonationAudit")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &ActivityORM{}
+	lockedRow := &ImpersonationAuditORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -941,7 +9993,7 @@ func DefaultStrictUpdateActivity(ctx context.Context, in *Activity, db *gorm.DB)
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -953,51 +10005,51 @@ func DefaultStrictUpdateActivity(ctx context.Context, in *Activity, db *gorm.DB)
 	return &pbResponse, err
 }
 
-type ActivityORMWithBeforeStrictUpdateCleanup interface {
+type ImpersonationAuditORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityORMWithBeforeStrictUpdateSave interface {
+type ImpersonationAuditORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityORMWithAfterStrictUpdateSave interface {
+type ImpersonationAuditORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchActivity executes a basic gorm update call with patch behavior
-func DefaultPatchActivity(ctx context.Context, in *Activity, updateMask *field_mask.FieldMask, db *gorm.DB) (*Activity, error) {
+// DefaultPatchImpersonationAudit executes a basic gorm update call with patch behavior
+func DefaultPatchImpersonationAudit(ctx context.Context, in *ImpersonationAudit, updateMask *field_mask.FieldMask, db *gorm.DB) (*ImpersonationAudit, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Activity
+	var pbObj ImpersonationAudit
 	var err error
-	if hook, ok := interface{}(&pbObj).(ActivityWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ImpersonationAuditWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadActivity(ctx, &Activity{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadImpersonationAudit(ctx, &ImpersonationAudit{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(ActivityWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ImpersonationAuditWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskActivity(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskImpersonationAudit(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(ActivityWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ImpersonationAuditWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateActivity(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateImpersonationAudit(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(ActivityWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ImpersonationAuditWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1005,28 +10057,28 @@ func DefaultPatchActivity(ctx context.Context, in *Activity, updateMask *field_m
 	return pbResponse, nil
 }
 
-type ActivityWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ImpersonationAuditWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ImpersonationAudit, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ImpersonationAuditWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ImpersonationAudit, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ImpersonationAuditWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ImpersonationAudit, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Activity, *field_mask.FieldMask, *gorm.DB) error
+type ImpersonationAuditWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ImpersonationAudit, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetActivity executes a bulk gorm update call with patch behavior
-func DefaultPatchSetActivity(ctx context.Context, objects []*Activity, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Activity, error) {
+// DefaultPatchSetImpersonationAudit executes a bulk gorm update call with patch behavior
+func DefaultPatchSetImpersonationAudit(ctx context.Context, objects []*ImpersonationAudit, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ImpersonationAudit, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Activity, 0, len(objects))
+	results := make([]*ImpersonationAudit, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchActivity(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchImpersonationAudit(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1037,8 +10089,8 @@ func DefaultPatchSetActivity(ctx context.Context, objects []*Activity, updateMas
 	return results, nil
 }
 
-// DefaultApplyFieldMaskActivity patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskActivity(ctx context.Context, patchee *Activity, patcher *Activity, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Activity, error) {
+// DefaultApplyFieldMaskImpersonationAudit patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskImpersonationAudit(ctx context.Context, patchee *ImpersonationAudit, patcher *ImpersonationAudit, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ImpersonationAudit, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1048,9 +10100,6 @@ func DefaultApplyFieldMaskActivity(ctx context.Context, patchee *Activity, patch
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
 	var updatedUser bool
-	var updatedLicenseKey bool
-	var updatedPayment bool
-	var updatedSubscription bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -1102,8 +10151,32 @@ func DefaultApplyFieldMaskActivity(ctx context.Context, patchee *Activity, patch
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"Kind" {
-			patchee.Kind = patcher.Kind
+		if f == prefix+"ImpersonationId" {
+			patchee.ImpersonationId = patcher.ImpersonationId
+			continue
+		}
+		if f == prefix+"AdminDiscourseId" {
+			patchee.AdminDiscourseId = patcher.AdminDiscourseId
+			continue
+		}
+		if f == prefix+"AdminUsername" {
+			patchee.AdminUsername = patcher.AdminUsername
+			continue
+		}
+		if f == prefix+"Method" {
+			patchee.Method = patcher.Method
+			continue
+		}
+		if f == prefix+"ReadWrite" {
+			patchee.ReadWrite = patcher.ReadWrite
+			continue
+		}
+		if f == prefix+"Allowed" {
+			patchee.Allowed = patcher.Allowed
+			continue
+		}
+		if f == prefix+"Reason" {
+			patchee.Reason = patcher.Reason
 			continue
 		}
 		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
@@ -1127,67 +10200,8 @@ func DefaultApplyFieldMaskActivity(ctx context.Context, patchee *Activity, patch
 			patchee.User = patcher.User
 			continue
 		}
-		if !updatedLicenseKey && strings.HasPrefix(f, prefix+"LicenseKey.") {
-			updatedLicenseKey = true
-			if patcher.LicenseKey == nil {
-				patchee.LicenseKey = nil
-				continue
-			}
-			if patchee.LicenseKey == nil {
-				patchee.LicenseKey = &LicenseKey{}
-			}
-			if o, err := DefaultApplyFieldMaskLicenseKey(ctx, patchee.LicenseKey, patcher.LicenseKey, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"LicenseKey.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.LicenseKey = o
-			}
-			continue
-		}
-		if f == prefix+"LicenseKey" {
-			updatedLicenseKey = true
-			patchee.LicenseKey = patcher.LicenseKey
-			continue
-		}
-		if !updatedPayment && strings.HasPrefix(f, prefix+"Payment.") {
-			updatedPayment = true
-			if patcher.Payment == nil {
-				patchee.Payment = nil
-				continue
-			}
-			if patchee.Payment == nil {
-				patchee.Payment = &Payment{}
-			}
-			if o, err := DefaultApplyFieldMaskPayment(ctx, patchee.Payment, patcher.Payment, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Payment.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Payment = o
-			}
-			continue
-		}
-		if f == prefix+"Payment" {
-			updatedPayment = true
-			patchee.Payment = patcher.Payment
-			continue
-		}
-		if !updatedSubscription && strings.HasPrefix(f, prefix+"Subscription.") {
-			updatedSubscription = true
-			if patcher.Subscription == nil {
-				patchee.Subscription = nil
-				continue
-			}
-			if patchee.Subscription == nil {
-				patchee.Subscription = &Subscription{}
-			}
-			if o, err := DefaultApplyFieldMaskSubscription(ctx, patchee.Subscription, patcher.Subscription, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Subscription.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Subscription = o
-			}
-			continue
-		}
-		if f == prefix+"Subscription" {
-			updatedSubscription = true
-			patchee.Subscription = patcher.Subscription
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
 			continue
 		}
 	}
@@ -1197,35 +10211,35 @@ func DefaultApplyFieldMaskActivity(ctx context.Context, patchee *Activity, patch
 	return patchee, nil
 }
 
-// DefaultListActivity executes a gorm list call
-func DefaultListActivity(ctx context.Context, db *gorm.DB) ([]*Activity, error) {
-	in := Activity{}
+// DefaultListImpersonationAudit executes a gorm list call
+func DefaultListImpersonationAudit(ctx context.Context, db *gorm.DB) ([]*ImpersonationAudit, error) {
+	in := ImpersonationAudit{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []ActivityORM{}
+	ormResponse := []ImpersonationAuditORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ActivityORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ImpersonationAuditORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Activity{}
+	pbResponse := []*ImpersonationAudit{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1236,18 +10250,18 @@ func DefaultListActivity(ctx context.Context, db *gorm.DB) ([]*Activity, error)
 	return pbResponse, nil
 }
 
-type ActivityORMWithBeforeListApplyQuery interface {
+type ImpersonationAuditORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityORMWithBeforeListFind interface {
+type ImpersonationAuditORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ActivityORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]ActivityORM) error
+type ImpersonationAuditORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ImpersonationAuditORM) error
 }
 
-// DefaultCreateLicenseKey executes a basic gorm create call
-func DefaultCreateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*LicenseKey, error) {
+// DefaultCreateInvoiceSequence executes a basic gorm create call
+func DefaultCreateInvoiceSequence(ctx context.Context, in *InvoiceSequence, db *gorm.DB) (*InvoiceSequence, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1255,7 +10269,7 @@ func DefaultCreateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1263,7 +10277,7 @@ func DefaultCreateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1272,14 +10286,14 @@ func DefaultCreateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type LicenseKeyORMWithBeforeCreate_ interface {
+type InvoiceSequenceORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithAfterCreate_ interface {
+type InvoiceSequenceORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*LicenseKey, error) {
+func DefaultReadInvoiceSequence(ctx context.Context, in *InvoiceSequence, db *gorm.DB) (*InvoiceSequence, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1290,21 +10304,21 @@ func DefaultReadLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*L
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := LicenseKeyORM{}
+	ormResponse := InvoiceSequenceORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(LicenseKeyORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(InvoiceSequenceORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1313,17 +10327,17 @@ func DefaultReadLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*L
 	return &pbResponse, err
 }
 
-type LicenseKeyORMWithBeforeReadApplyQuery interface {
+type InvoiceSequenceORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithBeforeReadFind interface {
+type InvoiceSequenceORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithAfterReadFind interface {
+type InvoiceSequenceORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) error {
+func DefaultDeleteInvoiceSequence(ctx context.Context, in *InvoiceSequence, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1334,29 +10348,29 @@ func DefaultDeleteLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) e
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&LicenseKeyORM{}).Error
+	err = db.Where(&ormObj).Delete(&InvoiceSequenceORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type LicenseKeyORMWithBeforeDelete_ interface {
+type InvoiceSequenceORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithAfterDelete_ interface {
+type InvoiceSequenceORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteLicenseKeySet(ctx context.Context, in []*LicenseKey, db *gorm.DB) error {
+func DefaultDeleteInvoiceSequenceSet(ctx context.Context, in []*InvoiceSequence, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1372,45 +10386,45 @@ func DefaultDeleteLicenseKeySet(ctx context.Context, in []*LicenseKey, db *gorm.
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&LicenseKeyORM{})).(LicenseKeyORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&InvoiceSequenceORM{})).(InvoiceSequenceORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&LicenseKeyORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&InvoiceSequenceORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&LicenseKeyORM{})).(LicenseKeyORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&InvoiceSequenceORM{})).(InvoiceSequenceORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type LicenseKeyORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*LicenseKey, *gorm.DB) (*gorm.DB, error)
+type InvoiceSequenceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*InvoiceSequence, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*LicenseKey, *gorm.DB) error
+type InvoiceSequenceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*InvoiceSequence, *gorm.DB) error
 }
 
-// DefaultStrictUpdateLicenseKey clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm.DB) (*LicenseKey, error) {
+// DefaultStrictUpdateInvoiceSequence clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateInvoiceSequence(ctx context.Context, in *InvoiceSequence, db *gorm.DB) (*InvoiceSequence, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLicenseKey")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateInvoiceSequence")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &LicenseKeyORM{}
+	lockedRow := &InvoiceSequenceORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1418,7 +10432,7 @@ func DefaultStrictUpdateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1430,51 +10444,51 @@ func DefaultStrictUpdateLicenseKey(ctx context.Context, in *LicenseKey, db *gorm
 	return &pbResponse, err
 }
 
-type LicenseKeyORMWithBeforeStrictUpdateCleanup interface {
+type InvoiceSequenceORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithBeforeStrictUpdateSave interface {
+type InvoiceSequenceORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithAfterStrictUpdateSave interface {
+type InvoiceSequenceORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchLicenseKey executes a basic gorm update call with patch behavior
-func DefaultPatchLicenseKey(ctx context.Context, in *LicenseKey, updateMask *field_mask.FieldMask, db *gorm.DB) (*LicenseKey, error) {
+// DefaultPatchInvoiceSequence executes a basic gorm update call with patch behavior
+func DefaultPatchInvoiceSequence(ctx context.Context, in *InvoiceSequence, updateMask *field_mask.FieldMask, db *gorm.DB) (*InvoiceSequence, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj LicenseKey
+	var pbObj InvoiceSequence
 	var err error
-	if hook, ok := interface{}(&pbObj).(LicenseKeyWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(InvoiceSequenceWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadLicenseKey(ctx, &LicenseKey{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadInvoiceSequence(ctx, &InvoiceSequence{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(LicenseKeyWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(InvoiceSequenceWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskLicenseKey(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskInvoiceSequence(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(LicenseKeyWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(InvoiceSequenceWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateLicenseKey(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateInvoiceSequence(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(LicenseKeyWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(InvoiceSequenceWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1482,28 +10496,28 @@ func DefaultPatchLicenseKey(ctx context.Context, in *LicenseKey, updateMask *fie
 	return pbResponse, nil
 }
 
-type LicenseKeyWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type InvoiceSequenceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *InvoiceSequence, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type InvoiceSequenceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *InvoiceSequence, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type InvoiceSequenceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *InvoiceSequence, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *LicenseKey, *field_mask.FieldMask, *gorm.DB) error
+type InvoiceSequenceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *InvoiceSequence, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetLicenseKey executes a bulk gorm update call with patch behavior
-func DefaultPatchSetLicenseKey(ctx context.Context, objects []*LicenseKey, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*LicenseKey, error) {
+// DefaultPatchSetInvoiceSequence executes a bulk gorm update call with patch behavior
+func DefaultPatchSetInvoiceSequence(ctx context.Context, objects []*InvoiceSequence, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*InvoiceSequence, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*LicenseKey, 0, len(objects))
+	results := make([]*InvoiceSequence, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchLicenseKey(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchInvoiceSequence(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1514,8 +10528,8 @@ func DefaultPatchSetLicenseKey(ctx context.Context, objects []*LicenseKey, updat
 	return results, nil
 }
 
-// DefaultApplyFieldMaskLicenseKey patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskLicenseKey(ctx context.Context, patchee *LicenseKey, patcher *LicenseKey, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*LicenseKey, error) {
+// DefaultApplyFieldMaskInvoiceSequence patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskInvoiceSequence(ctx context.Context, patchee *InvoiceSequence, patcher *InvoiceSequence, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*InvoiceSequence, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1524,8 +10538,6 @@ func DefaultApplyFieldMaskLicenseKey(ctx context.Context, patchee *LicenseKey, p
 	var err error
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
-	var updatedEffectiveFrom bool
-	var updatedUser bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -1573,84 +10585,16 @@ func DefaultApplyFieldMaskLicenseKey(ctx context.Context, patchee *LicenseKey, p
 			}
 		}
 		if f == prefix+"UpdatedAt" {
-			updatedUpdatedAt = true
-			patchee.UpdatedAt = patcher.UpdatedAt
-			continue
-		}
-		if !updatedEffectiveFrom && strings.HasPrefix(f, prefix+"EffectiveFrom.") {
-			if patcher.EffectiveFrom == nil {
-				patchee.EffectiveFrom = nil
-				continue
-			}
-			if patchee.EffectiveFrom == nil {
-				patchee.EffectiveFrom = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"EffectiveFrom."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.EffectiveFrom, patchee.EffectiveFrom, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"EffectiveFrom" {
-			updatedEffectiveFrom = true
-			patchee.EffectiveFrom = patcher.EffectiveFrom
-			continue
-		}
-		if f == prefix+"Key" {
-			patchee.Key = patcher.Key
-			continue
-		}
-		if f == prefix+"Revoked" {
-			patchee.Revoked = patcher.Revoked
-			continue
-		}
-		if f == prefix+"Duration" {
-			patchee.Duration = patcher.Duration
-			continue
-		}
-		if f == prefix+"ActiveUsageId" {
-			patchee.ActiveUsageId = patcher.ActiveUsageId
-			continue
-		}
-		if f == prefix+"Uses" {
-			patchee.Uses = patcher.Uses
-			continue
-		}
-		if f == prefix+"SandboxMode" {
-			patchee.SandboxMode = patcher.SandboxMode
-			continue
-		}
-		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
-			updatedUser = true
-			if patcher.User == nil {
-				patchee.User = nil
-				continue
-			}
-			if patchee.User == nil {
-				patchee.User = &User{}
-			}
-			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.User = o
-			}
-			continue
-		}
-		if f == prefix+"User" {
-			updatedUser = true
-			patchee.User = patcher.User
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"UserId" {
-			patchee.UserId = patcher.UserId
+		if f == prefix+"Series" {
+			patchee.Series = patcher.Series
 			continue
 		}
-		if f == prefix+"Tier" {
-			patchee.Tier = patcher.Tier
+		if f == prefix+"LastNumber" {
+			patchee.LastNumber = patcher.LastNumber
 			continue
 		}
 	}
@@ -1660,35 +10604,35 @@ func DefaultApplyFieldMaskLicenseKey(ctx context.Context, patchee *LicenseKey, p
 	return patchee, nil
 }
 
-// DefaultListLicenseKey executes a gorm list call
-func DefaultListLicenseKey(ctx context.Context, db *gorm.DB) ([]*LicenseKey, error) {
-	in := LicenseKey{}
+// DefaultListInvoiceSequence executes a gorm list call
+func DefaultListInvoiceSequence(ctx context.Context, db *gorm.DB) ([]*InvoiceSequence, error) {
+	in := InvoiceSequence{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []LicenseKeyORM{}
+	ormResponse := []InvoiceSequenceORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LicenseKeyORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(InvoiceSequenceORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*LicenseKey{}
+	pbResponse := []*InvoiceSequence{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1699,18 +10643,18 @@ func DefaultListLicenseKey(ctx context.Context, db *gorm.DB) ([]*LicenseKey, err
 	return pbResponse, nil
 }
 
-type LicenseKeyORMWithBeforeListApplyQuery interface {
+type InvoiceSequenceORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithBeforeListFind interface {
+type InvoiceSequenceORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LicenseKeyORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]LicenseKeyORM) error
+type InvoiceSequenceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]InvoiceSequenceORM) error
 }
 
-// DefaultCreatePayment executes a basic gorm create call
-func DefaultCreatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment, error) {
+// DefaultCreateUser executes a basic gorm create call
+func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1718,7 +10662,7 @@ func DefaultCreatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payme
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1726,7 +10670,7 @@ func DefaultCreatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payme
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1735,14 +10679,14 @@ func DefaultCreatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payme
 	return &pbResponse, err
 }
 
-type PaymentORMWithBeforeCreate_ interface {
+type UserORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithAfterCreate_ interface {
+type UserORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadPayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment, error) {
+func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1753,21 +10697,21 @@ func DefaultReadPayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PaymentORM{}
+	ormResponse := UserORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PaymentORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1776,17 +10720,17 @@ func DefaultReadPayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment
 	return &pbResponse, err
 }
 
-type PaymentORMWithBeforeReadApplyQuery interface {
+type UserORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithBeforeReadFind interface {
+type UserORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithAfterReadFind interface {
+type UserORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePayment(ctx context.Context, in *Payment, db *gorm.DB) error {
+func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1797,29 +10741,29 @@ func DefaultDeletePayment(ctx context.Context, in *Payment, db *gorm.DB) error {
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PaymentORM{}).Error
+	err = db.Where(&ormObj).Delete(&UserORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PaymentORMWithBeforeDelete_ interface {
+type UserORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithAfterDelete_ interface {
+type UserORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePaymentSet(ctx context.Context, in []*Payment, db *gorm.DB) error {
+func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1835,45 +10779,45 @@ func DefaultDeletePaymentSet(ctx context.Context, in []*Payment, db *gorm.DB) er
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PaymentORM{})).(PaymentORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PaymentORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&UserORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PaymentORM{})).(PaymentORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PaymentORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Payment, *gorm.DB) (*gorm.DB, error)
+type UserORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*User, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Payment, *gorm.DB) error
+type UserORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*User, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePayment clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePayment(ctx context.Context, in *Payment, db *gorm.DB) (*Payment, error) {
+// DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePayment")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &PaymentORM{}
+	lockedRow := &UserORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1881,7 +10825,7 @@ func DefaultStrictUpdatePayment(ctx context.Context, in *Payment, db *gorm.DB) (
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1893,51 +10837,51 @@ func DefaultStrictUpdatePayment(ctx context.Context, in *Payment, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type PaymentORMWithBeforeStrictUpdateCleanup interface {
+type UserORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithBeforeStrictUpdateSave interface {
+type UserORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithAfterStrictUpdateSave interface {
+type UserORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPayment executes a basic gorm update call with patch behavior
-func DefaultPatchPayment(ctx context.Context, in *Payment, updateMask *field_mask.FieldMask, db *gorm.DB) (*Payment, error) {
+// DefaultPatchUser executes a basic gorm update call with patch behavior
+func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Payment
+	var pbObj User
 	var err error
-	if hook, ok := interface{}(&pbObj).(PaymentWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPayment(ctx, &Payment{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PaymentWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPayment(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PaymentWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePayment(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PaymentWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1945,28 +10889,28 @@ func DefaultPatchPayment(ctx context.Context, in *Payment, updateMask *field_mas
 	return pbResponse, nil
 }
 
-type PaymentWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Payment, *field_mask.FieldMask, *gorm.DB) error
+type UserWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPayment executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPayment(ctx context.Context, objects []*Payment, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Payment, error) {
+// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Payment, 0, len(objects))
+	results := make([]*User, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPayment(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1977,8 +10921,8 @@ func DefaultPatchSetPayment(ctx context.Context, objects []*Payment, updateMasks
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPayment patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPayment(ctx context.Context, patchee *Payment, patcher *Payment, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Payment, error) {
+// DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1986,162 +10930,68 @@ func DefaultApplyFieldMaskPayment(ctx context.Context, patchee *Payment, patcher
 	}
 	var err error
 	var updatedCreatedAt bool
-	var updatedUpdatedAt bool
-	var updatedUser bool
-	var updatedLicenseKey bool
-	var updatedSubscription bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
-			if patcher.CreatedAt == nil {
-				patchee.CreatedAt = nil
-				continue
-			}
-			if patchee.CreatedAt == nil {
-				patchee.CreatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"CreatedAt" {
-			updatedCreatedAt = true
-			patchee.CreatedAt = patcher.CreatedAt
-			continue
-		}
-		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
-			if patcher.UpdatedAt == nil {
-				patchee.UpdatedAt = nil
-				continue
-			}
-			if patchee.UpdatedAt == nil {
-				patchee.UpdatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"UpdatedAt" {
-			updatedUpdatedAt = true
-			patchee.UpdatedAt = patcher.UpdatedAt
-			continue
-		}
-		if f == prefix+"Provider" {
-			patchee.Provider = patcher.Provider
-			continue
-		}
-		if f == prefix+"ReferenceId" {
-			patchee.ReferenceId = patcher.ReferenceId
-			continue
-		}
-		if f == prefix+"AmountInCents" {
-			patchee.AmountInCents = patcher.AmountInCents
-			continue
-		}
-		if f == prefix+"Currency" {
-			patchee.Currency = patcher.Currency
-			continue
-		}
-		if f == prefix+"LicenseDuration" {
-			patchee.LicenseDuration = patcher.LicenseDuration
-			continue
-		}
-		if f == prefix+"IsRenewal" {
-			patchee.IsRenewal = patcher.IsRenewal
-			continue
-		}
-		if f == prefix+"SandboxMode" {
-			patchee.SandboxMode = patcher.SandboxMode
-			continue
-		}
-		if f == prefix+"BillingEmail" {
-			patchee.BillingEmail = patcher.BillingEmail
-			continue
-		}
-		if f == prefix+"BillingName" {
-			patchee.BillingName = patcher.BillingName
-			continue
-		}
-		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
-			updatedUser = true
-			if patcher.User == nil {
-				patchee.User = nil
-				continue
-			}
-			if patchee.User == nil {
-				patchee.User = &User{}
-			}
-			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.User = o
-			}
-			continue
-		}
-		if f == prefix+"User" {
-			updatedUser = true
-			patchee.User = patcher.User
-			continue
-		}
-		if f == prefix+"UserId" {
-			patchee.UserId = patcher.UserId
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedLicenseKey && strings.HasPrefix(f, prefix+"LicenseKey.") {
-			updatedLicenseKey = true
-			if patcher.LicenseKey == nil {
-				patchee.LicenseKey = nil
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
 				continue
 			}
-			if patchee.LicenseKey == nil {
-				patchee.LicenseKey = &LicenseKey{}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
 			}
-			if o, err := DefaultApplyFieldMaskLicenseKey(ctx, patchee.LicenseKey, patcher.LicenseKey, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"LicenseKey.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.LicenseKey = o
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
 			}
-			continue
 		}
-		if f == prefix+"LicenseKey" {
-			updatedLicenseKey = true
-			patchee.LicenseKey = patcher.LicenseKey
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
-		if !updatedSubscription && strings.HasPrefix(f, prefix+"Subscription.") {
-			updatedSubscription = true
-			if patcher.Subscription == nil {
-				patchee.Subscription = nil
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
 				continue
 			}
-			if patchee.Subscription == nil {
-				patchee.Subscription = &Subscription{}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
 			}
-			if o, err := DefaultApplyFieldMaskSubscription(ctx, patchee.Subscription, patcher.Subscription, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Subscription.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Subscription = o
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
 			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"Subscription" {
-			updatedSubscription = true
-			patchee.Subscription = patcher.Subscription
+		if f == prefix+"DiscourseId" {
+			patchee.DiscourseId = patcher.DiscourseId
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"Username" {
+			patchee.Username = patcher.Username
 			continue
 		}
 	}
@@ -2151,35 +11001,35 @@ func DefaultApplyFieldMaskPayment(ctx context.Context, patchee *Payment, patcher
 	return patchee, nil
 }
 
-// DefaultListPayment executes a gorm list call
-func DefaultListPayment(ctx context.Context, db *gorm.DB) ([]*Payment, error) {
-	in := Payment{}
+// DefaultListUser executes a gorm list call
+func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
+	in := User{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []PaymentORM{}
+	ormResponse := []UserORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PaymentORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Payment{}
+	pbResponse := []*User{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2190,18 +11040,18 @@ func DefaultListPayment(ctx context.Context, db *gorm.DB) ([]*Payment, error) {
 	return pbResponse, nil
 }
 
-type PaymentORMWithBeforeListApplyQuery interface {
+type UserORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithBeforeListFind interface {
+type UserORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PaymentORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PaymentORM) error
+type UserORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
 }
 
-// DefaultCreateSubscription executes a basic gorm create call
-func DefaultCreateSubscription(ctx context.Context, in *Subscription, db *gorm.DB) (*Subscription, error) {
+// DefaultCreateUserRole executes a basic gorm create call
+func DefaultCreateUserRole(ctx context.Context, in *UserRole, db *gorm.DB) (*UserRole, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2209,7 +11059,7 @@ func DefaultCreateSubscription(ctx context.Context, in *Subscription, db *gorm.D
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2217,7 +11067,7 @@ func DefaultCreateSubscription(ctx context.Context, in *Subscription, db *gorm.D
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2226,14 +11076,14 @@ func DefaultCreateSubscription(ctx context.Context, in *Subscription, db *gorm.D
 	return &pbResponse, err
 }
 
-type SubscriptionORMWithBeforeCreate_ interface {
+type UserRoleORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithAfterCreate_ interface {
+type UserRoleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadSubscription(ctx context.Context, in *Subscription, db *gorm.DB) (*Subscription, error) {
+func DefaultReadUserRole(ctx context.Context, in *UserRole, db *gorm.DB) (*UserRole, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2244,21 +11094,21 @@ func DefaultReadSubscription(ctx context.Context, in *Subscription, db *gorm.DB)
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := SubscriptionORM{}
+	ormResponse := UserRoleORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(SubscriptionORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(UserRoleORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2267,17 +11117,17 @@ func DefaultReadSubscription(ctx context.Context, in *Subscription, db *gorm.DB)
 	return &pbResponse, err
 }
 
-type SubscriptionORMWithBeforeReadApplyQuery interface {
+type UserRoleORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithBeforeReadFind interface {
+type UserRoleORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithAfterReadFind interface {
+type UserRoleORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteSubscription(ctx context.Context, in *Subscription, db *gorm.DB) error {
+func DefaultDeleteUserRole(ctx context.Context, in *UserRole, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2288,29 +11138,29 @@ func DefaultDeleteSubscription(ctx context.Context, in *Subscription, db *gorm.D
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&SubscriptionORM{}).Error
+	err = db.Where(&ormObj).Delete(&UserRoleORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type SubscriptionORMWithBeforeDelete_ interface {
+type UserRoleORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithAfterDelete_ interface {
+type UserRoleORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteSubscriptionSet(ctx context.Context, in []*Subscription, db *gorm.DB) error {
+func DefaultDeleteUserRoleSet(ctx context.Context, in []*UserRole, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2326,45 +11176,45 @@ func DefaultDeleteSubscriptionSet(ctx context.Context, in []*Subscription, db *g
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&SubscriptionORM{})).(SubscriptionORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&UserRoleORM{})).(UserRoleORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&SubscriptionORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&UserRoleORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&SubscriptionORM{})).(SubscriptionORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&UserRoleORM{})).(UserRoleORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type SubscriptionORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Subscription, *gorm.DB) (*gorm.DB, error)
+type UserRoleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*UserRole, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Subscription, *gorm.DB) error
+type UserRoleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*UserRole, *gorm.DB) error
 }
 
-// DefaultStrictUpdateSubscription clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateSubscription(ctx context.Context, in *Subscription, db *gorm.DB) (*Subscription, error) {
+// DefaultStrictUpdateUserRole clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUserRole(ctx context.Context, in *UserRole, db *gorm.DB) (*UserRole, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateSubscription")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUserRole")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &SubscriptionORM{}
+	lockedRow := &UserRoleORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2372,7 +11222,7 @@ func DefaultStrictUpdateSubscription(ctx context.Context, in *Subscription, db *
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2384,51 +11234,51 @@ func DefaultStrictUpdateSubscription(ctx context.Context, in *Subscription, db *
 	return &pbResponse, err
 }
 
-type SubscriptionORMWithBeforeStrictUpdateCleanup interface {
+type UserRoleORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithBeforeStrictUpdateSave interface {
+type UserRoleORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithAfterStrictUpdateSave interface {
+type UserRoleORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchSubscription executes a basic gorm update call with patch behavior
-func DefaultPatchSubscription(ctx context.Context, in *Subscription, updateMask *field_mask.FieldMask, db *gorm.DB) (*Subscription, error) {
+// DefaultPatchUserRole executes a basic gorm update call with patch behavior
+func DefaultPatchUserRole(ctx context.Context, in *UserRole, updateMask *field_mask.FieldMask, db *gorm.DB) (*UserRole, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Subscription
+	var pbObj UserRole
 	var err error
-	if hook, ok := interface{}(&pbObj).(SubscriptionWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(UserRoleWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadSubscription(ctx, &Subscription{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadUserRole(ctx, &UserRole{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(SubscriptionWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(UserRoleWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskSubscription(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskUserRole(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(SubscriptionWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(UserRoleWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateSubscription(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateUserRole(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(SubscriptionWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(UserRoleWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2436,28 +11286,28 @@ func DefaultPatchSubscription(ctx context.Context, in *Subscription, updateMask
 	return pbResponse, nil
 }
 
-type SubscriptionWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserRoleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserRoleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserRoleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Subscription, *field_mask.FieldMask, *gorm.DB) error
+type UserRoleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetSubscription executes a bulk gorm update call with patch behavior
-func DefaultPatchSetSubscription(ctx context.Context, objects []*Subscription, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Subscription, error) {
+// DefaultPatchSetUserRole executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUserRole(ctx context.Context, objects []*UserRole, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*UserRole, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Subscription, 0, len(objects))
+	results := make([]*UserRole, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchSubscription(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchUserRole(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2468,8 +11318,8 @@ func DefaultPatchSetSubscription(ctx context.Context, objects []*Subscription, u
 	return results, nil
 }
 
-// DefaultApplyFieldMaskSubscription patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskSubscription(ctx context.Context, patchee *Subscription, patcher *Subscription, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Subscription, error) {
+// DefaultApplyFieldMaskUserRole patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUserRole(ctx context.Context, patchee *UserRole, patcher *UserRole, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*UserRole, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2478,9 +11328,7 @@ func DefaultApplyFieldMaskSubscription(ctx context.Context, patchee *Subscriptio
 	var err error
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
-	var updatedCurrentPeriodStart bool
 	var updatedUser bool
-	var updatedLicenseKey bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -2532,47 +11380,12 @@ func DefaultApplyFieldMaskSubscription(ctx context.Context, patchee *Subscriptio
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"StripeSubscriptionId" {
-			patchee.StripeSubscriptionId = patcher.StripeSubscriptionId
-			continue
-		}
-		if f == prefix+"StripeCustomerId" {
-			patchee.StripeCustomerId = patcher.StripeCustomerId
-			continue
-		}
-		if f == prefix+"Status" {
-			patchee.Status = patcher.Status
-			continue
-		}
-		if f == prefix+"Duration" {
-			patchee.Duration = patcher.Duration
-			continue
-		}
-		if !updatedCurrentPeriodStart && strings.HasPrefix(f, prefix+"CurrentPeriodStart.") {
-			if patcher.CurrentPeriodStart == nil {
-				patchee.CurrentPeriodStart = nil
-				continue
-			}
-			if patchee.CurrentPeriodStart == nil {
-				patchee.CurrentPeriodStart = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CurrentPeriodStart."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.CurrentPeriodStart, patchee.CurrentPeriodStart, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"CurrentPeriodStart" {
-			updatedCurrentPeriodStart = true
-			patchee.CurrentPeriodStart = patcher.CurrentPeriodStart
+		if f == prefix+"Role" {
+			patchee.Role = patcher.Role
 			continue
 		}
-		if f == prefix+"SandboxMode" {
-			patchee.SandboxMode = patcher.SandboxMode
+		if f == prefix+"GrantedBy" {
+			patchee.GrantedBy = patcher.GrantedBy
 			continue
 		}
 		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
@@ -2600,31 +11413,6 @@ func DefaultApplyFieldMaskSubscription(ctx context.Context, patchee *Subscriptio
 			patchee.UserId = patcher.UserId
 			continue
 		}
-		if !updatedLicenseKey && strings.HasPrefix(f, prefix+"LicenseKey.") {
-			updatedLicenseKey = true
-			if patcher.LicenseKey == nil {
-				patchee.LicenseKey = nil
-				continue
-			}
-			if patchee.LicenseKey == nil {
-				patchee.LicenseKey = &LicenseKey{}
-			}
-			if o, err := DefaultApplyFieldMaskLicenseKey(ctx, patchee.LicenseKey, patcher.LicenseKey, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"LicenseKey.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.LicenseKey = o
-			}
-			continue
-		}
-		if f == prefix+"LicenseKey" {
-			updatedLicenseKey = true
-			patchee.LicenseKey = patcher.LicenseKey
-			continue
-		}
-		if f == prefix+"LicenseKeyId" {
-			patchee.LicenseKeyId = patcher.LicenseKeyId
-			continue
-		}
 	}
 	if err != nil {
 		return nil, err
@@ -2632,35 +11420,35 @@ func DefaultApplyFieldMaskSubscription(ctx context.Context, patchee *Subscriptio
 	return patchee, nil
 }
 
-// DefaultListSubscription executes a gorm list call
-func DefaultListSubscription(ctx context.Context, db *gorm.DB) ([]*Subscription, error) {
-	in := Subscription{}
+// DefaultListUserRole executes a gorm list call
+func DefaultListUserRole(ctx context.Context, db *gorm.DB) ([]*UserRole, error) {
+	in := UserRole{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []SubscriptionORM{}
+	ormResponse := []UserRoleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SubscriptionORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Subscription{}
+	pbResponse := []*UserRole{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2671,18 +11459,18 @@ func DefaultListSubscription(ctx context.Context, db *gorm.DB) ([]*Subscription,
 	return pbResponse, nil
 }
 
-type SubscriptionORMWithBeforeListApplyQuery interface {
+type UserRoleORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithBeforeListFind interface {
+type UserRoleORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SubscriptionORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]SubscriptionORM) error
+type UserRoleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]UserRoleORM) error
 }
 
-// DefaultCreateUser executes a basic gorm create call
-func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+// DefaultCreateOffset executes a basic gorm create call
+func DefaultCreateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2690,7 +11478,7 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2698,7 +11486,7 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2707,14 +11495,14 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 	return &pbResponse, err
 }
 
-type UserORMWithBeforeCreate_ interface {
+type OffsetORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterCreate_ interface {
+type OffsetORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+func DefaultReadOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2725,21 +11513,21 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := UserORM{}
+	ormResponse := OffsetORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(OffsetORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2748,17 +11536,17 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 	return &pbResponse, err
 }
 
-type UserORMWithBeforeReadApplyQuery interface {
+type OffsetORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithBeforeReadFind interface {
+type OffsetORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterReadFind interface {
+type OffsetORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
+func DefaultDeleteOffset(ctx context.Context, in *Offset, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2769,29 +11557,29 @@ func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&UserORM{}).Error
+	err = db.Where(&ormObj).Delete(&OffsetORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type UserORMWithBeforeDelete_ interface {
+type OffsetORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterDelete_ interface {
+type OffsetORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
+func DefaultDeleteOffsetSet(ctx context.Context, in []*Offset, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2807,45 +11595,45 @@ func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&OffsetORM{})).(OffsetORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&UserORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&OffsetORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&OffsetORM{})).(OffsetORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type UserORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*User, *gorm.DB) (*gorm.DB, error)
+type OffsetORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Offset, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*User, *gorm.DB) error
+type OffsetORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Offset, *gorm.DB) error
 }
 
-// DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+// DefaultStrictUpdateOffset clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOffset")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &UserORM{}
+	lockedRow := &OffsetORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2853,7 +11641,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2865,51 +11653,51 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	return &pbResponse, err
 }
 
-type UserORMWithBeforeStrictUpdateCleanup interface {
+type OffsetORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithBeforeStrictUpdateSave interface {
+type OffsetORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterStrictUpdateSave interface {
+type OffsetORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchUser executes a basic gorm update call with patch behavior
-func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+// DefaultPatchOffset executes a basic gorm update call with patch behavior
+func DefaultPatchOffset(ctx context.Context, in *Offset, updateMask *field_mask.FieldMask, db *gorm.DB) (*Offset, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj User
+	var pbObj Offset
 	var err error
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(OffsetWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadOffset(ctx, &Offset{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(OffsetWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskOffset(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(OffsetWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateOffset(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(OffsetWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2917,28 +11705,28 @@ func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.Fiel
 	return pbResponse, nil
 }
 
-type UserWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type OffsetWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type OffsetWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type OffsetWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
+type OffsetWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
-func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
+// DefaultPatchSetOffset executes a bulk gorm update call with patch behavior
+func DefaultPatchSetOffset(ctx context.Context, objects []*Offset, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Offset, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*User, 0, len(objects))
+	results := make([]*Offset, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchOffset(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2949,8 +11737,8 @@ func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*fi
 	return results, nil
 }
 
-// DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
+// DefaultApplyFieldMaskOffset patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskOffset(ctx context.Context, patchee *Offset, patcher *Offset, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Offset, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -3010,16 +11798,12 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"DiscourseId" {
-			patchee.DiscourseId = patcher.DiscourseId
-			continue
-		}
-		if f == prefix+"Email" {
-			patchee.Email = patcher.Email
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
 			continue
 		}
-		if f == prefix+"Username" {
-			patchee.Username = patcher.Username
+		if f == prefix+"Data" {
+			patchee.Data = patcher.Data
 			continue
 		}
 	}
@@ -3029,35 +11813,35 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 	return patchee, nil
 }
 
-// DefaultListUser executes a gorm list call
-func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
-	in := User{}
+// DefaultListOffset executes a gorm list call
+func DefaultListOffset(ctx context.Context, db *gorm.DB) ([]*Offset, error) {
+	in := Offset{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []UserORM{}
+	ormResponse := []OffsetORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*User{}
+	pbResponse := []*Offset{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -3068,18 +11852,18 @@ func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
 	return pbResponse, nil
 }
 
-type UserORMWithBeforeListApplyQuery interface {
+type OffsetORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithBeforeListFind interface {
+type OffsetORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
+type OffsetORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]OffsetORM) error
 }
 
-// DefaultCreateOffset executes a basic gorm create call
-func DefaultCreateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, error) {
+// DefaultCreateWebhookEvent executes a basic gorm create call
+func DefaultCreateWebhookEvent(ctx context.Context, in *WebhookEvent, db *gorm.DB) (*WebhookEvent, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3087,7 +11871,7 @@ func DefaultCreateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset,
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3095,7 +11879,7 @@ func DefaultCreateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset,
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3104,14 +11888,14 @@ func DefaultCreateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset,
 	return &pbResponse, err
 }
 
-type OffsetORMWithBeforeCreate_ interface {
+type WebhookEventORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithAfterCreate_ interface {
+type WebhookEventORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, error) {
+func DefaultReadWebhookEvent(ctx context.Context, in *WebhookEvent, db *gorm.DB) (*WebhookEvent, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3122,21 +11906,21 @@ func DefaultReadOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, e
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := OffsetORM{}
+	ormResponse := WebhookEventORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(OffsetORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(WebhookEventORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3145,17 +11929,17 @@ func DefaultReadOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, e
 	return &pbResponse, err
 }
 
-type OffsetORMWithBeforeReadApplyQuery interface {
+type WebhookEventORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithBeforeReadFind interface {
+type WebhookEventORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithAfterReadFind interface {
+type WebhookEventORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteOffset(ctx context.Context, in *Offset, db *gorm.DB) error {
+func DefaultDeleteWebhookEvent(ctx context.Context, in *WebhookEvent, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3166,29 +11950,29 @@ func DefaultDeleteOffset(ctx context.Context, in *Offset, db *gorm.DB) error {
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&OffsetORM{}).Error
+	err = db.Where(&ormObj).Delete(&WebhookEventORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type OffsetORMWithBeforeDelete_ interface {
+type WebhookEventORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithAfterDelete_ interface {
+type WebhookEventORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteOffsetSet(ctx context.Context, in []*Offset, db *gorm.DB) error {
+func DefaultDeleteWebhookEventSet(ctx context.Context, in []*WebhookEvent, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3204,45 +11988,45 @@ func DefaultDeleteOffsetSet(ctx context.Context, in []*Offset, db *gorm.DB) erro
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&OffsetORM{})).(OffsetORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&WebhookEventORM{})).(WebhookEventORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&OffsetORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&WebhookEventORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&OffsetORM{})).(OffsetORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&WebhookEventORM{})).(WebhookEventORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type OffsetORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Offset, *gorm.DB) (*gorm.DB, error)
+type WebhookEventORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*WebhookEvent, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Offset, *gorm.DB) error
+type WebhookEventORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*WebhookEvent, *gorm.DB) error
 }
 
-// DefaultStrictUpdateOffset clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*Offset, error) {
+// DefaultStrictUpdateWebhookEvent clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWebhookEvent(ctx context.Context, in *WebhookEvent, db *gorm.DB) (*WebhookEvent, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOffset")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWebhookEvent")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &OffsetORM{}
+	lockedRow := &WebhookEventORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3250,7 +12034,7 @@ func DefaultStrictUpdateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*O
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3262,51 +12046,51 @@ func DefaultStrictUpdateOffset(ctx context.Context, in *Offset, db *gorm.DB) (*O
 	return &pbResponse, err
 }
 
-type OffsetORMWithBeforeStrictUpdateCleanup interface {
+type WebhookEventORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithBeforeStrictUpdateSave interface {
+type WebhookEventORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithAfterStrictUpdateSave interface {
+type WebhookEventORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchOffset executes a basic gorm update call with patch behavior
-func DefaultPatchOffset(ctx context.Context, in *Offset, updateMask *field_mask.FieldMask, db *gorm.DB) (*Offset, error) {
+// DefaultPatchWebhookEvent executes a basic gorm update call with patch behavior
+func DefaultPatchWebhookEvent(ctx context.Context, in *WebhookEvent, updateMask *field_mask.FieldMask, db *gorm.DB) (*WebhookEvent, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Offset
+	var pbObj WebhookEvent
 	var err error
-	if hook, ok := interface{}(&pbObj).(OffsetWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(WebhookEventWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadOffset(ctx, &Offset{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadWebhookEvent(ctx, &WebhookEvent{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(OffsetWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(WebhookEventWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskOffset(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskWebhookEvent(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(OffsetWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(WebhookEventWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateOffset(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateWebhookEvent(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(OffsetWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(WebhookEventWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -3314,28 +12098,28 @@ func DefaultPatchOffset(ctx context.Context, in *Offset, updateMask *field_mask.
 	return pbResponse, nil
 }
 
-type OffsetWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WebhookEventWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *WebhookEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WebhookEventWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *WebhookEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WebhookEventWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WebhookEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Offset, *field_mask.FieldMask, *gorm.DB) error
+type WebhookEventWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *WebhookEvent, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetOffset executes a bulk gorm update call with patch behavior
-func DefaultPatchSetOffset(ctx context.Context, objects []*Offset, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Offset, error) {
+// DefaultPatchSetWebhookEvent executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWebhookEvent(ctx context.Context, objects []*WebhookEvent, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*WebhookEvent, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Offset, 0, len(objects))
+	results := make([]*WebhookEvent, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchOffset(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchWebhookEvent(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -3346,8 +12130,8 @@ func DefaultPatchSetOffset(ctx context.Context, objects []*Offset, updateMasks [
 	return results, nil
 }
 
-// DefaultApplyFieldMaskOffset patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskOffset(ctx context.Context, patchee *Offset, patcher *Offset, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Offset, error) {
+// DefaultApplyFieldMaskWebhookEvent patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWebhookEvent(ctx context.Context, patchee *WebhookEvent, patcher *WebhookEvent, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*WebhookEvent, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -3356,6 +12140,8 @@ func DefaultApplyFieldMaskOffset(ctx context.Context, patchee *Offset, patcher *
 	var err error
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
+	var updatedNextAttemptAt bool
+	var updatedProcessedAt bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -3407,12 +12193,78 @@ func DefaultApplyFieldMaskOffset(ctx context.Context, patchee *Offset, patcher *
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"Version" {
-			patchee.Version = patcher.Version
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
 			continue
 		}
-		if f == prefix+"Data" {
-			patchee.Data = patcher.Data
+		if f == prefix+"EventId" {
+			patchee.EventId = patcher.EventId
+			continue
+		}
+		if f == prefix+"EventType" {
+			patchee.EventType = patcher.EventType
+			continue
+		}
+		if f == prefix+"Payload" {
+			patchee.Payload = patcher.Payload
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Attempts" {
+			patchee.Attempts = patcher.Attempts
+			continue
+		}
+		if f == prefix+"LastError" {
+			patchee.LastError = patcher.LastError
+			continue
+		}
+		if !updatedNextAttemptAt && strings.HasPrefix(f, prefix+"NextAttemptAt.") {
+			if patcher.NextAttemptAt == nil {
+				patchee.NextAttemptAt = nil
+				continue
+			}
+			if patchee.NextAttemptAt == nil {
+				patchee.NextAttemptAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"NextAttemptAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.NextAttemptAt, patchee.NextAttemptAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"NextAttemptAt" {
+			updatedNextAttemptAt = true
+			patchee.NextAttemptAt = patcher.NextAttemptAt
+			continue
+		}
+		if !updatedProcessedAt && strings.HasPrefix(f, prefix+"ProcessedAt.") {
+			if patcher.ProcessedAt == nil {
+				patchee.ProcessedAt = nil
+				continue
+			}
+			if patchee.ProcessedAt == nil {
+				patchee.ProcessedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ProcessedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ProcessedAt, patchee.ProcessedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ProcessedAt" {
+			updatedProcessedAt = true
+			patchee.ProcessedAt = patcher.ProcessedAt
 			continue
 		}
 	}
@@ -3422,35 +12274,35 @@ func DefaultApplyFieldMaskOffset(ctx context.Context, patchee *Offset, patcher *
 	return patchee, nil
 }
 
-// DefaultListOffset executes a gorm list call
-func DefaultListOffset(ctx context.Context, db *gorm.DB) ([]*Offset, error) {
-	in := Offset{}
+// DefaultListWebhookEvent executes a gorm list call
+func DefaultListWebhookEvent(ctx context.Context, db *gorm.DB) ([]*WebhookEvent, error) {
+	in := WebhookEvent{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []OffsetORM{}
+	ormResponse := []WebhookEventORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(OffsetORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(WebhookEventORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Offset{}
+	pbResponse := []*WebhookEvent{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -3461,12 +12313,12 @@ func DefaultListOffset(ctx context.Context, db *gorm.DB) ([]*Offset, error) {
 	return pbResponse, nil
 }
 
-type OffsetORMWithBeforeListApplyQuery interface {
+type WebhookEventORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithBeforeListFind interface {
+type WebhookEventORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type OffsetORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]OffsetORM) error
+type WebhookEventORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WebhookEventORM) error
 }
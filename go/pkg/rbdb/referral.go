@@ -0,0 +1,287 @@
+package rbdb
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// Referral codes are short enough to be typed, without the characters easily confused
+const (
+	referralCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	referralCodeLength   = 8
+	referralCodeAttempts = 5
+)
+
+// GetOrCreateReferralCode returns the referral code of a user, created on first use
+func GetOrCreateReferralCode(db *gorm.DB, userId int64) (*ReferralCodeORM, error) {
+	for attempt := 0; attempt < referralCodeAttempts; attempt++ {
+		var codeOrm ReferralCodeORM
+		err := db.Where(&ReferralCodeORM{UserId: userId}).First(&codeOrm).Error
+		if err == nil {
+			return &codeOrm, nil
+		}
+		if !IsRecordNotFoundError(err) {
+			return nil, GormToErrcode(err)
+		}
+
+		code, err := generateReferralCode()
+		if err != nil {
+			return nil, errcode.ERR_REFERRAL_CODE_GENERATION.Wrap(err)
+		}
+		// A taken code, or a code created concurrently for the user, fails on the unique indexes and is retried
+		codeOrm = ReferralCodeORM{Code: code, UserId: userId}
+		if err := db.Create(&codeOrm).Error; err == nil {
+			return &codeOrm, nil
+		}
+	}
+	return nil, errcode.ERR_REFERRAL_CODE_GENERATION.Wrap(fmt.Errorf("no free code for user %d", userId))
+}
+
+func generateReferralCode() (string, error) {
+	code := make([]byte, referralCodeLength)
+	max := big.NewInt(int64(len(referralCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = referralCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// NormalizeReferralCode returns a referral code as stored, codes are case-insensitive
+func NormalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// FindReferrer returns the user owning a referral code, 0 for unknown codes
+func FindReferrer(db *gorm.DB, code string) (int64, error) {
+	var codeOrm ReferralCodeORM
+	err := db.Where(&ReferralCodeORM{Code: NormalizeReferralCode(code)}).First(&codeOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, GormToErrcode(err)
+	}
+	return codeOrm.UserId, nil
+}
+
+// CreateCommissionTx records the commission earned by the referrer of a payment, in the transaction creating it
+// The commission is a share of the payment net of tax, held until payableAt. Payments without referrer earn nothing
+func CreateCommissionTx(tx *gorm.DB, paymentId int64, rate float64, payableAt time.Time) (*CommissionORM, error) {
+	var paymentOrm PaymentORM
+	if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	if paymentOrm.ReferrerId == 0 || paymentOrm.ReferrerId == paymentOrm.UserId || rate <= 0 {
+		return nil, nil
+	}
+
+	amountInCents := int64(math.Round(float64(paymentOrm.AmountInCents-paymentOrm.TaxInCents) * rate))
+	if amountInCents <= 0 {
+		return nil, nil
+	}
+	commissionOrm := &CommissionORM{
+		Kind:          int32(Commission_KIND_EARNING),
+		Status:        int32(Commission_STATUS_PENDING),
+		AmountInCents: amountInCents,
+		Currency:      NormalizeCurrency(paymentOrm.Currency),
+		Rate:          rate,
+		PayableAt:     &payableAt,
+		PaymentId:     paymentOrm.Id,
+		UserId:        paymentOrm.ReferrerId,
+	}
+	if err := tx.Create(commissionOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return commissionOrm, nil
+}
+
+// reverseCommissionTx takes back the commission on the refunded part of a payment, in the transaction recording the refund
+// Reversals of pending earnings are held with them, reversals of payable or paid ones are deducted from the next payout
+func reverseCommissionTx(tx *gorm.DB, paymentOrm *PaymentORM, refundId string, refundedInCents int64, full bool, at time.Time) error {
+	var earningOrm CommissionORM
+	err := tx.Where(&CommissionORM{PaymentId: paymentOrm.Id, Kind: int32(Commission_KIND_EARNING)}).First(&earningOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil
+		}
+		return GormToErrcode(err)
+	}
+
+	var reversedInCents int64
+	err = tx.Model(&CommissionORM{}).
+		Select("COALESCE(SUM(amount_in_cents), 0)").
+		Where(&CommissionORM{PaymentId: paymentOrm.Id, Kind: int32(Commission_KIND_REVERSAL)}).
+		Scan(&reversedInCents).Error
+	if err != nil {
+		return GormToErrcode(err)
+	}
+	remaining := earningOrm.AmountInCents + reversedInCents
+
+	amountInCents := remaining
+	if !full && paymentOrm.AmountInCents > 0 {
+		amountInCents = int64(math.Round(float64(earningOrm.AmountInCents) * float64(refundedInCents) / float64(paymentOrm.AmountInCents)))
+		if amountInCents > remaining {
+			amountInCents = remaining
+		}
+	}
+	if amountInCents <= 0 {
+		return nil
+	}
+
+	reversalOrm := &CommissionORM{
+		Kind:          int32(Commission_KIND_REVERSAL),
+		Status:        int32(Commission_STATUS_PAYABLE),
+		AmountInCents: -amountInCents,
+		Currency:      earningOrm.Currency,
+		Rate:          earningOrm.Rate,
+		PayableAt:     &at,
+		RefundId:      refundId,
+		PaymentId:     paymentOrm.Id,
+		UserId:        earningOrm.UserId,
+	}
+	if Commission_Status(earningOrm.Status) == Commission_STATUS_PENDING {
+		reversalOrm.Status = int32(Commission_STATUS_PENDING)
+		reversalOrm.PayableAt = earningOrm.PayableAt
+	}
+	return GormToErrcode(tx.Create(reversalOrm).Error)
+}
+
+// ReleaseCommissions makes the pending commissions past their holding period payable
+func ReleaseCommissions(db *gorm.DB, now time.Time) error {
+	err := db.Model(&CommissionORM{}).
+		Where("status = ? AND payable_at <= ?", int32(Commission_STATUS_PENDING), now).
+		Update("status", int32(Commission_STATUS_PAYABLE)).Error
+	return GormToErrcode(err)
+}
+
+// ListUserCommissions returns the latest commissions of a referrer
+func ListUserCommissions(db *gorm.DB, userId int64, limit int) ([]*CommissionORM, error) {
+	var commissionsOrm []*CommissionORM
+	err := db.Where(&CommissionORM{UserId: userId}).Order("created_at DESC, id DESC").Limit(limit).Find(&commissionsOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return commissionsOrm, nil
+}
+
+// CommissionBalance sums the commissions of a referrer in a currency by status
+type CommissionBalance struct {
+	Currency       string
+	PendingInCents int64
+	PayableInCents int64
+	PaidInCents    int64
+}
+
+// GetCommissionBalances sums all the commissions of a referrer, by currency
+func GetCommissionBalances(db *gorm.DB, userId int64) ([]*CommissionBalance, error) {
+	var rows []struct {
+		Currency      string
+		Status        int32
+		AmountInCents int64
+	}
+	err := db.Model(&CommissionORM{}).
+		Select("currency, status, SUM(amount_in_cents) AS amount_in_cents").
+		Where(&CommissionORM{UserId: userId}).
+		Group("currency, status").
+		Order("currency").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+
+	var balances []*CommissionBalance
+	for _, row := range rows {
+		if len(balances) == 0 || balances[len(balances)-1].Currency != row.Currency {
+			balances = append(balances, &CommissionBalance{Currency: row.Currency})
+		}
+		balance := balances[len(balances)-1]
+		switch Commission_Status(row.Status) {
+		case Commission_STATUS_PENDING:
+			balance.PendingInCents += row.AmountInCents
+		case Commission_STATUS_PAYABLE:
+			balance.PayableInCents += row.AmountInCents
+		case Commission_STATUS_PAID:
+			balance.PaidInCents += row.AmountInCents
+		}
+	}
+	return balances, nil
+}
+
+// PayableCommissions sums the payable commissions of a referrer in a currency
+type PayableCommissions struct {
+	UserId        int64
+	Currency      string
+	AmountInCents int64
+	Commissions   int32
+}
+
+// ListPayableCommissions returns the referrers owed a payout, largest amounts first
+// Referrers whose reversals cancel their earnings are left out until they earn more
+func ListPayableCommissions(db *gorm.DB) ([]*PayableCommissions, error) {
+	var payables []*PayableCommissions
+	err := db.Model(&CommissionORM{}).
+		Select("user_id, currency, SUM(amount_in_cents) AS amount_in_cents, COUNT(*) AS commissions").
+		Where("status = ?", int32(Commission_STATUS_PAYABLE)).
+		Group("user_id, currency").
+		Having("SUM(amount_in_cents) > 0").
+		Order("amount_in_cents DESC, user_id").
+		Scan(&payables).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return payables, nil
+}
+
+// PayCommissions marks the payable commissions of a referrer in a currency as paid by a payout
+func PayCommissions(db *gorm.DB, userId int64, currency string, payoutReference string, paidAt time.Time) ([]*CommissionORM, int64, error) {
+	currency = strings.ToLower(currency)
+	var commissionsOrm []*CommissionORM
+	var paidInCents int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where(&CommissionORM{UserId: userId, Currency: currency, Status: int32(Commission_STATUS_PAYABLE)}).
+			Order("created_at, id").
+			Find(&commissionsOrm).Error
+		if err != nil {
+			return GormToErrcode(err)
+		}
+
+		ids := make([]int64, 0, len(commissionsOrm))
+		for _, commissionOrm := range commissionsOrm {
+			paidInCents += commissionOrm.AmountInCents
+			ids = append(ids, commissionOrm.Id)
+		}
+		if paidInCents <= 0 {
+			return errcode.ERR_REFERRAL_NOTHING_PAYABLE.Wrap(fmt.Errorf("user %d owed %d %s", userId, paidInCents, currency))
+		}
+
+		err = tx.Model(&CommissionORM{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"status":           int32(Commission_STATUS_PAID),
+			"paid_at":          paidAt,
+			"payout_reference": payoutReference,
+		}).Error
+		if err != nil {
+			return GormToErrcode(err)
+		}
+		for _, commissionOrm := range commissionsOrm {
+			commissionOrm.Status = int32(Commission_STATUS_PAID)
+			commissionOrm.PaidAt = &paidAt
+			commissionOrm.PayoutReference = payoutReference
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return commissionsOrm, paidInCents, nil
+}
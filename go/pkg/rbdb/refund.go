@@ -0,0 +1,275 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// GetPaymentByReference finds a payment from its ID on the payment provider side
+func GetPaymentByReference(db *gorm.DB, referenceId string) (*PaymentORM, error) {
+	var paymentOrm PaymentORM
+	if err := db.Where(&PaymentORM{ReferenceId: referenceId}).First(&paymentOrm).Error; err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_PAYMENT_NOT_FOUND.Wrap(fmt.Errorf("reference %s", referenceId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &paymentOrm, nil
+}
+
+// RefundPayment records a refund of a payment and takes back the license time it paid for
+// A full refund of a purchase revokes the license, a refund of a renewal or a partial refund
+// shortens the license proportionally. Partial refunds of lifetime licenses keep the license.
+// The boolean is false when the refund was already recorded or the payment is fully refunded.
+func RefundPayment(db *gorm.DB, paymentId int64, refundId string, amountInCents int64, refundedAt time.Time) (*PaymentORM, bool, error) {
+	var paymentOrm PaymentORM
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		var err error
+		applied, err = refundPaymentTx(tx, &paymentOrm, refundId, amountInCents, refundedAt)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &paymentOrm, applied, nil
+}
+
+// refundPaymentTx is the transactional part of RefundPayment, an amount of 0 refunds what is left
+func refundPaymentTx(tx *gorm.DB, paymentOrm *PaymentORM, refundId string, amountInCents int64, refundedAt time.Time) (bool, error) {
+	// Duplicate deliveries of the same refund are ignored
+	refundDetails := fmt.Sprintf("refund_id=%s", refundId)
+	exists, err := paymentActivityExists(tx, paymentOrm.Id, Activity_KIND_PAYMENT_REFUNDED, refundDetails+",%")
+	if err != nil || exists {
+		return false, err
+	}
+
+	// A failed payment never brought money in, there is nothing to refund
+	if Payment_Status(paymentOrm.Status) == Payment_STATUS_FAILED {
+		return false, nil
+	}
+
+	remaining := paymentOrm.AmountInCents - paymentOrm.RefundedInCents
+	if remaining <= 0 {
+		return false, nil
+	}
+	if amountInCents <= 0 || amountInCents > remaining {
+		amountInCents = remaining
+	}
+
+	paymentOrm.RefundedInCents += amountInCents
+	status := Payment_STATUS_PARTIALLY_REFUNDED
+	if paymentOrm.RefundedInCents >= paymentOrm.AmountInCents {
+		status = Payment_STATUS_REFUNDED
+	}
+	setPaymentStatus(paymentOrm, status, refundedAt)
+	if err := tx.Save(paymentOrm).Error; err != nil {
+		return false, GormToErrcode(err)
+	}
+
+	details := fmt.Sprintf("%s,amount_in_cents=%d", refundDetails, amountInCents)
+	if err := createPaymentActivity(tx, paymentOrm, Activity_KIND_PAYMENT_REFUNDED, details); err != nil {
+		return false, err
+	}
+	if _, err := issueCreditNoteTx(tx, paymentOrm, refundId, amountInCents, refundedAt); err != nil {
+		return false, err
+	}
+
+	fullyRefunded := status == Payment_STATUS_REFUNDED
+	if err := reverseCommissionTx(tx, paymentOrm, refundId, amountInCents, fullyRefunded, refundedAt); err != nil {
+		return false, err
+	}
+	return true, takeBackLicenseTime(tx, paymentOrm, amountInCents, fullyRefunded, refundDetails)
+}
+
+// takeBackLicenseTime revokes or shortens the license of a payment after refundedInCents were taken back
+// full is true when nothing is left of the payment
+func takeBackLicenseTime(tx *gorm.DB, paymentOrm *PaymentORM, refundedInCents int64, full bool, details string) error {
+	if paymentOrm.LicenseKeyId == nil || paymentOrm.AmountInCents <= 0 {
+		return nil
+	}
+
+	var licenseOrm LicenseKeyORM
+	if err := tx.Where(&LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	if licenseOrm.Revoked {
+		return nil
+	}
+
+	// A refunded purchase loses its license, a refunded renewal only loses the period it paid for
+	if full && !paymentOrm.IsRenewal {
+		return revokeLicenseTx(tx, &licenseOrm, paymentOrm, details)
+	}
+
+	if licenseOrm.EffectiveFrom == nil {
+		return nil
+	}
+	periodEnd, ok := LicensePeriodEnd(*licenseOrm.EffectiveFrom, LicenseKey_Duration(licenseOrm.Duration))
+	if !ok {
+		// Lifetime licenses have no period to shorten, a partial refund is a discount
+		return nil
+	}
+
+	period := periodEnd.Sub(*licenseOrm.EffectiveFrom)
+	shortenBy := time.Duration(float64(period) * float64(refundedInCents) / float64(paymentOrm.AmountInCents)).Round(time.Second)
+	effectiveFrom := licenseOrm.EffectiveFrom.Add(-shortenBy)
+	licenseOrm.EffectiveFrom = &effectiveFrom
+	if err := tx.Save(&licenseOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+
+	return createPaymentActivity(tx, paymentOrm, Activity_KIND_LICENSE_SHORTENED, fmt.Sprintf("%s,shortened_by=%s", details, shortenBy))
+}
+
+// OpenPaymentDispute flags a payment with a dispute and revokes its license while the dispute is open
+// The boolean is false when the dispute was already recorded
+func OpenPaymentDispute(db *gorm.DB, paymentId int64, disputeId string, openedAt time.Time) (*PaymentORM, bool, error) {
+	var paymentOrm PaymentORM
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		if paymentOrm.DisputeId == disputeId {
+			return nil
+		}
+
+		paymentOrm.DisputeId = disputeId
+		paymentOrm.DisputedAt = &openedAt
+		if err := tx.Save(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		details := fmt.Sprintf("dispute_id=%s", disputeId)
+		if err := createPaymentActivity(tx, &paymentOrm, Activity_KIND_PAYMENT_DISPUTED, details); err != nil {
+			return err
+		}
+		applied = true
+
+		if paymentOrm.LicenseKeyId == nil {
+			return nil
+		}
+		var licenseOrm LicenseKeyORM
+		if err := tx.Where(&LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		if licenseOrm.Revoked {
+			return nil
+		}
+		return revokeLicenseTx(tx, &licenseOrm, &paymentOrm, details)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &paymentOrm, applied, nil
+}
+
+// ResolvePaymentDispute closes a dispute opened with OpenPaymentDispute
+// The license revoked for the dispute is restored, unless the buyer won the dispute of a purchase:
+// the payment is then fully refunded and the license stays revoked. A renewal only loses its period.
+// The boolean is false when the resolution was already recorded
+func ResolvePaymentDispute(db *gorm.DB, paymentId int64, disputeId string, buyerWon bool, resolvedAt time.Time) (*PaymentORM, bool, error) {
+	var paymentOrm PaymentORM
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&PaymentORM{Id: paymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		disputeDetails := fmt.Sprintf("dispute_id=%s", disputeId)
+		exists, err := paymentActivityExists(tx, paymentOrm.Id, Activity_KIND_PAYMENT_DISPUTE_RESOLVED, disputeDetails+",%")
+		if err != nil || exists {
+			return err
+		}
+
+		outcome := "seller"
+		if buyerWon {
+			outcome = "buyer"
+		}
+		if err := createPaymentActivity(tx, &paymentOrm, Activity_KIND_PAYMENT_DISPUTE_RESOLVED, fmt.Sprintf("%s,won_by=%s", disputeDetails, outcome)); err != nil {
+			return err
+		}
+		applied = true
+
+		if !buyerWon || paymentOrm.IsRenewal {
+			if err := restoreDisputedLicenseTx(tx, &paymentOrm, disputeDetails); err != nil {
+				return err
+			}
+		}
+		if buyerWon {
+			_, err := refundPaymentTx(tx, &paymentOrm, "dispute-"+disputeId, 0, resolvedAt)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &paymentOrm, applied, nil
+}
+
+// restoreDisputedLicenseTx lifts the revocation applied when the dispute was opened, other revocations are kept
+func restoreDisputedLicenseTx(tx *gorm.DB, paymentOrm *PaymentORM, disputeDetails string) error {
+	if paymentOrm.LicenseKeyId == nil {
+		return nil
+	}
+
+	revokedForDispute, err := paymentActivityExists(tx, paymentOrm.Id, Activity_KIND_LICENSE_REVOKED, disputeDetails)
+	if err != nil || !revokedForDispute {
+		return err
+	}
+
+	var licenseOrm LicenseKeyORM
+	if err := tx.Where(&LicenseKeyORM{Id: *paymentOrm.LicenseKeyId}).First(&licenseOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	if !licenseOrm.Revoked {
+		return nil
+	}
+
+	licenseOrm.Revoked = false
+	if err := tx.Save(&licenseOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	return createPaymentActivity(tx, paymentOrm, Activity_KIND_LICENSE_RESTORED, disputeDetails)
+}
+
+func revokeLicenseTx(tx *gorm.DB, licenseOrm *LicenseKeyORM, paymentOrm *PaymentORM, details string) error {
+	licenseOrm.Revoked = true
+	if err := tx.Save(licenseOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+	return createPaymentActivity(tx, paymentOrm, Activity_KIND_LICENSE_REVOKED, details)
+}
+
+func createPaymentActivity(tx *gorm.DB, paymentOrm *PaymentORM, kind Activity_Kind, details string) error {
+	activityOrm := &ActivityORM{
+		Kind:           int32(kind),
+		Details:        details,
+		UserId:         &paymentOrm.UserId,
+		LicenseKeyId:   paymentOrm.LicenseKeyId,
+		PaymentId:      &paymentOrm.Id,
+		SubscriptionId: paymentOrm.SubscriptionId,
+	}
+	return GormToErrcode(tx.Create(activityOrm).Error)
+}
+
+// paymentActivityExists checks if an activity of a payment matches a details pattern (SQL LIKE)
+func paymentActivityExists(tx *gorm.DB, paymentId int64, kind Activity_Kind, detailsPattern string) (bool, error) {
+	var count int64
+	err := tx.Model(&ActivityORM{}).
+		Where("payment_id = ? AND kind = ? AND details LIKE ?", paymentId, int32(kind), detailsPattern).
+		Count(&count).Error
+	if err != nil {
+		return false, GormToErrcode(err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,144 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// CreateRefundRequest records the refund request of a payment, a payment has at most one pending request
+func CreateRefundRequest(db *gorm.DB, paymentOrm *PaymentORM, reason string) (*RefundRequestORM, error) {
+	requestOrm := &RefundRequestORM{
+		Status:    int32(RefundRequest_STATUS_PENDING),
+		Reason:    reason,
+		PaymentId: paymentOrm.Id,
+		UserId:    paymentOrm.UserId,
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var pending int64
+		err := tx.Model(&RefundRequestORM{}).
+			Where(&RefundRequestORM{PaymentId: paymentOrm.Id, Status: int32(RefundRequest_STATUS_PENDING)}).
+			Count(&pending).Error
+		if err != nil {
+			return GormToErrcode(err)
+		}
+		if pending > 0 {
+			return errcode.ERR_REFUND_REQUEST_ALREADY_PENDING.Wrap(fmt.Errorf("payment %d", paymentOrm.Id))
+		}
+
+		if err := tx.Create(requestOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		details := fmt.Sprintf("refund_request_id=%d", requestOrm.Id)
+		return createPaymentActivity(tx, paymentOrm, Activity_KIND_REFUND_REQUESTED, details)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return requestOrm, nil
+}
+
+// GetRefundRequest finds a refund request with its payment and user
+func GetRefundRequest(db *gorm.DB, requestId int64) (*RefundRequestORM, error) {
+	var requestOrm RefundRequestORM
+	err := db.Preload("Payment").Preload("User").Where(&RefundRequestORM{Id: requestId}).First(&requestOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_REFUND_REQUEST_NOT_FOUND.Wrap(fmt.Errorf("%d", requestId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &requestOrm, nil
+}
+
+// ListRefundRequests returns the refund requests in a status with their payment and user, oldest first
+func ListRefundRequests(db *gorm.DB, status RefundRequest_Status, limit int) ([]*RefundRequestORM, error) {
+	var requestsOrm []*RefundRequestORM
+	err := db.Preload("Payment").Preload("User").
+		Where(&RefundRequestORM{Status: int32(status)}).
+		Order("created_at, id").
+		Limit(limit).
+		Find(&requestsOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return requestsOrm, nil
+}
+
+// ApproveRefundRequest records the provider refund of a pending request on its payment, see RefundPayment
+// The whole payment is refunded, which revokes the license of a purchase
+func ApproveRefundRequest(db *gorm.DB, requestId int64, refundId string, decidedBy string, note string, decidedAt time.Time) (*RefundRequestORM, *PaymentORM, error) {
+	var requestOrm RefundRequestORM
+	var paymentOrm PaymentORM
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := loadPendingRefundRequest(tx, requestId, &requestOrm); err != nil {
+			return err
+		}
+		if err := tx.Where(&PaymentORM{Id: requestOrm.PaymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		// The refund webhook may have recorded the refund already, it is then skipped
+		if _, err := refundPaymentTx(tx, &paymentOrm, refundId, 0, decidedAt); err != nil {
+			return err
+		}
+
+		requestOrm.RefundId = refundId
+		return decideRefundRequestTx(tx, &requestOrm, &paymentOrm, RefundRequest_STATUS_APPROVED, decidedBy, note, decidedAt)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &requestOrm, &paymentOrm, nil
+}
+
+// DenyRefundRequest closes a pending refund request without refund
+func DenyRefundRequest(db *gorm.DB, requestId int64, decidedBy string, note string, decidedAt time.Time) (*RefundRequestORM, error) {
+	var requestOrm RefundRequestORM
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := loadPendingRefundRequest(tx, requestId, &requestOrm); err != nil {
+			return err
+		}
+		var paymentOrm PaymentORM
+		if err := tx.Where(&PaymentORM{Id: requestOrm.PaymentId}).First(&paymentOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		return decideRefundRequestTx(tx, &requestOrm, &paymentOrm, RefundRequest_STATUS_DENIED, decidedBy, note, decidedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &requestOrm, nil
+}
+
+func loadPendingRefundRequest(tx *gorm.DB, requestId int64, requestOrm *RefundRequestORM) error {
+	if err := tx.Where(&RefundRequestORM{Id: requestId}).First(requestOrm).Error; err != nil {
+		if IsRecordNotFoundError(err) {
+			return errcode.ERR_REFUND_REQUEST_NOT_FOUND.Wrap(fmt.Errorf("%d", requestId))
+		}
+		return GormToErrcode(err)
+	}
+	if RefundRequest_Status(requestOrm.Status) != RefundRequest_STATUS_PENDING {
+		return errcode.ERR_REFUND_REQUEST_ALREADY_DECIDED.Wrap(fmt.Errorf("%d is %s", requestId, RefundRequest_Status(requestOrm.Status)))
+	}
+	return nil
+}
+
+func decideRefundRequestTx(tx *gorm.DB, requestOrm *RefundRequestORM, paymentOrm *PaymentORM, status RefundRequest_Status, decidedBy string, note string, decidedAt time.Time) error {
+	requestOrm.Status = int32(status)
+	requestOrm.DecidedBy = decidedBy
+	requestOrm.DecisionNote = note
+	requestOrm.DecidedAt = &decidedAt
+	if err := tx.Save(requestOrm).Error; err != nil {
+		return GormToErrcode(err)
+	}
+
+	kind := Activity_KIND_REFUND_REQUEST_DENIED
+	if status == RefundRequest_STATUS_APPROVED {
+		kind = Activity_KIND_REFUND_REQUEST_APPROVED
+	}
+	details := fmt.Sprintf("refund_request_id=%d,decided_by=%s", requestOrm.Id, decidedBy)
+	return createPaymentActivity(tx, paymentOrm, kind, details)
+}
@@ -0,0 +1,66 @@
+package rbdb
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RevenueTotal aggregates the payments of a provider in a currency
+type RevenueTotal struct {
+	Provider        int32
+	Currency        string
+	Payments        int32
+	GrossInCents    int64
+	RefundedInCents int64
+}
+
+// settledPaymentStatuses are the statuses of payments that brought money in, even if refunded afterwards
+var settledPaymentStatuses = []int32{
+	int32(Payment_STATUS_COMPLETED),
+	int32(Payment_STATUS_REFUNDED),
+	int32(Payment_STATUS_PARTIALLY_REFUNDED),
+}
+
+// GetRevenue sums the settled payments created in [from, to), zero times leave the range open
+// Failed and pending payments are not revenue, they can be counted with CountPaymentsByStatus
+// Currencies are grouped regardless of their case, rows stored before NormalizeCurrency may be upper case
+func GetRevenue(db *gorm.DB, from time.Time, to time.Time, includeSandbox bool) ([]*RevenueTotal, error) {
+	query := revenueQuery(db, from, to, includeSandbox).
+		Select("provider, LOWER(currency) AS currency, COUNT(*) AS payments, SUM(amount_in_cents) AS gross_in_cents, SUM(refunded_in_cents) AS refunded_in_cents").
+		Where("status IN ?", settledPaymentStatuses).
+		Group("provider, LOWER(currency)").
+		Order("provider, currency")
+
+	var totals []*RevenueTotal
+	if err := query.Scan(&totals).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return totals, nil
+}
+
+// CountPaymentsByStatus counts the payments created in [from, to) with a status, zero times leave the range open
+func CountPaymentsByStatus(db *gorm.DB, status Payment_Status, from time.Time, to time.Time, includeSandbox bool) (int64, error) {
+	var count int64
+	err := revenueQuery(db, from, to, includeSandbox).
+		Where("status = ?", int32(status)).
+		Count(&count).Error
+	if err != nil {
+		return 0, GormToErrcode(err)
+	}
+	return count, nil
+}
+
+func revenueQuery(db *gorm.DB, from time.Time, to time.Time, includeSandbox bool) *gorm.DB {
+	query := db.Model(&PaymentORM{})
+	if !from.IsZero() {
+		query = query.Where("created_at >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("created_at < ?", to)
+	}
+	if !includeSandbox {
+		query = query.Where("sandbox_mode = ?", false)
+	}
+	return query
+}
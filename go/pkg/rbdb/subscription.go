@@ -0,0 +1,172 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// GetSubscriptionByProviderID finds a subscription from its ID on the payment provider side
+func GetSubscriptionByProviderID(db *gorm.DB, provider Payment_Provider, providerSubscriptionId string) (*SubscriptionORM, error) {
+	var subscriptionOrm SubscriptionORM
+	err := db.Where(&SubscriptionORM{
+		Provider:               int32(provider),
+		ProviderSubscriptionId: providerSubscriptionId,
+	}).First(&subscriptionOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_SUBSCRIPTION_NOT_FOUND.Wrap(fmt.Errorf("%s subscription %s", provider, providerSubscriptionId))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &subscriptionOrm, nil
+}
+
+// UserHasActiveSubscription checks if a user has a subscription that is still billed
+func UserHasActiveSubscription(db *gorm.DB, userId int64) (bool, error) {
+	var count int64
+	err := db.Model(&SubscriptionORM{}).
+		Where("user_id = ? AND status IN ?", userId, []int32{
+			int32(Subscription_STATUS_ACTIVE),
+			int32(Subscription_STATUS_PAST_DUE),
+		}).
+		Count(&count).Error
+	if err != nil {
+		return false, GormToErrcode(err)
+	}
+	return count > 0, nil
+}
+
+// CreateSubscription records a new active subscription and links the payment of its first period
+func CreateSubscription(db *gorm.DB, subscriptionOrm *SubscriptionORM, paymentId int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		subscriptionOrm.Status = int32(Subscription_STATUS_ACTIVE)
+		if err := tx.Create(subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		if err := tx.Model(&PaymentORM{}).
+			Where("id = ?", paymentId).
+			Update("subscription_id", subscriptionOrm.Id).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		activityOrm := &ActivityORM{
+			Kind:           int32(Activity_KIND_SUBSCRIPTION_CREATED),
+			UserId:         &subscriptionOrm.UserId,
+			LicenseKeyId:   &subscriptionOrm.LicenseKeyId,
+			PaymentId:      &paymentId,
+			SubscriptionId: &subscriptionOrm.Id,
+		}
+		return GormToErrcode(tx.Create(activityOrm).Error)
+	})
+}
+
+// RenewSubscription extends the license of a subscription after a renewal payment
+// The payment must already be created, it gets linked to the subscription and the license
+func RenewSubscription(db *gorm.DB, subscriptionId int64, paymentId int64, periodStart time.Time, periodEnd time.Time) (*LicenseKey, error) {
+	var renewedLicense *LicenseKey
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var subscriptionOrm SubscriptionORM
+		if err := tx.Where(&SubscriptionORM{Id: subscriptionId}).First(&subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		if subscriptionOrm.Status == int32(Subscription_STATUS_CANCELED) {
+			return errcode.ERR_SUBSCRIPTION_ALREADY_CANCELED.Wrap(fmt.Errorf("subscription %d", subscriptionId))
+		}
+
+		if err := tx.Model(&PaymentORM{}).
+			Where("id = ?", paymentId).
+			Update("subscription_id", subscriptionOrm.Id).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		// Subscriptions renew before the license expires, so the renewal is forced
+		var err error
+		renewedLicense, err = RenewLicense(tx, subscriptionOrm.LicenseKeyId, subscriptionOrm.UserId, paymentId, true)
+		if err != nil {
+			return err
+		}
+
+		subscriptionOrm.Status = int32(Subscription_STATUS_ACTIVE)
+		subscriptionOrm.CurrentPeriodStart = &periodStart
+		subscriptionOrm.CurrentPeriodEnd = &periodEnd
+		if err := tx.Save(&subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		activityOrm := &ActivityORM{
+			Kind:           int32(Activity_KIND_SUBSCRIPTION_RENEWED),
+			UserId:         &subscriptionOrm.UserId,
+			LicenseKeyId:   &subscriptionOrm.LicenseKeyId,
+			PaymentId:      &paymentId,
+			SubscriptionId: &subscriptionOrm.Id,
+		}
+		return GormToErrcode(tx.Create(activityOrm).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return renewedLicense, nil
+}
+
+// MarkSubscriptionPaymentFailed flags a subscription whose renewal payment failed
+// The license is left untouched and expires on its own unless the provider retry succeeds
+func MarkSubscriptionPaymentFailed(db *gorm.DB, subscriptionId int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var subscriptionOrm SubscriptionORM
+		if err := tx.Where(&SubscriptionORM{Id: subscriptionId}).First(&subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		if subscriptionOrm.Status == int32(Subscription_STATUS_CANCELED) {
+			return nil
+		}
+
+		subscriptionOrm.Status = int32(Subscription_STATUS_PAST_DUE)
+		if err := tx.Save(&subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		activityOrm := &ActivityORM{
+			Kind:           int32(Activity_KIND_SUBSCRIPTION_PAYMENT_FAILED),
+			UserId:         &subscriptionOrm.UserId,
+			LicenseKeyId:   &subscriptionOrm.LicenseKeyId,
+			SubscriptionId: &subscriptionOrm.Id,
+		}
+		return GormToErrcode(tx.Create(activityOrm).Error)
+	})
+}
+
+// CancelSubscription marks a subscription as canceled, the license stays valid until the end of the paid period
+// Canceling an already canceled subscription returns ERR_SUBSCRIPTION_ALREADY_CANCELED
+func CancelSubscription(db *gorm.DB, subscriptionId int64, canceledAt time.Time) (*SubscriptionORM, error) {
+	var subscriptionOrm SubscriptionORM
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&SubscriptionORM{Id: subscriptionId}).First(&subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		if subscriptionOrm.Status == int32(Subscription_STATUS_CANCELED) {
+			return errcode.ERR_SUBSCRIPTION_ALREADY_CANCELED.Wrap(fmt.Errorf("subscription %d", subscriptionId))
+		}
+
+		subscriptionOrm.Status = int32(Subscription_STATUS_CANCELED)
+		subscriptionOrm.CanceledAt = &canceledAt
+		if err := tx.Save(&subscriptionOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+
+		activityOrm := &ActivityORM{
+			Kind:           int32(Activity_KIND_SUBSCRIPTION_CANCELED),
+			UserId:         &subscriptionOrm.UserId,
+			LicenseKeyId:   &subscriptionOrm.LicenseKeyId,
+			SubscriptionId: &subscriptionOrm.Id,
+		}
+		return GormToErrcode(tx.Create(activityOrm).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &subscriptionOrm, nil
+}
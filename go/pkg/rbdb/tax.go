@@ -0,0 +1,121 @@
+package rbdb
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// SetTaxRate creates or updates the VAT rate of a country
+func SetTaxRate(db *gorm.DB, countryCode string, rate float64) (*TaxRateORM, error) {
+	countryCode = strings.ToUpper(strings.TrimSpace(countryCode))
+	switch {
+	case len(countryCode) != 2:
+		return nil, errcode.ERR_PAYMENT_TAX_RATE_INVALID.Wrap(fmt.Errorf("invalid country code %q", countryCode))
+	case rate < 0 || rate >= 1 || math.IsNaN(rate):
+		return nil, errcode.ERR_PAYMENT_TAX_RATE_INVALID.Wrap(fmt.Errorf("invalid rate %v", rate))
+	}
+
+	var rateOrm TaxRateORM
+	err := db.Where(&TaxRateORM{CountryCode: countryCode}).First(&rateOrm).Error
+	if err != nil && !IsRecordNotFoundError(err) {
+		return nil, GormToErrcode(err)
+	}
+	rateOrm.CountryCode = countryCode
+	rateOrm.Rate = rate
+	if err := db.Save(&rateOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return &rateOrm, nil
+}
+
+// ListTaxRates returns the VAT rates of all the countries
+func ListTaxRates(db *gorm.DB) ([]*TaxRateORM, error) {
+	var ratesOrm []*TaxRateORM
+	if err := db.Order("country_code").Find(&ratesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return ratesOrm, nil
+}
+
+// GetTaxRate returns the VAT rate of a country, countries without rate are not taxed
+func GetTaxRate(db *gorm.DB, countryCode string) (float64, error) {
+	var rateOrm TaxRateORM
+	err := db.Where(&TaxRateORM{CountryCode: strings.ToUpper(countryCode)}).First(&rateOrm).Error
+	if err != nil {
+		if IsRecordNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, GormToErrcode(err)
+	}
+	return rateOrm.Rate, nil
+}
+
+// TaxIncluded returns the VAT part of a price including it
+func TaxIncluded(grossInCents int64, rate float64) int64 {
+	if rate <= 0 {
+		return 0
+	}
+	return grossInCents - int64(math.Round(float64(grossInCents)/(1+rate)))
+}
+
+// TaxTotal aggregates the taxes of the payments to a country in a currency
+type TaxTotal struct {
+	CountryCode        string
+	Currency           string
+	Payments           int32
+	GrossInCents       int64
+	TaxInCents         int64
+	RefundedInCents    int64
+	RefundedTaxInCents int64
+}
+
+// GetTaxReport sums the taxes of the settled payments created in [from, to)
+// The tax part of a refund is in proportion of the refunded amount
+func GetTaxReport(db *gorm.DB, from time.Time, to time.Time, includeSandbox bool) ([]*TaxTotal, error) {
+	var paymentsOrm []*PaymentORM
+	err := revenueQuery(db, from, to, includeSandbox).
+		Select("tax_country_code, currency, amount_in_cents, tax_in_cents, refunded_in_cents").
+		Where("status IN ?", settledPaymentStatuses).
+		Where("provider <> ?", int32(Payment_PROVIDER_MANUAL)).
+		Find(&paymentsOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+
+	byKey := map[string]*TaxTotal{}
+	for _, paymentOrm := range paymentsOrm {
+		currency := NormalizeCurrency(paymentOrm.Currency)
+		key := paymentOrm.TaxCountryCode + "/" + currency
+		total, ok := byKey[key]
+		if !ok {
+			total = &TaxTotal{CountryCode: paymentOrm.TaxCountryCode, Currency: currency}
+			byKey[key] = total
+		}
+		total.Payments++
+		total.GrossInCents += paymentOrm.AmountInCents
+		total.TaxInCents += paymentOrm.TaxInCents
+		total.RefundedInCents += paymentOrm.RefundedInCents
+		if paymentOrm.AmountInCents > 0 {
+			refundedTax := float64(paymentOrm.TaxInCents) * float64(paymentOrm.RefundedInCents) / float64(paymentOrm.AmountInCents)
+			total.RefundedTaxInCents += int64(math.Round(refundedTax))
+		}
+	}
+
+	totals := make([]*TaxTotal, 0, len(byKey))
+	for _, total := range byKey {
+		totals = append(totals, total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].CountryCode != totals[j].CountryCode {
+			return totals[i].CountryCode < totals[j].CountryCode
+		}
+		return totals[i].Currency < totals[j].Currency
+	})
+	return totals, nil
+}
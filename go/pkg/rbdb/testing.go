@@ -95,6 +95,7 @@ func TestingCreateTestPayment(t *testing.T, db *gorm.DB, user *User, duration Li
 		ReferenceId:     fmt.Sprintf("test-payment-%d", time.Now().UnixNano()),
 		AmountInCents:   900, // 9 euros in cents
 		Currency:        "eur",
+		Status:          Payment_STATUS_COMPLETED,
 		LicenseDuration: duration,
 		UserId:          user.Id,
 	}
@@ -35,3 +35,41 @@ func UserHasActiveLicense(db *gorm.DB, userId int64) (bool, error) {
 
 	return false, nil
 }
+
+// UsersWithActiveLicense returns every user owning at least one active (non-expired, non-revoked) license
+func UsersWithActiveLicense(db *gorm.DB) ([]*UserORM, error) {
+	var licensesOrm []*LicenseKeyORM
+	err := db.Where("revoked = ?", false).Find(&licensesOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+
+	activeUserIds := make([]int64, 0, len(licensesOrm))
+	seen := make(map[int64]bool, len(licensesOrm))
+	for _, licenseOrm := range licensesOrm {
+		if seen[licenseOrm.UserId] {
+			continue
+		}
+
+		licensePb, err := licenseOrm.ToPB(context.Background())
+		if err != nil {
+			continue // Skip this license if we can't convert it
+		}
+
+		if !IsLicenseExpired(&licensePb) {
+			seen[licenseOrm.UserId] = true
+			activeUserIds = append(activeUserIds, licenseOrm.UserId)
+		}
+	}
+
+	if len(activeUserIds) == 0 {
+		return []*UserORM{}, nil
+	}
+
+	var usersOrm []*UserORM
+	if err := db.Where("id IN ?", activeUserIds).Find(&usersOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+
+	return usersOrm, nil
+}
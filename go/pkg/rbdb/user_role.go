@@ -0,0 +1,83 @@
+package rbdb
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// GrantUserRole gives a role to a user, granting a role twice returns the first grant
+func GrantUserRole(db *gorm.DB, userId int64, role UserRole_Role, grantedBy string) (*UserRoleORM, error) {
+	var roleOrm UserRoleORM
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var userOrm UserORM
+		if err := tx.Where(&UserORM{Id: userId}).First(&userOrm).Error; err != nil {
+			if IsRecordNotFoundError(err) {
+				return errcode.ERR_USER_NOT_FOUND.Wrap(fmt.Errorf("%d", userId))
+			}
+			return GormToErrcode(err)
+		}
+
+		err := tx.Where(&UserRoleORM{UserId: userId, Role: int32(role)}).First(&roleOrm).Error
+		if err == nil {
+			return nil
+		}
+		if !IsRecordNotFoundError(err) {
+			return GormToErrcode(err)
+		}
+
+		roleOrm = UserRoleORM{UserId: userId, Role: int32(role), GrantedBy: grantedBy}
+		if err := tx.Create(&roleOrm).Error; err != nil {
+			return GormToErrcode(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &roleOrm, nil
+}
+
+// RevokeUserRole removes a role granted to a user
+func RevokeUserRole(db *gorm.DB, userId int64, role UserRole_Role) error {
+	result := db.Where("user_id = ? AND role = ?", userId, int32(role)).Delete(&UserRoleORM{})
+	if result.Error != nil {
+		return GormToErrcode(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errcode.ERR_ROLE_NOT_GRANTED.Wrap(fmt.Errorf("user %d doesn't have %s", userId, role))
+	}
+	return nil
+}
+
+// ListUserRoles returns the role grants with their user, of one user when userId is set
+func ListUserRoles(db *gorm.DB, userId int64) ([]*UserRoleORM, error) {
+	var rolesOrm []*UserRoleORM
+	query := db.Preload("User").Order("user_id, role")
+	if userId != 0 {
+		query = query.Where(&UserRoleORM{UserId: userId})
+	}
+	if err := query.Find(&rolesOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return rolesOrm, nil
+}
+
+// GetRolesByDiscourseId returns the roles granted to the user of a Discourse account
+func GetRolesByDiscourseId(db *gorm.DB, discourseId int64) ([]UserRole_Role, error) {
+	var roles []int32
+	err := db.Model(&UserRoleORM{}).
+		Joins("JOIN users ON users.id = user_roles.user_id").
+		Where("users.discourse_id = ?", discourseId).
+		Pluck("user_roles.role", &roles).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+
+	result := make([]UserRole_Role, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, UserRole_Role(role))
+	}
+	return result, nil
+}
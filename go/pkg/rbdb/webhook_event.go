@@ -0,0 +1,143 @@
+package rbdb
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"rslbot.com/go/pkg/errcode"
+)
+
+// ReceiveWebhookEvent stores a verified webhook event in the inbox before it is processed.
+// The event is created pending and leased until leaseUntil, so the retry worker leaves it to the caller.
+// It returns false if the event was already received, the stored event is then returned as is.
+func ReceiveWebhookEvent(db *gorm.DB, provider WebhookEvent_Provider, eventID string, eventType string, payload []byte, leaseUntil time.Time) (*WebhookEventORM, bool, error) {
+	eventOrm := WebhookEventORM{
+		Provider:      int32(provider),
+		EventId:       eventID,
+		EventType:     eventType,
+		Payload:       string(payload),
+		Status:        int32(WebhookEvent_STATUS_PENDING),
+		NextAttemptAt: &leaseUntil,
+	}
+
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&eventOrm)
+	if result.Error != nil {
+		return nil, false, GormToErrcode(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return &eventOrm, true, nil
+	}
+
+	var existingOrm WebhookEventORM
+	err := db.Where(&WebhookEventORM{Provider: int32(provider), EventId: eventID}).First(&existingOrm).Error
+	if err != nil {
+		return nil, false, GormToErrcode(err)
+	}
+	return &existingOrm, false, nil
+}
+
+// GetWebhookEvent loads an inbox event by ID
+func GetWebhookEvent(db *gorm.DB, id int64) (*WebhookEventORM, error) {
+	var eventOrm WebhookEventORM
+	if err := db.Where(&WebhookEventORM{Id: id}).First(&eventOrm).Error; err != nil {
+		if IsRecordNotFoundError(err) {
+			return nil, errcode.ERR_WEBHOOK_EVENT_NOT_FOUND.Wrap(fmt.Errorf("id %d", id))
+		}
+		return nil, GormToErrcode(err)
+	}
+	return &eventOrm, nil
+}
+
+// ListWebhookEvents returns the latest inbox events, optionally filtered by status and provider
+func ListWebhookEvents(db *gorm.DB, status WebhookEvent_Status, provider WebhookEvent_Provider, limit int) ([]*WebhookEventORM, error) {
+	query := db.Model(&WebhookEventORM{})
+	if status != WebhookEvent_STATUS_UNSPECIFIED {
+		query = query.Where("status = ?", int32(status))
+	}
+	if provider != WebhookEvent_PROVIDER_UNSPECIFIED {
+		query = query.Where("provider = ?", int32(provider))
+	}
+
+	var eventsOrm []*WebhookEventORM
+	if err := query.Order("created_at DESC").Limit(limit).Find(&eventsOrm).Error; err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return eventsOrm, nil
+}
+
+// ListDueWebhookEvents returns the pending events whose next attempt is due
+func ListDueWebhookEvents(db *gorm.DB, now time.Time, limit int) ([]*WebhookEventORM, error) {
+	var eventsOrm []*WebhookEventORM
+	err := db.
+		Where("status = ? AND next_attempt_at <= ?", int32(WebhookEvent_STATUS_PENDING), now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&eventsOrm).Error
+	if err != nil {
+		return nil, GormToErrcode(err)
+	}
+	return eventsOrm, nil
+}
+
+// ClaimWebhookEvent leases a due event until leaseUntil, so that concurrent workers do not process it twice
+// It returns false when another worker claimed the event first
+func ClaimWebhookEvent(db *gorm.DB, eventOrm *WebhookEventORM, leaseUntil time.Time) (bool, error) {
+	result := db.Model(&WebhookEventORM{}).
+		Where("id = ? AND status = ? AND next_attempt_at = ?", eventOrm.Id, int32(WebhookEvent_STATUS_PENDING), eventOrm.NextAttemptAt).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return false, GormToErrcode(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	eventOrm.NextAttemptAt = &leaseUntil
+	return true, nil
+}
+
+// MarkWebhookEventProcessed records a successful attempt
+func MarkWebhookEventProcessed(db *gorm.DB, eventOrm *WebhookEventORM, processedAt time.Time) error {
+	eventOrm.Status = int32(WebhookEvent_STATUS_PROCESSED)
+	eventOrm.Attempts++
+	eventOrm.LastError = ""
+	eventOrm.NextAttemptAt = nil
+	eventOrm.ProcessedAt = &processedAt
+	return GormToErrcode(db.Save(eventOrm).Error)
+}
+
+// MarkWebhookEventAttemptFailed records a failed attempt, the event is retried at retryAt
+// A zero retryAt gives up on the event, which stays failed until it is replayed
+func MarkWebhookEventAttemptFailed(db *gorm.DB, eventOrm *WebhookEventORM, lastError string, retryAt time.Time) error {
+	eventOrm.Attempts++
+	eventOrm.LastError = lastError
+	if retryAt.IsZero() {
+		eventOrm.Status = int32(WebhookEvent_STATUS_FAILED)
+		eventOrm.NextAttemptAt = nil
+	} else {
+		eventOrm.Status = int32(WebhookEvent_STATUS_PENDING)
+		eventOrm.NextAttemptAt = &retryAt
+	}
+	return GormToErrcode(db.Save(eventOrm).Error)
+}
+
+// ResetWebhookEvent puts an event back in the inbox for a replay leased until leaseUntil, with a fresh attempts count
+func ResetWebhookEvent(db *gorm.DB, eventOrm *WebhookEventORM, leaseUntil time.Time) error {
+	eventOrm.Status = int32(WebhookEvent_STATUS_PENDING)
+	eventOrm.Attempts = 0
+	eventOrm.NextAttemptAt = &leaseUntil
+	return GormToErrcode(db.Save(eventOrm).Error)
+}
+
+// backfillWebhookEventStatus marks the events recorded before the inbox existed as processed,
+// they were recorded in the transaction that processed them
+func backfillWebhookEventStatus(db *gorm.DB) error {
+	return db.Model(&WebhookEventORM{}).
+		Where("status = ?", int32(WebhookEvent_STATUS_UNSPECIFIED)).
+		Updates(map[string]interface{}{
+			"status":       int32(WebhookEvent_STATUS_PROCESSED),
+			"attempts":     1,
+			"processed_at": gorm.Expr("created_at"),
+		}).Error
+}
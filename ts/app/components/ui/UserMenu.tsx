@@ -48,9 +48,12 @@ export default function UserMenu() {
     }
   }, [])
 
-  const handleLoginClick = () => {
-    const loginURL = getDiscourseLoginURL()
-    window.location.href = loginURL
+  const handleLoginClick = async () => {
+    try {
+      window.location.href = await getDiscourseLoginURL()
+    } catch (error) {
+      console.error("Failed to start the login:", error)
+    }
   }
 
   const handleLicensesClick = () => {
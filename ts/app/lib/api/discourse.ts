@@ -1,30 +1,20 @@
 import type { DiscourseSSOUser } from "~/store/sessionStore"
-import CryptoJS from "crypto-js"
-import { API_CONFIG, getAppUrl } from "~/lib/utils/api"
+import { apiRequest, getAppUrl } from "~/lib/utils/api"
+
+interface StartSSOResponse {
+  ssoUrl: string
+  expiresAt: string
+}
 
 /**
- * Generates the URL for Discourse SSO authentication
+ * Starts a Discourse SSO login, the backend signs the request with a single use nonce
  */
-export function getDiscourseLoginURL(): string {
-  // Generate a nonce
-  const nonce = `nonce-${Date.now()}-${Math.random().toString(36).substring(2, 15)}`
-
-  // Define the return URL with proper protocol and host
-  const returnURL = getAppUrl("/auth/callback")
-
-  // Create the payload
-  const payload = `return_sso_url=${encodeURIComponent(returnURL)}&nonce=${encodeURIComponent(nonce)}`
-
-  // Base64 encode the payload
-  const base64Payload = btoa(payload)
-
-  // Create HMAC-SHA256 signature
-  const sig = CryptoJS.HmacSHA256(base64Payload, API_CONFIG.DISCOURSE_SECRET).toString(
-    CryptoJS.enc.Hex
-  )
-
-  // Return the complete SSO URL
-  return `${API_CONFIG.DISCOURSE_URL}/session/sso_provider?sso=${encodeURIComponent(base64Payload)}&sig=${encodeURIComponent(sig)}`
+export async function getDiscourseLoginURL(): Promise<string> {
+  const { ssoUrl } = await apiRequest<StartSSOResponse>("/auth/sso/start", {
+    method: "POST",
+    body: JSON.stringify({ returnSsoUrl: getAppUrl("/auth/callback") }),
+  })
+  return ssoUrl
 }
 
 /**
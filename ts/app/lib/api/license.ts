@@ -63,11 +63,11 @@ interface SetupAutoRenewalResponse {
 /**
  * Get all licenses for the authenticated user
  */
-export async function getUserLicenses(ssoToken: string): Promise<LicenseKey[]> {
+export async function getUserLicenses(accessToken: string): Promise<LicenseKey[]> {
   const response = await apiRequest<GetLicensesResponse>(
     "/user/licenses",
     { method: "GET" },
-    ssoToken
+    accessToken
   )
 
   return response.licenses
@@ -77,7 +77,7 @@ export async function getUserLicenses(ssoToken: string): Promise<LicenseKey[]> {
  * Setup auto-renewal for a license
  */
 export async function setupAutoRenewal(
-  ssoToken: string,
+  accessToken: string,
   licenseKeyId: string
 ): Promise<{ checkoutUrl?: string }> {
   const response = await apiRequest<SetupAutoRenewalResponse>(
@@ -88,7 +88,7 @@ export async function setupAutoRenewal(
         license_key_id: licenseKeyId,
       }),
     },
-    ssoToken
+    accessToken
   )
 
   return {
@@ -99,7 +99,7 @@ export async function setupAutoRenewal(
 /**
  * Cancel auto-renewal for a license
  */
-export async function cancelAutoRenewal(ssoToken: string, licenseKeyId: string): Promise<void> {
+export async function cancelAutoRenewal(accessToken: string, licenseKeyId: string): Promise<void> {
   await apiRequest(
     "/license/auto-renewal/cancel",
     {
@@ -108,6 +108,6 @@ export async function cancelAutoRenewal(ssoToken: string, licenseKeyId: string):
         license_key_id: licenseKeyId,
       }),
     },
-    ssoToken
+    accessToken
   )
 }
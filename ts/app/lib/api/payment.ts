@@ -14,7 +14,7 @@ interface CreateCheckoutParams {
  * Create a PayPal checkout session via the backend API
  */
 export async function createPayPalCheckout(
-  ssoToken: string,
+  accessToken: string,
   params: CreateCheckoutParams
 ): Promise<PayPalCheckoutResponse> {
   const response = await apiRequest<PayPalCheckoutResponse>(
@@ -26,7 +26,7 @@ export async function createPayPalCheckout(
         renewal_key_id: params.renewalKey || 0,
       }),
     },
-    ssoToken
+    accessToken
   )
 
   // Validate response
@@ -41,14 +41,31 @@ interface GetSessionResponse {
   licenseKeyId: number
 }
 
+interface ExchangeSSOResponse {
+  accessToken: string
+  accessTokenExpiresAt: string
+  refreshToken: string
+  refreshTokenExpiresAt: string
+}
+
+/**
+ * Exchange a Discourse SSO response for session tokens
+ */
+export async function exchangeSSO(sso: string, sig: string): Promise<ExchangeSSOResponse> {
+  return apiRequest<ExchangeSSOResponse>("/auth/sso", {
+    method: "POST",
+    body: JSON.stringify({ sso, sig }),
+  })
+}
+
 /**
  * Get current session information
  */
-export async function getUserSession(ssoToken: string): Promise<GetSessionResponse["user"]> {
+export async function getUserSession(accessToken: string): Promise<GetSessionResponse["user"]> {
   const response = await apiRequest<GetSessionResponse>(
     "/user/session",
     { method: "GET" },
-    ssoToken
+    accessToken
   )
 
   return response.user
@@ -57,11 +74,11 @@ export async function getUserSession(ssoToken: string): Promise<GetSessionRespon
 /**
  * Get all subscriptions for the authenticated user
  */
-export async function getUserSubscriptions(ssoToken: string): Promise<Subscription[]> {
+export async function getUserSubscriptions(accessToken: string): Promise<Subscription[]> {
   const response = await apiRequest<GetSubscriptionsResponse>(
     "/user/subscriptions",
     { method: "GET" },
-    ssoToken
+    accessToken
   )
 
   return response.subscriptions
@@ -70,6 +87,6 @@ export async function getUserSubscriptions(ssoToken: string): Promise<Subscripti
 /**
  * Log out the user using the backend API
  */
-export async function logoutUser(ssoToken: string): Promise<void> {
-  await apiRequest<LogoutResponse>("/user/logout", { method: "POST" }, ssoToken)
+export async function logoutUser(accessToken: string): Promise<void> {
+  await apiRequest<LogoutResponse>("/user/logout", { method: "POST" }, accessToken)
 }
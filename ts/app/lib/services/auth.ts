@@ -1,15 +1,12 @@
 import { parseDiscoursePayload } from "~/lib/api/discourse"
-import { getUserSession, logoutUser as logoutUserApi } from "~/lib/api/user"
+import { exchangeSSO, getUserSession, logoutUser as logoutUserApi } from "~/lib/api/user"
 import { useSessionStore } from "~/store/sessionStore"
-import type { DiscourseSSOUser } from "~/store/sessionStore"
+import type { DiscourseSSOUser, SessionTokens } from "~/store/sessionStore"
 
 /**
  * Handles the Discourse SSO login process
  */
 export async function handleDiscourseLogin(sso: string, sig: string): Promise<boolean> {
-  // Create the auth token
-  const token = `SSO_${sso}.${sig}`
-
   // Parse user info from SSO payload
   const discourseUser = parseDiscoursePayload(sso)
 
@@ -17,16 +14,15 @@ export async function handleDiscourseLogin(sso: string, sig: string): Promise<bo
     throw new Error("Invalid SSO payload")
   }
 
-  // Store the token and complete user info from Discourse
   const sessionStore = useSessionStore.getState()
-  sessionStore.setSession(discourseUser, token)
 
-  // Just validate the session with backend
+  // Exchange the SSO response for session tokens, the backend verifies it once
   try {
-    await getUserSession(token)
+    const { accessToken, accessTokenExpiresAt, refreshToken } = await exchangeSSO(sso, sig)
+    sessionStore.setSession(discourseUser, { accessToken, accessTokenExpiresAt, refreshToken })
     return true
   } catch (error) {
-    console.error("Error validating session with backend:", error)
+    console.error("Error exchanging SSO response with backend:", error)
     sessionStore.clearSession()
     return false
   }
@@ -37,17 +33,17 @@ export async function handleDiscourseLogin(sso: string, sig: string): Promise<bo
  */
 export async function checkExistingSession(): Promise<boolean> {
   const sessionStore = useSessionStore.getState()
-  const { isAuthenticated, ssoToken, discourseUser } = sessionStore
+  const { isAuthenticated, accessToken, discourseUser } = sessionStore
 
   // Check if we have a session and user data
-  if (!isAuthenticated || !ssoToken || !discourseUser) {
+  if (!isAuthenticated || !accessToken || !discourseUser) {
     sessionStore.clearSession()
     return false
   }
 
   try {
     // Just validate that the token is still valid with the backend
-    await getUserSession(ssoToken)
+    await getUserSession(accessToken)
     return true
   } catch (error) {
     console.error("Existing session is invalid:", error)
@@ -59,10 +55,13 @@ export async function checkExistingSession(): Promise<boolean> {
 /**
  * Initialize user session with provided user data
  */
-export function initializeUserSession(user: DiscourseSSOUser, token: string): DiscourseSSOUser {
+export function initializeUserSession(
+  user: DiscourseSSOUser,
+  tokens: SessionTokens
+): DiscourseSSOUser {
   try {
     const sessionStore = useSessionStore.getState()
-    sessionStore.setSession(user, token)
+    sessionStore.setSession(user, tokens)
     return user
   } catch (error) {
     let errorMessage = "Failed to initialize user session"
@@ -80,12 +79,12 @@ export function initializeUserSession(user: DiscourseSSOUser, token: string): Di
 export async function logout(): Promise<void> {
   try {
     const sessionStore = useSessionStore.getState()
-    const { ssoToken, discourseUser } = sessionStore
+    const { accessToken, discourseUser } = sessionStore
 
     // Only call the API if we have a token and user
-    if (ssoToken && discourseUser) {
+    if (accessToken && discourseUser) {
       try {
-        await logoutUserApi(ssoToken)
+        await logoutUserApi(accessToken)
       } catch (apiError) {
         // Log API errors but continue with local logout
         console.error("Error calling logout API:", apiError)
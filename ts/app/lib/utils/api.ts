@@ -1,3 +1,5 @@
+import { useSessionStore } from "~/store/sessionStore"
+
 /**
  * Environment configuration constants
  * These can be accessed anywhere in the application
@@ -8,9 +10,6 @@ export const API_CONFIG = {
     process.env.NODE_ENV === "development" ? "http://localhost:8080" : "https://api.rslbot.com",
 
   DISCOURSE_URL: "https://community.rslbot.com",
-
-  // Discourse secret key (should be in env vars in production)
-  DISCOURSE_SECRET: "y<6aS~lI@bPMZSweDnonEb4.pGPJl",
 }
 
 /**
@@ -33,13 +32,6 @@ export function getAppUrl(path = "/"): string {
   return `${baseUrl}${path.startsWith("/") ? path : `/${path}`}`
 }
 
-/**
- * Format a token for use in authorization headers
- */
-export function formatAuthToken(token: string): string {
-  return token.startsWith("SSO_") ? token : `SSO_${token}`
-}
-
 /**
  * Create authorization headers with the given token
  */
@@ -49,7 +41,7 @@ export function createAuthHeaders(token: string | null): HeadersInit {
   }
 
   if (token) {
-    headers.Authorization = `Bearer ${formatAuthToken(token)}`
+    headers.Authorization = `Bearer ${token}`
   }
 
   return headers
@@ -66,7 +58,7 @@ export async function apiRequest<T>(
   const url = `${API_CONFIG.API_BASE_URL}${endpoint.startsWith("/") ? endpoint : `/${endpoint}`}`
 
   // Add authorization headers if token is provided
-  const headers = createAuthHeaders(token)
+  const headers = createAuthHeaders(token ? await freshAccessToken(token) : null)
 
   try {
     const response = await fetch(url, {
@@ -102,3 +94,49 @@ export async function apiRequest<T>(
     throw error
   }
 }
+
+interface RefreshSessionResponse {
+  accessToken: string
+  accessTokenExpiresAt: string
+  refreshToken: string
+}
+
+// Access tokens are refreshed when they expire within this margin
+const ACCESS_TOKEN_REFRESH_MARGIN_MS = 60 * 1000
+
+let pendingRefresh: Promise<string> | null = null
+
+/**
+ * Returns the session access token to send instead of token, refreshing it when it is about to expire.
+ * Concurrent requests share the same refresh, the refresh token works only once.
+ */
+async function freshAccessToken(token: string): Promise<string> {
+  const { accessToken, accessTokenExpiresAt, refreshToken } = useSessionStore.getState()
+  if (token !== accessToken || !refreshToken || !accessTokenExpiresAt) {
+    return token
+  }
+  if (Date.parse(accessTokenExpiresAt) - Date.now() > ACCESS_TOKEN_REFRESH_MARGIN_MS) {
+    return token
+  }
+
+  pendingRefresh ??= refreshSession(refreshToken).finally(() => {
+    pendingRefresh = null
+  })
+  return pendingRefresh
+}
+
+async function refreshSession(refreshToken: string): Promise<string> {
+  const sessionStore = useSessionStore.getState()
+  try {
+    const tokens = await apiRequest<RefreshSessionResponse>("/auth/refresh", {
+      method: "POST",
+      body: JSON.stringify({ refreshToken }),
+    })
+    sessionStore.setTokens(tokens)
+    return tokens.accessToken
+  } catch (error) {
+    // The session was revoked or expired, the user has to log in again
+    sessionStore.clearSession()
+    throw error
+  }
+}
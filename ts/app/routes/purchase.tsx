@@ -24,12 +24,12 @@ export default function PurchasePage() {
   const [isSubmitting, setIsSubmitting] = useState(false)
   const [error, setError] = useState<string | null>(null)
 
-  const { isAuthenticated, ssoToken } = useSessionStore()
+  const { isAuthenticated, accessToken } = useSessionStore()
 
   const handleCheckout = async (e: React.FormEvent) => {
     e.preventDefault()
 
-    if (!isAuthenticated || !ssoToken) {
+    if (!isAuthenticated || !accessToken) {
       setError("You must be logged in to make a purchase.")
       return
     }
@@ -39,7 +39,7 @@ export default function PurchasePage() {
       setError(null)
 
       // Use PayPal checkout
-      const checkoutData = await createPayPalCheckout(ssoToken, {
+      const checkoutData = await createPayPalCheckout(accessToken, {
         licenseDuration: selectedDuration,
       })
 
@@ -12,16 +12,25 @@ export interface DiscourseSSOUser {
   admin?: boolean
 }
 
+// Session tokens issued by the backend in exchange for a Discourse SSO response
+export interface SessionTokens {
+  accessToken: string
+  accessTokenExpiresAt: string
+  refreshToken: string
+}
+
 interface SessionState {
   discourseUser: DiscourseSSOUser | null
-  ssoToken: string | null
+  accessToken: string | null
+  accessTokenExpiresAt: string | null
+  refreshToken: string | null
   isAuthenticated: boolean
   loading: boolean
   error: string | null
 
   // Actions
-  setSession: (user: DiscourseSSOUser, token: string) => void
-  setToken: (token: string) => void
+  setSession: (user: DiscourseSSOUser, tokens: SessionTokens) => void
+  setTokens: (tokens: SessionTokens) => void
   setLoading: () => void
   setError: (error: string) => void
   clearSession: () => void
@@ -32,25 +41,27 @@ export const useSessionStore = create<SessionState>()(
   persist(
     (set, get) => ({
       discourseUser: null,
-      ssoToken: null,
+      accessToken: null,
+      accessTokenExpiresAt: null,
+      refreshToken: null,
       isAuthenticated: false,
       loading: false,
       error: null,
 
-      // Set user and token after successful authentication
-      setSession: (user, token) =>
+      // Set user and tokens after successful authentication
+      setSession: (user, tokens) =>
         set({
           discourseUser: user,
-          ssoToken: token,
+          ...tokens,
           isAuthenticated: true,
           loading: false,
           error: null,
         }),
 
-      // Set just the token
-      setToken: (token) =>
+      // Set just the tokens, after a refresh
+      setTokens: (tokens) =>
         set({
-          ssoToken: token,
+          ...tokens,
         }),
 
       // Start loading state
@@ -71,7 +82,9 @@ export const useSessionStore = create<SessionState>()(
       clearSession: () =>
         set({
           discourseUser: null,
-          ssoToken: null,
+          accessToken: null,
+          accessTokenExpiresAt: null,
+          refreshToken: null,
           isAuthenticated: false,
           loading: false,
           error: null,
@@ -79,16 +92,20 @@ export const useSessionStore = create<SessionState>()(
 
       // Check if the current session is valid
       checkSessionValidity: () => {
-        const { discourseUser, ssoToken, isAuthenticated } = get()
-        return isAuthenticated && !!discourseUser && !!ssoToken
+        const { discourseUser, accessToken, isAuthenticated } = get()
+        return isAuthenticated && !!discourseUser && !!accessToken
       },
     }),
     {
       name: "session-storage",
+      // Version 0 stored the raw SSO token, which the backend no longer accepts
+      version: 1,
       storage: createJSONStorage(() => localStorage),
       partialize: (state) => ({
         discourseUser: state.discourseUser,
-        ssoToken: state.ssoToken,
+        accessToken: state.accessToken,
+        accessTokenExpiresAt: state.accessTokenExpiresAt,
+        refreshToken: state.refreshToken,
         isAuthenticated: state.isAuthenticated,
       }),
     }